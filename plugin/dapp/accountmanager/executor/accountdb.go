@@ -426,6 +426,16 @@ func findAccountByAddr(localdb dbm.KV, addr string) (*et.Account, error) {
 	return nil, types.ErrNotFound
 }
 
+// FindAccountByAddr 根据地址查询账户信息，供其它执行器校验账户状态
+func FindAccountByAddr(localdb dbm.KV, addr string) (*et.Account, error) {
+	return findAccountByAddr(localdb, addr)
+}
+
+// GetManagerAddr 获取管理员地址，供其它执行器校验管理员权限
+func GetManagerAddr(cfg *types.Chain33Config, db dbm.KV) string {
+	return getManagerAddr(cfg, db, ConfNameManagerAddr, DefaultManagerAddr)
+}
+
 func findAccountListByStatus(localdb dbm.KV, status, direction int32, primaryKey string) (*et.ReplyAccountList, error) {
 	if status == et.Expired {
 		return findAccountListByIndex(localdb, time.Now().Unix(), primaryKey)
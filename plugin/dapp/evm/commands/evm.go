@@ -7,6 +7,7 @@ package commands
 import (
 	"encoding/hex"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"time"
@@ -44,8 +45,10 @@ func EvmCmd() *cobra.Command {
 		createContractCmd(),
 		callContractCmd(),
 		abiCmd(),
+		verifyCmd(),
 		estimateContractCmd(),
 		checkContractAddrCmd(),
+		storageCmd(),
 		evmDebugCmd(),
 		evmTransferCmd(),
 		evmWithdrawCmd(),
@@ -410,6 +413,18 @@ func callContract(cmd *cobra.Command, args []string) {
 	}
 
 	action := evmtypes.EVMContractAction{Amount: amountInt64, Code: bCode, GasLimit: 0, GasPrice: 0, Note: note, Abi: abi}
+	// 代理其它账户调用合约
+	delegateFrom, _ := cmd.Flags().GetString("delegate_from")
+	if delegateFrom != "" {
+		delegateTy, _ := cmd.Flags().GetInt32("delegate_ty")
+		delegateTxid, _ := cmd.Flags().GetUint64("delegate_txid")
+		action.Delegate = &evmtypes.EVMDelegateCall{From: delegateFrom, Ty: delegateTy, MultiSigTxId: delegateTxid}
+		// 多重签名账户代理调用时，只输出需要通过multisig合约提交的payload
+		if payload, _ := cmd.Flags().GetBool("delegate_payload"); payload {
+			fmt.Println(common.ToHex(evmtypes.DelegatePayload(toAddr, &action)))
+			return
+		}
+	}
 
 	//name表示发给哪个执行器
 	data, err := createEvmTx(cfg, &action, name, caller, toAddr, expire, rpcLaddr, feeInt64)
@@ -435,6 +450,11 @@ func addCallContractFlags(cmd *cobra.Command) {
 	cmd.Flags().Float64P("amount", "a", 0, "the amount transfer to the contract (optional)")
 
 	cmd.Flags().StringP("abi", "b", "", "call with abi")
+
+	cmd.Flags().StringP("delegate_from", "", "", "call on behalf of the address (optional)")
+	cmd.Flags().Int32P("delegate_ty", "", evmtypes.DelegateMultiSig, "delegate type, 1: accountmanager manager, 2: multisig owners")
+	cmd.Flags().Uint64P("delegate_txid", "", 0, "txid of the executed multisig submit tx (optional)")
+	cmd.Flags().BoolP("delegate_payload", "", false, "print the payload to submit by multisig owners instead of sending the tx (optional)")
 }
 
 func addCommonFlags(cmd *cobra.Command) {
@@ -491,6 +511,147 @@ func getAbi(cmd *cobra.Command, args []string) {
 	}
 }
 
+// 合约源码验证命令
+func verifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "EVM contract source verification commands",
+		Args:  cobra.MinimumNArgs(1),
+	}
+
+	cmd.AddCommand(
+		submitVerifyCmd(),
+		getVerifyCmd(),
+	)
+	return cmd
+}
+
+func submitVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Compile the source and register the verification of evm contract",
+		Run:   submitVerify,
+	}
+	addSubmitVerifyFlags(cmd)
+	return cmd
+}
+
+func addSubmitVerifyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("exec", "e", "", "evm contract name, like user.evm.xxxxx")
+	cmd.MarkFlagRequired("exec")
+
+	cmd.Flags().StringP("caller", "c", "", "the contract creator address")
+	cmd.MarkFlagRequired("caller")
+
+	cmd.Flags().StringP("sol", "", "", "sol file path")
+	cmd.MarkFlagRequired("sol")
+	cmd.Flags().StringP("solc", "", "solc", "solc compiler")
+	cmd.Flags().StringP("contract", "", "", "contract name in the sol file (optional if only one contract)")
+
+	cmd.Flags().StringP("expire", "p", "120s", "transaction expire time (optional)")
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee (optional)")
+}
+
+func submitVerify(cmd *cobra.Command, args []string) {
+	title, _ := cmd.Flags().GetString("title")
+	cfg := types.GetCliSysParam(title)
+
+	name, _ := cmd.Flags().GetString("exec")
+	caller, _ := cmd.Flags().GetString("caller")
+	sol, _ := cmd.Flags().GetString("sol")
+	solc, _ := cmd.Flags().GetString("solc")
+	contractName, _ := cmd.Flags().GetString("contract")
+	expire, _ := cmd.Flags().GetString("expire")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+
+	feeInt64 := uint64(fee*1e4) * 1e4
+
+	source, err := ioutil.ReadFile(sol)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "read sol file error", err)
+		return
+	}
+	contracts, err := compiler.CompileSolidity(solc, sol)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Failed to build Solidity contract", err)
+		return
+	}
+	var contract *compiler.Contract
+	for key, c := range contracts {
+		if contractName == "" || strings.HasSuffix(key, ":"+contractName) {
+			if contract != nil {
+				fmt.Fprintln(os.Stderr, "There are too many contracts in the sol file, please specify --contract.")
+				return
+			}
+			contract = c
+		}
+	}
+	if contract == nil {
+		fmt.Fprintln(os.Stderr, "Contract is not found in the sol file.")
+		return
+	}
+
+	runtimeCode, err := common.FromHex(contract.RuntimeCode)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse evm runtime code error", err)
+		return
+	}
+	abi, _ := json.Marshal(contract.Info.AbiDefinition)
+	verify := &evmtypes.EVMContractVerify{
+		CompilerVersion: contract.Info.CompilerVersion,
+		Settings:        contract.Info.CompilerOptions,
+		SourceHash:      common.ToHex(common.Sha256(source)),
+		Abi:             string(abi),
+		RuntimeCode:     runtimeCode,
+	}
+	action := evmtypes.EVMContractAction{Verify: verify}
+
+	data, err := createEvmTx(cfg, &action, name, caller, address.ExecAddress(name), expire, rpcLaddr, feeInt64)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "verify contract error", err)
+		return
+	}
+
+	params := rpctypes.RawParm{
+		Data: data,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.SendTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func getVerifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "get the source verification of evm contract",
+		Run:   getVerify,
+	}
+
+	cmd.Flags().StringP("address", "a", "", "evm contract address")
+	cmd.MarkFlagRequired("address")
+
+	return cmd
+}
+
+func getVerify(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("address")
+
+	var req = evmtypes.EvmQueryVerifyReq{Address: addr}
+	var resp evmtypes.EVMContractVerifyInfo
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	query := sendQuery(rpcLaddr, "GetContractVerify", &req, &resp)
+
+	if query {
+		data, err := json.MarshalIndent(&resp, "", "  ")
+		if err != nil {
+			fmt.Println(resp.String())
+		} else {
+			fmt.Println(string(data))
+		}
+	}
+}
+
 func callAbiCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "call",
@@ -633,7 +794,8 @@ func evmDebugCmd() *cobra.Command {
 	cmd.AddCommand(
 		evmDebugQueryCmd(),
 		evmDebugSetCmd(),
-		evmDebugClearCmd())
+		evmDebugClearCmd(),
+		evmDebugTraceCmd())
 
 	return cmd
 }
@@ -684,6 +846,126 @@ func evmDebugRPC(cmd *cobra.Command, flag int32) {
 	}
 }
 
+// 重放历史交易，跟踪合约执行过程
+func evmDebugTraceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace",
+		Short: "Replay a transaction and trace the contract execution",
+		Run:   evmDebugTrace,
+	}
+	addEvmDebugTraceFlags(cmd)
+	return cmd
+}
+
+func addEvmDebugTraceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("hash", "s", "", "transaction hash")
+	cmd.MarkFlagRequired("hash")
+	cmd.Flags().StringP("tracer", "t", "callTracer", "tracer type, callTracer or structLogger")
+	cmd.Flags().BoolP("disable_stack", "", false, "do not capture stack in structLogger")
+	cmd.Flags().BoolP("disable_memory", "", false, "do not capture memory in structLogger")
+	cmd.Flags().BoolP("disable_storage", "", false, "do not capture storage in structLogger")
+	cmd.Flags().Int32P("limit", "l", 0, "max count of struct logs, 0 means default")
+}
+
+func evmDebugTrace(cmd *cobra.Command, args []string) {
+	hash, _ := cmd.Flags().GetString("hash")
+	tracer, _ := cmd.Flags().GetString("tracer")
+	disableStack, _ := cmd.Flags().GetBool("disable_stack")
+	disableMemory, _ := cmd.Flags().GetBool("disable_memory")
+	disableStorage, _ := cmd.Flags().GetBool("disable_storage")
+	limit, _ := cmd.Flags().GetInt32("limit")
+
+	var req = evmtypes.ReqEVMTrace{Hash: hash, Tracer: tracer, DisableStack: disableStack, DisableMemory: disableMemory, DisableStorage: disableStorage, Limit: limit}
+	var resp evmtypes.ReplyEVMTrace
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	query := sendQuery(rpcLaddr, "TraceTransaction", &req, &resp)
+
+	if query {
+		data, err := json.MarshalIndent(&resp, "", "  ")
+		if err != nil {
+			fmt.Println(resp.String())
+		} else {
+			fmt.Println(string(data))
+		}
+	}
+}
+
+// 查询合约存储数据
+func storageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage",
+		Short: "Query contract storage",
+		Args:  cobra.MinimumNArgs(1),
+	}
+	cmd.AddCommand(
+		storageGetCmd(),
+		storageDumpCmd(),
+	)
+	return cmd
+}
+
+func storageGetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "get",
+		Short: "Get contract storage at the slot",
+		Run:   storageGet,
+	}
+	cmd.Flags().StringP("addr", "a", "", "contract address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("slot", "s", "", "storage slot in hex")
+	cmd.MarkFlagRequired("slot")
+	cmd.Flags().Int64P("height", "t", 0, "block height, 0 means the latest")
+	return cmd
+}
+
+func storageGet(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	slot, _ := cmd.Flags().GetString("slot")
+	height, _ := cmd.Flags().GetInt64("height")
+
+	var req = evmtypes.ReqEVMGetStorageAt{Address: addr, Slot: slot, Height: height}
+	var resp evmtypes.EVMStorageItem
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	query := sendQuery(rpcLaddr, "GetStorageAt", &req, &resp)
+
+	if query {
+		proto.MarshalText(os.Stdout, &resp)
+	}
+}
+
+func storageDumpCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dump",
+		Short: "Dump the latest contract storage by page",
+		Run:   storageDump,
+	}
+	cmd.Flags().StringP("addr", "a", "", "contract address")
+	cmd.MarkFlagRequired("addr")
+	cmd.Flags().StringP("primary", "p", "", "last slot of the previous page")
+	cmd.Flags().Int32P("count", "c", 0, "count of storage items, 0 means default")
+	return cmd
+}
+
+func storageDump(cmd *cobra.Command, args []string) {
+	addr, _ := cmd.Flags().GetString("addr")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	var req = evmtypes.ReqEVMDumpStorage{Address: addr, PrimaryKey: primary, Count: count}
+	var resp evmtypes.ReplyEVMDumpStorage
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	query := sendQuery(rpcLaddr, "DumpStorage", &req, &resp)
+
+	if query {
+		data, err := json.MarshalIndent(&resp, "", "  ")
+		if err != nil {
+			fmt.Println(resp.String())
+		} else {
+			fmt.Println(string(data))
+		}
+	}
+}
+
 // 向EVM合约地址转账
 func evmTransferCmd() *cobra.Command {
 	cmd := &cobra.Command{
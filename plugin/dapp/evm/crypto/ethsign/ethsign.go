@@ -0,0 +1,106 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package ethsign 以太坊格式交易的签名验证
+// 签名数据为以太坊钱包签名的原始交易，只用于验签，不支持生成密钥和签名
+package ethsign
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/crypto"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrNotSupport 以太坊交易由以太坊钱包签名
+var ErrNotSupport = errors.New("ErrNotSupport")
+
+func init() {
+	crypto.Register(evmtypes.EthSignName, &Driver{}, false)
+}
+
+// Driver 以太坊交易签名驱动
+type Driver struct{}
+
+// GenKey 不支持
+func (d Driver) GenKey() (crypto.PrivKey, error) {
+	return nil, ErrNotSupport
+}
+
+// PrivKeyFromBytes 不支持
+func (d Driver) PrivKeyFromBytes(b []byte) (crypto.PrivKey, error) {
+	return nil, ErrNotSupport
+}
+
+// PubKeyFromBytes 压缩格式的secp256k1公钥
+func (d Driver) PubKeyFromBytes(b []byte) (crypto.PubKey, error) {
+	if _, err := ethcrypto.DecompressPubkey(b); err != nil {
+		return nil, err
+	}
+	return PubKeyEth(common.CopyBytes(b)), nil
+}
+
+// SignatureFromBytes 以太坊原始交易
+func (d Driver) SignatureFromBytes(b []byte) (crypto.Signature, error) {
+	return SignatureEth(common.CopyBytes(b)), nil
+}
+
+// PubKeyEth 公钥
+type PubKeyEth []byte
+
+// Bytes 字节格式
+func (pubKey PubKeyEth) Bytes() []byte {
+	return common.CopyBytes(pubKey)
+}
+
+// VerifyBytes 校验以太坊签名和交易内容
+func (pubKey PubKeyEth) VerifyBytes(msg []byte, sig crypto.Signature) bool {
+	raw, ok := sig.(SignatureEth)
+	if !ok {
+		return false
+	}
+	return evmtypes.VerifyEthTx(msg, pubKey, raw)
+}
+
+// KeyString 公钥字符串格式
+func (pubKey PubKeyEth) KeyString() string {
+	return common.ToHex(pubKey)
+}
+
+// Equals 公钥是否相同
+func (pubKey PubKeyEth) Equals(other crypto.PubKey) bool {
+	if otherEth, ok := other.(PubKeyEth); ok {
+		return bytes.Equal(pubKey, otherEth)
+	}
+	return false
+}
+
+// SignatureEth 签名，即以太坊原始交易
+type SignatureEth []byte
+
+// Bytes 字节格式
+func (sig SignatureEth) Bytes() []byte {
+	return common.CopyBytes(sig)
+}
+
+// IsZero 是否为空
+func (sig SignatureEth) IsZero() bool {
+	return len(sig) == 0
+}
+
+// String 字符串格式
+func (sig SignatureEth) String() string {
+	return common.ToHex(sig)
+}
+
+// Equals 签名是否相同
+func (sig SignatureEth) Equals(other crypto.Signature) bool {
+	if otherEth, ok := other.(SignatureEth); ok {
+		return bytes.Equal(sig, otherEth)
+	}
+	return false
+}
@@ -0,0 +1,94 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ethsign
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signEthTx(t *testing.T, etx *ethtypes.Transaction, chainID int64) []byte {
+	return signEthTxWith(t, etx, ethtypes.NewEIP155Signer(big.NewInt(chainID)))
+}
+
+const ethTestKey = "cc38546e9e659d15e6b4893f0ab32a06d103931a8230b0bde71459d2b27d6944"
+
+func signEthTxWith(t *testing.T, etx *ethtypes.Transaction, signer ethtypes.Signer) []byte {
+	key, err := ethcrypto.HexToECDSA(ethTestKey)
+	require.Nil(t, err)
+	signed, err := ethtypes.SignTx(etx, signer, key)
+	require.Nil(t, err)
+	raw, err := rlp.EncodeToBytes(signed)
+	require.Nil(t, err)
+	return raw
+}
+
+func TestEthTxCheckSign(t *testing.T) {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"local\"\nChainID=33", 1))
+	execer := cfg.ExecName(evmtypes.ExecutorName)
+	chainID := evmtypes.EthChainID(cfg.GetChainID())
+	to := ethcommon.HexToAddress("0x2ba4ed4ad6bb5fd1c2df3c0a0a6c1f7e2d3e8b2c")
+	etx := ethtypes.NewTransaction(5, to, big.NewInt(3e10), 200000, big.NewInt(2e10), []byte{0x01, 0x02})
+	raw := signEthTx(t, etx, chainID)
+
+	tx, err := evmtypes.EthTxToChain33(raw, execer, cfg.GetChainID())
+	require.Nil(t, err)
+	assert.Equal(t, int64(400000), tx.Fee)
+	assert.Equal(t, int64(5), tx.Nonce)
+	assert.Equal(t, evmtypes.EthAddrToChain33(to.Bytes()), tx.To)
+	var action evmtypes.EVMContractAction
+	require.Nil(t, types.Decode(tx.Payload, &action))
+	assert.Equal(t, uint64(3), action.Amount)
+	assert.Equal(t, uint64(200000), action.GasLimit)
+	assert.Equal(t, uint32(2), action.GasPrice)
+
+	// 交易费从签名公钥按chain33规则生成的地址扣除，合约中的调用者为以太坊钱包中的地址
+	assert.Equal(t, address.PubKeyToAddr(tx.Signature.Pubkey), tx.From())
+	key, err := ethcrypto.HexToECDSA(ethTestKey)
+	require.Nil(t, err)
+	assert.Equal(t, evmtypes.EthAddrToChain33(ethcrypto.PubkeyToAddress(key.PublicKey).Bytes()), evmtypes.TxSender(tx))
+	assert.True(t, tx.CheckSign())
+
+	// 修改交易内容后验签失败
+	tx.Fee++
+	assert.False(t, tx.CheckSign())
+	tx.Fee--
+	tx.Execer = []byte("user.evm.test")
+	assert.False(t, tx.CheckSign())
+
+	// 创建合约的交易目标地址为evm执行器地址
+	create := ethtypes.NewContractCreation(0, big.NewInt(0), 100000, big.NewInt(1e10), []byte{0x60, 0x80})
+	tx, err = evmtypes.EthTxToChain33(signEthTx(t, create, chainID), execer, cfg.GetChainID())
+	require.Nil(t, err)
+	assert.Equal(t, address.ExecAddress(execer), tx.To)
+	assert.True(t, tx.CheckSign())
+
+	// 金额不能转换为chain33金额单位
+	bad := ethtypes.NewTransaction(0, to, big.NewInt(1), 100000, big.NewInt(1e10), nil)
+	_, err = evmtypes.EthTxToChain33(signEthTx(t, bad, chainID), execer, cfg.GetChainID())
+	assert.Equal(t, evmtypes.ErrEthTxAmount, err)
+
+	// 只接受链ID一致的EIP-155签名
+	_, err = evmtypes.EthTxToChain33(signEthTx(t, etx, chainID+1), execer, cfg.GetChainID())
+	assert.Equal(t, evmtypes.ErrEthTxChainID, err)
+	_, err = evmtypes.EthTxToChain33(signEthTxWith(t, etx, ethtypes.HomesteadSigner{}), execer, cfg.GetChainID())
+	assert.Equal(t, evmtypes.ErrEthTxChainID, err)
+	// 交易中的chainID修改后验签失败
+	tx, err = evmtypes.EthTxToChain33(raw, execer, cfg.GetChainID())
+	require.Nil(t, err)
+	tx.ChainID++
+	assert.False(t, tx.CheckSign())
+}
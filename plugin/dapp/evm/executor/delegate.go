@@ -0,0 +1,65 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 代理调用合约
+// EVM只认交易的发送者，accountmanager管理的账户和多重签名账户没有办法直接调用合约，
+// 代理调用由有权限的账户发起，合约中的调用者为被代理的账户，手续费由交易发送者支付：
+// accountmanager管理员可以代理状态正常并且没有过期的账户；
+// 多重签名账户的所有者可以代理多重签名账户，需要先在multisig合约中用MultiSigSubmitTx提交evmtypes.DelegatePayload，
+// 权重满足并且过了执行延迟之后，交易发送者携带此txid代理调用，每个txid只能使用一次；
+// 因此代理调用始终需要多重签名账户要求的权重和执行延迟，不使用每日限额，也包括通过ERC20预编译合约转出token。
+// 代理调用不能从被代理的账户转出金额
+
+import (
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	amexec "github.com/33cn/plugin/plugin/dapp/accountmanager/executor"
+	amtypes "github.com/33cn/plugin/plugin/dapp/accountmanager/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	msexec "github.com/33cn/plugin/plugin/dapp/multisig/executor"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
+)
+
+// 校验交易发送者是否有权代理调用，返回需要写入状态数据库的数据
+func (evm *EVMExecutor) checkDelegate(tx *types.Transaction, index int, action *evmtypes.EVMContractAction) ([]*types.KeyValue, error) {
+	cfg := evm.GetAPI().GetConfig()
+	if !cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMDelegate) {
+		return nil, types.ErrActionNotSupport
+	}
+	delegate := action.Delegate
+	if action.Amount > 0 {
+		return nil, types.ErrAmount
+	}
+	if delegate.From == tx.From() {
+		return nil, types.ErrInvalidParam
+	}
+
+	switch delegate.Ty {
+	case evmtypes.DelegateAccountManager:
+		if tx.From() != amexec.GetManagerAddr(cfg, evm.GetStateDB()) {
+			return nil, model.ErrDelegateNotAuthorized
+		}
+		account, err := amexec.FindAccountByAddr(evm.GetLocalDB(), delegate.From)
+		if err != nil || account.Status != amtypes.Normal || account.ExpireTime <= evm.GetBlockTime() {
+			return nil, model.ErrDelegateNotAuthorized
+		}
+		return nil, nil
+	case evmtypes.DelegateMultiSig:
+		payload := evmtypes.DelegatePayload(tx.To, action)
+		kv, err := msexec.UseSubmitTx(evm.GetStateDB(), evmtypes.ExecutorName, tx.From(), delegate.From, delegate.MultiSigTxId, payload)
+		if err == mty.ErrSubmitTxUsed {
+			return nil, model.ErrDelegateTxUsed
+		}
+		if err != nil {
+			log.Error("checkDelegate", "multiSigAddr", delegate.From, "txid", delegate.MultiSigTxId, "err", err)
+			return nil, model.ErrDelegateNotAuthorized
+		}
+		return []*types.KeyValue{kv}, nil
+	default:
+		return nil, types.ErrInvalidParam
+	}
+}
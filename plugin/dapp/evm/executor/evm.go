@@ -30,9 +30,21 @@ var (
 
 var driverName = evmtypes.ExecutorName
 
+type subConfig struct {
+	// 可以跟踪最近多少个区块中的交易
+	TraceMaxBlocks int64 `json:"traceMaxBlocks"`
+	// 同时进行的交易跟踪数量
+	TraceConcurrency int `json:"traceConcurrency"`
+}
+
 // Init 初始化本合约对象
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
 	driverName = name
+	var subCfg subConfig
+	if sub != nil {
+		types.MustDecode(sub, &subCfg)
+	}
+	initTrace(subCfg.TraceMaxBlocks, subCfg.TraceConcurrency)
 	drivers.Register(cfg, driverName, newEVMDriver, cfg.GetDappFork(driverName, evmtypes.EVMEnable))
 	EvmAddress = address.ExecAddress(cfg.ExecName(name))
 	// 初始化硬分叉数据
@@ -143,6 +155,10 @@ func (evm *EVMExecutor) getNewAddr(txHash []byte) common.Address {
 
 // CheckTx 校验交易
 func (evm *EVMExecutor) CheckTx(tx *types.Transaction, index int) error {
+	cfg := evm.GetAPI().GetConfig()
+	if evmtypes.IsEthTx(tx) && !cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEthSign) {
+		return types.ErrNotSupport
+	}
 	return nil
 }
 
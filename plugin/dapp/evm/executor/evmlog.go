@@ -0,0 +1,202 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 合约事件日志的本地索引
+// 每条日志保存一条记录，并按合约地址和topic0-topic3分别建立索引，索引的值为记录的主键
+// 主键由区块高度、交易序号和日志序号组成，按主键顺序即为日志生成的顺序
+// 索引随ExecLocal生成的其它数据一起通过AddRollbackKV在区块回滚时删除
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 一次查询最多返回的日志数量
+	evmLogPageSize = 100
+	// 支持索引的主题数量
+	evmLogMaxTopics = 4
+)
+
+func calcEVMLogPrimary(height int64, txIndex, logIndex int) string {
+	return fmt.Sprintf("%s.%05d", dapp.HeightIndexStr(height, int64(txIndex)), logIndex)
+}
+
+func calcEVMLogPrefix() []byte {
+	return []byte(fmt.Sprintf("LODB-%s-log:", evmtypes.ExecutorName))
+}
+
+func calcEVMLogAddrPrefix(addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-log-addr:%s:", evmtypes.ExecutorName, addr))
+}
+
+func calcEVMLogTopicPrefix(i int, topic []byte) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-log-topic%d:%s:", evmtypes.ExecutorName, i, common.ToHex(topic)))
+}
+
+// 为交易收据中的事件日志生成索引
+func (evm *EVMExecutor) indexEVMLogs(tx *types.Transaction, receipt *types.ReceiptData, index int) ([]*types.KeyValue, error) {
+	var kvs []*types.KeyValue
+	logIndex := 0
+	for _, item := range receipt.Logs {
+		if item.Ty != evmtypes.TyLogEVMEventData {
+			continue
+		}
+		var evmLog evmtypes.EVMLog
+		if err := types.Decode(item.Log, &evmLog); err != nil {
+			return nil, err
+		}
+		record := &evmtypes.EVMLogRecord{
+			Height:       evm.GetHeight(),
+			TxIndex:      int32(index),
+			LogIndex:     int32(logIndex),
+			TxHash:       tx.Hash(),
+			ContractAddr: evmLog.ContractAddr,
+			Topic:        evmLog.Topic,
+			Data:         evmLog.Data,
+		}
+		primary := []byte(calcEVMLogPrimary(evm.GetHeight(), index, logIndex))
+		kvs = append(kvs, &types.KeyValue{Key: append(calcEVMLogPrefix(), primary...), Value: types.Encode(record)})
+		kvs = append(kvs, &types.KeyValue{Key: append(calcEVMLogAddrPrefix(evmLog.ContractAddr), primary...), Value: primary})
+		for i, topic := range evmLog.Topic {
+			if i >= evmLogMaxTopics {
+				break
+			}
+			kvs = append(kvs, &types.KeyValue{Key: append(calcEVMLogTopicPrefix(i, topic), primary...), Value: primary})
+		}
+		logIndex++
+	}
+	return kvs, nil
+}
+
+// 日志查询条件
+type evmLogFilter struct {
+	from, to int64
+	addr     string
+	topics   [][]byte
+}
+
+func (f *evmLogFilter) match(record *evmtypes.EVMLogRecord) bool {
+	if record.Height < f.from || (f.to > 0 && record.Height > f.to) {
+		return false
+	}
+	if f.addr != "" && record.ContractAddr != f.addr {
+		return false
+	}
+	for i, topic := range f.topics {
+		if len(topic) == 0 {
+			continue
+		}
+		if i >= len(record.Topic) || !bytes.Equal(record.Topic[i], topic) {
+			return false
+		}
+	}
+	return true
+}
+
+func (evm *EVMExecutor) listEVMLogs(req *evmtypes.ReqEVMLogs) (*evmtypes.ReplyEVMLogs, error) {
+	if len(req.Topics) > evmLogMaxTopics || (req.ToBlock > 0 && req.ToBlock < req.FromBlock) {
+		return nil, types.ErrInvalidParam
+	}
+	filter := &evmLogFilter{from: req.FromBlock, to: req.ToBlock, addr: req.Address}
+	for _, topic := range req.Topics {
+		b, err := common.FromHex(topic)
+		if err != nil || (len(b) != 0 && len(b) != common.Sha256Len) {
+			return nil, types.ErrInvalidParam
+		}
+		filter.topics = append(filter.topics, b)
+	}
+	count := req.Count
+	if count <= 0 || count > evmLogPageSize {
+		count = evmLogPageSize
+	}
+
+	// 优先使用合约地址索引，其次使用第一个指定的主题索引
+	prefix := calcEVMLogPrefix()
+	if filter.addr != "" {
+		prefix = calcEVMLogAddrPrefix(filter.addr)
+	} else {
+		for i, topic := range filter.topics {
+			if len(topic) > 0 {
+				prefix = calcEVMLogTopicPrefix(i, topic)
+				break
+			}
+		}
+	}
+	byPrimary := bytes.Equal(prefix, calcEVMLogPrefix())
+
+	localdb := evm.GetLocalDB()
+	var key []byte
+	if req.PrimaryKey != "" {
+		key = append(common.CopyBytes(prefix), []byte(req.PrimaryKey)...)
+	} else if req.FromBlock > 0 {
+		// 找到起始高度之前的最后一条日志，从它之后开始查询
+		seek := append(common.CopyBytes(prefix), []byte(dapp.HeightIndexStr(req.FromBlock, 0))...)
+		values, err := localdb.List(prefix, seek, 1, dbm.ListSeek)
+		if err != nil && err != types.ErrNotFound {
+			return nil, err
+		}
+		if len(values) == 2 {
+			key = values[0]
+		}
+	}
+
+	reply := &evmtypes.ReplyEVMLogs{}
+	for {
+		values, err := localdb.List(prefix, key, count, dbm.ListASC)
+		if err == types.ErrNotFound || len(values) == 0 {
+			return reply, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			// 主记录中直接保存日志，索引中保存的是主键
+			record, err := evm.getEVMLogRecord(value, !byPrimary)
+			if err != nil {
+				return nil, err
+			}
+			primary := calcEVMLogPrimary(record.Height, int(record.TxIndex), int(record.LogIndex))
+			key = append(common.CopyBytes(prefix), []byte(primary)...)
+			if filter.to > 0 && record.Height > filter.to {
+				return reply, nil
+			}
+			if !filter.match(record) {
+				continue
+			}
+			reply.Logs = append(reply.Logs, record)
+			if int32(len(reply.Logs)) == count {
+				reply.PrimaryKey = primary
+				return reply, nil
+			}
+		}
+		if int32(len(values)) < count {
+			return reply, nil
+		}
+	}
+}
+
+// value为索引中的主键时，需要先读取主记录
+func (evm *EVMExecutor) getEVMLogRecord(value []byte, isIndex bool) (*evmtypes.EVMLogRecord, error) {
+	if isIndex {
+		var err error
+		value, err = evm.GetLocalDB().Get(append(calcEVMLogPrefix(), value...))
+		if err != nil {
+			return nil, err
+		}
+	}
+	var record evmtypes.EVMLogRecord
+	if err := types.Decode(value, &record); err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
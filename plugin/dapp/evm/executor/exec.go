@@ -14,6 +14,7 @@ import (
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
@@ -22,13 +23,38 @@ import (
 // Exec 本合约执行逻辑
 func (evm *EVMExecutor) Exec(tx *types.Transaction, index int) (*types.Receipt, error) {
 	evm.CheckInit()
+	var action evmtypes.EVMContractAction
+	if err := types.Decode(tx.Payload, &action); err != nil {
+		return nil, err
+	}
+	// 登记合约源码验证信息，不执行合约
+	if action.Verify != nil {
+		return evm.execVerify(tx, action.Verify)
+	}
+	// 代理调用需要先校验交易发送者是否有权代理
+	var delegateKV []*types.KeyValue
+	if action.Delegate != nil {
+		kvs, err := evm.checkDelegate(tx, index, &action)
+		if err != nil {
+			return nil, err
+		}
+		delegateKV = kvs
+	}
 	// 先转换消息
 	msg, err := evm.GetMessage(tx, index)
 	if err != nil {
 		return nil, err
 	}
 
-	return evm.innerExec(msg, tx.Hash(), index, evm.GetTxFee(tx, index), false)
+	receipt, err := evm.innerExec(msg, tx.Hash(), index, evm.GetTxFee(tx, index), false)
+	if err != nil || len(delegateKV) == 0 {
+		return receipt, err
+	}
+	if receipt == nil {
+		receipt = &types.Receipt{Ty: types.ExecOk}
+	}
+	receipt.KV = append(receipt.KV, delegateKV...)
+	return receipt, nil
 }
 
 // 通用的EVM合约执行逻辑封装
@@ -66,6 +92,19 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 	// 状态机中设置当前交易状态
 	evm.mStateDB.Prepare(common.BytesToHash(txHash), index)
 
+	gasLimit := context.GasLimit
+	london := cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMLondon)
+	if london {
+		// 交易中声明的访问列表需要预先支付Gas
+		accessList := msg.AccessList()
+		accessGas := uint64(len(accessList))*params.TxAccessListAddressGas + uint64(accessList.StorageKeys())*params.TxAccessListStorageKeyGas
+		if accessGas > gasLimit {
+			return receipt, model.ErrOutOfGas
+		}
+		gasLimit -= accessGas
+		evm.mStateDB.PrepareAccessList(msg.From().String(), contractAddr.String(), env.ActivePrecompiles(), accessList)
+	}
+
 	if isCreate {
 		// 如果携带ABI数据，则对数据合法性进行检查
 		if len(msg.ABI()) > 0 && cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMABI) {
@@ -74,7 +113,7 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 				return receipt, err
 			}
 		}
-		ret, snapshot, leftOverGas, vmerr = env.Create(runtime.AccountRef(msg.From()), contractAddr, msg.Data(), context.GasLimit, execName, msg.Alias(), msg.ABI())
+		ret, snapshot, leftOverGas, vmerr = env.Create(runtime.AccountRef(msg.From()), contractAddr, msg.Data(), gasLimit, execName, msg.Alias(), msg.ABI())
 	} else {
 		inData := msg.Data()
 		// 在这里进行ABI和十六进制的调用参数转换
@@ -87,7 +126,7 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 			methodName = funcName
 			log.Debug("call contract ", "abi funcName", funcName, "packData", common.Bytes2Hex(inData))
 		}
-		ret, snapshot, leftOverGas, vmerr = env.Call(runtime.AccountRef(msg.From()), *msg.To(), inData, context.GasLimit, msg.Value())
+		ret, snapshot, leftOverGas, vmerr = env.Call(runtime.AccountRef(msg.From()), *msg.To(), inData, gasLimit, msg.Value())
 		log.Debug("call(create) contract ", "input", common.Bytes2Hex(inData))
 	}
 	usedGas := msg.GasLimit() - leftOverGas
@@ -102,6 +141,15 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 		return receipt, vmerr
 	}
 
+	// EIP-3529 返还的Gas不超过消耗Gas的五分之一
+	if london {
+		refund := evm.mStateDB.GetRefund()
+		if maxRefund := usedGas / params.RefundQuotientEIP3529; refund > maxRefund {
+			refund = maxRefund
+		}
+		usedGas -= refund
+	}
+
 	// 计算消耗了多少费用（实际消耗的费用）
 	usedFee, overflow := common.SafeMul(usedGas, uint64(msg.GasPrice()))
 	// 费用消耗溢出，执行失败
@@ -135,6 +183,10 @@ func (evm *EVMExecutor) innerExec(msg *common.Message, txHash []byte, index int,
 	}
 	logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogCallContract, Log: types.Encode(contractReceipt)})
 	logs = append(logs, evm.mStateDB.GetReceiptLogs(contractAddr.String())...)
+	if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMEventLog) {
+		// 合约事件日志写入收据，供外部按合约地址和主题检索
+		logs = append(logs, evm.mStateDB.GetEventLogs()...)
+	}
 
 	if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMKVHash) {
 		// 将执行时生成的合约状态数据变更信息也计算哈希并保存
@@ -175,6 +227,14 @@ func (evm *EVMExecutor) GetMessage(tx *types.Transaction, index int) (msg *commo
 	}
 	// 此处暂时不考虑消息发送签名的处理，chain33在mempool中对签名做了检查
 	from := getCaller(tx)
+	// 代理调用时合约中的调用者为被代理的账户
+	if action.Delegate != nil {
+		delegator := common.StringToAddress(action.Delegate.From)
+		if delegator == nil {
+			return msg, types.ErrInvalidAddress
+		}
+		from = *delegator
+	}
 	to := getReceiver(tx)
 	if to == nil {
 		return msg, types.ErrInvalidAddress
@@ -191,6 +251,16 @@ func (evm *EVMExecutor) GetMessage(tx *types.Transaction, index int) (msg *commo
 
 	// 合约的GasLimit即为调用者为本次合约调用准备支付的手续费
 	msg = common.NewMessage(from, to, tx.Nonce, action.Amount, gasLimit, gasPrice, action.Code, action.GetAlias(), action.Abi)
+	if len(action.AccessList) > 0 {
+		if !evm.GetAPI().GetConfig().IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMLondon) {
+			return nil, types.ErrActionNotSupport
+		}
+		accessList, err := convertAccessList(action.AccessList)
+		if err != nil {
+			return nil, err
+		}
+		msg.SetAccessList(accessList)
+	}
 	return msg, err
 }
 
@@ -246,9 +316,9 @@ func getDataHashKey(addr common.Address) []byte {
 	return []byte(fmt.Sprintf("mavl-%v-data-hash:%v", evmtypes.ExecutorName, addr))
 }
 
-// 从交易信息中获取交易发起人地址
+// 从交易信息中获取交易发起人地址，以太坊格式签名的交易为以太坊地址
 func getCaller(tx *types.Transaction) common.Address {
-	return *common.StringToAddress(tx.From())
+	return *common.StringToAddress(evmtypes.TxSender(tx))
 }
 
 // 从交易信息中获取交易目标地址，在创建合约交易中，此地址为空
@@ -258,3 +328,24 @@ func getReceiver(tx *types.Transaction) *common.Address {
 	}
 	return common.StringToAddress(tx.To)
 }
+
+// 转换交易中的访问列表，地址为chain33格式地址，存储为十六进制编码
+func convertAccessList(tuples []*evmtypes.EVMAccessTuple) (common.AccessList, error) {
+	accessList := make(common.AccessList, 0, len(tuples))
+	for _, tuple := range tuples {
+		addr := common.StringToAddress(tuple.Address)
+		if addr == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		item := common.AccessTuple{Address: *addr}
+		for _, key := range tuple.StorageKeys {
+			b, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+			if err != nil || len(b) != common.HashLength {
+				return nil, types.ErrInvalidParam
+			}
+			item.StorageKeys = append(item.StorageKeys, common.BytesToHash(b))
+		}
+		accessList = append(accessList, item)
+	}
+	return accessList, nil
+}
@@ -15,16 +15,18 @@ func (evm *EVMExecutor) ExecDelLocal(tx *types.Transaction, receipt *types.Recei
 	if err != nil {
 		return nil, err
 	}
-	if receipt.GetTy() != types.ExecOk {
-		return set, nil
-	}
-	cfg := evm.GetAPI().GetConfig()
-	if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMState) {
-		kvs, err := evm.DelRollbackKV(tx, []byte(evmtypes.ExecutorName))
-		if err != nil {
-			return nil, err
+	if receipt.GetTy() == types.ExecOk {
+		cfg := evm.GetAPI().GetConfig()
+		if cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMState) {
+			kvs, err := evm.DelRollbackKV(tx, []byte(evmtypes.ExecutorName))
+			if err != nil {
+				return nil, err
+			}
+			set.KV = kvs
 		}
-		set.KV = kvs
 	}
-	return set, err
+	if evmtypes.IsEthTx(tx) {
+		set.KV = append(set.KV, &types.KeyValue{Key: getEthTxHashKey(evmtypes.EthTxHash(tx.Signature.Signature)), Value: nil})
+	}
+	return set, nil
 }
@@ -6,7 +6,9 @@ package executor
 
 import (
 	"bytes"
+	"fmt"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/types"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 )
@@ -17,6 +19,10 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 	if err != nil {
 		return nil, err
 	}
+	if evmtypes.IsEthTx(tx) {
+		// 以太坊格式的交易，保存以太坊交易哈希到chain33交易哈希的映射，执行失败的交易也需要保存
+		set.KV = append(set.KV, &types.KeyValue{Key: getEthTxHashKey(evmtypes.EthTxHash(tx.Signature.Signature)), Value: tx.Hash()})
+	}
 	if receipt.GetTy() != types.ExecOk {
 		return set, nil
 	}
@@ -31,18 +37,33 @@ func (evm *EVMExecutor) ExecLocal(tx *types.Transaction, receipt *types.ReceiptD
 				if err != nil {
 					return set, err
 				}
-				//转换老的log的key-> 新的key
-				key := []byte(changeItem.Key)
-				if bytes.HasPrefix(key, []byte("mavl-")) {
-					key[0] = 'L'
-					key[1] = 'O'
-					key[2] = 'D'
-					key[3] = 'B'
-				}
-				set.KV = append(set.KV, &types.KeyValue{Key: key, Value: changeItem.CurrentValue})
+				set.KV = append(set.KV, &types.KeyValue{Key: getStateItemLocalKey(changeItem.Key), Value: changeItem.CurrentValue})
 			}
 		}
 	}
+	// 合约事件日志索引
+	logKVs, err := evm.indexEVMLogs(tx, receipt, index)
+	if err != nil {
+		return set, err
+	}
+	set.KV = append(set.KV, logKVs...)
 	set.KV = evm.AddRollbackKV(tx, []byte(evmtypes.ExecutorName), set.KV)
 	return set, err
 }
+
+// 以太坊交易哈希到chain33交易哈希的映射
+func getEthTxHashKey(ethHash []byte) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-ethtx:%s", evmtypes.ExecutorName, common.ToHex(ethHash)))
+}
+
+// 转换老的log的key-> 新的key
+func getStateItemLocalKey(itemKey string) []byte {
+	key := []byte(itemKey)
+	if bytes.HasPrefix(key, []byte("mavl-")) {
+		key[0] = 'L'
+		key[1] = 'O'
+		key[2] = 'D'
+		key[3] = 'B'
+	}
+	return key
+}
@@ -0,0 +1,132 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 批量只读调用合约
+// 所有调用共用一个执行器，在同一个状态快照上依次执行，每个调用结束后回滚它的数据变更，
+// 所以调用之间互不影响；覆盖的余额和代码以及执行中写入的数据都只保存在内存中
+
+import (
+	"math/big"
+	"strconv"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 一次最多执行的调用数量
+const evmMulticallMaxCalls = 100
+
+// 批量调用时使用的状态数据库，写入的数据保存在内存中
+type multicallStateDB struct {
+	dbm.KV
+	cache map[string][]byte
+}
+
+func newMulticallStateDB(db dbm.KV) *multicallStateDB {
+	return &multicallStateDB{KV: db, cache: make(map[string][]byte)}
+}
+
+func (db *multicallStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := db.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return db.KV.Get(key)
+}
+
+func (db *multicallStateDB) Set(key []byte, value []byte) error {
+	db.cache[string(key)] = value
+	return nil
+}
+
+func (db *multicallStateDB) Begin() {}
+
+func (db *multicallStateDB) Commit() error { return nil }
+
+func (db *multicallStateDB) Rollback() {}
+
+// Query_Multicall 在同一个状态快照上批量只读调用合约，返回每个调用的执行结果
+func (evm *EVMExecutor) Query_Multicall(in *evmtypes.EvmMulticallReq) (types.Message, error) {
+	if in == nil || len(in.Calls) == 0 || len(in.Calls) > evmMulticallMaxCalls {
+		return nil, types.ErrInvalidParam
+	}
+	cfg := evm.GetAPI().GetConfig()
+	// 如果未指定调用地址，则直接使用一个虚拟的地址发起调用
+	caller := common.ExecAddress(cfg.ExecName(evmtypes.ExecutorName))
+	if len(in.Caller) > 0 {
+		callAddr := common.StringToAddress(in.Caller)
+		if callAddr == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		caller = *callAddr
+	}
+	calls := make([]common.Address, len(in.Calls))
+	for i, call := range in.Calls {
+		to := common.StringToAddress(call.To)
+		if to == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		calls[i] = *to
+	}
+
+	exec := NewEVMExecutor()
+	exec.SetAPI(evm.GetAPI())
+	exec.SetName(evm.GetName())
+	exec.SetStateDB(newMulticallStateDB(evm.GetStateDB()))
+	exec.SetLocalDB(newTraceLocalDB(evm.GetLocalDB()))
+	exec.SetEnv(evm.GetHeight(), evm.GetBlockTime(), evm.GetDifficulty())
+	exec.CheckInit()
+	mdb := exec.mStateDB
+
+	for _, override := range in.Overrides {
+		addr := common.StringToAddress(override.Address)
+		if addr == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		if len(override.Code) > 0 {
+			mdb.CreateAccount(addr.String(), caller.String(), "", "")
+			mdb.SetCode(addr.String(), override.Code)
+		}
+		if override.Balance != "" {
+			balance, err := strconv.ParseInt(override.Balance, 10, 64)
+			if err != nil || balance < 0 {
+				return nil, types.ErrInvalidParam
+			}
+			mdb.SetBalance(addr.String(), balance)
+		}
+	}
+
+	txHash := common.BigToHash(big.NewInt(evmtypes.MaxGasLimit))
+	reply := &evmtypes.EvmMulticallResp{}
+	for i, call := range in.Calls {
+		gasLimit := call.GasLimit
+		if gasLimit == 0 || gasLimit > evmtypes.MaxGasLimit {
+			gasLimit = evmtypes.MaxGasLimit
+		}
+		msg := common.NewMessage(caller, &calls[i], 0, 0, gasLimit, 1, call.Data, "multicall", "")
+		env := runtime.NewEVM(exec.NewEVMContext(msg), mdb, *exec.vmCfg, cfg)
+		mdb.Prepare(txHash, i)
+		if cfg.IsDappFork(exec.GetHeight(), "evm", evmtypes.ForkEVMLondon) {
+			mdb.PrepareAccessList(caller.String(), calls[i].String(), env.ActivePrecompiles(), nil)
+		}
+
+		snapshot := mdb.Snapshot()
+		ret, _, leftOverGas, err := env.Call(runtime.AccountRef(caller), calls[i], call.Data, gasLimit, 0)
+		mdb.RevertToSnapshot(snapshot)
+
+		result := &evmtypes.EvmMulticallResult{Success: err == nil, Ret: ret, UsedGas: gasLimit - leftOverGas}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		reply.Results = append(reply.Results, result)
+	}
+	return reply, nil
+}
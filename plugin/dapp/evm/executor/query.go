@@ -186,3 +186,72 @@ func (evm *EVMExecutor) Query_QueryABI(in *evmtypes.EvmQueryAbiReq) (types.Messa
 
 	return &evmtypes.EvmQueryAbiResp{Address: in.GetAddress(), Abi: abiData}, nil
 }
+
+// Query_EthCall 使用原始调用数据只读调用合约，返回合约的原始返回值，不修改原有执行器的状态数据
+func (evm *EVMExecutor) Query_EthCall(in *evmtypes.EvmCallDataReq) (types.Message, error) {
+	evm.CheckInit()
+	cfg := evm.GetAPI().GetConfig()
+	// 如果未指定调用地址，则直接使用一个虚拟的地址发起调用
+	caller := common.ExecAddress(cfg.ExecName(evmtypes.ExecutorName))
+	if len(in.Caller) > 0 {
+		callAddr := common.StringToAddress(in.Caller)
+		if callAddr == nil {
+			return nil, types.ErrInvalidAddress
+		}
+		caller = *callAddr
+	}
+	// 未指定合约地址时模拟创建合约
+	to := common.StringToAddress(EvmAddress)
+	if len(in.To) > 0 {
+		to = common.StringToAddress(in.To)
+		if to == nil {
+			return nil, types.ErrInvalidAddress
+		}
+	}
+	msg := common.NewMessage(caller, to, 0, in.Amount, evmtypes.MaxGasLimit, 1, in.Data, "ethCall", "")
+	txHash := common.BigToHash(big.NewInt(evmtypes.MaxGasLimit)).Bytes()
+
+	receipt, err := evm.innerExec(msg, txHash, 1, evmtypes.MaxGasLimit, true)
+	if err != nil {
+		return nil, err
+	}
+	ret := &evmtypes.EvmCallDataResp{}
+	if receipt != nil {
+		if callData := getCallReceipt(receipt.GetLogs()); callData != nil {
+			ret.Ret = callData.Ret
+			ret.UsedGas = callData.UsedGas
+		}
+	}
+	return ret, nil
+}
+
+// Query_GetCode 查询合约代码
+func (evm *EVMExecutor) Query_GetCode(in *evmtypes.CheckEVMAddrReq) (types.Message, error) {
+	evm.CheckInit()
+	addr := common.StringToAddress(in.Addr)
+	if addr == nil {
+		return nil, types.ErrInvalidAddress
+	}
+	return &evmtypes.EvmGetCodeResp{Addr: in.Addr, Code: evm.mStateDB.GetCode(addr.String())}, nil
+}
+
+// Query_GetTxHashByEthHash 根据以太坊交易哈希查询对应的chain33交易哈希
+func (evm *EVMExecutor) Query_GetTxHashByEthHash(in *types.ReqString) (types.Message, error) {
+	ethHash, err := common.HexToBytes(in.Data)
+	if err != nil || len(ethHash) != common.HashLength {
+		return nil, types.ErrInvalidParam
+	}
+	value, err := evm.GetLocalDB().Get(getEthTxHashKey(ethHash))
+	if err != nil {
+		return nil, err
+	}
+	return &types.ReplyString{Data: common.Bytes2Hex(value)}, nil
+}
+
+// Query_GetLogs 按区块范围、合约地址和主题查询合约事件日志，支持分页
+func (evm *EVMExecutor) Query_GetLogs(in *evmtypes.ReqEVMLogs) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return evm.listEVMLogs(in)
+}
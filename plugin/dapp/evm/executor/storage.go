@@ -0,0 +1,208 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 合约存储数据的查询
+// ForkEVMState之前合约存储保存在合约状态EVMContractState中，可以读取任意高度的状态；
+// 之后保存在localdb中，localdb只保存最新的数据，历史高度需要按收据中的状态变更日志回退，
+// 所以和交易跟踪一样只能查询最近traceMaxBlocks个区块，并且占用交易跟踪的并发数。
+// 还没有被调用过的老合约，存储数据仍然保存在合约状态中
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+// 一次最多导出的存储数量
+const evmStoragePageSize = 100
+
+func calcEVMStoragePrefix(addr string) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-state:%s:", evmtypes.ExecutorName, addr))
+}
+
+// 获取指定高度的合约状态数据库，height为0或者不小于当前高度时返回最新状态
+func (evm *EVMExecutor) getStorageStateDB(height int64) (*state.MemoryStateDB, error) {
+	if height < 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if height == 0 || height >= evm.GetHeight() {
+		evm.CheckInit()
+		return evm.mStateDB, nil
+	}
+
+	api := evm.GetAPI()
+	isFork := api.GetConfig().IsDappFork(height, "evm", evmtypes.ForkEVMState)
+	if isFork && evm.GetHeight()-height >= traceMaxBlocks {
+		return nil, model.ErrStorageTooOld
+	}
+	last := height
+	if isFork {
+		last = evm.GetHeight()
+	}
+	// blocks[0]为查询高度的区块，之后的区块用来回退localdb中的存储数据
+	blocks, err := evm.getTraceBlocks(height, last)
+	if err != nil {
+		return nil, err
+	}
+	stateDB := newTraceStateDB(api, blocks[0].Block.StateHash)
+	localDB := newTraceLocalDB(evm.GetLocalDB())
+	if isFork {
+		if err := localDB.revert(blocks[1:]); err != nil {
+			return nil, err
+		}
+	}
+	return state.NewMemoryStateDB(stateDB, localDB, nil, height, api), nil
+}
+
+// Query_GetStorageAt 查询合约指定存储位置的数据，支持查询历史高度
+func (evm *EVMExecutor) Query_GetStorageAt(in *evmtypes.ReqEVMGetStorageAt) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	addr := common.StringToAddress(in.Address)
+	if addr == nil {
+		return nil, types.ErrInvalidAddress
+	}
+	slot := common.FromHex(in.Slot)
+	if len(slot) == 0 || len(slot) > common.HashLength {
+		return nil, types.ErrInvalidParam
+	}
+	key := common.BytesToHash(slot)
+	// 历史高度需要读取多个区块，和交易跟踪共用同时进行的数量限制
+	if in.Height > 0 && in.Height < evm.GetHeight() {
+		select {
+		case traceSem <- struct{}{}:
+			defer func() { <-traceSem }()
+		default:
+			return nil, model.ErrTraceBusy
+		}
+	}
+
+	mdb, err := evm.getStorageStateDB(in.Height)
+	if err != nil {
+		return nil, err
+	}
+	acc := mdb.GetAccount(addr.String())
+	if acc == nil {
+		return nil, model.ErrAddrNotExists
+	}
+	value := mdb.GetState(addr.String(), key)
+	// 存储数据还没有迁移到localdb
+	if storage := acc.State.GetStorage(); len(storage) > 0 {
+		value = common.BytesToHash(storage[key.Hex()])
+	}
+	return &evmtypes.EVMStorageItem{Key: key.Hex(), Value: value.Hex()}, nil
+}
+
+// Query_DumpStorage 按存储位置的顺序分页导出合约最新的全部存储数据
+func (evm *EVMExecutor) Query_DumpStorage(in *evmtypes.ReqEVMDumpStorage) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	addr := common.StringToAddress(in.Address)
+	if addr == nil {
+		return nil, types.ErrInvalidAddress
+	}
+	var primary string
+	if in.PrimaryKey != "" {
+		key := common.FromHex(in.PrimaryKey)
+		if len(key) != common.HashLength {
+			return nil, types.ErrInvalidParam
+		}
+		primary = common.BytesToHash(key).Hex()
+	}
+	count := int(in.Count)
+	if count <= 0 || count > evmStoragePageSize {
+		count = evmStoragePageSize
+	}
+
+	evm.CheckInit()
+	acc := evm.mStateDB.GetAccount(addr.String())
+	if acc == nil {
+		return nil, model.ErrAddrNotExists
+	}
+	storage := acc.State.GetStorage()
+	if len(storage) > 0 || !evm.GetAPI().GetConfig().IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMState) {
+		return dumpContractStorage(storage, primary, count), nil
+	}
+	return evm.dumpLocalStorage(addr.String(), primary, count)
+}
+
+// 导出保存在合约状态中的存储数据
+func dumpContractStorage(storage map[string][]byte, primary string, count int) *evmtypes.ReplyEVMDumpStorage {
+	keys := make([]string, 0, len(storage))
+	for key := range storage {
+		if key > primary {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	reply := &evmtypes.ReplyEVMDumpStorage{}
+	for _, key := range keys {
+		value := common.BytesToHash(storage[key])
+		if value == (common.Hash{}) {
+			continue
+		}
+		reply.Storage = append(reply.Storage, &evmtypes.EVMStorageItem{Key: key, Value: value.Hex()})
+		if len(reply.Storage) == count {
+			reply.PrimaryKey = reply.Storage[count-1].Key
+			break
+		}
+	}
+	return reply
+}
+
+// 导出保存在localdb中的存储数据，值为0的存储位置不返回
+func (evm *EVMExecutor) dumpLocalStorage(addr, primary string, count int) (*evmtypes.ReplyEVMDumpStorage, error) {
+	prefix := calcEVMStoragePrefix(addr)
+	var key []byte
+	if primary != "" {
+		key = append(common.CopyBytes(prefix), []byte(primary)...)
+	}
+	localdb := evm.GetLocalDB()
+	reply := &evmtypes.ReplyEVMDumpStorage{}
+	for {
+		values, err := localdb.List(prefix, key, int32(count), dbm.ListASC|dbm.ListWithKey)
+		if err == types.ErrNotFound || len(values) == 0 {
+			return reply, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range values {
+			var kv types.KeyValue
+			if err := types.Decode(value, &kv); err != nil {
+				return nil, err
+			}
+			key = kv.Key
+			if !bytes.HasPrefix(kv.Key, prefix) {
+				return reply, nil
+			}
+			if common.BytesToHash(kv.Value) == (common.Hash{}) {
+				continue
+			}
+			item := &evmtypes.EVMStorageItem{
+				Key:   string(kv.Key[len(prefix):]),
+				Value: common.BytesToHash(kv.Value).Hex(),
+			}
+			reply.Storage = append(reply.Storage, item)
+			if len(reply.Storage) == count {
+				reply.PrimaryKey = item.Key
+				return reply, nil
+			}
+		}
+		if len(values) < count {
+			return reply, nil
+		}
+	}
+}
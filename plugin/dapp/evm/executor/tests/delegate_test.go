@@ -0,0 +1,197 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	amexec "github.com/33cn/plugin/plugin/dapp/accountmanager/executor"
+	amtypes "github.com/33cn/plugin/plugin/dapp/accountmanager/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	mstypes "github.com/33cn/plugin/plugin/dapp/multisig/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDelegateTx(privKey crypto.PrivKey, contract string, delegate *evmtypes.EVMDelegateCall) *types.Transaction {
+	return createDelegateCallTx(privKey, contract, nil, delegate)
+}
+
+func createDelegateCallTx(privKey crypto.PrivKey, contract string, input []byte, delegate *evmtypes.EVMDelegateCall) *types.Transaction {
+	action := evmtypes.EVMContractAction{GasLimit: 100000, Code: input, Delegate: delegate}
+	tx := &types.Transaction{Execer: []byte("evm"), Payload: types.Encode(&action), Fee: 100000, To: contract}
+	tx.Sign(types.SECP256K1, privKey)
+	return tx
+}
+
+// 模拟multisig合约中提交的MultiSigSubmitTx交易
+func saveDelegateSubmitTx(mdb db.KV, multiSigAddr string, txid uint64, tx *types.Transaction, executed bool) {
+	var action evmtypes.EVMContractAction
+	types.Decode(tx.Payload, &action)
+	payloadHash := sha256.Sum256(evmtypes.DelegatePayload(tx.To, &action))
+	multiSigTx := &mstypes.MultiSigTx{
+		MultiSigAddr: multiSigAddr,
+		Txid:         txid,
+		TxType:       mstypes.SubmitOperate,
+		Executed:     executed,
+		Execer:       evmtypes.ExecutorName,
+		PayloadHash:  payloadHash[:],
+	}
+	mdb.Set([]byte(fmt.Sprintf("mavl-multisig-tx-%s-%018d", multiSigAddr, txid)), types.Encode(multiSigTx))
+}
+
+// 合约返回调用者地址
+func delegateCaller(t *testing.T, receipt *types.Receipt) string {
+	for _, item := range receipt.Logs {
+		if item.Ty != evmtypes.TyLogCallContract {
+			continue
+		}
+		var res evmtypes.ReceiptEVMContract
+		require.Nil(t, types.Decode(item.Log, &res))
+		require.Equal(t, res.Caller, common.BytesToAddress(res.Ret[12:]).String())
+		return res.Caller
+	}
+	return ""
+}
+
+func TestDelegateCall(t *testing.T) {
+	ownerA, ownerB, ownerC := getPrivKey(), getPrivKey(), getPrivKey()
+	addrA, addrB, addrC := getAddr(ownerA).String(), getAddr(ownerB).String(), getAddr(ownerC).String()
+	mdb := buildStateDB(addrA, 500000000)
+	inst, statedb := newTestEVM(mdb, 10000000)
+	inst.SetEnv(10000000, 1000, uint64(10))
+	localdb := inst.GetLocalDB()
+	for _, addr := range []string{addrB, addrC} {
+		statedb.CoinsAccount.SaveAccount(&types.Account{Addr: addr, Balance: 500000000})
+	}
+
+	contract := address.ExecAddress("user.evm.delegate")
+	statedb.CreateAccount(contract, addrA, "user.evm.delegate", "")
+	statedb.SetCode(contract, common.FromHex("3360005260206000f3"))
+
+	// 多重签名账户，需要权重2
+	multiSigAddr := getAddr(getPrivKey()).String()
+	multiSig := &mstypes.MultiSig{
+		MultiSigAddr:   multiSigAddr,
+		Owners:         []*mstypes.Owner{{OwnerAddr: addrA, Weight: 1}, {OwnerAddr: addrB, Weight: 2}, {OwnerAddr: addrC, Weight: 1}},
+		RequiredWeight: 2,
+	}
+	mdb.Set([]byte("mavl-multisig-"+multiSigAddr), types.Encode(multiSig))
+	delegate := &evmtypes.EVMDelegateCall{From: multiSigAddr, Ty: evmtypes.DelegateMultiSig}
+	execTx := func(tx *types.Transaction) (*types.Receipt, error) {
+		receipt, err := inst.Exec(tx, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range receipt.KV {
+			mdb.Set(kv.Key, kv.Value)
+		}
+		return receipt, nil
+	}
+
+	// 需要multisig合约中已执行的MultiSigSubmitTx，即使发送者的权重满足要求也不能直接代理
+	_, err := execTx(createDelegateTx(ownerB, contract, delegate))
+	assert.Equal(t, model.ErrDelegateNotAuthorized, err)
+	saveDelegateSubmitTx(mdb, multiSigAddr, 0, createDelegateTx(ownerA, contract, delegate), false)
+	_, err = execTx(createDelegateTx(ownerA, contract, delegate))
+	assert.Equal(t, model.ErrDelegateNotAuthorized, err)
+	saveDelegateSubmitTx(mdb, multiSigAddr, 0, createDelegateTx(ownerA, contract, delegate), true)
+	// 非owner不能代理
+	_, err = execTx(createDelegateTx(getPrivKey(), contract, delegate))
+	assert.Equal(t, model.ErrDelegateNotAuthorized, err)
+	receipt, err := execTx(createDelegateTx(ownerA, contract, delegate))
+	require.Nil(t, err)
+	assert.Equal(t, multiSigAddr, delegateCaller(t, receipt))
+	// 同一笔多重签名交易只能使用一次
+	_, err = execTx(createDelegateTx(ownerC, contract, delegate))
+	assert.Equal(t, model.ErrDelegateTxUsed, err)
+
+	// 通过ERC20预编译合约转出token也需要执行延迟之后的多重签名交易，不使用每日限额
+	evmAddr := address.ExecAddress(chainTestCfg.ExecName(evmtypes.ExecutorName))
+	assetDB, err := account.NewAccountDB(chainTestCfg, "token", "TEST", mdb)
+	require.Nil(t, err)
+	assetDB.SaveExecAccount(evmAddr, &types.Account{Addr: multiSigAddr, Balance: 1000})
+	assetDB.SaveAccount(&types.Account{Addr: evmAddr, Balance: 1000})
+	args := append(erc20Uint(64), erc20Uint(128)...)
+	args = append(args, erc20Uint(5)...)
+	args = append(args, common.RightPadBytes([]byte("token"), 32)...)
+	args = append(args, erc20Uint(4)...)
+	args = append(args, common.RightPadBytes([]byte("TEST"), 32)...)
+	tx := createTx(ownerA, erc20Input("tokenAddress(string,string)", args), 100000, 0)
+	tx.To = runtime.ERC20FactoryAddress.String()
+	tx.Sign(types.SECP256K1, ownerA)
+	_, err = execTx(&tx)
+	require.Nil(t, err)
+
+	token := runtime.ERC20TokenAddress("token", "TEST").String()
+	transfer := erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(addrC).Bytes()), erc20Uint(300))
+	delegate = &evmtypes.EVMDelegateCall{From: multiSigAddr, Ty: evmtypes.DelegateMultiSig, MultiSigTxId: 1}
+	// 多重签名交易确认的是调用合约0的内容，不能用于ERC20转账
+	saveDelegateSubmitTx(mdb, multiSigAddr, 1, createDelegateTx(ownerA, contract, delegate), true)
+	_, err = execTx(createDelegateCallTx(ownerA, token, transfer, delegate))
+	assert.Equal(t, model.ErrDelegateNotAuthorized, err)
+	// 执行延迟未到期
+	saveDelegateSubmitTx(mdb, multiSigAddr, 1, createDelegateCallTx(ownerA, token, transfer, delegate), false)
+	_, err = execTx(createDelegateCallTx(ownerA, token, transfer, delegate))
+	assert.Equal(t, model.ErrDelegateNotAuthorized, err)
+	assert.Equal(t, int64(1000), assetDB.LoadExecAccount(multiSigAddr, evmAddr).Balance)
+	saveDelegateSubmitTx(mdb, multiSigAddr, 1, createDelegateCallTx(ownerA, token, transfer, delegate), true)
+	_, err = execTx(createDelegateCallTx(ownerA, token, transfer, delegate))
+	require.Nil(t, err)
+	assert.Equal(t, int64(700), assetDB.LoadExecAccount(multiSigAddr, evmAddr).Balance)
+	assert.Equal(t, int64(300), assetDB.LoadExecAccount(addrC, evmAddr).Balance)
+	_, err = execTx(createDelegateCallTx(ownerA, token, transfer, delegate))
+	assert.Equal(t, model.ErrDelegateTxUsed, err)
+	delegate = &evmtypes.EVMDelegateCall{From: multiSigAddr, Ty: evmtypes.DelegateMultiSig}
+
+	// 不能从被代理的账户转出金额
+	amountTx := createDelegateTx(ownerB, contract, delegate)
+	action := evmtypes.EVMContractAction{Amount: 1, GasLimit: 100000, Delegate: delegate}
+	amountTx.Payload = types.Encode(&action)
+	amountTx.Sign(types.SECP256K1, ownerB)
+	_, err = inst.Exec(amountTx, 0)
+	assert.Equal(t, types.ErrAmount, err)
+
+	// accountmanager管理员代理状态正常的账户
+	item := &types.ConfigItem{
+		Key:   "mavl-manage-" + amexec.ConfNameManagerAddr,
+		Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{addrA}}},
+	}
+	mdb.Set([]byte(item.Key), types.Encode(item))
+	managed := getAddr(getPrivKey()).String()
+	table := amexec.NewAccountTable(localdb)
+	require.Nil(t, table.Add(&amtypes.Account{AccountID: "delegate", Addr: managed, Status: amtypes.Normal, ExpireTime: 2000, Index: 1}))
+	kvs, err := table.Save()
+	require.Nil(t, err)
+	for _, kv := range kvs {
+		require.Nil(t, localdb.Set(kv.Key, kv.Value))
+	}
+	delegate = &evmtypes.EVMDelegateCall{From: managed, Ty: evmtypes.DelegateAccountManager}
+	_, err = inst.Exec(createDelegateTx(ownerB, contract, delegate), 0)
+	assert.Equal(t, model.ErrDelegateNotAuthorized, err)
+	receipt, err = inst.Exec(createDelegateTx(ownerA, contract, delegate), 0)
+	require.Nil(t, err)
+	assert.Equal(t, managed, delegateCaller(t, receipt))
+
+	// 过期的账户不能代理
+	inst.SetEnv(10000000, 2000, uint64(10))
+	_, err = inst.Exec(createDelegateTx(ownerA, contract, delegate), 0)
+	assert.Equal(t, model.ErrDelegateNotAuthorized, err)
+
+	// 分叉之前不支持
+	inst.SetEnv(1, 1000, uint64(10))
+	_, err = inst.Exec(createDelegateTx(ownerA, contract, delegate), 0)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
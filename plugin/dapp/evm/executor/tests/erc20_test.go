@@ -0,0 +1,175 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func erc20Input(method string, args ...[]byte) []byte {
+	input := crypto.Keccak256([]byte(method))[:4]
+	for _, arg := range args {
+		input = append(input, arg...)
+	}
+	return input
+}
+
+func erc20Word(b []byte) []byte {
+	return common.LeftPadBytes(b, 32)
+}
+
+func erc20Uint(v int64) []byte {
+	return erc20Word(big.NewInt(v).Bytes())
+}
+
+func TestERC20Precompile(t *testing.T) {
+	privKey := getPrivKey()
+	owner := getAddr(privKey).String()
+	tx := createTx(privKey, nil, 210000, 0)
+	mdb := buildStateDB(owner, 500000000)
+
+	// 在evm执行器下存入资产
+	evmAddr := address.ExecAddress(chainTestCfg.ExecName(evmtypes.ExecutorName))
+	assetDB, err := account.NewAccountDB(chainTestCfg, "token", "TEST", mdb)
+	require.Nil(t, err)
+	assetDB.SaveExecAccount(evmAddr, &types.Account{Addr: owner, Balance: 1000})
+	assetDB.SaveAccount(&types.Account{Addr: evmAddr, Balance: 1000})
+
+	// 在资产合约分叉高度之后执行
+	inst, statedb := newTestEVM(mdb, 10000000)
+	msg, err := inst.GetMessage(&tx, 0)
+	require.Nil(t, err)
+	env := runtime.NewEVM(inst.NewEVMContext(msg), statedb, *inst.GetVMConfig(), chainTestCfg)
+
+	call := func(from string, to common.Address, input []byte) ([]byte, error) {
+		ret, _, _, err := env.Call(runtime.AccountRef(*common.StringToAddress(from)), to, input, 1000000, 0)
+		return ret, err
+	}
+	balanceOf := func(token common.Address, addr string) int64 {
+		ret, err := call(owner, token, erc20Input("balanceOf(address)", erc20Word(common.StringToAddress(addr).Bytes())))
+		require.Nil(t, err)
+		return new(big.Int).SetBytes(ret).Int64()
+	}
+
+	// 工厂合约注册资产合约
+	args := append(erc20Uint(64), erc20Uint(128)...)
+	args = append(args, erc20Uint(5)...)
+	args = append(args, common.RightPadBytes([]byte("token"), 32)...)
+	args = append(args, erc20Uint(4)...)
+	args = append(args, common.RightPadBytes([]byte("TEST"), 32)...)
+	ret, err := call(owner, runtime.ERC20FactoryAddress, erc20Input("tokenAddress(string,string)", args))
+	require.Nil(t, err)
+	token := runtime.ERC20TokenAddress("token", "TEST")
+	assert.Equal(t, erc20Word(token.Bytes()), ret)
+	assert.True(t, statedb.Exist(token.String()))
+
+	ret, err = call(owner, token, erc20Input("symbol()"))
+	require.Nil(t, err)
+	assert.Equal(t, "TEST", string(ret[64:68]))
+	ret, err = call(owner, token, erc20Input("totalSupply()"))
+	require.Nil(t, err)
+	assert.Equal(t, erc20Uint(1000), ret)
+	assert.Equal(t, int64(1000), balanceOf(token, owner))
+
+	// 转账并生成Transfer事件
+	other := getAddr(getPrivKey()).String()
+	spender := getAddr(getPrivKey()).String()
+	ret, err = call(owner, token, erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(other).Bytes()), erc20Uint(300)))
+	require.Nil(t, err)
+	assert.Equal(t, erc20Uint(1), ret)
+	assert.Equal(t, int64(700), balanceOf(token, owner))
+	assert.Equal(t, int64(300), balanceOf(token, other))
+	logs := statedb.GetEventLogs()
+	require.Equal(t, 1, len(logs))
+
+	// 余额不足
+	_, err = call(other, token, erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(owner).Bytes()), erc20Uint(301)))
+	assert.Equal(t, types.ErrNoBalance, err)
+
+	// 授权后由第三方转账，超出授权额度失败
+	_, err = call(other, token, erc20Input("approve(address,uint256)", erc20Word(common.StringToAddress(spender).Bytes()), erc20Uint(100)))
+	require.Nil(t, err)
+	transferFrom := erc20Input("transferFrom(address,address,uint256)", erc20Word(common.StringToAddress(other).Bytes()), erc20Word(common.StringToAddress(owner).Bytes()), erc20Uint(60))
+	_, err = call(spender, token, transferFrom)
+	require.Nil(t, err)
+	_, err = call(spender, token, transferFrom)
+	assert.Equal(t, model.ErrERC20Allowance, err)
+	ret, err = call(owner, token, erc20Input("allowance(address,address)", erc20Word(common.StringToAddress(other).Bytes()), erc20Word(common.StringToAddress(spender).Bytes())))
+	require.Nil(t, err)
+	assert.Equal(t, erc20Uint(40), ret)
+	assert.Equal(t, int64(760), balanceOf(token, owner))
+	assert.Equal(t, int64(240), balanceOf(token, other))
+
+	// 授权type(uint256).max表示不限额度，转账时不扣减；转账金额仍然需要在chain33金额的范围内
+	maxUint := common.BigToHash(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))).Bytes()
+	_, err = call(other, token, erc20Input("approve(address,uint256)", erc20Word(common.StringToAddress(spender).Bytes()), maxUint))
+	require.Nil(t, err)
+	_, err = call(spender, token, erc20Input("transferFrom(address,address,uint256)", erc20Word(common.StringToAddress(other).Bytes()), erc20Word(common.StringToAddress(other).Bytes()), erc20Uint(60)))
+	require.Nil(t, err)
+	ret, err = call(owner, token, erc20Input("allowance(address,address)", erc20Word(common.StringToAddress(other).Bytes()), erc20Word(common.StringToAddress(spender).Bytes())))
+	require.Nil(t, err)
+	assert.Equal(t, maxUint, ret)
+	_, err = call(other, token, erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(owner).Bytes()), maxUint))
+	assert.Equal(t, model.ErrERC20Input, err)
+
+	// 同一版本中的多次转账回滚后恢复原来的余额
+	var sdb state.EVMStateDB = statedb
+	snapshot := sdb.Snapshot()
+	require.Nil(t, sdb.TransferAsset("token", "TEST", owner, other, 100))
+	require.Nil(t, sdb.TransferAsset("token", "TEST", owner, other, 200))
+	require.Nil(t, sdb.TransferAsset("token", "TEST", other, owner, 50))
+	sdb.RevertToSnapshot(snapshot)
+	assert.Equal(t, int64(760), balanceOf(token, owner))
+	assert.Equal(t, int64(240), balanceOf(token, other))
+
+	// token的冻结限制对合约内部的转账同样有效
+	frozenKey := []byte("mavl-token-frozen-TEST-" + other)
+	mdb.Set(frozenKey, types.Encode(&types.Int32{Data: 1}))
+	_, err = call(owner, token, erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(other).Bytes()), erc20Uint(1)))
+	assert.Equal(t, tokenty.ErrTokenAddrFrozen, err)
+	mdb.Set(frozenKey, types.Encode(&types.Int32{}))
+	assert.Equal(t, int64(760), balanceOf(token, owner))
+
+	// 只读调用中不允许转账
+	_, _, err = env.StaticCall(runtime.AccountRef(*common.StringToAddress(owner)), token, erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(other).Bytes()), erc20Uint(1)), 1000000)
+	assert.Equal(t, model.ErrWriteProtection, err)
+
+	// 只有工厂合约注册的资产合约才能访问资产，用户不能部署资产合约代码
+	fake := common.StringToAddress(getAddr(getPrivKey()).String())
+	deploy := common.FromHex("66fe65726332303a6000526007" + "6019f3")
+	_, _, _, err = env.Create(runtime.AccountRef(*common.StringToAddress(owner)), *fake, deploy, 1000000, "user.evm.fake", "", "")
+	assert.Equal(t, model.ErrInvalidCode, err)
+	assert.Equal(t, 0, len(statedb.GetCode(fake.String())))
+	// 合约地址和代码中的资产不一致
+	statedb.CreateAccount(fake.String(), owner, "user.evm.fake", "")
+	statedb.SetCode(fake.String(), append([]byte("\xfeerc20:"), []byte("token:TEST")...))
+	_, err = call(owner, *fake, erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(other).Bytes()), erc20Uint(1)))
+	assert.NotNil(t, err)
+	// 没有工厂合约写入的标记
+	unmarked := runtime.ERC20TokenAddress("token", "OTHER")
+	statedb.CreateAccount(unmarked.String(), owner, "token:OTHER", "")
+	statedb.SetCode(unmarked.String(), append([]byte("\xfeerc20:"), []byte("token:TEST")...))
+	_, err = call(owner, unmarked, erc20Input("transfer(address,uint256)", erc20Word(common.StringToAddress(other).Bytes()), erc20Uint(1)))
+	assert.NotNil(t, err)
+	statedb.SetCode(unmarked.String(), append([]byte("\xfeerc20:"), []byte("token:OTHER")...))
+	_, err = call(owner, unmarked, erc20Input("balanceOf(address)", erc20Word(common.StringToAddress(owner).Bytes())))
+	assert.NotNil(t, err)
+	assert.Equal(t, int64(760), balanceOf(token, owner))
+	assert.Equal(t, int64(240), balanceOf(token, other))
+}
@@ -0,0 +1,58 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEthTxSender(t *testing.T) {
+	key, err := ethcrypto.GenerateKey()
+	require.Nil(t, err)
+	ethAddr := evmtypes.EthAddrToChain33(ethcrypto.PubkeyToAddress(key.PublicKey).Bytes())
+	mdb := buildStateDB(ethAddr, 500000000)
+	height := chainTestCfg.GetDappFork(evmtypes.ExecutorName, evmtypes.ForkEVMEthSign)
+	inst, statedb := newTestEVM(mdb, height)
+
+	// 合约返回调用者地址
+	contract := address.ExecAddress("user.evm.ethsender")
+	statedb.CreateAccount(contract, ethAddr, "user.evm.ethsender", "")
+	statedb.SetCode(contract, common.FromHex("3360005260206000f3"))
+	to, err := evmtypes.Chain33AddrToEth(contract)
+	require.Nil(t, err)
+	etx := ethtypes.NewTransaction(0, ethcommon.BytesToAddress(to), big.NewInt(0), 100000, big.NewInt(1e10), nil)
+	chainID := int32(33)
+	signed, err := ethtypes.SignTx(etx, ethtypes.NewEIP155Signer(big.NewInt(evmtypes.EthChainID(chainID))), key)
+	require.Nil(t, err)
+	raw, err := rlp.EncodeToBytes(signed)
+	require.Nil(t, err)
+	tx, err := evmtypes.EthTxToChain33(raw, evmtypes.ExecutorName, chainID)
+	require.Nil(t, err)
+
+	// 合约中的msg.sender为从签名中恢复的以太坊地址，而不是按chain33规则生成的tx.From()
+	sender, err := ethtypes.Sender(ethtypes.NewEIP155Signer(big.NewInt(evmtypes.EthChainID(chainID))), signed)
+	require.Nil(t, err)
+	assert.Equal(t, evmtypes.EthAddrToChain33(sender.Bytes()), evmtypes.TxSender(tx))
+	assert.NotEqual(t, tx.From(), evmtypes.TxSender(tx))
+	receipt, err := inst.Exec(tx, 0)
+	require.Nil(t, err)
+	assert.Equal(t, evmtypes.EthAddrToChain33(sender.Bytes()), delegateCaller(t, receipt))
+
+	// 分叉之前不支持以太坊格式签名的交易
+	inst.SetEnv(height-1, 0, uint64(10))
+	assert.Equal(t, types.ErrNotSupport, inst.CheckTx(tx, 0))
+}
@@ -118,6 +118,9 @@ func runCase(tt *testing.T, c VMCase, file string) {
 	inst.SetEnv(c.env.currentNumber, c.env.currentTimestamp, uint64(c.env.currentDifficulty))
 	inst.CheckInit()
 	statedb := inst.GetMStateDB()
+	// ForkEVMState之后合约存储数据保存在localdb中
+	ldb, _ := db.NewGoMemDB("test_local", "", 0)
+	statedb.LocalDB = db.NewKVDB(ldb)
 	mdb := createStateDB(statedb, c)
 	statedb.StateDB = mdb
 	statedb.CoinsAccount = account.NewCoinsAccount(chainTestCfg)
@@ -134,15 +137,15 @@ func runCase(tt *testing.T, c VMCase, file string) {
 	var (
 		ret []byte
 		//addr common.Address
-		//leftGas uint64
-		err error
+		leftGas uint64
+		err     error
 	)
 
 	if len(c.exec.address) > 0 {
-		ret, _, _, err = env.Call(runtime.AccountRef(msg.From()), *common.StringToAddress(c.exec.address), msg.Data(), msg.GasLimit(), msg.Value())
+		ret, _, leftGas, err = env.Call(runtime.AccountRef(msg.From()), *common.StringToAddress(c.exec.address), msg.Data(), msg.GasLimit(), msg.Value())
 	} else {
 		addr := crypto.RandomContractAddress()
-		ret, _, _, err = env.Create(runtime.AccountRef(msg.From()), *addr, msg.Data(), msg.GasLimit(), "testExecName", "", "")
+		ret, _, leftGas, err = env.Create(runtime.AccountRef(msg.From()), *addr, msg.Data(), msg.GasLimit(), "testExecName", "", "")
 	}
 
 	if err != nil {
@@ -155,10 +158,13 @@ func runCase(tt *testing.T, c VMCase, file string) {
 		tt.Fail()
 		return
 	}
-	// 4 检查执行结果 post (注意，这里不检查Gas具体扣费数额，因为计费规则不一样，值检查执行结果是否正确)
+	// 4 检查执行结果 post (注意，用例中没有指定gas时不检查Gas具体扣费数额，因为计费规则不一样，只检查执行结果是否正确)
 	t := NewTester(tt)
 	// 4.1 返回结果
 	t.assertEqualsB(ret, getBin(c.out))
+	if c.gas > 0 {
+		t.assertEqualsV(int(msg.GasLimit()-leftGas), int(c.gas))
+	}
 
 	// 4.2 账户余额以及数据
 	for k, v := range c.post {
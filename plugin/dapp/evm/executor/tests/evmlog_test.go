@@ -0,0 +1,77 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEVMLogIndex(t *testing.T) {
+	mdb, err := db.NewGoMemDB("test", "", 0)
+	require.Nil(t, err)
+	inst, _ := newTestEVM(mdb, 1)
+	localdb := inst.GetLocalDB()
+
+	topicA := common.Sha256([]byte("Transfer"))
+	topicB := common.Sha256([]byte("Approval"))
+	newLog := func(addr string, topics ...[]byte) *types.ReceiptLog {
+		return &types.ReceiptLog{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(&evmtypes.EVMLog{ContractAddr: addr, Topic: topics, Data: []byte(addr)})}
+	}
+	// 高度1到5，每个高度一笔交易，合约A和B交替产生事件
+	for height := int64(1); height <= 5; height++ {
+		inst.SetEnv(height, 0, 0)
+		addr := "contractA"
+		if height%2 == 0 {
+			addr = "contractB"
+		}
+		receipt := &types.ReceiptData{Ty: types.ExecOk, Logs: []*types.ReceiptLog{newLog(addr, topicA), newLog(addr, topicB, topicA)}}
+		tx := &types.Transaction{Execer: []byte(evmtypes.ExecutorName), Nonce: height}
+		set, err := inst.ExecLocal(tx, receipt, 0)
+		require.Nil(t, err)
+		for _, kv := range set.KV {
+			require.Nil(t, localdb.Set(kv.Key, kv.Value))
+		}
+	}
+
+	query := func(req *evmtypes.ReqEVMLogs) *evmtypes.ReplyEVMLogs {
+		msg, err := inst.Query_GetLogs(req)
+		require.Nil(t, err)
+		return msg.(*evmtypes.ReplyEVMLogs)
+	}
+	reply := query(&evmtypes.ReqEVMLogs{})
+	assert.Equal(t, 10, len(reply.Logs))
+	assert.Equal(t, "", reply.PrimaryKey)
+
+	// 按合约地址和区块范围查询
+	reply = query(&evmtypes.ReqEVMLogs{FromBlock: 2, ToBlock: 4, Address: "contractA"})
+	require.Equal(t, 2, len(reply.Logs))
+	assert.Equal(t, int64(3), reply.Logs[0].Height)
+	assert.Equal(t, int32(1), reply.Logs[1].LogIndex)
+
+	// 按主题查询，空字符串表示任意主题
+	reply = query(&evmtypes.ReqEVMLogs{FromBlock: 4, Topics: []string{"", common.ToHex(topicA)}})
+	require.Equal(t, 2, len(reply.Logs))
+	assert.Equal(t, int64(4), reply.Logs[0].Height)
+	assert.Equal(t, "contractB", reply.Logs[0].ContractAddr)
+	assert.Equal(t, int64(5), reply.Logs[1].Height)
+
+	// 分页查询
+	reply = query(&evmtypes.ReqEVMLogs{Address: "contractB", Count: 3})
+	require.Equal(t, 3, len(reply.Logs))
+	require.NotEqual(t, "", reply.PrimaryKey)
+	reply = query(&evmtypes.ReqEVMLogs{Address: "contractB", Count: 3, PrimaryKey: reply.PrimaryKey})
+	require.Equal(t, 1, len(reply.Logs))
+	assert.Equal(t, int64(4), reply.Logs[0].Height)
+
+	_, err = inst.Query_GetLogs(&evmtypes.ReqEVMLogs{FromBlock: 3, ToBlock: 2})
+	assert.Equal(t, types.ErrInvalidParam, err)
+}
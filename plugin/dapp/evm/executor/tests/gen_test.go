@@ -20,6 +20,7 @@ type TestData struct {
 	Code string
 	Out  string
 	Err  string
+	Gas  string
 }
 
 func clearTestCase(basePath string) {
@@ -0,0 +1,52 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLondonAccessList(t *testing.T) {
+	_, statedb := newTestEVM(buildStateDB(getAddr(getPrivKey()).String(), 0), 10000000)
+
+	sender := getAddr(getPrivKey()).String()
+	contract := "1FwuqJsLH1c4LRBGYPHvKtspEYmS8ag2kf"
+	other := getAddr(getPrivKey()).String()
+	slot := common.BytesToHash([]byte{1})
+	var sdb state.EVMStateDB = statedb
+	sdb.CreateAccount(contract, sender, "user.evm.test", "")
+	sdb.SetState(contract, slot, common.BytesToHash([]byte{5}))
+
+	// 交易开始时重置访问列表、返还的Gas和存储的原始值
+	statedb.Prepare(common.BytesToHash([]byte("tx")), 0)
+	sdb.PrepareAccessList(sender, contract, nil, common.AccessList{{Address: *common.StringToAddress(other), StorageKeys: []common.Hash{slot}}})
+	assert.True(t, sdb.AddressInAccessList(sender))
+	assert.True(t, sdb.AddressInAccessList(contract))
+	addrOk, slotOk := sdb.SlotInAccessList(other, slot)
+	assert.True(t, addrOk && slotOk)
+	_, slotOk = sdb.SlotInAccessList(contract, slot)
+	assert.False(t, slotOk)
+	assert.Equal(t, uint64(0), sdb.GetRefund())
+
+	// 回滚后删除快照之后加入访问列表的数据，存储恢复到修改前的值
+	snapshot := sdb.Snapshot()
+	sdb.AddSlotToAccessList(contract, slot)
+	sdb.SetState(contract, slot, common.BytesToHash([]byte{6}))
+	sdb.SetState(contract, slot, common.BytesToHash([]byte{7}))
+	sdb.AddRefund(100)
+	sdb.SubRefund(40)
+	assert.Equal(t, uint64(60), sdb.GetRefund())
+	assert.Equal(t, common.BytesToHash([]byte{5}), sdb.GetCommittedState(contract, slot))
+	sdb.RevertToSnapshot(snapshot)
+	_, slotOk = sdb.SlotInAccessList(contract, slot)
+	assert.False(t, slotOk)
+	assert.True(t, sdb.AddressInAccessList(contract))
+	assert.Equal(t, common.BytesToHash([]byte{5}), sdb.GetState(contract, slot))
+	assert.Equal(t, uint64(0), sdb.GetRefund())
+}
@@ -0,0 +1,71 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulticall(t *testing.T) {
+	privKey := getPrivKey()
+	caller := getAddr(privKey).String()
+	mdb := buildStateDB(caller, 500000000)
+
+	inst, statedb := newTestEVM(mdb, 10000000)
+
+	// 存储位置0加1后保存并返回
+	counter := address.ExecAddress("user.evm.counter")
+	counterCode := common.FromHex("6000546001018060005560005260206000f3")
+	// 返回合约自身的余额
+	balance := address.ExecAddress("user.evm.balance")
+	balanceCode := common.FromHex("303160005260206000f3")
+
+	req := &evmtypes.EvmMulticallReq{
+		Caller: caller,
+		Calls: []*evmtypes.EvmMulticallCall{
+			{To: counter},
+			{To: counter},
+			{To: balance},
+			{To: caller},
+		},
+		Overrides: []*evmtypes.EvmStateOverride{
+			{Address: counter, Code: counterCode},
+			{Address: balance, Code: balanceCode, Balance: "12345"},
+		},
+	}
+	msg, err := inst.Query_Multicall(req)
+	require.Nil(t, err)
+	results := msg.(*evmtypes.EvmMulticallResp).Results
+	require.Equal(t, 4, len(results))
+	// 每个调用都在同一个状态上执行
+	for _, result := range results[:2] {
+		assert.True(t, result.Success)
+		assert.Equal(t, common.BigToHash(big.NewInt(1)).Bytes(), result.Ret)
+		assert.True(t, result.UsedGas > 0)
+	}
+	assert.True(t, results[2].Success)
+	assert.Equal(t, common.BigToHash(big.NewInt(12345)).Bytes(), results[2].Ret)
+	// 单个调用失败不影响其它调用
+	assert.False(t, results[3].Success)
+	assert.Equal(t, model.ErrAddrNotExists.Error(), results[3].Error)
+
+	// 覆盖的数据不会写入状态数据库
+	assert.False(t, statedb.Exist(counter))
+	assert.False(t, statedb.Exist(balance))
+
+	req.Calls = append(req.Calls, &evmtypes.EvmMulticallCall{To: "invalid"})
+	_, err = inst.Query_Multicall(req)
+	assert.NotNil(t, err)
+	_, err = inst.Query_Multicall(&evmtypes.EvmMulticallReq{})
+	assert.NotNil(t, err)
+}
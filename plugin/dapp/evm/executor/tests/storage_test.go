@@ -0,0 +1,78 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractStorage(t *testing.T) {
+	word := func(v int64) common.Hash { return common.BigToHash(big.NewInt(v)) }
+	privKey := getPrivKey()
+	creator := getAddr(privKey).String()
+	mdb := buildStateDB(creator, 500000000)
+	inst, statedb := newTestEVM(mdb, 10000000)
+
+	code := common.FromHex("6080604052600080fd00")
+	contract := address.ExecAddress("user.evm.storage")
+	statedb.CreateAccount(contract, creator, "user.evm.storage", "")
+	statedb.SetCode(contract, code)
+	statedb.SetState(contract, word(1), word(2))
+	statedb.SetState(contract, word(2), word(3))
+	statedb.SetState(contract, word(3), common.Hash{})
+	statedb.SetState(contract, word(32), word(1))
+
+	msg, err := inst.Query_GetStorageAt(&evmtypes.ReqEVMGetStorageAt{Address: contract, Slot: "0x1"})
+	require.Nil(t, err)
+	item := msg.(*evmtypes.EVMStorageItem)
+	assert.Equal(t, word(1).Hex(), item.Key)
+	assert.Equal(t, word(2).Hex(), item.Value)
+
+	_, err = inst.Query_GetStorageAt(&evmtypes.ReqEVMGetStorageAt{Address: contract, Slot: "0x"})
+	assert.NotNil(t, err)
+	_, err = inst.Query_GetStorageAt(&evmtypes.ReqEVMGetStorageAt{Address: creator, Slot: "0x1"})
+	assert.Equal(t, model.ErrAddrNotExists, err)
+	// localdb中的历史数据只能回退最近的区块
+	_, err = inst.Query_GetStorageAt(&evmtypes.ReqEVMGetStorageAt{Address: contract, Slot: "0x1", Height: 9000000})
+	assert.Equal(t, model.ErrStorageTooOld, err)
+	// 默认和交易跟踪一样只能查询最近128个区块
+	_, err = inst.Query_GetStorageAt(&evmtypes.ReqEVMGetStorageAt{Address: contract, Slot: "0x1", Height: 10000000 - 128})
+	assert.Equal(t, model.ErrStorageTooOld, err)
+
+	// 分页导出，值为0的存储位置不返回
+	msg, err = inst.Query_DumpStorage(&evmtypes.ReqEVMDumpStorage{Address: contract, Count: 2})
+	require.Nil(t, err)
+	reply := msg.(*evmtypes.ReplyEVMDumpStorage)
+	require.Equal(t, 2, len(reply.Storage))
+	assert.Equal(t, word(2).Hex(), reply.Storage[1].Key)
+	assert.Equal(t, reply.Storage[1].Key, reply.PrimaryKey)
+	msg, err = inst.Query_DumpStorage(&evmtypes.ReqEVMDumpStorage{Address: contract, Count: 2, PrimaryKey: reply.PrimaryKey})
+	require.Nil(t, err)
+	reply = msg.(*evmtypes.ReplyEVMDumpStorage)
+	require.Equal(t, 1, len(reply.Storage))
+	assert.Equal(t, word(32).Hex(), reply.Storage[0].Key)
+	assert.Equal(t, word(1).Hex(), reply.Storage[0].Value)
+	assert.Equal(t, "", reply.PrimaryKey)
+
+	// 还没有迁移的老合约，存储数据保存在合约状态中
+	legacy := address.ExecAddress("user.evm.legacy")
+	statedb.CreateAccount(legacy, creator, "user.evm.legacy", "")
+	statedb.SetCode(legacy, code)
+	statedb.GetAccount(legacy).State.Storage[word(1).Hex()] = word(3).Bytes()
+	msg, err = inst.Query_GetStorageAt(&evmtypes.ReqEVMGetStorageAt{Address: legacy, Slot: "0x01"})
+	require.Nil(t, err)
+	assert.Equal(t, word(3).Hex(), msg.(*evmtypes.EVMStorageItem).Value)
+	msg, err = inst.Query_DumpStorage(&evmtypes.ReqEVMDumpStorage{Address: legacy})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(msg.(*evmtypes.ReplyEVMDumpStorage).Storage))
+}
@@ -0,0 +1,123 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/types"
+	evm "github.com/33cn/plugin/plugin/dapp/evm/executor"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// 使用指定的跟踪器创建合约
+func traceCreateContract(t *testing.T, code []byte, tracer runtime.Tracer) error {
+	privKey := getPrivKey()
+	tx := createTx(privKey, code, 210000, 0)
+	mdb := buildStateDB(getAddr(privKey).String(), 500000000)
+
+	inst, statedb := newTestEVM(mdb, 10)
+	msg, err := inst.GetMessage(&tx, 0)
+	require.Nil(t, err)
+
+	vmcfg := *inst.GetVMConfig()
+	vmcfg.Debug = true
+	vmcfg.Tracer = tracer
+	env := runtime.NewEVM(inst.NewEVMContext(msg), statedb, vmcfg, chainTestCfg)
+	_, _, _, err = env.Create(runtime.AccountRef(msg.From()), *common.StringToAddress("1FwuqJsLH1c4LRBGYPHvKtspEYmS8ag2kf"), msg.Data(), msg.GasLimit(), fmt.Sprintf("%s%s", evmtypes.EvmPrefix, common.BytesToHash(tx.Hash()).Hex()), "", "")
+	return err
+}
+
+func TestCallTracer(t *testing.T) {
+	// 部署代码中调用一个不存在的地址，然后将返回值写入存储
+	code, _ := hex.DecodeString("600060006000600060006112346161a8f1600055" + "00")
+	tracer := runtime.NewCallTracer()
+	require.Nil(t, traceCreateContract(t, code, tracer))
+
+	frame := tracer.Result()
+	require.NotNil(t, frame)
+	assert.Equal(t, runtime.CREATE, frame.Type)
+	assert.Nil(t, frame.Err)
+	assert.True(t, frame.GasUsed > 0)
+	require.Equal(t, 1, len(frame.Calls))
+	call := frame.Calls[0]
+	assert.Equal(t, runtime.CALL, call.Type)
+	assert.Equal(t, uint64(25000), call.Gas)
+	assert.Equal(t, model.ErrAddrNotExists, call.Err)
+	assert.Equal(t, "1FwuqJsLH1c4LRBGYPHvKtspEYmS8ag2kf", call.From.String())
+}
+
+func TestStructLogger(t *testing.T) {
+	code, _ := hex.DecodeString("600060006000600060006112346161a8f1600055" + "00")
+	logger := runtime.NewStructLogger(&runtime.LogConfig{DisableMemory: true})
+	require.Nil(t, traceCreateContract(t, code, logger))
+
+	logs := logger.StructLogs()
+	require.Equal(t, 11, len(logs))
+	assert.Equal(t, runtime.PUSH1, logs[0].Op)
+	assert.Equal(t, runtime.CALL, logs[7].Op)
+	assert.Equal(t, 7, len(logs[7].Stack))
+	// SSTORE记录写入的存储数据
+	sstore := logs[9]
+	assert.Equal(t, runtime.SSTORE, sstore.Op)
+	assert.Equal(t, 1, len(sstore.Storage))
+	assert.Equal(t, runtime.STOP, logs[10].Op)
+	assert.Nil(t, logger.Error())
+
+	// 限制记录条数
+	logger = runtime.NewStructLogger(&runtime.LogConfig{Limit: 3})
+	require.Nil(t, traceCreateContract(t, code, logger))
+	assert.Equal(t, 3, len(logger.StructLogs()))
+}
+
+func TestTraceTransactionLimit(t *testing.T) {
+	tx := createTx(getPrivKey(), nil, 210000, 0)
+	details := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: &tx, Height: 10}}}
+	started := make(chan struct{}, 10)
+	release := make(chan struct{})
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig").Return(chainTestCfg)
+	api.On("GetTransactionByHash", mock.Anything).Run(func(mock.Arguments) {
+		started <- struct{}{}
+		<-release
+	}).Return(details, nil)
+	// 默认只能跟踪最近128个区块中的交易
+	api.On("GetLastHeader").Return(&types.Header{Height: 10 + 128}, nil)
+
+	inst := evm.NewEVMExecutor()
+	inst.SetAPI(api)
+	req := &evmtypes.ReqEVMTrace{Hash: hex.EncodeToString(tx.Hash())}
+	errs := make(chan error, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			_, err := inst.Query_TraceTransaction(req)
+			errs <- err
+		}()
+	}
+	<-started
+	<-started
+	// 默认最多同时跟踪2笔交易
+	_, err := inst.Query_TraceTransaction(req)
+	assert.Equal(t, model.ErrTraceBusy, err)
+	// 查询历史高度的合约存储和交易跟踪共用并发限制
+	inst.SetEnv(10+128, 0, 0)
+	_, err = inst.Query_GetStorageAt(&evmtypes.ReqEVMGetStorageAt{Address: getAddr(getPrivKey()).String(), Slot: "0x1", Height: 10})
+	assert.Equal(t, model.ErrTraceBusy, err)
+
+	close(release)
+	assert.Equal(t, model.ErrTraceTooOld, <-errs)
+	assert.Equal(t, model.ErrTraceTooOld, <-errs)
+	_, err = inst.Query_TraceTransaction(req)
+	assert.Equal(t, model.ErrTraceTooOld, err)
+}
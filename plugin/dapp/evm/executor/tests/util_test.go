@@ -257,6 +257,26 @@ func buildStateDB(addr string, balance int64) *db.GoMemDB {
 	return mdb
 }
 
+// 创建测试用的执行器，状态数据库和localdb都使用mdb
+func newTestEVM(mdb *db.GoMemDB, height int64) (*evm.EVMExecutor, *state.MemoryStateDB) {
+	inst := evm.NewEVMExecutor()
+	q := queue.New("channel")
+	q.SetConfig(chainTestCfg)
+	api, _ := client.New(q.Client(), nil)
+	inst.SetAPI(api)
+	localdb := db.NewKVDB(mdb)
+	inst.SetStateDB(mdb)
+	inst.SetLocalDB(localdb)
+	inst.SetEnv(height, 0, uint64(10))
+	inst.CheckInit()
+	statedb := inst.GetMStateDB()
+	statedb.StateDB = mdb
+	statedb.LocalDB = localdb
+	statedb.CoinsAccount = account.NewCoinsAccount(chainTestCfg)
+	statedb.CoinsAccount.SetDB(mdb)
+	return inst, statedb
+}
+
 func createContract(mdb *db.GoMemDB, tx types.Transaction, maxCodeSize int) (ret []byte, contractAddr common.Address, leftOverGas uint64, statedb *state.MemoryStateDB, err error) {
 	inst := evm.NewEVMExecutor()
 	q := queue.New("channel")
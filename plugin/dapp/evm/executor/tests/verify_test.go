@@ -0,0 +1,86 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tests
+
+import (
+	"testing"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createVerifyTx(privKey crypto.PrivKey, contract string, verify *evmtypes.EVMContractVerify) *types.Transaction {
+	action := evmtypes.EVMContractAction{Verify: verify}
+	tx := &types.Transaction{Execer: []byte("evm"), Payload: types.Encode(&action), Fee: 100000, To: contract}
+	tx.Sign(types.SECP256K1, privKey)
+	return tx
+}
+
+func TestContractVerify(t *testing.T) {
+	privKey := getPrivKey()
+	creator := getAddr(privKey).String()
+	mdb := buildStateDB(creator, 500000000)
+
+	inst, statedb := newTestEVM(mdb, 10000000)
+
+	// 预置一个已经部署的合约
+	code, _ := common.FromHex("6080604052600080fd00")
+	contract := address.ExecAddress("user.evm.verify")
+	statedb.CreateAccount(contract, creator, "user.evm.verify", "")
+	statedb.SetCode(contract, code)
+
+	verify := &evmtypes.EVMContractVerify{
+		CompilerVersion: "0.8.4+commit.c7e474f2",
+		Settings:        `{"optimizer":{"enabled":true,"runs":200}}`,
+		SourceHash:      common.ToHex(common.Sha256([]byte("contract A {}"))),
+		Abi:             "[]",
+		RuntimeCode:     code,
+	}
+
+	// 代码不一致
+	verify.RuntimeCode, _ = common.FromHex("6080604052")
+	_, err := inst.Exec(createVerifyTx(privKey, contract, verify), 0)
+	assert.Equal(t, model.ErrVerifyCodeMismatch, err)
+	verify.RuntimeCode = code
+
+	// 非创建者
+	_, err = inst.Exec(createVerifyTx(getPrivKey(), contract, verify), 0)
+	assert.Equal(t, model.ErrVerifyNotCreator, err)
+
+	// 源码哈希格式错误
+	hash := verify.SourceHash
+	verify.SourceHash = "0x1234"
+	_, err = inst.Exec(createVerifyTx(privKey, contract, verify), 0)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	verify.SourceHash = hash
+
+	_, err = inst.Query_GetContractVerify(&evmtypes.EvmQueryVerifyReq{Address: contract})
+	assert.Equal(t, model.ErrVerifyNotFound, err)
+
+	tx := createVerifyTx(privKey, contract, verify)
+	receipt, err := inst.Exec(tx, 0)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(receipt.KV))
+	require.Equal(t, evmtypes.TyLogEVMContractVerify, int(receipt.Logs[0].Ty))
+	mdb.Set(receipt.KV[0].Key, receipt.KV[0].Value)
+
+	msg, err := inst.Query_GetContractVerify(&evmtypes.EvmQueryVerifyReq{Address: contract})
+	require.Nil(t, err)
+	info := msg.(*evmtypes.EVMContractVerifyInfo)
+	assert.Equal(t, contract, info.Addr)
+	assert.Equal(t, verify.CompilerVersion, info.CompilerVersion)
+	assert.Equal(t, verify.SourceHash, info.SourceHash)
+	assert.Equal(t, "[]", info.Abi)
+	assert.Equal(t, common.ToHex(statedb.GetCodeHash(contract).Bytes()), info.CodeHash)
+	assert.Equal(t, creator, info.Submitter)
+	assert.Equal(t, common.ToHex(tx.Hash()), info.TxHash)
+	assert.Equal(t, "verifyEvmContract", tx.ActionName())
+}
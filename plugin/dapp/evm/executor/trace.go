@@ -0,0 +1,350 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 历史交易的重放跟踪
+// 合约账户和代码保存在状态数据库中，直接使用交易所在区块的父区块状态；
+// 从ForkEVMState开始合约存储数据保存在localdb中，localdb只保存最新的数据，
+// 需要按照收据中的状态变更日志，把交易所在区块及之后区块的变更逐个回退，所以只能跟踪最近的区块中的交易，
+// 可以跟踪的区块数和同时进行的跟踪数量在配置文件[exec.sub.evm]中通过traceMaxBlocks和traceConcurrency配置。
+// 同一区块中排在前面的evm交易会先重放，其它执行器的交易和手续费的扣除不会重放。
+// 重放过程中写入的数据都只保存在内存中，不会修改链上数据
+
+import (
+	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
+	dbm "github.com/33cn/chain33/common/db"
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/runtime"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+const (
+	// 默认只能跟踪最近这些区块中的交易
+	evmTraceDefaultBlocks = 128
+	// 配置的跟踪区块数上限
+	evmTraceMaxBlocks = 1000
+	// 默认同时进行的跟踪数量
+	evmTraceDefaultConcurrency = 2
+	// 每次获取的区块数量
+	evmTraceBlockBatch = 100
+	// structLogger最多记录的指令条数
+	evmTraceMaxLogs = 10000
+
+	evmCallTracer   = "callTracer"
+	evmStructLogger = "structLogger"
+)
+
+var (
+	traceMaxBlocks int64 = evmTraceDefaultBlocks
+	traceSem             = make(chan struct{}, evmTraceDefaultConcurrency)
+)
+
+// 根据配置设置跟踪的区块数和同时进行的跟踪数量
+func initTrace(maxBlocks int64, concurrency int) {
+	traceMaxBlocks = evmTraceDefaultBlocks
+	if maxBlocks > 0 {
+		traceMaxBlocks = maxBlocks
+	}
+	if traceMaxBlocks > evmTraceMaxBlocks {
+		traceMaxBlocks = evmTraceMaxBlocks
+	}
+	if concurrency <= 0 {
+		concurrency = evmTraceDefaultConcurrency
+	}
+	traceSem = make(chan struct{}, concurrency)
+}
+
+// 跟踪交易时使用的状态数据库，读取指定状态哈希下的数据，写入的数据保存在内存中
+type traceStateDB struct {
+	api       client.QueueProtocolAPI
+	stateHash []byte
+	cache     map[string][]byte
+}
+
+func newTraceStateDB(api client.QueueProtocolAPI, stateHash []byte) *traceStateDB {
+	return &traceStateDB{api: api, stateHash: stateHash, cache: make(map[string][]byte)}
+}
+
+func (db *traceStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := db.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	reply, err := db.api.StoreGet(&types.StoreGet{StateHash: db.stateHash, Keys: [][]byte{key}})
+	if err != nil {
+		return nil, err
+	}
+	if len(reply.Values) == 0 || reply.Values[0] == nil {
+		return nil, types.ErrNotFound
+	}
+	return reply.Values[0], nil
+}
+
+func (db *traceStateDB) Set(key []byte, value []byte) error {
+	db.cache[string(key)] = value
+	return nil
+}
+
+func (db *traceStateDB) Begin() {}
+
+func (db *traceStateDB) Commit() error { return nil }
+
+func (db *traceStateDB) Rollback() {}
+
+// 跟踪交易时使用的localdb，回退和写入的数据保存在内存中，List只能查询到原有的数据
+type traceLocalDB struct {
+	dbm.KVDB
+	cache map[string][]byte
+}
+
+func newTraceLocalDB(db dbm.KVDB) *traceLocalDB {
+	return &traceLocalDB{KVDB: db, cache: make(map[string][]byte)}
+}
+
+func (db *traceLocalDB) Get(key []byte) ([]byte, error) {
+	if value, ok := db.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return db.KVDB.Get(key)
+}
+
+func (db *traceLocalDB) Set(key []byte, value []byte) error {
+	db.cache[string(key)] = value
+	return nil
+}
+
+func (db *traceLocalDB) Begin() {}
+
+func (db *traceLocalDB) Commit() error { return nil }
+
+func (db *traceLocalDB) Rollback() {}
+
+// 按收据中的状态变更日志，从最新的区块开始回退合约存储数据
+func (db *traceLocalDB) revert(blocks []*types.BlockDetail) error {
+	for i := len(blocks) - 1; i >= 0; i-- {
+		receipts := blocks[i].Receipts
+		for j := len(receipts) - 1; j >= 0; j-- {
+			if receipts[j].GetTy() != types.ExecOk {
+				continue
+			}
+			logs := receipts[j].Logs
+			for k := len(logs) - 1; k >= 0; k-- {
+				if logs[k].Ty != evmtypes.TyLogEVMStateChangeItem {
+					continue
+				}
+				var changeItem evmtypes.EVMStateChangeItem
+				if err := types.Decode(logs[k].Log, &changeItem); err != nil {
+					return err
+				}
+				db.cache[string(getStateItemLocalKey(changeItem.Key))] = changeItem.PreValue
+			}
+		}
+	}
+	return nil
+}
+
+// 获取[start, end]高度的区块详情
+func (evm *EVMExecutor) getTraceBlocks(start, end int64) ([]*types.BlockDetail, error) {
+	var blocks []*types.BlockDetail
+	for start <= end {
+		last := start + evmTraceBlockBatch - 1
+		if last > end {
+			last = end
+		}
+		details, err := evm.GetAPI().GetBlocks(&types.ReqBlocks{Start: start, End: last, IsDetail: true})
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(details.Items)) != last-start+1 {
+			return nil, types.ErrBlockNotFound
+		}
+		blocks = append(blocks, details.Items...)
+		start = last + 1
+	}
+	return blocks, nil
+}
+
+// 创建在指定区块环境和数据库上执行的执行器
+func (evm *EVMExecutor) newTraceExecutor(block *types.Block, stateDB dbm.KV, localDB dbm.KVDB) *EVMExecutor {
+	exec := NewEVMExecutor()
+	exec.SetAPI(evm.GetAPI())
+	exec.SetName(evm.GetName())
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(localDB)
+	exec.SetEnv(block.Height, block.BlockTime, uint64(block.Difficulty))
+	exec.SetTxs(block.Txs)
+	return exec
+}
+
+// Query_TraceTransaction 在交易所在区块的父区块状态上重放交易，返回合约调用树或者指令执行记录
+func (evm *EVMExecutor) Query_TraceTransaction(in *evmtypes.ReqEVMTrace) (types.Message, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	var (
+		callTracer   *runtime.CallTracer
+		structLogger *runtime.StructLogger
+		tracer       runtime.Tracer
+	)
+	switch in.Tracer {
+	case "", evmCallTracer:
+		callTracer = runtime.NewCallTracer()
+		tracer = callTracer
+	case evmStructLogger:
+		limit := int(in.Limit)
+		if limit <= 0 || limit > evmTraceMaxLogs {
+			limit = evmTraceMaxLogs
+		}
+		structLogger = runtime.NewStructLogger(&runtime.LogConfig{DisableStack: in.DisableStack, DisableMemory: in.DisableMemory, DisableStorage: in.DisableStorage, Limit: limit})
+		tracer = structLogger
+	default:
+		return nil, model.ErrTracerNotSupport
+	}
+	// 跟踪需要读取多个区块并重放交易，限制同时进行的数量
+	select {
+	case traceSem <- struct{}{}:
+		defer func() { <-traceSem }()
+	default:
+		return nil, model.ErrTraceBusy
+	}
+
+	hash, err := common.FromHex(in.Hash)
+	if err != nil {
+		return nil, types.ErrInvalidParam
+	}
+	api := evm.GetAPI()
+	details, err := api.GetTransactionByHash(&types.ReqHashes{Hashes: [][]byte{hash}})
+	if err != nil {
+		return nil, err
+	}
+	if len(details.Txs) == 0 || details.Txs[0] == nil || details.Txs[0].Tx == nil {
+		return nil, types.ErrTxNotExist
+	}
+	detail := details.Txs[0]
+	if string(types.GetRealExecName(detail.Tx.Execer)) != evmtypes.ExecutorName {
+		return nil, types.ErrActionNotSupport
+	}
+	header, err := api.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	if detail.Height <= 0 || header.Height-detail.Height >= traceMaxBlocks {
+		return nil, model.ErrTraceTooOld
+	}
+
+	// blocks[0]为父区块，blocks[1]为交易所在区块
+	blocks, err := evm.getTraceBlocks(detail.Height-1, header.Height)
+	if err != nil {
+		return nil, err
+	}
+	block := blocks[1].Block
+	if int(detail.Index) >= len(block.Txs) || len(blocks[1].Receipts) != len(block.Txs) {
+		return nil, types.ErrTxNotExist
+	}
+	stateDB := newTraceStateDB(api, blocks[0].Block.StateHash)
+	localDB := newTraceLocalDB(evm.GetLocalDB())
+	if api.GetConfig().IsDappFork(detail.Height, "evm", evmtypes.ForkEVMState) {
+		if err := localDB.revert(blocks[1:]); err != nil {
+			return nil, err
+		}
+	}
+
+	// 重放同一区块中排在前面并且执行成功的evm交易
+	for i := 0; i < int(detail.Index); i++ {
+		tx := block.Txs[i]
+		if blocks[1].Receipts[i].GetTy() != types.ExecOk || string(types.GetRealExecName(tx.Execer)) != evmtypes.ExecutorName {
+			continue
+		}
+		receipt, err := evm.newTraceExecutor(block, stateDB, localDB).Exec(tx, i)
+		if err != nil {
+			log.Debug("TraceTransaction replay", "hash", common.ToHex(tx.Hash()), "err", err)
+			continue
+		}
+		for _, kv := range receipt.GetKV() {
+			_ = stateDB.Set(kv.Key, kv.Value)
+		}
+	}
+
+	exec := evm.newTraceExecutor(block, stateDB, localDB)
+	exec.vmCfg.Debug = true
+	exec.vmCfg.Tracer = tracer
+	_, execErr := exec.Exec(detail.Tx, int(detail.Index))
+
+	reply := &evmtypes.ReplyEVMTrace{}
+	if callTracer != nil {
+		frame := callTracer.Result()
+		if frame == nil {
+			// 没有执行到合约代码
+			if execErr != nil {
+				return nil, execErr
+			}
+			return reply, nil
+		}
+		reply.Call = convertCallFrame(frame)
+		reply.GasUsed = frame.GasUsed
+		reply.Failed = frame.Err != nil
+		reply.ReturnValue = common.ToHex(frame.Output)
+		return reply, nil
+	}
+	reply.GasUsed = structLogger.GasUsed()
+	reply.Failed = execErr != nil
+	reply.ReturnValue = common.ToHex(structLogger.Output())
+	for _, item := range structLogger.StructLogs() {
+		reply.StructLogs = append(reply.StructLogs, convertStructLog(&item))
+	}
+	return reply, nil
+}
+
+func convertCallFrame(frame *runtime.CallFrame) *evmtypes.EVMCallFrame {
+	call := &evmtypes.EVMCallFrame{
+		Type:    frame.Type.String(),
+		From:    frame.From.String(),
+		To:      frame.To.String(),
+		Value:   frame.Value,
+		Gas:     frame.Gas,
+		GasUsed: frame.GasUsed,
+		Input:   common.ToHex(frame.Input),
+		Output:  common.ToHex(frame.Output),
+	}
+	if frame.Err != nil {
+		call.Error = frame.Err.Error()
+	}
+	for _, sub := range frame.Calls {
+		call.Calls = append(call.Calls, convertCallFrame(sub))
+	}
+	return call
+}
+
+func convertStructLog(item *runtime.StructLog) *evmtypes.EVMStructLog {
+	structLog := &evmtypes.EVMStructLog{
+		Pc:      item.Pc,
+		Op:      item.Op.String(),
+		Gas:     item.Gas,
+		GasCost: item.GasCost,
+		Depth:   int32(item.Depth),
+		Memory:  item.Memory,
+	}
+	for _, v := range item.Stack {
+		structLog.Stack = append(structLog.Stack, "0x"+v.Text(16))
+	}
+	if len(item.Storage) > 0 {
+		structLog.Storage = make(map[string]string, len(item.Storage))
+		for k, v := range item.Storage {
+			structLog.Storage[common.ToHex(k.Bytes())] = common.ToHex(v.Bytes())
+		}
+	}
+	if item.Err != nil {
+		structLog.Error = item.Err.Error()
+	}
+	return structLog
+}
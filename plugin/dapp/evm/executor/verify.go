@@ -0,0 +1,93 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 合约源码验证信息登记
+// 源码在链外编译，链上只检查提交的运行时代码和合约部署后的代码是否一致，
+// 一致时保存编译器版本、编译参数、源码哈希和ABI，区块浏览器和审计方可以据此重新编译源码进行核对；
+// 只有合约创建者可以登记，重复登记时覆盖之前的信息
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/abi"
+	evmcommon "github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+func calcContractVerifyKey(addr string) []byte {
+	return []byte("mavl-" + evmtypes.ExecutorName + "-verify: " + addr)
+}
+
+// 登记合约源码验证信息
+func (evm *EVMExecutor) execVerify(tx *types.Transaction, verify *evmtypes.EVMContractVerify) (*types.Receipt, error) {
+	cfg := evm.GetAPI().GetConfig()
+	if !cfg.IsDappFork(evm.GetHeight(), "evm", evmtypes.ForkEVMVerify) {
+		return nil, types.ErrActionNotSupport
+	}
+	sourceHash, err := common.FromHex(verify.SourceHash)
+	if err != nil || len(sourceHash) != common.Sha256Len {
+		return nil, types.ErrInvalidParam
+	}
+	if len(verify.Abi) > 0 {
+		if _, err := abi.JSON(strings.NewReader(verify.Abi)); err != nil {
+			return nil, err
+		}
+	}
+
+	addr := getReceiver(tx)
+	if addr == nil {
+		return nil, types.ErrInvalidAddress
+	}
+	account := evm.mStateDB.GetAccount(addr.String())
+	if account == nil {
+		return nil, model.ErrAddrNotExists
+	}
+	// 以太坊格式交易创建的合约，创建者为同一公钥对应的以太坊地址
+	creator := account.GetCreator()
+	if creator != tx.From() && creator != evmtypes.PubKeyToEthAddr(tx.GetSignature().GetPubkey()) {
+		return nil, model.ErrVerifyNotCreator
+	}
+	codeHash := evmcommon.ToHash(verify.RuntimeCode)
+	if !bytes.Equal(codeHash.Bytes(), account.Data.GetCodeHash()) {
+		return nil, model.ErrVerifyCodeMismatch
+	}
+
+	info := &evmtypes.EVMContractVerifyInfo{
+		Addr:            addr.String(),
+		CompilerVersion: verify.CompilerVersion,
+		Settings:        verify.Settings,
+		SourceHash:      common.ToHex(sourceHash),
+		Abi:             verify.Abi,
+		CodeHash:        common.ToHex(codeHash.Bytes()),
+		Submitter:       tx.From(),
+		Height:          evm.GetHeight(),
+		TxHash:          common.ToHex(tx.Hash()),
+	}
+	kv := &types.KeyValue{Key: calcContractVerifyKey(info.Addr), Value: types.Encode(info)}
+	log := &types.ReceiptLog{Ty: evmtypes.TyLogEVMContractVerify, Log: types.Encode(info)}
+	return &types.Receipt{Ty: types.ExecOk, KV: []*types.KeyValue{kv}, Logs: []*types.ReceiptLog{log}}, nil
+}
+
+// Query_GetContractVerify 查询合约源码验证信息
+func (evm *EVMExecutor) Query_GetContractVerify(in *evmtypes.EvmQueryVerifyReq) (types.Message, error) {
+	addr := evmcommon.StringToAddress(in.GetAddress())
+	if addr == nil {
+		return nil, types.ErrInvalidAddress
+	}
+	value, err := evm.GetStateDB().Get(calcContractVerifyKey(addr.String()))
+	if err != nil || len(value) == 0 {
+		return nil, model.ErrVerifyNotFound
+	}
+	var info evmtypes.EVMContractVerifyInfo
+	if err := types.Decode(value, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
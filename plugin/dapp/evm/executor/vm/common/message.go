@@ -16,6 +16,26 @@ type Message struct {
 	gasPrice uint32
 	data     []byte
 	abi      string
+	// EIP-2930访问列表
+	accessList AccessList
+}
+
+// AccessTuple 访问列表中的地址和存储
+type AccessTuple struct {
+	Address     Address
+	StorageKeys []Hash
+}
+
+// AccessList 交易中预先声明访问的地址和存储
+type AccessList []AccessTuple
+
+// StorageKeys 访问列表中存储的数量
+func (al AccessList) StorageKeys() int {
+	sum := 0
+	for _, tuple := range al {
+		sum += len(tuple.StorageKeys)
+	}
+	return sum
 }
 
 // NewMessage 新建消息结构
@@ -59,3 +79,9 @@ func (m Message) Alias() string { return m.alias }
 
 // ABI 合约ABI
 func (m Message) ABI() string { return m.abi }
+
+// AccessList 访问列表
+func (m Message) AccessList() AccessList { return m.accessList }
+
+// SetAccessList 设置访问列表
+func (m *Message) SetAccessList(list AccessList) { m.accessList = list }
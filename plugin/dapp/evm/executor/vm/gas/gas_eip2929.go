@@ -0,0 +1,183 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package gas
+
+// EIP-2929 访问列表相关的Gas计算（Berlin），以及EIP-3529对返还Gas的调整（London）
+// Table中的ExtcodeSize、ExtcodeCopy、Balance、SLoad、Calls为访问warm地址或存储的价格，
+// 首次访问cold地址或存储时，在下面的计算逻辑中补足差价
+
+import (
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/mm"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
+)
+
+var (
+	// TableBerlin Berlin版本的Gas定价，访问状态数据的价格为warm状态下的价格
+	TableBerlin = Table{
+		ExtcodeSize: params.WarmStorageReadCostEIP2929,
+		ExtcodeCopy: params.WarmStorageReadCostEIP2929,
+		Balance:     params.WarmStorageReadCostEIP2929,
+		SLoad:       params.WarmStorageReadCostEIP2929,
+		Calls:       params.WarmStorageReadCostEIP2929,
+		Suicide:     0,
+		ExpByte:     10,
+	}
+
+	// SStoreEIP2929 Berlin版本的存储计费
+	SStoreEIP2929 = MakeSStoreEIP2929(params.SstoreClearRefundEIP2200)
+	// SStoreEIP3529 London版本的存储计费，清空存储返还的Gas减少
+	SStoreEIP3529 = MakeSStoreEIP2929(params.SstoreClearsScheduleRefundEIP3529)
+
+	// CallEIP2929 调用合约计费
+	CallEIP2929 = makeCallVariantEIP2929(Call)
+	// CallCodeEIP2929 调用合约代码计费
+	CallCodeEIP2929 = makeCallVariantEIP2929(CallCode)
+	// DelegateCallEIP2929 委托调用计费
+	DelegateCallEIP2929 = makeCallVariantEIP2929(DelegateCall)
+	// StaticCallEIP2929 静态调用计费
+	StaticCallEIP2929 = makeCallVariantEIP2929(StaticCall)
+
+	// SuicideEIP2929 Berlin版本的自杀操作计费
+	SuicideEIP2929 = makeSuicideEIP2929(true)
+	// SuicideEIP3529 London版本的自杀操作计费，不再返还Gas
+	SuicideEIP3529 = makeSuicideEIP2929(false)
+)
+
+// SLoadEIP2929 加载存储计费，首次访问的存储按cold价格计费
+func SLoadEIP2929(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	addr := contractGas.Address.String()
+	slot := common.Uint256ToHash(stack.Back(0))
+	if _, slotOk := evm.StateDB.SlotInAccessList(addr, slot); !slotOk {
+		evm.StateDB.AddSlotToAccessList(addr, slot)
+		return params.ColdSloadCostEIP2929, nil
+	}
+	return params.WarmStorageReadCostEIP2929, nil
+}
+
+// MakeSStoreEIP2929 生成EIP-2929存储计费方法，clearingRefund为清空存储时返还的Gas
+// 计费规则和EIP-2200相同，根据存储在交易开始时的值、当前值和新值计算
+func MakeSStoreEIP2929(clearingRefund uint64) CalcGasFunc {
+	return func(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+		// 剩余Gas不足时不允许修改存储
+		if contractGas.Gas <= params.SstoreSentryGasEIP2200 {
+			return 0, model.ErrOutOfGas
+		}
+		var (
+			addr    = contractGas.Address.String()
+			slot    = common.Uint256ToHash(stack.Back(0))
+			value   = common.Uint256ToHash(stack.Back(1))
+			current = evm.StateDB.GetState(addr, slot)
+			cost    = uint64(0)
+		)
+		if _, slotOk := evm.StateDB.SlotInAccessList(addr, slot); !slotOk {
+			cost = params.ColdSloadCostEIP2929
+			evm.StateDB.AddSlotToAccessList(addr, slot)
+		}
+
+		// 值没有变化
+		if current == value {
+			return cost + params.WarmStorageReadCostEIP2929, nil
+		}
+		original := evm.StateDB.GetCommittedState(addr, slot)
+		// 交易中首次修改此存储
+		if original == current {
+			if original == (common.Hash{}) {
+				return cost + params.SstoreInitGasEIP2200, nil
+			}
+			if value == (common.Hash{}) {
+				evm.StateDB.AddRefund(clearingRefund)
+			}
+			return cost + (params.SstoreCleanGasEIP2200 - params.ColdSloadCostEIP2929), nil
+		}
+		// 交易中已经修改过此存储，调整之前返还的Gas
+		if original != (common.Hash{}) {
+			if current == (common.Hash{}) {
+				evm.StateDB.SubRefund(clearingRefund)
+			} else if value == (common.Hash{}) {
+				evm.StateDB.AddRefund(clearingRefund)
+			}
+		}
+		if original == value {
+			if original == (common.Hash{}) {
+				evm.StateDB.AddRefund(params.SstoreInitGasEIP2200 - params.WarmStorageReadCostEIP2929)
+			} else {
+				evm.StateDB.AddRefund((params.SstoreCleanGasEIP2200 - params.ColdSloadCostEIP2929) - params.WarmStorageReadCostEIP2929)
+			}
+		}
+		return cost + params.WarmStorageReadCostEIP2929, nil
+	}
+}
+
+// AccountCheckEIP2929 BALANCE、EXTCODESIZE、EXTCODEHASH指令计费，首次访问的地址按cold价格计费
+func AccountCheckEIP2929(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	addr := common.Uint256ToAddress(stack.Back(0)).String()
+	if !evm.StateDB.AddressInAccessList(addr) {
+		evm.StateDB.AddAddressToAccessList(addr)
+		return params.ColdAccountAccessCostEIP2929, nil
+	}
+	return params.WarmStorageReadCostEIP2929, nil
+}
+
+// ExtCodeCopyEIP2929 扩展代码复制计费，首次访问的地址需要补足cold价格
+func ExtCodeCopyEIP2929(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+	gas, err := ExtCodeCopy(gt, evm, contractGas, stack, mem, memorySize)
+	if err != nil {
+		return 0, err
+	}
+	addr := common.Uint256ToAddress(stack.Back(0)).String()
+	if !evm.StateDB.AddressInAccessList(addr) {
+		evm.StateDB.AddAddressToAccessList(addr)
+		var overflow bool
+		if gas, overflow = common.SafeAdd(gas, params.ColdAccountAccessCostEIP2929-params.WarmStorageReadCostEIP2929); overflow {
+			return 0, model.ErrGasUintOverflow
+		}
+	}
+	return gas, nil
+}
+
+// 调用合约时首次访问的地址需要补足cold价格
+// 补足的Gas需要先从可用Gas中扣除，再计算传递给被调用合约的Gas
+func makeCallVariantEIP2929(oldCalculator CalcGasFunc) CalcGasFunc {
+	return func(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+		addr := common.Uint256ToAddress(stack.Back(1)).String()
+		if evm.StateDB.AddressInAccessList(addr) {
+			return oldCalculator(gt, evm, contractGas, stack, mem, memorySize)
+		}
+		evm.StateDB.AddAddressToAccessList(addr)
+		coldCost := params.ColdAccountAccessCostEIP2929 - params.WarmStorageReadCostEIP2929
+		if contractGas.Gas < coldCost {
+			return 0, model.ErrOutOfGas
+		}
+		contractGas.Gas -= coldCost
+		gas, err := oldCalculator(gt, evm, contractGas, stack, mem, memorySize)
+		contractGas.Gas += coldCost
+		if err != nil {
+			return 0, err
+		}
+		var overflow bool
+		if gas, overflow = common.SafeAdd(gas, coldCost); overflow {
+			return 0, model.ErrGasUintOverflow
+		}
+		return gas, nil
+	}
+}
+
+// 自杀操作计费，首次访问的受益地址按cold价格计费
+func makeSuicideEIP2929(refundsEnabled bool) CalcGasFunc {
+	return func(gt Table, evm *params.EVMParam, contractGas *params.GasParam, stack *mm.Stack, mem *mm.Memory, memorySize uint64) (uint64, error) {
+		gas := gt.Suicide
+		addr := common.Uint256ToAddress(stack.Back(0)).String()
+		if !evm.StateDB.AddressInAccessList(addr) {
+			evm.StateDB.AddAddressToAccessList(addr)
+			gas += params.ColdAccountAccessCostEIP2929
+		}
+		if refundsEnabled && !evm.StateDB.HasSuicided(contractGas.Address.String()) {
+			evm.StateDB.AddRefund(params.SelfdestructRefundGas)
+		}
+		return gas, nil
+	}
+}
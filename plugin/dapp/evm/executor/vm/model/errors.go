@@ -49,4 +49,34 @@ var (
 	ErrInvalidJump = errors.New("invalid jump destination")
 	// ErrInvalidRetsub invalid retsub
 	ErrInvalidRetsub = errors.New("invalid retsub")
+
+	// ErrTraceTooOld transaction is too old to trace
+	ErrTraceTooOld = errors.New("transaction is too old to trace")
+	// ErrTraceBusy too many transactions are being traced
+	ErrTraceBusy = errors.New("too many transactions are being traced")
+	// ErrTracerNotSupport tracer not supported
+	ErrTracerNotSupport = errors.New("tracer not supported")
+	// ErrStorageTooOld height is too old to query contract storage
+	ErrStorageTooOld = errors.New("height is too old to query contract storage")
+
+	// ErrInvalidCode invalid code: must not begin with 0xef
+	ErrInvalidCode = errors.New("invalid code: must not begin with 0xef")
+	// ErrVerifyCodeMismatch contract runtime code mismatch
+	ErrVerifyCodeMismatch = errors.New("contract runtime code mismatch")
+	// ErrVerifyNotCreator only contract creator can register verification
+	ErrVerifyNotCreator = errors.New("only contract creator can register verification")
+	// ErrVerifyNotFound contract verification not found
+	ErrVerifyNotFound = errors.New("contract verification not found")
+	// ErrDelegateNotAuthorized sender is not authorized to call on behalf of the address
+	ErrDelegateNotAuthorized = errors.New("sender is not authorized to call on behalf of the address")
+	// ErrDelegateTxUsed multisig tx has been used by a delegate call
+	ErrDelegateTxUsed = errors.New("multisig tx has been used by a delegate call")
+	// ErrERC20Method erc20: method not supported
+	ErrERC20Method = errors.New("erc20: method not supported")
+	// ErrERC20Input erc20: invalid input
+	ErrERC20Input = errors.New("erc20: invalid input")
+	// ErrERC20Allowance erc20: insufficient allowance
+	ErrERC20Allowance = errors.New("erc20: insufficient allowance")
+	// ErrERC20Delegate erc20: delegate call not allowed
+	ErrERC20Delegate = errors.New("erc20: delegate call not allowed")
 )
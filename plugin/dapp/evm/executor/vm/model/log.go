@@ -10,7 +10,7 @@ import (
 )
 
 // ContractLog 合约在日志，对应EVM中的Log指令，可以生成指定的日志信息
-// 目前这些日志只是在合约执行完成时进行打印，没有其它用途
+// ForkEVMEventLog之后这些日志会写入交易收据
 type ContractLog struct {
 	// Address 合约地址
 	Address common.Address
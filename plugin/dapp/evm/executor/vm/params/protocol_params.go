@@ -43,14 +43,26 @@ const (
 	//NetSstoreResetRefund      uint64 = 4800  // Once per SSTORE operation for resetting to the original non-zero value
 	//NetSstoreResetClearRefund uint64 = 19800 // Once per SSTORE operation for resetting to the original zero value
 	//
-	//SstoreSentryGasEIP2200   uint64 = 2300  // Minimum gas required to be present for an SSTORE call, not consumed
+	SstoreSentryGasEIP2200 uint64 = 2300 // Minimum gas required to be present for an SSTORE call, not consumed
 	//SstoreNoopGasEIP2200     uint64 = 800   // Once per SSTORE operation if the value doesn't change.
 	//SstoreDirtyGasEIP2200    uint64 = 800   // Once per SSTORE operation if a dirty value is changed.
-	//SstoreInitGasEIP2200     uint64 = 20000 // Once per SSTORE operation from clean zero to non-zero
+	SstoreInitGasEIP2200 uint64 = 20000 // Once per SSTORE operation from clean zero to non-zero
 	//SstoreInitRefundEIP2200  uint64 = 19200 // Once per SSTORE operation for resetting to the original zero value
-	//SstoreCleanGasEIP2200    uint64 = 5000  // Once per SSTORE operation from clean non-zero to something else
+	SstoreCleanGasEIP2200 uint64 = 5000 // Once per SSTORE operation from clean non-zero to something else
 	//SstoreCleanRefundEIP2200 uint64 = 4200  // Once per SSTORE operation for resetting to the original non-zero value
-	//SstoreClearRefundEIP2200 uint64 = 15000 // Once per SSTORE operation for clearing an originally existing storage slot
+	SstoreClearRefundEIP2200 uint64 = 15000 // Once per SSTORE operation for clearing an originally existing storage slot
+
+	ColdAccountAccessCostEIP2929 uint64 = 2600 // COLD_ACCOUNT_ACCESS_COST
+	ColdSloadCostEIP2929         uint64 = 2100 // COLD_SLOAD_COST
+	WarmStorageReadCostEIP2929   uint64 = 100  // WARM_STORAGE_READ_COST
+
+	// SSTORE_RESET_GAS - COLD_SLOAD_COST + ACCESS_LIST_STORAGE_KEY_COST
+	SstoreClearsScheduleRefundEIP3529 uint64 = SstoreCleanGasEIP2200 - ColdSloadCostEIP2929 + TxAccessListStorageKeyGas
+
+	TxAccessListAddressGas    uint64 = 2400 // Per address specified in EIP 2930 access list
+	TxAccessListStorageKeyGas uint64 = 1900 // Per storage key specified in EIP 2930 access list
+
+	RefundQuotientEIP3529 uint64 = 5 // Maximum refund quotient; max gas refund is 1/5 of gas used after EIP 3529
 
 	JumpdestGas uint64 = 1 // Once per JUMPDEST operation.
 	//EpochDuration uint64 = 30000 // Duration between proof-of-work epochs.
@@ -131,6 +143,11 @@ const (
 	Bls12381PairingPerPairGas uint64 = 23000  // Per-point pair gas price for BLS12-381 elliptic curve pairing check
 	Bls12381MapG1Gas          uint64 = 5500   // Gas price for BLS12-381 mapping field element to G1 operation
 	Bls12381MapG2Gas          uint64 = 110000 // Gas price for BLS12-381 mapping field element to G2 operation
+
+	ERC20ReadGas     uint64 = 1000  // Price for reading chain33 asset balance or allowance
+	ERC20TransferGas uint64 = 30000 // Price for transferring chain33 asset in evm executor
+	ERC20ApproveGas  uint64 = 25000 // Price for setting allowance of chain33 asset
+	ERC20FactoryGas  uint64 = 40000 // Price for registering the ERC-20 address of chain33 asset
 )
 
 // Bls12381MultiExpDiscountTable Gas discount table for BLS12-381 G1 and G2 multi exponentiation operations
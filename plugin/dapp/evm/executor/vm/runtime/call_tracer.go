@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"time"
+
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/mm"
+)
+
+// CallFrame 一次合约调用的信息，Calls为本次调用中发起的内部调用
+type CallFrame struct {
+	// Type 调用类型，CALL/CALLCODE/DELEGATECALL/STATICCALL/CREATE
+	Type OpCode
+	// From 调用者地址
+	From common.Address
+	// To 被调用的合约地址
+	To common.Address
+	// Value 转账金额
+	Value uint64
+	// Gas 调用时提供的Gas
+	Gas uint64
+	// GasUsed 调用消耗的Gas
+	GasUsed uint64
+	// Input 调用参数，创建合约时为合约代码
+	Input []byte
+	// Output 返回数据
+	Output []byte
+	// Err 调用错误
+	Err error
+	// Calls 内部调用
+	Calls []*CallFrame
+}
+
+// CallTracer 记录合约执行过程中的调用树
+type CallTracer struct {
+	callstack []*CallFrame
+}
+
+// NewCallTracer 创建调用树记录器
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// CaptureStart 记录最外层调用
+func (t *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	typ := CALL
+	if create {
+		typ = CREATE
+	}
+	t.callstack = []*CallFrame{{Type: typ, From: from, To: to, Input: common.CopyBytes(input), Gas: gas, Value: value}}
+	return nil
+}
+
+// CaptureState 不记录指令执行状态
+func (t *CallTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, rStack *mm.ReturnStack, rData []byte, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureFault 错误在调用结束时记录
+func (t *CallTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, rStack *mm.ReturnStack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd 记录最外层调用的结果
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	t.callstack[0].Output = common.CopyBytes(output)
+	t.callstack[0].GasUsed = gasUsed
+	t.callstack[0].Err = err
+	return nil
+}
+
+// CaptureEnter 内部调用入栈
+func (t *CallTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+	if len(t.callstack) == 0 {
+		return
+	}
+	t.callstack = append(t.callstack, &CallFrame{Type: typ, From: from, To: to, Input: common.CopyBytes(input), Gas: gas, Value: value})
+}
+
+// CaptureExit 内部调用出栈，并加入上一层调用的内部调用列表
+func (t *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	size := len(t.callstack)
+	if size <= 1 {
+		return
+	}
+	call := t.callstack[size-1]
+	t.callstack = t.callstack[:size-1]
+	call.Output = common.CopyBytes(output)
+	call.GasUsed = gasUsed
+	call.Err = err
+	t.callstack[size-2].Calls = append(t.callstack[size-2].Calls, call)
+}
+
+// Result 返回调用树，未发生调用时返回nil
+func (t *CallTracer) Result() *CallFrame {
+	if len(t.callstack) == 0 {
+		return nil
+	}
+	return t.callstack[0]
+}
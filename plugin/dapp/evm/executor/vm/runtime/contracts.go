@@ -5,11 +5,13 @@
 package runtime
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"math"
 	"math/big"
+	"strings"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common/crypto"
@@ -19,6 +21,7 @@ import (
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/params"
 
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/model"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
 	"golang.org/x/crypto/ripemd160"
 )
 
@@ -941,3 +944,379 @@ func (c *bls12381MapG2) Run(input []byte) ([]byte, error) {
 	// Encode the G2 point to 256 bytes
 	return g.EncodePoint(r), nil
 }
+
+// StatefulPrecompiledContract 需要读写状态数据的预编译合约
+type StatefulPrecompiledContract interface {
+	// 计算当前合约执行需要消耗的Gas
+	RequiredGas(input []byte) uint64
+
+	// 执行预编译的合约固定逻辑，通过evm读写状态数据
+	Run(evm *EVM, contract *Contract, input []byte) ([]byte, error)
+}
+
+// RunStatefulPrecompiledContract 调用需要读写状态数据的预编译合约
+func RunStatefulPrecompiledContract(p StatefulPrecompiledContract, evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
+	gas := p.RequiredGas(input)
+	if contract.UseGas(gas) {
+		return p.Run(evm, contract, input)
+	}
+	return nil, model.ErrOutOfGas
+}
+
+// 以ERC-20接口访问chain33资产的预编译合约
+// 每种资产(exec, symbol)对应一个ERC-20合约地址，由工厂合约计算并注册，
+// 注册后合约地址下保存以erc20CodePrefix开头的代码，并在存储中写入标记，
+// 调用时合约地址需要和代码中的资产计算出的地址一致并且存在标记才识别为资产合约，用户不能部署以erc20CodePrefix开头的合约代码；
+// 余额为地址在evm执行器下的资产余额，资产需要先转入evm执行器，授权额度保存在资产合约地址的存储中
+
+// ERC20FactoryAddress 资产ERC-20合约的工厂合约地址
+var ERC20FactoryAddress = common.BytesToAddress([]byte{1, 0})
+
+// 资产合约代码前缀，0xfe为INVALID指令，保证代码不会被解释执行
+var erc20CodePrefix = []byte("\xfeerc20:")
+
+// 工厂合约注册资产合约时写入的标记
+var erc20MarkerKey = common.BytesToHash(crypto.Keccak256([]byte("erc20:token")))
+
+// chain33资产精度为1e8
+const erc20Decimals = 8
+
+// 不限额度的授权，transferFrom时不扣减
+var erc20UnlimitedAllowance = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+var (
+	erc20TokenAddressID = erc20MethodID("tokenAddress(string,string)")
+
+	erc20NameID         = erc20MethodID("name()")
+	erc20SymbolID       = erc20MethodID("symbol()")
+	erc20DecimalsID     = erc20MethodID("decimals()")
+	erc20TotalSupplyID  = erc20MethodID("totalSupply()")
+	erc20BalanceOfID    = erc20MethodID("balanceOf(address)")
+	erc20TransferID     = erc20MethodID("transfer(address,uint256)")
+	erc20AllowanceID    = erc20MethodID("allowance(address,address)")
+	erc20ApproveID      = erc20MethodID("approve(address,uint256)")
+	erc20TransferFromID = erc20MethodID("transferFrom(address,address,uint256)")
+
+	erc20TransferEvent = common.BytesToHash(crypto.Keccak256([]byte("Transfer(address,address,uint256)")))
+	erc20ApprovalEvent = common.BytesToHash(crypto.Keccak256([]byte("Approval(address,address,uint256)")))
+)
+
+func erc20MethodID(method string) string {
+	return string(crypto.Keccak256([]byte(method))[:4])
+}
+
+// ERC20TokenAddress 计算资产对应的ERC-20合约地址
+func ERC20TokenAddress(exec, symbol string) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(exec + ":" + symbol))[12:])
+}
+
+// 获取需要读写状态数据的预编译合约
+func statefulPrecompiled(evm *EVM, contract *Contract) StatefulPrecompiledContract {
+	if !evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMERC20) {
+		return nil
+	}
+	if isERC20Factory(*contract.CodeAddr) {
+		return &erc20Factory{}
+	}
+	if exec, symbol, ok := erc20Asset(contract.Code); ok {
+		addr := ERC20TokenAddress(exec, symbol)
+		if bytes.Equal(addr.Bytes(), contract.CodeAddr.Bytes()) && evm.StateDB.GetState(addr.String(), erc20MarkerKey) != (common.Hash{}) {
+			return &erc20Token{exec: exec, symbol: symbol}
+		}
+	}
+	return nil
+}
+
+// 从资产合约代码中解析资产名称
+func erc20Asset(code []byte) (string, string, bool) {
+	if !isERC20Code(code) {
+		return "", "", false
+	}
+	asset := strings.SplitN(string(code[len(erc20CodePrefix):]), ":", 2)
+	if len(asset) != 2 {
+		return "", "", false
+	}
+	return asset[0], asset[1], true
+}
+
+// 是否以资产合约代码前缀开头
+func isERC20Code(code []byte) bool {
+	return bytes.HasPrefix(code, erc20CodePrefix)
+}
+
+// 地址结构中包含指针，需要按字节比较
+func isERC20Factory(addr common.Address) bool {
+	return bytes.Equal(addr.Bytes(), ERC20FactoryAddress.Bytes())
+}
+
+// 工厂合约 tokenAddress(string exec, string symbol) returns (address)
+// 资产合约地址不存在时进行注册，只读调用时只返回地址
+type erc20Factory struct{}
+
+func (c *erc20Factory) RequiredGas(input []byte) uint64 {
+	return params.ERC20FactoryGas
+}
+
+func (c *erc20Factory) Run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
+	if len(input) < 4 || string(input[:4]) != erc20TokenAddressID {
+		return nil, model.ErrERC20Method
+	}
+	exec, err := erc20StringArg(input[4:], 0)
+	if err != nil {
+		return nil, err
+	}
+	symbol, err := erc20StringArg(input[4:], 1)
+	if err != nil {
+		return nil, err
+	}
+	if exec == "" || symbol == "" || strings.Contains(exec, ":") {
+		return nil, model.ErrERC20Input
+	}
+	// 检查资产名称是否合法
+	if _, err := evm.StateDB.GetAssetSupply(exec, symbol); err != nil {
+		return nil, err
+	}
+
+	addr := ERC20TokenAddress(exec, symbol)
+	if !evm.StateDB.Exist(addr.String()) && !evm.Interpreter.readOnly {
+		evm.StateDB.CreateAccount(addr.String(), ERC20FactoryAddress.String(), exec+":"+symbol, symbol)
+		evm.StateDB.SetCode(addr.String(), append(common.CopyBytes(erc20CodePrefix), []byte(exec+":"+symbol)...))
+		evm.StateDB.SetState(addr.String(), erc20MarkerKey, common.BytesToHash([]byte{1}))
+	}
+	return common.LeftPadBytes(addr.Bytes(), 32), nil
+}
+
+// 资产合约，实现ERC-20标准接口
+type erc20Token struct {
+	exec   string
+	symbol string
+}
+
+func (c *erc20Token) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return params.ERC20ReadGas
+	}
+	switch string(input[:4]) {
+	case erc20TransferID:
+		return params.ERC20TransferGas
+	case erc20ApproveID:
+		return params.ERC20ApproveGas
+	case erc20TransferFromID:
+		return params.ERC20TransferGas + params.ERC20ApproveGas
+	default:
+		return params.ERC20ReadGas
+	}
+}
+
+func (c *erc20Token) Run(evm *EVM, contract *Contract, input []byte) ([]byte, error) {
+	// 授权额度保存在资产合约的存储中，不允许在其它合约的上下文中执行
+	if !bytes.Equal(contract.Address().Bytes(), contract.CodeAddr.Bytes()) {
+		return nil, model.ErrERC20Delegate
+	}
+	if contract.value > 0 || len(input) < 4 {
+		return nil, model.ErrERC20Input
+	}
+	exec, symbol := c.exec, c.symbol
+	method, args := string(input[:4]), input[4:]
+
+	switch method {
+	case erc20NameID, erc20SymbolID:
+		return erc20EncodeString(symbol), nil
+	case erc20DecimalsID:
+		return common.LeftPadBytes([]byte{erc20Decimals}, 32), nil
+	case erc20TotalSupplyID:
+		supply, err := evm.StateDB.GetAssetSupply(exec, symbol)
+		if err != nil {
+			return nil, err
+		}
+		return erc20EncodeAmount(supply), nil
+	case erc20BalanceOfID:
+		owner, err := erc20AddressArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		balance, err := evm.StateDB.GetAssetBalance(exec, symbol, owner.String())
+		if err != nil {
+			return nil, err
+		}
+		return erc20EncodeAmount(balance), nil
+	case erc20AllowanceID:
+		owner, err := erc20AddressArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		spender, err := erc20AddressArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return evm.StateDB.GetState(contract.Address().String(), erc20AllowanceKey(owner, spender)).Bytes(), nil
+	case erc20TransferID, erc20ApproveID, erc20TransferFromID:
+		if evm.Interpreter.readOnly {
+			return nil, model.ErrWriteProtection
+		}
+		return c.write(evm, contract, exec, symbol, method, args)
+	default:
+		return nil, model.ErrERC20Method
+	}
+}
+
+// 执行修改状态的方法，成功时返回true
+func (c *erc20Token) write(evm *EVM, contract *Contract, exec, symbol, method string, args []byte) ([]byte, error) {
+	token := contract.Address()
+	sender := contract.Caller()
+	switch method {
+	case erc20TransferID:
+		to, err := erc20AddressArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := erc20AmountArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		if err := erc20Transfer(evm, token, exec, symbol, sender, to, amount); err != nil {
+			return nil, err
+		}
+	case erc20ApproveID:
+		spender, err := erc20AddressArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		// 授权额度不需要在chain33金额的范围内，以兼容授权type(uint256).max的合约
+		amount, err := erc20UintArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		erc20Approve(evm, token, sender, spender, amount)
+	case erc20TransferFromID:
+		from, err := erc20AddressArg(args, 0)
+		if err != nil {
+			return nil, err
+		}
+		to, err := erc20AddressArg(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := erc20AmountArg(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		// 从自己账户转出时不需要授权，授权额度为type(uint256).max时不扣减
+		if !bytes.Equal(from.Bytes(), sender.Bytes()) {
+			allowance := evm.StateDB.GetState(token.String(), erc20AllowanceKey(from, sender)).Big()
+			if allowance.Cmp(big.NewInt(amount)) < 0 {
+				return nil, model.ErrERC20Allowance
+			}
+			if allowance.Cmp(erc20UnlimitedAllowance) != 0 {
+				evm.StateDB.SetState(token.String(), erc20AllowanceKey(from, sender), common.BigToHash(allowance.Sub(allowance, big.NewInt(amount))))
+			}
+		}
+		if err := erc20Transfer(evm, token, exec, symbol, from, to, amount); err != nil {
+			return nil, err
+		}
+	}
+	return common.LeftPadBytes([]byte{1}, 32), nil
+}
+
+func erc20Transfer(evm *EVM, token common.Address, exec, symbol string, from, to common.Address, amount int64) error {
+	if err := evm.StateDB.TransferAsset(exec, symbol, from.String(), to.String(), amount); err != nil {
+		return err
+	}
+	evm.StateDB.AddLog(&model.ContractLog{
+		Address: token,
+		Topics:  []common.Hash{erc20TransferEvent, from.Hash(), to.Hash()},
+		Data:    erc20EncodeAmount(amount),
+	})
+	return nil
+}
+
+func erc20Approve(evm *EVM, token common.Address, owner, spender common.Address, amount *big.Int) {
+	evm.StateDB.SetState(token.String(), erc20AllowanceKey(owner, spender), common.BigToHash(amount))
+	evm.StateDB.AddLog(&model.ContractLog{
+		Address: token,
+		Topics:  []common.Hash{erc20ApprovalEvent, owner.Hash(), spender.Hash()},
+		Data:    common.BigToHash(amount).Bytes(),
+	})
+}
+
+func erc20AllowanceKey(owner, spender common.Address) common.Hash {
+	return common.BytesToHash(crypto.Keccak256(owner.Bytes(), spender.Bytes()))
+}
+
+// 获取第n个32字节的参数
+func erc20Arg(args []byte, n int) ([]byte, error) {
+	if len(args) < (n+1)*32 {
+		return nil, model.ErrERC20Input
+	}
+	return args[n*32 : (n+1)*32], nil
+}
+
+func erc20AddressArg(args []byte, n int) (common.Address, error) {
+	word, err := erc20Arg(args, n)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !allZero(word[:12]) {
+		return common.Address{}, model.ErrERC20Input
+	}
+	return common.BytesToAddress(word[12:]), nil
+}
+
+func erc20UintArg(args []byte, n int) (*big.Int, error) {
+	word, err := erc20Arg(args, n)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(word), nil
+}
+
+// 转账金额需要在chain33金额的范围内
+func erc20AmountArg(args []byte, n int) (int64, error) {
+	amount, err := erc20UintArg(args, n)
+	if err != nil {
+		return 0, err
+	}
+	if !amount.IsInt64() {
+		return 0, model.ErrERC20Input
+	}
+	return amount.Int64(), nil
+}
+
+func erc20StringArg(args []byte, n int) (string, error) {
+	word, err := erc20Arg(args, n)
+	if err != nil {
+		return "", err
+	}
+	offset := new(big.Int).SetBytes(word)
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(args)) {
+		return "", model.ErrERC20Input
+	}
+	data := args[offset.Uint64():]
+	if len(data) < 32 {
+		return "", model.ErrERC20Input
+	}
+	size := new(big.Int).SetBytes(data[:32])
+	if !size.IsUint64() || size.Uint64() > uint64(len(data)-32) {
+		return "", model.ErrERC20Input
+	}
+	return string(data[32 : 32+size.Uint64()]), nil
+}
+
+func erc20EncodeAmount(amount int64) []byte {
+	return common.LeftPadBytes(big.NewInt(amount).Bytes(), 32)
+}
+
+func erc20EncodeString(s string) []byte {
+	ret := common.LeftPadBytes([]byte{32}, 32)
+	ret = append(ret, common.LeftPadBytes(big.NewInt(int64(len(s))).Bytes(), 32)...)
+	return append(ret, common.RightPadBytes([]byte(s), (len(s)+31)/32*32)...)
+}
+
+func allZero(b []byte) bool {
+	for _, v := range b {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -43,6 +43,10 @@ func run(evm *EVM, contract *Contract, input []byte) (ret []byte, err error) {
 		if p := precompiles[*contract.CodeAddr]; p != nil {
 			return RunPrecompiledContract(p, input, contract)
 		}
+		// 需要读写状态数据的预编译合约
+		if p := statefulPrecompiled(evm, contract); p != nil {
+			return RunStatefulPrecompiledContract(p, evm, contract, input)
+		}
 	}
 	// 在此处打印下自定义合约的错误信息
 	ret, err = evm.Interpreter.Run(contract, input)
@@ -134,9 +138,28 @@ func NewEVM(ctx Context, statedb state.EVMStateDB, vmConfig Config, cfg *types.C
 // GasTable 返回不同操作消耗的Gas定价表
 // 接收区块高度作为参数，方便以后在这里作分叉处理
 func (evm *EVM) GasTable(num *big.Int) gas.Table {
+	if evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMLondon) {
+		return gas.TableBerlin
+	}
 	return gas.TableHomestead
 }
 
+// ActivePrecompiles 返回当前分叉下预编译合约的地址
+func (evm *EVM) ActivePrecompiles() []string {
+	precompiles := PrecompiledContractsByzantium
+	if evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMYoloV1) {
+		precompiles = PrecompiledContractsYoloV1
+	}
+	addrs := make([]string, 0, len(precompiles)+1)
+	for addr := range precompiles {
+		addrs = append(addrs, addr.String())
+	}
+	if evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMERC20) {
+		addrs = append(addrs, ERC20FactoryAddress.String())
+	}
+	return addrs
+}
+
 // Cancel 调用此操作会在任意时刻取消此EVM的解释运行逻辑，支持重复调用
 func (evm *EVM) Cancel() {
 	atomic.StoreInt32(&evm.abort, 1)
@@ -183,6 +206,14 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 		return nil, -1, gas, err
 	}
 
+	// 调试模式下记录内部调用
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureEnter(CALL, caller.Address(), addr, input, gas, value)
+		defer func() {
+			evm.VMConfig.Tracer.CaptureExit(ret, gas-leftOverGas, err)
+		}()
+	}
+
 	if !evm.StateDB.Exist(addr.String()) {
 		//预编译分叉处理： chain33中目前只存在拜占庭和最新的黄皮书v1版本（兼容伊斯坦布尔版本）
 		precompiles := PrecompiledContractsByzantium
@@ -190,8 +221,10 @@ func (evm *EVM) Call(caller ContractRef, addr common.Address, input []byte, gas
 		if evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMYoloV1) {
 			precompiles = PrecompiledContractsYoloV1
 		}
+		// 资产ERC-20工厂合约没有对应的账户
+		isFactory := isERC20Factory(addr) && evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMERC20)
 		// 合约地址在自定义合约和预编译合约中都不存在时，可能为外部账户
-		if precompiles[addr] == nil {
+		if precompiles[addr] == nil && !isFactory {
 			// 只有一种情况会走到这里来，就是合约账户向外部账户转账的情况
 			if len(input) > 0 || value == 0 {
 				// 其它情况要求地址必须存在，所以需要报错
@@ -262,6 +295,14 @@ func (evm *EVM) CallCode(caller ContractRef, addr common.Address, input []byte,
 		return nil, gas, err
 	}
 
+	// 调试模式下记录内部调用
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureEnter(CALLCODE, caller.Address(), addr, input, gas, value)
+		defer func() {
+			evm.VMConfig.Tracer.CaptureExit(ret, gas-leftOverGas, err)
+		}()
+	}
+
 	// 如果是已经销毁状态的合约是不允许调用的
 	if evm.StateDB.HasSuicided(addr.String()) {
 		return nil, gas, model.ErrDestruct
@@ -296,6 +337,14 @@ func (evm *EVM) DelegateCall(caller ContractRef, addr common.Address, input []by
 		return nil, gas, err
 	}
 
+	// 调试模式下记录内部调用
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureEnter(DELEGATECALL, caller.Address(), addr, input, gas, 0)
+		defer func() {
+			evm.VMConfig.Tracer.CaptureExit(ret, gas-leftOverGas, err)
+		}()
+	}
+
 	// 如果是已经销毁状态的合约是不允许调用的
 	if evm.StateDB.HasSuicided(addr.String()) {
 		return nil, gas, model.ErrDestruct
@@ -331,6 +380,14 @@ func (evm *EVM) StaticCall(caller ContractRef, addr common.Address, input []byte
 		return nil, gas, err
 	}
 
+	// 调试模式下记录内部调用
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureEnter(STATICCALL, caller.Address(), addr, input, gas, 0)
+		defer func() {
+			evm.VMConfig.Tracer.CaptureExit(ret, gas-leftOverGas, err)
+		}()
+	}
+
 	// 如果是已经销毁状态的合约是不允许调用的
 	if evm.StateDB.HasSuicided(addr.String()) {
 		return nil, gas, model.ErrDestruct
@@ -377,10 +434,25 @@ func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []b
 		return nil, -1, gas, err
 	}
 
+	// 调试模式下记录内部调用
+	if evm.VMConfig.Debug && evm.depth > 0 {
+		evm.VMConfig.Tracer.CaptureEnter(CREATE, caller.Address(), contractAddr, code, gas, 0)
+		defer func() {
+			evm.VMConfig.Tracer.CaptureExit(ret, gas-leftOverGas, err)
+		}()
+	}
+
 	// 创建新的合约对象，包含双方地址以及合约代码，可用Gas信息
 	contract := NewContract(caller, AccountRef(contractAddr), 0, gas)
 	contract.SetCallCode(&contractAddr, common.ToHash(code), code)
 
+	cfg := evm.StateDB.GetConfig()
+	london := cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMLondon)
+	// 新合约地址在快照之前加入访问列表，创建失败时依然为warm状态
+	if london {
+		evm.StateDB.AddAddressToAccessList(contractAddr.String())
+	}
+
 	// 创建一个新的账户对象（合约账户）
 	snapshot = evm.StateDB.Snapshot()
 	evm.StateDB.CreateAccount(contractAddr.String(), contract.CallerAddress.String(), execName, alias)
@@ -396,7 +468,14 @@ func (evm *EVM) Create(caller ContractRef, contractAddr common.Address, code []b
 	// 检查部署后的合约代码大小是否超限
 	maxCodeSizeExceeded := len(ret) > evm.maxCodeSize
 
-	cfg := evm.StateDB.GetConfig()
+	// EIP-3541 不允许部署以0xEF开头的合约代码
+	if err == nil && london && len(ret) >= 1 && ret[0] == 0xEF {
+		err = model.ErrInvalidCode
+	}
+	// 不允许部署资产合约代码前缀开头的合约代码
+	if err == nil && isERC20Code(ret) && cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMERC20) {
+		err = model.ErrInvalidCode
+	}
 	// 如果执行成功，计算存储合约代码需要花费的Gas
 	if err == nil && !maxCodeSizeExceeded {
 		createDataGas := uint64(len(ret)) * params.CreateDataGas
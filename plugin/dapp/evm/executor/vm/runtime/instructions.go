@@ -901,6 +901,12 @@ func opSelfBalance(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 	return nil, nil
 }
 
+// 获取区块的基础手续费，chain33中没有基础手续费，返回0
+func opBaseFee(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
+	callContext.stack.Push(uint256.NewInt())
+	return nil, nil
+}
+
 // opChainID implements CHAINID opcode
 //func opChainID(pc *uint64, evm *EVM, callContext *callCtx) ([]byte, error) {
 //	chainId, _ := uint256.FromBig(evm.ChainConfig.ChainID)
@@ -53,6 +53,9 @@ func NewInterpreter(evm *EVM, cfg Config) *Interpreter {
 			//这里需要替换为最新得指令集
 			cfg.JumpTable = YoloV1InstructionSet
 		}
+		if evm.cfg.IsDappFork(evm.StateDB.GetBlockHeight(), "evm", evmtypes.ForkEVMLondon) {
+			cfg.JumpTable = LondonInstructionSet
+		}
 	}
 
 	return &Interpreter{
@@ -49,11 +49,48 @@ var (
 	ConstantinopleInstructionSet = NewConstantinopleInstructionSet()
 	// YoloV1InstructionSet 黄皮书指令集
 	YoloV1InstructionSet = NewYoloV1InstructionSet()
+	// LondonInstructionSet 伦敦版本指令集
+	LondonInstructionSet = NewLondonInstructionSet()
 )
 
 // JumpTable contains the EVM opcodes supported at a given fork.
 type JumpTable [256]Operation
 
+// NewLondonInstructionSet 伦敦版本支持的指令集
+// EIP-3198 BASEFEE指令，EIP-3529 减少SSTORE和SELFDESTRUCT返还的Gas
+func NewLondonInstructionSet() JumpTable {
+	instructionSet := NewBerlinInstructionSet()
+	// New opcode
+	instructionSet[BASEFEE] = Operation{
+		Execute:       opBaseFee,
+		GasCost:       gas.ConstGasFunc(gas.GasQuickStep),
+		ValidateStack: mm.MakeStackFunc(0, 1),
+		Valid:         true,
+	}
+	instructionSet[SSTORE].GasCost = gas.SStoreEIP3529
+	instructionSet[SELFDESTRUCT].GasCost = gas.SuicideEIP3529
+	return instructionSet
+}
+
+// NewBerlinInstructionSet 柏林版本支持的指令集
+// EIP-2929 访问状态数据的指令按地址和存储是否已经访问过分别计费
+// 在黄皮书指令集的基础上修改，保留已经部署的合约中可能使用的子程序指令
+func NewBerlinInstructionSet() JumpTable {
+	instructionSet := NewYoloV1InstructionSet()
+	instructionSet[SLOAD].GasCost = gas.SLoadEIP2929
+	instructionSet[SSTORE].GasCost = gas.SStoreEIP2929
+	instructionSet[BALANCE].GasCost = gas.AccountCheckEIP2929
+	instructionSet[EXTCODESIZE].GasCost = gas.AccountCheckEIP2929
+	instructionSet[EXTCODEHASH].GasCost = gas.AccountCheckEIP2929
+	instructionSet[EXTCODECOPY].GasCost = gas.ExtCodeCopyEIP2929
+	instructionSet[CALL].GasCost = gas.CallEIP2929
+	instructionSet[CALLCODE].GasCost = gas.CallCodeEIP2929
+	instructionSet[DELEGATECALL].GasCost = gas.DelegateCallEIP2929
+	instructionSet[STATICCALL].GasCost = gas.StaticCallEIP2929
+	instructionSet[SELFDESTRUCT].GasCost = gas.SuicideEIP2929
+	return instructionSet
+}
+
 // NewYoloV1InstructionSet 黄皮书指令集
 func NewYoloV1InstructionSet() JumpTable {
 	instructionSet := NewConstantinopleInstructionSet()
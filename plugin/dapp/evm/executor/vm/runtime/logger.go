@@ -28,6 +28,10 @@ type Tracer interface {
 	CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, rStack *mm.ReturnStack, contract *Contract, depth int, err error) error
 	// CaptureEnd 结束记录
 	CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error
+	// CaptureEnter 进入合约内部调用，typ为调用指令
+	CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64)
+	// CaptureExit 合约内部调用结束
+	CaptureExit(output []byte, gasUsed uint64, err error)
 }
 
 // JSONLogger 使用json格式打印日志
@@ -149,3 +153,119 @@ func (logger *JSONLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Durat
 	}
 	return logger.encoder.Encode(endLog{common.Bytes2Hex(output), int64(gasUsed), t, ""})
 }
+
+// CaptureEnter 目前实现为空
+func (logger *JSONLogger) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+}
+
+// CaptureExit 目前实现为空
+func (logger *JSONLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
+// StructLogger 在内存中收集每条指令的执行状态，用于交易跟踪
+type StructLogger struct {
+	cfg LogConfig
+
+	storage map[string]Storage
+	logs    []StructLog
+	output  []byte
+	gasUsed uint64
+	err     error
+}
+
+// NewStructLogger 创建指令跟踪记录器
+func NewStructLogger(cfg *LogConfig) *StructLogger {
+	logger := &StructLogger{storage: make(map[string]Storage)}
+	if cfg != nil {
+		logger.cfg = *cfg
+	}
+	return logger
+}
+
+// CaptureStart 开始记录
+func (l *StructLogger) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value uint64) error {
+	return nil
+}
+
+// CaptureState 记录当前指令执行前的状态，超过记录条数上限后不再记录
+func (l *StructLogger) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, rStack *mm.ReturnStack, rData []byte, contract *Contract, depth int, err error) error {
+	if l.cfg.Limit != 0 && len(l.logs) >= l.cfg.Limit {
+		return nil
+	}
+	log := StructLog{
+		Pc:         pc,
+		Op:         op,
+		Gas:        gas,
+		GasCost:    cost,
+		MemorySize: memory.Len(),
+		Depth:      depth,
+		Err:        err,
+	}
+	if !l.cfg.DisableMemory {
+		log.Memory = formatMemory(memory.Data())
+	}
+	if !l.cfg.DisableStack {
+		log.Stack = formatStack(stack.Data())
+		log.ReturnStack = rStack.Data()
+	}
+	if !l.cfg.DisableReturnData {
+		log.ReturnData = common.CopyBytes(rData)
+	}
+	// 只记录本次执行中读写过的存储数据
+	if !l.cfg.DisableStorage && (op == SLOAD || op == SSTORE) && stack.Len() >= 1 {
+		addr := contract.Address().String()
+		if l.storage[addr] == nil {
+			l.storage[addr] = make(Storage)
+		}
+		key := common.Hash(stack.Back(0).Bytes32())
+		if op == SSTORE && stack.Len() >= 2 {
+			l.storage[addr][key] = common.Hash(stack.Back(1).Bytes32())
+		} else {
+			l.storage[addr][key] = env.StateDB.GetState(addr, key)
+		}
+		log.Storage = l.storage[addr].Copy()
+	}
+	l.logs = append(l.logs, log)
+	return nil
+}
+
+// CaptureFault 错误已经在CaptureState中记录
+func (l *StructLogger) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *mm.Memory, stack *mm.Stack, rStack *mm.ReturnStack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd 记录执行结果
+func (l *StructLogger) CaptureEnd(output []byte, gasUsed uint64, t time.Duration, err error) error {
+	l.output = common.CopyBytes(output)
+	l.gasUsed = gasUsed
+	l.err = err
+	return nil
+}
+
+// CaptureEnter 内部调用的指令已经在CaptureState中记录
+func (l *StructLogger) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value uint64) {
+}
+
+// CaptureExit 内部调用的指令已经在CaptureState中记录
+func (l *StructLogger) CaptureExit(output []byte, gasUsed uint64, err error) {
+}
+
+// StructLogs 返回记录的指令执行状态
+func (l *StructLogger) StructLogs() []StructLog {
+	return l.logs
+}
+
+// Output 返回合约执行结果
+func (l *StructLogger) Output() []byte {
+	return l.output
+}
+
+// GasUsed 返回合约执行消耗的Gas
+func (l *StructLogger) GasUsed() uint64 {
+	return l.gasUsed
+}
+
+// Error 返回合约执行错误
+func (l *StructLogger) Error() error {
+	return l.err
+}
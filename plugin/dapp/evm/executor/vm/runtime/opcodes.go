@@ -92,6 +92,7 @@ func initMap() {
 		GASLIMIT:    "GASLIMIT",
 		CHAINID:     "CHAINID",
 		SELFBALANCE: "SELFBALANCE",
+		BASEFEE:     "BASEFEE",
 
 		// 0x50 range - 'storage' and execution
 		POP: "POP",
@@ -326,6 +327,8 @@ const (
 	CHAINID OpCode = 0x46
 	// SELFBALANCE op
 	SELFBALANCE OpCode = 0x47
+	// BASEFEE op
+	BASEFEE OpCode = 0x48
 )
 
 const (
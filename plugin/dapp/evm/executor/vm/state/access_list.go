@@ -0,0 +1,125 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package state
+
+// EIP-2929/2930 访问列表
+// 交易执行过程中访问过的地址和存储为warm状态，首次访问为cold状态，两者计费不同；
+// 访问列表的生命周期为一个交易，在交易执行前由Prepare清空，合约执行失败时随快照回滚
+
+import (
+	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
+)
+
+type accessList struct {
+	addresses map[string]struct{}
+	slots     map[string]map[common.Hash]struct{}
+}
+
+func newAccessList() *accessList {
+	return &accessList{
+		addresses: make(map[string]struct{}),
+		slots:     make(map[string]map[common.Hash]struct{}),
+	}
+}
+
+func (al *accessList) containsAddress(addr string) bool {
+	_, ok := al.addresses[addr]
+	return ok
+}
+
+// 返回地址和存储是否在访问列表中
+func (al *accessList) contains(addr string, slot common.Hash) (addressOk bool, slotOk bool) {
+	addressOk = al.containsAddress(addr)
+	if slots, ok := al.slots[addr]; ok {
+		_, slotOk = slots[slot]
+	}
+	return addressOk, slotOk
+}
+
+// 返回地址是否为新加入
+func (al *accessList) addAddress(addr string) bool {
+	if al.containsAddress(addr) {
+		return false
+	}
+	al.addresses[addr] = struct{}{}
+	return true
+}
+
+// 返回地址和存储是否为新加入
+func (al *accessList) addSlot(addr string, slot common.Hash) (addrChange bool, slotChange bool) {
+	addrChange = al.addAddress(addr)
+	slots, ok := al.slots[addr]
+	if !ok {
+		slots = make(map[common.Hash]struct{})
+		al.slots[addr] = slots
+	}
+	if _, ok := slots[slot]; ok {
+		return addrChange, false
+	}
+	slots[slot] = struct{}{}
+	return addrChange, true
+}
+
+func (al *accessList) deleteAddress(addr string) {
+	delete(al.addresses, addr)
+}
+
+func (al *accessList) deleteSlot(addr string, slot common.Hash) {
+	slots, ok := al.slots[addr]
+	if !ok {
+		return
+	}
+	delete(slots, slot)
+	if len(slots) == 0 {
+		delete(al.slots, addr)
+	}
+}
+
+// PrepareAccessList 交易执行前初始化访问列表
+// 交易发起方、目标地址、预编译合约以及交易中声明的地址和存储默认为warm状态
+func (mdb *MemoryStateDB) PrepareAccessList(sender, dst string, precompiles []string, list common.AccessList) {
+	mdb.AddAddressToAccessList(sender)
+	if dst != "" {
+		mdb.AddAddressToAccessList(dst)
+	}
+	for _, addr := range precompiles {
+		mdb.AddAddressToAccessList(addr)
+	}
+	for _, tuple := range list {
+		addr := tuple.Address.String()
+		mdb.AddAddressToAccessList(addr)
+		for _, key := range tuple.StorageKeys {
+			mdb.AddSlotToAccessList(addr, key)
+		}
+	}
+}
+
+// AddressInAccessList 地址是否在访问列表中
+func (mdb *MemoryStateDB) AddressInAccessList(addr string) bool {
+	return mdb.accessList.containsAddress(addr)
+}
+
+// SlotInAccessList 地址和存储是否在访问列表中
+func (mdb *MemoryStateDB) SlotInAccessList(addr string, slot common.Hash) (addressOk bool, slotOk bool) {
+	return mdb.accessList.contains(addr, slot)
+}
+
+// AddAddressToAccessList 将地址加入访问列表
+func (mdb *MemoryStateDB) AddAddressToAccessList(addr string) {
+	if mdb.accessList.addAddress(addr) {
+		mdb.addChange(accessListAddAccountChange{baseChange: baseChange{}, address: addr})
+	}
+}
+
+// AddSlotToAccessList 将地址和存储加入访问列表
+func (mdb *MemoryStateDB) AddSlotToAccessList(addr string, slot common.Hash) {
+	addrMod, slotMod := mdb.accessList.addSlot(addr, slot)
+	if addrMod {
+		mdb.addChange(accessListAddAccountChange{baseChange: baseChange{}, address: addr})
+	}
+	if slotMod {
+		mdb.addChange(accessListAddSlotChange{baseChange: baseChange{}, address: addr, slot: slot})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package state
+
+// 合约中使用的chain33资产
+// 资产需要先转入evm执行器，合约中看到的余额为地址在evm执行器下的资产余额，
+// 合约之间的资产转移也在evm执行器下进行
+
+import (
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
+)
+
+// 获取资产的账户操作对象和evm执行器地址
+func (mdb *MemoryStateDB) assetAccount(exec, symbol string) (*account.DB, string, error) {
+	cfg := mdb.GetConfig()
+	acc, err := account.NewAccountDB(cfg, exec, symbol, mdb.StateDB)
+	if err != nil {
+		return nil, "", err
+	}
+	return acc, address.ExecAddress(cfg.ExecName(evmtypes.ExecutorName)), nil
+}
+
+// GetAssetBalance 获取地址在evm执行器下的资产余额
+func (mdb *MemoryStateDB) GetAssetBalance(exec, symbol, addr string) (int64, error) {
+	acc, execAddr, err := mdb.assetAccount(exec, symbol)
+	if err != nil {
+		return 0, err
+	}
+	return acc.LoadExecAccount(addr, execAddr).GetBalance(), nil
+}
+
+// GetAssetSupply 获取转入evm执行器的资产总量，即evm执行器地址的资产余额
+func (mdb *MemoryStateDB) GetAssetSupply(exec, symbol string) (int64, error) {
+	acc, execAddr, err := mdb.assetAccount(exec, symbol)
+	if err != nil {
+		return 0, err
+	}
+	return acc.LoadAccount(execAddr).GetBalance(), nil
+}
+
+// TransferAsset 在evm执行器下转移资产
+// token资产需要满足token合约的转账限制，转账金额为0或者转给自己时只检查余额，和ERC-20的行为保持一致
+func (mdb *MemoryStateDB) TransferAsset(exec, symbol, sender, recipient string, amount int64) error {
+	if amount < 0 {
+		return types.ErrAmount
+	}
+	acc, execAddr, err := mdb.assetAccount(exec, symbol)
+	if err != nil {
+		return err
+	}
+	// token的暂停, 冻结和白名单限制对合约内部的转账同样有效
+	if err := tokenexec.CheckTransferRestriction(mdb.GetConfig(), mdb.StateDB, mdb.blockHeight, exec, symbol, sender, recipient); err != nil {
+		return err
+	}
+	if amount == 0 || sender == recipient {
+		if acc.LoadExecAccount(sender, execAddr).GetBalance() < amount {
+			return types.ErrNoBalance
+		}
+		return nil
+	}
+
+	var prev []*types.KeyValue
+	for _, addr := range []string{sender, recipient} {
+		for _, kv := range acc.GetExecKVSet(execAddr, acc.LoadExecAccount(addr, execAddr)) {
+			if !mdb.assetChanged(kv.Key) {
+				prev = append(prev, kv)
+			}
+		}
+	}
+	ret, err := acc.ExecTransfer(sender, recipient, execAddr, amount)
+	if err != nil {
+		return err
+	}
+	mdb.addChange(assetTransferChange{
+		baseChange: baseChange{},
+		prev:       prev,
+		data:       ret.KV,
+		logs:       ret.Logs,
+	})
+	return nil
+}
+
+// 当前版本中是否已经记录过账户转账前的数据
+// 同一版本中的变更按顺序回滚，所以只保留最早的数据
+func (mdb *MemoryStateDB) assetChanged(key []byte) bool {
+	if mdb.currentVer == nil {
+		return false
+	}
+	for _, entry := range mdb.currentVer.entries {
+		ch, ok := entry.(assetTransferChange)
+		if !ok {
+			continue
+		}
+		for _, kv := range ch.prev {
+			if string(kv.Key) == string(key) {
+				return true
+			}
+		}
+	}
+	return false
+}
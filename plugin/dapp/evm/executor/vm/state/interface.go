@@ -45,6 +45,8 @@ type EVMStateDB interface {
 
 	// AddRefund 合约Gas奖励回馈
 	AddRefund(uint64)
+	// SubRefund 扣减合约Gas奖励
+	SubRefund(uint64)
 	// GetRefund 获取合约Gas奖励
 	GetRefund() uint64
 
@@ -52,6 +54,19 @@ type EVMStateDB interface {
 	GetState(string, common.Hash) common.Hash
 	// SetState 设置合约状态数据
 	SetState(string, common.Hash, common.Hash)
+	// GetCommittedState 获取合约状态数据在当前交易开始时的值
+	GetCommittedState(string, common.Hash) common.Hash
+
+	// AddressInAccessList 地址是否在访问列表中
+	AddressInAccessList(string) bool
+	// SlotInAccessList 地址和存储是否在访问列表中
+	SlotInAccessList(string, common.Hash) (addressOk bool, slotOk bool)
+	// AddAddressToAccessList 将地址加入访问列表
+	AddAddressToAccessList(string)
+	// AddSlotToAccessList 将地址和存储加入访问列表
+	AddSlotToAccessList(string, common.Hash)
+	// PrepareAccessList 交易执行前初始化访问列表
+	PrepareAccessList(sender, dst string, precompiles []string, list common.AccessList)
 
 	// Suicide 合约自销毁
 	Suicide(string) bool
@@ -80,6 +95,13 @@ type EVMStateDB interface {
 	// Transfer 转账交易
 	Transfer(sender, recipient string, amount uint64) bool
 
+	// GetAssetBalance 获取地址在evm执行器下的资产余额
+	GetAssetBalance(exec, symbol, addr string) (int64, error)
+	// GetAssetSupply 获取转入evm执行器的资产总量
+	GetAssetSupply(exec, symbol string) (int64, error)
+	// TransferAsset 在evm执行器下转移资产，合约执行失败时回滚
+	TransferAsset(exec, symbol, sender, recipient string, amount int64) error
+
 	// GetBlockHeight 返回当前区块高度
 	GetBlockHeight() int64
 
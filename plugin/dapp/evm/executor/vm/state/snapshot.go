@@ -7,6 +7,7 @@ package state
 import (
 	"sort"
 
+	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/plugin/plugin/dapp/evm/executor/vm/common"
 	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
@@ -40,6 +41,15 @@ func (ver *Snapshot) revert() bool {
 	if ver.entries == nil {
 		return true
 	}
+	// ForkEVMLondon之后按生成顺序的倒序回滚，同一版本中多次变更的数据才能恢复到最早的状态
+	mdb := ver.statedb
+	if mdb.api.GetConfig().IsDappFork(mdb.blockHeight, "evm", evmtypes.ForkEVMLondon) {
+		entries := ver.entries
+		for i := len(entries) - 1; i >= 0; i-- {
+			entries[i].revert(mdb)
+		}
+		return true
+	}
 	for _, entry := range ver.entries {
 		entry.revert(ver.statedb)
 	}
@@ -145,6 +155,28 @@ type (
 		logs   []*types.ReceiptLog
 	}
 
+	// 资产转账事件
+	// 和合约转账不同，资产转账在合约执行失败时需要回滚，prev保存转账前的账户数据
+	assetTransferChange struct {
+		baseChange
+		prev []*types.KeyValue
+		data []*types.KeyValue
+		logs []*types.ReceiptLog
+	}
+
+	// 访问列表增加地址事件
+	accessListAddAccountChange struct {
+		baseChange
+		address string
+	}
+
+	// 访问列表增加存储事件
+	accessListAddSlotChange struct {
+		baseChange
+		address string
+		slot    common.Hash
+	}
+
 	// 合约生成日志事件
 	addLogChange struct {
 		baseChange
@@ -291,6 +323,14 @@ func (ch refundChange) revert(mdb *MemoryStateDB) {
 	mdb.refund = ch.prev
 }
 
+func (ch accessListAddAccountChange) revert(mdb *MemoryStateDB) {
+	mdb.accessList.deleteAddress(ch.address)
+}
+
+func (ch accessListAddSlotChange) revert(mdb *MemoryStateDB) {
+	mdb.accessList.deleteSlot(ch.address, ch.slot)
+}
+
 func (ch addLogChange) revert(mdb *MemoryStateDB) {
 	logs := mdb.logs[ch.txhash]
 	if len(logs) == 1 {
@@ -311,3 +351,19 @@ func (ch transferChange) getData(mdb *MemoryStateDB) []*types.KeyValue {
 func (ch transferChange) getLog(mdb *MemoryStateDB) []*types.ReceiptLog {
 	return ch.logs
 }
+
+func (ch assetTransferChange) revert(mdb *MemoryStateDB) {
+	for _, kv := range ch.prev {
+		if err := mdb.StateDB.Set(kv.Key, kv.Value); err != nil {
+			log15.Error("revert asset transfer", "key", string(kv.Key), "err", err)
+		}
+	}
+}
+
+func (ch assetTransferChange) getData(mdb *MemoryStateDB) []*types.KeyValue {
+	return ch.data
+}
+
+func (ch assetTransferChange) getLog(mdb *MemoryStateDB) []*types.ReceiptLog {
+	return ch.logs
+}
@@ -40,6 +40,11 @@ type MemoryStateDB struct {
 	// 合约执行过程中退回的资金
 	refund uint64
 
+	// 当前交易的访问列表（ForkEVMLondon）
+	accessList *accessList
+	// 当前交易中修改过的存储在交易开始时的值
+	originStates map[string]map[common.Hash]common.Hash
+
 	// 存储makeLogN指令对应的日志数据
 	logs    map[common.Hash][]*model.ContractLog
 	logSize uint
@@ -78,6 +83,8 @@ func NewMemoryStateDB(StateDB db.KV, LocalDB db.KVDB, CoinsAccount *account.DB,
 		preimages:    make(map[common.Hash][]byte),
 		stateDirty:   make(map[string]interface{}),
 		dataDirty:    make(map[string]interface{}),
+		accessList:   newAccessList(),
+		originStates: make(map[string]map[common.Hash]common.Hash),
 		blockHeight:  blockHeight,
 		refund:       0,
 		txIndex:      0,
@@ -91,6 +98,10 @@ func NewMemoryStateDB(StateDB db.KV, LocalDB db.KVDB, CoinsAccount *account.DB,
 func (mdb *MemoryStateDB) Prepare(txHash common.Hash, txIndex int) {
 	mdb.txHash = txHash
 	mdb.txIndex = txIndex
+	// 返还的Gas、访问列表和存储的原始值只在一个交易内有效
+	mdb.refund = 0
+	mdb.accessList = newAccessList()
+	mdb.originStates = make(map[string]map[common.Hash]common.Hash)
 }
 
 // CreateAccount 创建一个新的合约账户对象
@@ -157,6 +168,28 @@ func (mdb *MemoryStateDB) GetBalance(addr string) uint64 {
 	return 0
 }
 
+// SetBalance 直接设置GetBalance读取的账户余额，不记录变更，只用于查询时覆盖余额
+func (mdb *MemoryStateDB) SetBalance(addr string, balance int64) {
+	if mdb.CoinsAccount == nil {
+		return
+	}
+	if !mdb.Exist(addr) {
+		ac := mdb.CoinsAccount.LoadAccount(addr)
+		ac.Balance = balance
+		mdb.CoinsAccount.SaveAccount(ac)
+		return
+	}
+	// 和GetBalance一致，合约的余额为创建者或者合约自身在合约地址下的余额
+	owner := mdb.GetAccount(addr).GetCreator()
+	cfg := mdb.api.GetConfig()
+	if cfg.IsDappFork(mdb.GetBlockHeight(), "evm", evmtypes.ForkEVMFrozen) {
+		owner = addr
+	}
+	ac := mdb.CoinsAccount.LoadExecAccount(owner, addr)
+	ac.Balance = balance
+	mdb.CoinsAccount.SaveExecAccount(addr, ac)
+}
+
 // GetNonce 目前chain33中没有保留账户的nonce信息，这里临时添加到合约账户中；
 // 所以，目前只有合约对象有nonce值
 func (mdb *MemoryStateDB) GetNonce(addr string) uint64 {
@@ -236,6 +269,17 @@ func (mdb *MemoryStateDB) AddRefund(gas uint64) {
 	mdb.refund += gas
 }
 
+// SubRefund 扣减返还的Gas
+func (mdb *MemoryStateDB) SubRefund(gas uint64) {
+	mdb.addChange(refundChange{baseChange: baseChange{}, prev: mdb.refund})
+	if gas > mdb.refund {
+		log15.Error("SubRefund refund counter below zero", "gas", gas, "refund", mdb.refund)
+		mdb.refund = 0
+		return
+	}
+	mdb.refund -= gas
+}
+
 // GetRefund 获取奖励
 func (mdb *MemoryStateDB) GetRefund() uint64 {
 	return mdb.refund
@@ -266,10 +310,29 @@ func (mdb *MemoryStateDB) GetState(addr string, key common.Hash) common.Hash {
 	return common.Hash{}
 }
 
+// GetCommittedState 获取合约状态数据在当前交易开始时的值
+func (mdb *MemoryStateDB) GetCommittedState(addr string, key common.Hash) common.Hash {
+	if origins, ok := mdb.originStates[addr]; ok {
+		if val, ok := origins[key]; ok {
+			return val
+		}
+	}
+	return mdb.GetState(addr, key)
+}
+
 // SetState SSTORE 指令修改合约状态数据
 func (mdb *MemoryStateDB) SetState(addr string, key common.Hash, value common.Hash) {
 	acc := mdb.GetAccount(addr)
 	if acc != nil {
+		// 记录交易中首次修改时的原始值
+		origins, ok := mdb.originStates[addr]
+		if !ok {
+			origins = make(map[common.Hash]common.Hash)
+			mdb.originStates[addr] = origins
+		}
+		if _, ok := origins[key]; !ok {
+			origins[key] = acc.GetState(key)
+		}
 		acc.SetState(key, value)
 		// 新的分叉中状态数据变更不需要单独进行标识
 		cfg := mdb.api.GetConfig()
@@ -692,7 +755,7 @@ func (mdb *MemoryStateDB) mergeResult(one, two *types.Receipt) (ret *types.Recei
 }
 
 // AddLog LOG0-4 指令对应的具体操作
-// 生成对应的日志信息，目前这些生成的日志信息会在合约执行后打印到日志文件中
+// 生成对应的日志信息，合约执行后打印到日志文件中，ForkEVMEventLog之后同时写入交易收据
 func (mdb *MemoryStateDB) AddLog(log *model.ContractLog) {
 	mdb.addChange(addLogChange{txhash: mdb.txHash})
 	log.TxHash = mdb.txHash
@@ -701,6 +764,18 @@ func (mdb *MemoryStateDB) AddLog(log *model.ContractLog) {
 	mdb.logSize++
 }
 
+// GetEventLogs 获取当前交易中LOG指令生成的事件日志，转换为收据日志
+func (mdb *MemoryStateDB) GetEventLogs() (logs []*types.ReceiptLog) {
+	for _, item := range mdb.logs[mdb.txHash] {
+		evmLog := &evmtypes.EVMLog{ContractAddr: item.Address.String(), Data: item.Data}
+		for _, topic := range item.Topics {
+			evmLog.Topic = append(evmLog.Topic, topic.Bytes())
+		}
+		logs = append(logs, &types.ReceiptLog{Ty: evmtypes.TyLogEVMEventData, Log: types.Encode(evmLog)})
+	}
+	return
+}
+
 // AddPreimage 存储sha3指令对应的数据
 func (mdb *MemoryStateDB) AddPreimage(hash common.Hash, data []byte) {
 	// 目前只用于打印日志
@@ -0,0 +1,248 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+// 以太坊兼容的JSON-RPC接口
+// chain33的jsonrpc服务使用Service.Method格式的方法名，而web3、ethers等以太坊工具使用JSON-RPC 2.0和eth_xxx格式的方法名，
+// 所以以太坊接口单独监听一个地址，在配置文件[rpc.sub.evm]中配置ethBindAddr开启，以太坊交易的链ID为chain33的ChainID
+//
+// 接口中所有0x格式的地址都是chain33地址的20字节哈希，以太坊格式交易的发送方为以太坊钱包中的地址，
+// 和合约中的msg.sender一致，但是交易费从公钥按chain33规则生成的地址扣除
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	log "github.com/33cn/chain33/common/log/log15"
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+)
+
+var elog = log.New("module", "evm.ethrpc")
+
+const (
+	// 请求体大小上限
+	maxEthRequestSize = 5 * 1024 * 1024
+
+	ethErrParse          = -32700
+	ethErrInvalidRequest = -32600
+	ethErrMethodNotFound = -32601
+	ethErrInvalidParams  = -32602
+	ethErrServer         = -32000
+)
+
+type ethRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params"`
+}
+
+type ethResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *ethError       `json:"error,omitempty"`
+}
+
+type ethError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *ethError) Error() string {
+	return e.Message
+}
+
+func invalidParams(err error) error {
+	return &ethError{Code: ethErrInvalidParams, Message: err.Error()}
+}
+
+type ethHandler func(api *ethAPI, params []json.RawMessage) (interface{}, error)
+
+// 以太坊接口方法列表
+var ethHandlers = map[string]ethHandler{
+	"web3_clientVersion":        (*ethAPI).clientVersion,
+	"web3_sha3":                 (*ethAPI).sha3,
+	"net_version":               (*ethAPI).netVersion,
+	"net_listening":             (*ethAPI).netListening,
+	"net_peerCount":             (*ethAPI).netPeerCount,
+	"eth_chainId":               (*ethAPI).ethChainID,
+	"eth_syncing":               (*ethAPI).syncing,
+	"eth_blockNumber":           (*ethAPI).blockNumber,
+	"eth_gasPrice":              (*ethAPI).gasPrice,
+	"eth_accounts":              (*ethAPI).accounts,
+	"eth_getBalance":            (*ethAPI).getBalance,
+	"eth_getCode":               (*ethAPI).getCode,
+	"eth_getTransactionCount":   (*ethAPI).getTransactionCount,
+	"eth_call":                  (*ethAPI).call,
+	"eth_estimateGas":           (*ethAPI).estimateGas,
+	"eth_sendRawTransaction":    (*ethAPI).sendRawTransaction,
+	"eth_getTransactionByHash":  (*ethAPI).getTransactionByHash,
+	"eth_getTransactionReceipt": (*ethAPI).getTransactionReceipt,
+	"eth_getBlockByNumber":      (*ethAPI).getBlockByNumber,
+	"eth_getBlockByHash":        (*ethAPI).getBlockByHash,
+	"eth_getLogs":               (*ethAPI).getLogs,
+}
+
+// 根据配置启动以太坊兼容接口
+func startEthServer(cli *channelClient) {
+	cfg := cli.GetConfig()
+	conf := types.Conf(cfg, "config.rpc.sub.evm")
+	bindAddr := conf.GStr("ethBindAddr")
+	if bindAddr == "" {
+		return
+	}
+	chainID := evmtypes.EthChainID(cfg.GetChainID())
+	listener, err := net.Listen("tcp", bindAddr)
+	if err != nil {
+		elog.Error("startEthServer", "bindAddr", bindAddr, "err", err)
+		return
+	}
+	api := &ethAPI{cli: cli, chainID: chainID}
+	go func() {
+		err := http.Serve(listener, api)
+		elog.Info("eth rpc server stopped", "err", err)
+	}()
+	elog.Info("eth rpc server listen", "addr", listener.Addr().String(), "chainID", chainID)
+}
+
+// ServeHTTP 处理JSON-RPC 2.0请求，支持批量请求
+func (api *ethAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+	if r.Method == http.MethodOptions {
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	data, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxEthRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	result, err := json.Marshal(api.handleMessage(data))
+	if err != nil {
+		elog.Error("ServeHTTP marshal", "err", err)
+		return
+	}
+	_, err = w.Write(result)
+	if err != nil {
+		elog.Debug("ServeHTTP write", "err", err)
+	}
+}
+
+// 处理单个或批量请求
+func (api *ethAPI) handleMessage(data []byte) interface{} {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var reqs []json.RawMessage
+		if err := json.Unmarshal(data, &reqs); err != nil {
+			return errorResponse(nil, &ethError{Code: ethErrParse, Message: err.Error()})
+		}
+		if len(reqs) == 0 {
+			return errorResponse(nil, &ethError{Code: ethErrInvalidRequest, Message: "empty batch"})
+		}
+		resps := make([]*ethResponse, 0, len(reqs))
+		for _, req := range reqs {
+			resps = append(resps, api.handleRequest(req))
+		}
+		return resps
+	}
+	return api.handleRequest(data)
+}
+
+func (api *ethAPI) handleRequest(data []byte) *ethResponse {
+	var req ethRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		return errorResponse(nil, &ethError{Code: ethErrParse, Message: err.Error()})
+	}
+	if req.JSONRPC != "2.0" || req.Method == "" {
+		return errorResponse(req.ID, &ethError{Code: ethErrInvalidRequest, Message: "invalid request"})
+	}
+	handler, ok := ethHandlers[req.Method]
+	if !ok {
+		return errorResponse(req.ID, &ethError{Code: ethErrMethodNotFound, Message: "the method " + req.Method + " does not exist/is not available"})
+	}
+	var params []json.RawMessage
+	if len(req.Params) > 0 && string(req.Params) != "null" {
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return errorResponse(req.ID, &ethError{Code: ethErrInvalidParams, Message: "params must be an array"})
+		}
+	}
+	result, err := handler(api, params)
+	if err != nil {
+		elog.Debug("eth rpc", "method", req.Method, "err", err)
+		if e, ok := err.(*ethError); ok {
+			return errorResponse(req.ID, e)
+		}
+		return errorResponse(req.ID, &ethError{Code: ethErrServer, Message: err.Error()})
+	}
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return errorResponse(req.ID, &ethError{Code: ethErrServer, Message: err.Error()})
+	}
+	return &ethResponse{JSONRPC: "2.0", ID: req.ID, Result: raw}
+}
+
+func errorResponse(id json.RawMessage, err *ethError) *ethResponse {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	return &ethResponse{JSONRPC: "2.0", ID: id, Error: err}
+}
+
+// 按顺序解析参数，缺少的参数保持默认值
+func parseParams(params []json.RawMessage, args ...interface{}) error {
+	if len(params) > len(args) {
+		return invalidParams(types.ErrInvalidParam)
+	}
+	for i, param := range params {
+		if string(param) == "null" {
+			continue
+		}
+		if err := json.Unmarshal(param, args[i]); err != nil {
+			return invalidParams(err)
+		}
+	}
+	return nil
+}
+
+// 区块高度参数，latest和pending都表示最新区块
+type blockNumber int64
+
+const latestBlockNumber = blockNumber(-1)
+
+func (b *blockNumber) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	switch str {
+	case "latest", "pending":
+		*b = latestBlockNumber
+		return nil
+	case "earliest":
+		*b = 0
+		return nil
+	}
+	if !strings.HasPrefix(str, "0x") {
+		return types.ErrInvalidParam
+	}
+	height, err := strconv.ParseInt(str[2:], 16, 64)
+	if err != nil {
+		return err
+	}
+	*b = blockNumber(height)
+	return nil
+}
@@ -0,0 +1,755 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rpc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	evmtypes "github.com/33cn/plugin/plugin/dapp/evm/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// eth_getLogs一次最多扫描的区块数
+	maxLogBlockRange = 1000
+	// 每次从区块链读取的区块数
+	logBlockBatch = 100
+)
+
+var errTxNotFound = errors.New("transaction not found")
+
+type ethAPI struct {
+	cli     *channelClient
+	chainID int64
+}
+
+// 交易调用参数
+type ethCallArgs struct {
+	From  *ethcommon.Address `json:"from"`
+	To    *ethcommon.Address `json:"to"`
+	Gas   *hexutil.Uint64    `json:"gas"`
+	Value *hexutil.Big       `json:"value"`
+	Data  *hexutil.Bytes     `json:"data"`
+	Input *hexutil.Bytes     `json:"input"`
+}
+
+func (args *ethCallArgs) data() []byte {
+	if args.Input != nil {
+		return *args.Input
+	}
+	if args.Data != nil {
+		return *args.Data
+	}
+	return nil
+}
+
+func (args *ethCallArgs) amount() (uint64, error) {
+	if args.Value == nil {
+		return 0, nil
+	}
+	return weiToAmount(args.Value.ToInt())
+}
+
+type ethTransaction struct {
+	BlockHash        *ethcommon.Hash    `json:"blockHash"`
+	BlockNumber      *hexutil.Big       `json:"blockNumber"`
+	From             ethcommon.Address  `json:"from"`
+	Gas              hexutil.Uint64     `json:"gas"`
+	GasPrice         *hexutil.Big       `json:"gasPrice"`
+	Hash             ethcommon.Hash     `json:"hash"`
+	Input            hexutil.Bytes      `json:"input"`
+	Nonce            hexutil.Uint64     `json:"nonce"`
+	To               *ethcommon.Address `json:"to"`
+	TransactionIndex *hexutil.Uint64    `json:"transactionIndex"`
+	Value            *hexutil.Big       `json:"value"`
+	V                *hexutil.Big       `json:"v"`
+	R                *hexutil.Big       `json:"r"`
+	S                *hexutil.Big       `json:"s"`
+}
+
+type ethReceipt struct {
+	TransactionHash   ethcommon.Hash     `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64     `json:"transactionIndex"`
+	BlockHash         ethcommon.Hash     `json:"blockHash"`
+	BlockNumber       *hexutil.Big       `json:"blockNumber"`
+	From              ethcommon.Address  `json:"from"`
+	To                *ethcommon.Address `json:"to"`
+	CumulativeGasUsed hexutil.Uint64     `json:"cumulativeGasUsed"`
+	GasUsed           hexutil.Uint64     `json:"gasUsed"`
+	ContractAddress   *ethcommon.Address `json:"contractAddress"`
+	Logs              []*ethtypes.Log    `json:"logs"`
+	LogsBloom         ethtypes.Bloom     `json:"logsBloom"`
+	Status            hexutil.Uint64     `json:"status"`
+}
+
+type ethBlock struct {
+	Number           hexutil.Uint64      `json:"number"`
+	Hash             ethcommon.Hash      `json:"hash"`
+	ParentHash       ethcommon.Hash      `json:"parentHash"`
+	Nonce            ethtypes.BlockNonce `json:"nonce"`
+	Sha3Uncles       ethcommon.Hash      `json:"sha3Uncles"`
+	LogsBloom        ethtypes.Bloom      `json:"logsBloom"`
+	TransactionsRoot ethcommon.Hash      `json:"transactionsRoot"`
+	StateRoot        ethcommon.Hash      `json:"stateRoot"`
+	ReceiptsRoot     ethcommon.Hash      `json:"receiptsRoot"`
+	Miner            ethcommon.Address   `json:"miner"`
+	Difficulty       hexutil.Uint64      `json:"difficulty"`
+	TotalDifficulty  hexutil.Uint64      `json:"totalDifficulty"`
+	ExtraData        hexutil.Bytes       `json:"extraData"`
+	Size             hexutil.Uint64      `json:"size"`
+	GasLimit         hexutil.Uint64      `json:"gasLimit"`
+	GasUsed          hexutil.Uint64      `json:"gasUsed"`
+	Timestamp        hexutil.Uint64      `json:"timestamp"`
+	Transactions     []interface{}       `json:"transactions"`
+	Uncles           []ethcommon.Hash    `json:"uncles"`
+}
+
+type ethFilter struct {
+	BlockHash *ethcommon.Hash   `json:"blockHash"`
+	FromBlock *blockNumber      `json:"fromBlock"`
+	ToBlock   *blockNumber      `json:"toBlock"`
+	Address   json.RawMessage   `json:"address"`
+	Topics    []json.RawMessage `json:"topics"`
+}
+
+func (api *ethAPI) clientVersion(params []json.RawMessage) (interface{}, error) {
+	version, err := api.cli.Version()
+	if err != nil {
+		return nil, err
+	}
+	return "chain33/" + version.Chain33, nil
+}
+
+func (api *ethAPI) sha3(params []json.RawMessage) (interface{}, error) {
+	var data hexutil.Bytes
+	if err := parseParams(params, &data); err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(ethcrypto.Keccak256(data)), nil
+}
+
+func (api *ethAPI) netVersion(params []json.RawMessage) (interface{}, error) {
+	return fmt.Sprintf("%d", api.chainID), nil
+}
+
+func (api *ethAPI) netListening(params []json.RawMessage) (interface{}, error) {
+	return true, nil
+}
+
+func (api *ethAPI) netPeerCount(params []json.RawMessage) (interface{}, error) {
+	peers, err := api.cli.PeerInfo(&types.P2PGetPeerReq{})
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(len(peers.GetPeers())), nil
+}
+
+func (api *ethAPI) ethChainID(params []json.RawMessage) (interface{}, error) {
+	return hexutil.Uint64(api.chainID), nil
+}
+
+func (api *ethAPI) syncing(params []json.RawMessage) (interface{}, error) {
+	reply, err := api.cli.IsSync()
+	if err != nil {
+		return nil, err
+	}
+	if reply.IsOk {
+		return false, nil
+	}
+	header, err := api.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]hexutil.Uint64{
+		"startingBlock": 0,
+		"currentBlock":  hexutil.Uint64(header.Height),
+		"highestBlock":  hexutil.Uint64(header.Height),
+	}, nil
+}
+
+func (api *ethAPI) blockNumber(params []json.RawMessage) (interface{}, error) {
+	header, err := api.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(header.Height), nil
+}
+
+// gasPrice为chain33中的1个最小金额单位
+func (api *ethAPI) gasPrice(params []json.RawMessage) (interface{}, error) {
+	return (*hexutil.Big)(big.NewInt(evmtypes.EthCoinPrecision)), nil
+}
+
+// 节点不管理以太坊账户，交易由以太坊钱包签名
+func (api *ethAPI) accounts(params []json.RawMessage) (interface{}, error) {
+	return []ethcommon.Address{}, nil
+}
+
+// 查询地址在evm执行器中的coins余额
+func (api *ethAPI) getBalance(params []json.RawMessage) (interface{}, error) {
+	var addr ethcommon.Address
+	number := latestBlockNumber
+	if err := parseParams(params, &addr, &number); err != nil {
+		return nil, err
+	}
+	cfg := api.cli.GetConfig()
+	accountDB := api.cli.GetCoinsAccountDB()
+	execAddr := address.ExecAddress(cfg.ExecName(evmtypes.ExecutorName))
+	var acc *types.Account
+	var err error
+	if number == latestBlockNumber {
+		acc, err = accountDB.LoadExecAccountQueue(api.cli, toChain33Addr(addr), execAddr)
+	} else {
+		header, herr := api.getHeader(int64(number))
+		if herr != nil {
+			return nil, herr
+		}
+		acc, err = accountDB.LoadExecAccountHistoryQueue(api.cli, toChain33Addr(addr), execAddr, header.StateHash)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(amountToWei(acc.Balance)), nil
+}
+
+func (api *ethAPI) getCode(params []json.RawMessage) (interface{}, error) {
+	var addr ethcommon.Address
+	var number blockNumber
+	if err := parseParams(params, &addr, &number); err != nil {
+		return nil, err
+	}
+	reply, err := api.cli.Query(evmtypes.ExecutorName, "GetCode", &evmtypes.CheckEVMAddrReq{Addr: toChain33Addr(addr)})
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(reply.(*evmtypes.EvmGetCodeResp).Code), nil
+}
+
+// chain33交易不使用递增的nonce，这里返回地址的交易数量，保证以太坊钱包每次生成的交易哈希不同
+func (api *ethAPI) getTransactionCount(params []json.RawMessage) (interface{}, error) {
+	var addr ethcommon.Address
+	var number blockNumber
+	if err := parseParams(params, &addr, &number); err != nil {
+		return nil, err
+	}
+	overview, err := api.cli.GetAddrOverview(&types.ReqAddr{Addr: toChain33Addr(addr)})
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Uint64(overview.TxCount), nil
+}
+
+// 只支持在最新区块的状态上调用
+func (api *ethAPI) call(params []json.RawMessage) (interface{}, error) {
+	var args ethCallArgs
+	var number blockNumber
+	if err := parseParams(params, &args, &number); err != nil {
+		return nil, err
+	}
+	amount, err := args.amount()
+	if err != nil {
+		return nil, invalidParams(err)
+	}
+	req := &evmtypes.EvmCallDataReq{Data: args.data(), Amount: amount}
+	if args.From != nil {
+		req.Caller = toChain33Addr(*args.From)
+	}
+	if args.To != nil {
+		req.To = toChain33Addr(*args.To)
+	}
+	reply, err := api.cli.Query(evmtypes.ExecutorName, "EthCall", req)
+	if err != nil {
+		return nil, err
+	}
+	return hexutil.Bytes(reply.(*evmtypes.EvmCallDataResp).Ret), nil
+}
+
+func (api *ethAPI) estimateGas(params []json.RawMessage) (interface{}, error) {
+	var args ethCallArgs
+	var number blockNumber
+	if err := parseParams(params, &args, &number); err != nil {
+		return nil, err
+	}
+	amount, err := args.amount()
+	if err != nil {
+		return nil, invalidParams(err)
+	}
+	req := &evmtypes.EstimateEVMGasReq{Code: args.data(), Amount: amount}
+	if args.From != nil {
+		req.Caller = toChain33Addr(*args.From)
+	}
+	if args.To != nil {
+		req.To = toChain33Addr(*args.To)
+	}
+	reply, err := api.cli.Query(evmtypes.ExecutorName, "EstimateGas", req)
+	if err != nil {
+		return nil, err
+	}
+	gas := reply.(*evmtypes.EstimateEVMGasResp).Gas
+	// gasPrice为1时交易费等于gas，需要满足chain33按交易大小计算的最低交易费，
+	// 转换后的交易同时包含调用数据和以太坊原始交易，按两倍调用数据估算交易大小
+	minFee := uint64((int64(2*len(args.data()))/1000 + 1) * api.cli.GetConfig().GetMinTxFeeRate())
+	if gas < minFee {
+		gas = minFee
+	}
+	return hexutil.Uint64(gas), nil
+}
+
+func (api *ethAPI) sendRawTransaction(params []json.RawMessage) (interface{}, error) {
+	var raw hexutil.Bytes
+	if err := parseParams(params, &raw); err != nil {
+		return nil, err
+	}
+	etx, err := evmtypes.DecodeEthTx(raw)
+	if err != nil {
+		return nil, invalidParams(err)
+	}
+	if !etx.Protected() || etx.ChainId().Cmp(big.NewInt(api.chainID)) != 0 {
+		return nil, invalidParams(fmt.Errorf("invalid chain id %v, expect %d", etx.ChainId(), api.chainID))
+	}
+	cfg := api.cli.GetConfig()
+	tx, err := evmtypes.EthTxToChain33(raw, cfg.ExecName(evmtypes.ExecutorName), cfg.GetChainID())
+	if err != nil {
+		return nil, invalidParams(err)
+	}
+	reply, err := api.cli.SendTx(tx)
+	if err != nil {
+		return nil, err
+	}
+	if !reply.IsOk {
+		return nil, errors.New(string(reply.Msg))
+	}
+	return etx.Hash(), nil
+}
+
+func (api *ethAPI) getTransactionByHash(params []json.RawMessage) (interface{}, error) {
+	var hash ethcommon.Hash
+	if err := parseParams(params, &hash); err != nil {
+		return nil, err
+	}
+	detail, err := api.queryTx(hash)
+	if err == errTxNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	blockHash, err := api.getBlockHash(detail.Height)
+	if err != nil {
+		return nil, err
+	}
+	return newEthTransaction(detail.Tx, blockHash, detail.Height, detail.Index), nil
+}
+
+func (api *ethAPI) getTransactionReceipt(params []json.RawMessage) (interface{}, error) {
+	var hash ethcommon.Hash
+	if err := parseParams(params, &hash); err != nil {
+		return nil, err
+	}
+	detail, err := api.queryTx(hash)
+	if err == errTxNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 日志序号和累计gas需要按区块计算
+	blocks, err := api.cli.GetBlocks(&types.ReqBlocks{Start: detail.Height, End: detail.Height, IsDetail: true})
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks.Items) != 1 {
+		return nil, types.ErrBlockNotFound
+	}
+	receipts := api.blockReceipts(blocks.Items[0])
+	if detail.Index >= int64(len(receipts)) {
+		return nil, errTxNotFound
+	}
+	return receipts[detail.Index], nil
+}
+
+func (api *ethAPI) getBlockByNumber(params []json.RawMessage) (interface{}, error) {
+	number := latestBlockNumber
+	var fullTx bool
+	if err := parseParams(params, &number, &fullTx); err != nil {
+		return nil, err
+	}
+	height := int64(number)
+	if number == latestBlockNumber {
+		header, err := api.cli.GetLastHeader()
+		if err != nil {
+			return nil, err
+		}
+		height = header.Height
+	}
+	blocks, err := api.cli.GetBlocks(&types.ReqBlocks{Start: height, End: height, IsDetail: true})
+	if err != nil || len(blocks.Items) != 1 {
+		return nil, nil
+	}
+	return api.newEthBlock(blocks.Items[0], fullTx), nil
+}
+
+func (api *ethAPI) getBlockByHash(params []json.RawMessage) (interface{}, error) {
+	var hash ethcommon.Hash
+	var fullTx bool
+	if err := parseParams(params, &hash, &fullTx); err != nil {
+		return nil, err
+	}
+	blocks, err := api.cli.GetBlockByHashes(&types.ReqHashes{Hashes: [][]byte{hash.Bytes()}})
+	if err != nil || len(blocks.Items) != 1 || blocks.Items[0] == nil {
+		return nil, nil
+	}
+	return api.newEthBlock(blocks.Items[0], fullTx), nil
+}
+
+// 按区块范围扫描交易收据中的合约事件日志
+func (api *ethAPI) getLogs(params []json.RawMessage) (interface{}, error) {
+	var filter ethFilter
+	if err := parseParams(params, &filter); err != nil {
+		return nil, err
+	}
+	addrs, topics, err := parseLogFilter(&filter)
+	if err != nil {
+		return nil, invalidParams(err)
+	}
+	logs := []*ethtypes.Log{}
+	if filter.BlockHash != nil {
+		blocks, err := api.cli.GetBlockByHashes(&types.ReqHashes{Hashes: [][]byte{filter.BlockHash.Bytes()}})
+		if err != nil || len(blocks.Items) != 1 || blocks.Items[0] == nil {
+			return nil, types.ErrBlockNotFound
+		}
+		return filterLogs(api.blockLogs(blocks.Items[0]), addrs, topics), nil
+	}
+
+	header, err := api.cli.GetLastHeader()
+	if err != nil {
+		return nil, err
+	}
+	from, to := header.Height, header.Height
+	if filter.FromBlock != nil && *filter.FromBlock != latestBlockNumber {
+		from = int64(*filter.FromBlock)
+	}
+	if filter.ToBlock != nil && *filter.ToBlock != latestBlockNumber && int64(*filter.ToBlock) < to {
+		to = int64(*filter.ToBlock)
+	}
+	if from > to {
+		return logs, nil
+	}
+	if to-from >= maxLogBlockRange {
+		return nil, invalidParams(fmt.Errorf("block range too large, max %d", maxLogBlockRange))
+	}
+	for start := from; start <= to; start += logBlockBatch {
+		end := start + logBlockBatch - 1
+		if end > to {
+			end = to
+		}
+		blocks, err := api.cli.GetBlocks(&types.ReqBlocks{Start: start, End: end, IsDetail: true})
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range blocks.Items {
+			logs = append(logs, filterLogs(api.blockLogs(block), addrs, topics)...)
+		}
+	}
+	return logs, nil
+}
+
+// 以太坊交易哈希转换为chain33交易哈希后查询交易，chain33格式的交易直接使用交易哈希查询
+func (api *ethAPI) queryTx(hash ethcommon.Hash) (*types.TransactionDetail, error) {
+	txHash := hash.Bytes()
+	reply, err := api.cli.Query(evmtypes.ExecutorName, "GetTxHashByEthHash", &types.ReqString{Data: hash.Hex()})
+	if err == nil {
+		txHash = ethcommon.FromHex(reply.(*types.ReplyString).Data)
+	}
+	detail, err := api.cli.QueryTx(&types.ReqHash{Hash: txHash})
+	if err != nil || detail.GetTx() == nil {
+		return nil, errTxNotFound
+	}
+	return detail, nil
+}
+
+func (api *ethAPI) getHeader(height int64) (*types.Header, error) {
+	headers, err := api.cli.GetHeaders(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return nil, err
+	}
+	if len(headers.Items) != 1 {
+		return nil, types.ErrBlockNotFound
+	}
+	return headers.Items[0], nil
+}
+
+func (api *ethAPI) getBlockHash(height int64) (ethcommon.Hash, error) {
+	reply, err := api.cli.GetBlockHash(&types.ReqInt{Height: height})
+	if err != nil {
+		return ethcommon.Hash{}, err
+	}
+	return ethcommon.BytesToHash(reply.Hash), nil
+}
+
+func (api *ethAPI) newEthBlock(detail *types.BlockDetail, fullTx bool) *ethBlock {
+	cfg := api.cli.GetConfig()
+	block := detail.Block
+	blockHash := ethcommon.BytesToHash(block.Hash(cfg))
+	result := &ethBlock{
+		Number:           hexutil.Uint64(block.Height),
+		Hash:             blockHash,
+		ParentHash:       ethcommon.BytesToHash(block.ParentHash),
+		Sha3Uncles:       ethtypes.EmptyUncleHash,
+		TransactionsRoot: ethcommon.BytesToHash(block.TxHash),
+		StateRoot:        ethcommon.BytesToHash(block.StateHash),
+		Difficulty:       hexutil.Uint64(block.Difficulty),
+		ExtraData:        hexutil.Bytes{},
+		Size:             hexutil.Uint64(types.Size(block)),
+		GasLimit:         hexutil.Uint64(evmtypes.MaxGasLimit),
+		Timestamp:        hexutil.Uint64(block.BlockTime),
+		Transactions:     []interface{}{},
+		Uncles:           []ethcommon.Hash{},
+	}
+	var logs []*ethtypes.Log
+	for _, receipt := range api.blockReceipts(detail) {
+		result.GasUsed += receipt.GasUsed
+		logs = append(logs, receipt.Logs...)
+	}
+	result.LogsBloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(logs).Bytes())
+	for i, tx := range block.Txs {
+		if fullTx {
+			result.Transactions = append(result.Transactions, newEthTransaction(tx, blockHash, block.Height, int64(i)))
+		} else {
+			result.Transactions = append(result.Transactions, ethTxHash(tx))
+		}
+	}
+	return result
+}
+
+// 区块中所有交易的收据，非evm交易只有状态信息
+func (api *ethAPI) blockReceipts(detail *types.BlockDetail) []*ethReceipt {
+	cfg := api.cli.GetConfig()
+	block := detail.Block
+	blockHash := ethcommon.BytesToHash(block.Hash(cfg))
+	evmExecAddr := address.ExecAddress(cfg.ExecName(evmtypes.ExecutorName))
+	var receipts []*ethReceipt
+	var cumulativeGas hexutil.Uint64
+	var logIndex uint
+	for i, tx := range block.Txs {
+		receipt := &ethReceipt{
+			TransactionHash:  ethTxHash(tx),
+			TransactionIndex: hexutil.Uint64(i),
+			BlockHash:        blockHash,
+			BlockNumber:      (*hexutil.Big)(big.NewInt(block.Height)),
+			From:             toEthAddr(evmtypes.TxSender(tx)),
+			Logs:             []*ethtypes.Log{},
+		}
+		if tx.To != evmExecAddr {
+			to := toEthAddr(tx.To)
+			receipt.To = &to
+		}
+		var data *types.ReceiptData
+		if i < len(detail.Receipts) {
+			data = detail.Receipts[i]
+		}
+		if data.GetTy() == types.ExecOk {
+			receipt.Status = 1
+		}
+		if evmtypes.IsEthTx(tx) {
+			// 执行失败时按交易的gasLimit计算
+			var action evmtypes.EVMContractAction
+			if types.Decode(tx.Payload, &action) == nil {
+				receipt.GasUsed = hexutil.Uint64(action.GasLimit)
+			}
+		}
+		for _, item := range data.GetLogs() {
+			switch item.Ty {
+			case evmtypes.TyLogCallContract:
+				var call evmtypes.ReceiptEVMContract
+				if types.Decode(item.Log, &call) != nil {
+					continue
+				}
+				receipt.GasUsed = hexutil.Uint64(call.UsedGas)
+				if len(call.ContractName) > 0 {
+					contractAddr := toEthAddr(call.ContractAddr)
+					receipt.ContractAddress = &contractAddr
+				}
+			case evmtypes.TyLogEVMEventData:
+				var evmLog evmtypes.EVMLog
+				if types.Decode(item.Log, &evmLog) != nil {
+					continue
+				}
+				log := &ethtypes.Log{
+					Address:     toEthAddr(evmLog.ContractAddr),
+					Data:        evmLog.Data,
+					BlockNumber: uint64(block.Height),
+					TxHash:      receipt.TransactionHash,
+					TxIndex:     uint(i),
+					BlockHash:   blockHash,
+					Index:       logIndex,
+				}
+				for _, topic := range evmLog.Topic {
+					log.Topics = append(log.Topics, ethcommon.BytesToHash(topic))
+				}
+				logIndex++
+				receipt.Logs = append(receipt.Logs, log)
+			}
+		}
+		cumulativeGas += receipt.GasUsed
+		receipt.CumulativeGasUsed = cumulativeGas
+		receipt.LogsBloom = ethtypes.BytesToBloom(ethtypes.LogsBloom(receipt.Logs).Bytes())
+		receipts = append(receipts, receipt)
+	}
+	return receipts
+}
+
+func (api *ethAPI) blockLogs(detail *types.BlockDetail) []*ethtypes.Log {
+	var logs []*ethtypes.Log
+	for _, receipt := range api.blockReceipts(detail) {
+		logs = append(logs, receipt.Logs...)
+	}
+	return logs
+}
+
+// 以太坊格式的交易从签名中的原始交易获取交易信息
+func newEthTransaction(tx *types.Transaction, blockHash ethcommon.Hash, height, index int64) *ethTransaction {
+	txIndex := hexutil.Uint64(index)
+	result := &ethTransaction{
+		BlockHash:        &blockHash,
+		BlockNumber:      (*hexutil.Big)(big.NewInt(height)),
+		From:             toEthAddr(evmtypes.TxSender(tx)),
+		Hash:             ethTxHash(tx),
+		Nonce:            hexutil.Uint64(tx.Nonce),
+		TransactionIndex: &txIndex,
+		GasPrice:         (*hexutil.Big)(big.NewInt(evmtypes.EthCoinPrecision)),
+		Value:            (*hexutil.Big)(new(big.Int)),
+		V:                (*hexutil.Big)(new(big.Int)),
+		R:                (*hexutil.Big)(new(big.Int)),
+		S:                (*hexutil.Big)(new(big.Int)),
+	}
+	if evmtypes.IsEthTx(tx) {
+		if etx, err := evmtypes.DecodeEthTx(tx.Signature.Signature); err == nil {
+			v, r, s := etx.RawSignatureValues()
+			result.Gas = hexutil.Uint64(etx.Gas())
+			result.GasPrice = (*hexutil.Big)(etx.GasPrice())
+			result.Input = etx.Data()
+			result.Nonce = hexutil.Uint64(etx.Nonce())
+			result.To = etx.To()
+			result.Value = (*hexutil.Big)(etx.Value())
+			result.V, result.R, result.S = (*hexutil.Big)(v), (*hexutil.Big)(r), (*hexutil.Big)(s)
+			return result
+		}
+	}
+	to := toEthAddr(tx.To)
+	result.To = &to
+	result.Gas = hexutil.Uint64(tx.Fee)
+	var action evmtypes.EVMContractAction
+	if types.Decode(tx.Payload, &action) == nil {
+		result.Input = action.Code
+		result.Value = (*hexutil.Big)(amountToWei(int64(action.Amount)))
+	}
+	return result
+}
+
+func parseLogFilter(filter *ethFilter) ([]ethcommon.Address, [][]ethcommon.Hash, error) {
+	var addrs []ethcommon.Address
+	if len(filter.Address) > 0 && string(filter.Address) != "null" {
+		var addr ethcommon.Address
+		if err := json.Unmarshal(filter.Address, &addr); err == nil {
+			addrs = append(addrs, addr)
+		} else if err := json.Unmarshal(filter.Address, &addrs); err != nil {
+			return nil, nil, err
+		}
+	}
+	var topics [][]ethcommon.Hash
+	for _, raw := range filter.Topics {
+		var set []ethcommon.Hash
+		if string(raw) != "null" {
+			var topic ethcommon.Hash
+			if err := json.Unmarshal(raw, &topic); err == nil {
+				set = append(set, topic)
+			} else if err := json.Unmarshal(raw, &set); err != nil {
+				return nil, nil, err
+			}
+		}
+		topics = append(topics, set)
+	}
+	return addrs, topics, nil
+}
+
+// 地址为空时匹配所有合约，每个位置的主题为空时匹配任意主题
+func filterLogs(logs []*ethtypes.Log, addrs []ethcommon.Address, topics [][]ethcommon.Hash) []*ethtypes.Log {
+	var ret []*ethtypes.Log
+Logs:
+	for _, log := range logs {
+		if len(addrs) > 0 && !containsAddr(addrs, log.Address) {
+			continue
+		}
+		if len(topics) > len(log.Topics) {
+			continue
+		}
+		for i, set := range topics {
+			if len(set) > 0 && !containsHash(set, log.Topics[i]) {
+				continue Logs
+			}
+		}
+		ret = append(ret, log)
+	}
+	return ret
+}
+
+func containsAddr(addrs []ethcommon.Address, addr ethcommon.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
+
+func containsHash(hashes []ethcommon.Hash, hash ethcommon.Hash) bool {
+	for _, h := range hashes {
+		if h == hash {
+			return true
+		}
+	}
+	return false
+}
+
+// 以太坊格式的交易使用以太坊交易哈希
+func ethTxHash(tx *types.Transaction) ethcommon.Hash {
+	if evmtypes.IsEthTx(tx) {
+		return ethcommon.BytesToHash(evmtypes.EthTxHash(tx.Signature.Signature))
+	}
+	return ethcommon.BytesToHash(tx.Hash())
+}
+
+func toChain33Addr(addr ethcommon.Address) string {
+	return evmtypes.EthAddrToChain33(addr.Bytes())
+}
+
+func toEthAddr(addr string) ethcommon.Address {
+	b, err := evmtypes.Chain33AddrToEth(addr)
+	if err != nil {
+		return ethcommon.Address{}
+	}
+	return ethcommon.BytesToAddress(b)
+}
+
+func amountToWei(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(evmtypes.EthCoinPrecision))
+}
+
+func weiToAmount(wei *big.Int) (uint64, error) {
+	amount, rem := new(big.Int).QuoRem(wei, big.NewInt(evmtypes.EthCoinPrecision), new(big.Int))
+	if rem.Sign() != 0 || !amount.IsUint64() {
+		return 0, evmtypes.ErrEthTxAmount
+	}
+	return amount.Uint64(), nil
+}
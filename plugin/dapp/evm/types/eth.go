@@ -0,0 +1,208 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+// 以太坊格式交易的转换
+// 以太坊钱包签名的原始交易转换为evm执行器的交易，签名类型为EthSignType，
+// 签名中的公钥为从以太坊签名中恢复的压缩公钥，签名数据为以太坊原始交易，
+// 验签时从原始交易重新生成chain33交易并比较，保证交易内容和以太坊签名一致；
+// 只接受EIP-155签名的交易，链ID为chain33配置的ChainID，交易的ChainID字段也参与验签，防止交易在其它链上重放，
+// ChainID为0时无法使用EIP-155签名，不支持以太坊格式交易；
+// 合约中的调用者为公钥对应的以太坊地址，和以太坊钱包中的地址一致，交易费仍然从公钥按chain33规则生成的地址(tx.From())扣除
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// EthSignName 以太坊交易签名算法名称
+	EthSignName = "evm.ethsign"
+	// EthSignType 以太坊交易签名类型
+	EthSignType = 260
+	// EthCoinPrecision 以太坊金额单位(wei)和chain33金额单位的倍数
+	EthCoinPrecision = 1e10
+)
+
+var (
+	// ErrEthTxInvalid 以太坊交易格式错误
+	ErrEthTxInvalid = errors.New("ErrEthTxInvalid")
+	// ErrEthTxAmount 以太坊交易金额不能转换为chain33金额
+	ErrEthTxAmount = errors.New("ErrEthTxAmount")
+	// ErrEthTxGas 以太坊交易的gas或gasPrice超出范围
+	ErrEthTxGas = errors.New("ErrEthTxGas")
+	// ErrEthTxSign 以太坊交易签名错误
+	ErrEthTxSign = errors.New("ErrEthTxSign")
+	// ErrEthTxChainID 以太坊交易没有使用EIP-155签名或者链ID不一致
+	ErrEthTxChainID = errors.New("ErrEthTxChainID")
+)
+
+// EthChainID 以太坊交易的链ID，使用chain33的chainID
+func EthChainID(chainID int32) int64 {
+	return int64(chainID)
+}
+
+// DecodeEthTx 解码以太坊原始交易
+func DecodeEthTx(raw []byte) (*ethtypes.Transaction, error) {
+	etx := new(ethtypes.Transaction)
+	if err := rlp.DecodeBytes(raw, etx); err != nil {
+		return nil, ErrEthTxInvalid
+	}
+	return etx, nil
+}
+
+// EthTxPubKey 从以太坊交易签名中恢复压缩格式的公钥，交易需要使用链ID为chainID的EIP-155签名
+func EthTxPubKey(etx *ethtypes.Transaction, chainID int64) ([]byte, error) {
+	if !etx.Protected() || etx.ChainId().Cmp(big.NewInt(chainID)) != 0 {
+		return nil, ErrEthTxChainID
+	}
+	signer := ethtypes.NewEIP155Signer(etx.ChainId())
+	v, r, s := etx.RawSignatureValues()
+	v = new(big.Int).Sub(v, new(big.Int).Mul(etx.ChainId(), big.NewInt(2)))
+	v.Sub(v, big.NewInt(8))
+	if v.BitLen() > 8 {
+		return nil, ErrEthTxSign
+	}
+	recID := byte(v.Uint64() - 27)
+	if !ethcrypto.ValidateSignatureValues(recID, r, s, true) {
+		return nil, ErrEthTxSign
+	}
+	sig := make([]byte, 65)
+	rb, sb := r.Bytes(), s.Bytes()
+	copy(sig[32-len(rb):32], rb)
+	copy(sig[64-len(sb):64], sb)
+	sig[64] = recID
+	pub, err := ethcrypto.SigToPub(signer.Hash(etx).Bytes(), sig)
+	if err != nil {
+		return nil, ErrEthTxSign
+	}
+	return ethcrypto.CompressPubkey(pub), nil
+}
+
+// EthTxToChain33 将以太坊原始交易转换为evm执行器交易
+// execer为执行器名称(平行链需要带前缀)，chainID为chain33的链ID
+func EthTxToChain33(raw []byte, execer string, chainID int32) (*types.Transaction, error) {
+	etx, err := DecodeEthTx(raw)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := EthTxPubKey(etx, EthChainID(chainID))
+	if err != nil {
+		return nil, err
+	}
+	tx, err := ethTxToChain33(etx, execer, chainID)
+	if err != nil {
+		return nil, err
+	}
+	tx.Signature = &types.Signature{Ty: EthSignType, Pubkey: pub, Signature: raw}
+	return tx, nil
+}
+
+func ethTxToChain33(etx *ethtypes.Transaction, execer string, chainID int32) (*types.Transaction, error) {
+	precision := big.NewInt(EthCoinPrecision)
+	amount, rem := new(big.Int).QuoRem(etx.Value(), precision, new(big.Int))
+	if rem.Sign() != 0 || !amount.IsUint64() {
+		return nil, ErrEthTxAmount
+	}
+	// gasPrice不足chain33的最小单位时按1计算
+	gasPrice := new(big.Int).Quo(etx.GasPrice(), precision)
+	if gasPrice.Sign() == 0 {
+		gasPrice.SetInt64(1)
+	}
+	if gasPrice.Cmp(big.NewInt(math.MaxUint32)) > 0 {
+		return nil, ErrEthTxGas
+	}
+	fee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(etx.Gas()))
+	if !fee.IsInt64() || etx.Nonce() > math.MaxInt64 {
+		return nil, ErrEthTxGas
+	}
+
+	action := &EVMContractAction{Amount: amount.Uint64(), GasLimit: etx.Gas(), GasPrice: uint32(gasPrice.Uint64()), Code: etx.Data()}
+	// 目标地址为空时创建合约
+	to := address.ExecAddress(execer)
+	if etx.To() != nil {
+		to = EthAddrToChain33(etx.To().Bytes())
+	}
+	tx := &types.Transaction{Execer: []byte(execer), Payload: types.Encode(action), Fee: fee.Int64(), Nonce: int64(etx.Nonce()), To: to, ChainID: chainID}
+	return tx, nil
+}
+
+// VerifyEthTx 校验chain33交易和签名中的以太坊原始交易是否一致
+// msg为去掉签名后的chain33交易编码，pub为交易签名中的公钥
+func VerifyEthTx(msg, pub, raw []byte) bool {
+	var tx types.Transaction
+	if err := types.Decode(msg, &tx); err != nil {
+		return false
+	}
+	if string(types.GetParaExecName(tx.Execer)) != ExecutorName {
+		return false
+	}
+	etx, err := DecodeEthTx(raw)
+	if err != nil {
+		return false
+	}
+	signPub, err := EthTxPubKey(etx, EthChainID(tx.ChainID))
+	if err != nil || !bytes.Equal(signPub, pub) {
+		return false
+	}
+	expect, err := ethTxToChain33(etx, string(tx.Execer), tx.ChainID)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(types.Encode(expect), msg)
+}
+
+// EthTxHash 计算以太坊原始交易的哈希
+func EthTxHash(raw []byte) []byte {
+	return ethcrypto.Keccak256(raw)
+}
+
+// IsEthTx 交易是否为以太坊格式签名的交易
+func IsEthTx(tx *types.Transaction) bool {
+	return tx.GetSignature().GetTy() == EthSignType
+}
+
+// TxSender 交易在合约中的调用者，以太坊格式签名的交易为公钥对应的以太坊地址，其它交易为tx.From()
+func TxSender(tx *types.Transaction) string {
+	if IsEthTx(tx) {
+		if addr := PubKeyToEthAddr(tx.Signature.Pubkey); addr != "" {
+			return addr
+		}
+	}
+	return tx.From()
+}
+
+// PubKeyToEthAddr 压缩格式的secp256k1公钥按以太坊规则(keccak哈希)生成的地址，转换为chain33地址格式，公钥格式错误时返回空
+func PubKeyToEthAddr(pub []byte) string {
+	key, err := ethcrypto.DecompressPubkey(pub)
+	if err != nil {
+		return ""
+	}
+	return EthAddrToChain33(ethcrypto.PubkeyToAddress(*key).Bytes())
+}
+
+// EthAddrToChain33 20字节的以太坊格式地址转换为chain33地址
+func EthAddrToChain33(b []byte) string {
+	addr := &address.Address{Version: address.NormalVer}
+	addr.SetBytes(b)
+	return addr.String()
+}
+
+// Chain33AddrToEth chain33地址转换为20字节的以太坊格式地址
+func Chain33AddrToEth(addr string) ([]byte, error) {
+	a, err := address.NewAddrFromString(addr)
+	if err != nil {
+		return nil, err
+	}
+	return a.Hash160[:], nil
+}
@@ -22,6 +22,7 @@ var (
 	actionName = map[string]int32{
 		"EvmCreate": EvmCreateAction,
 		"EvmCall":   EvmCallAction,
+		"EvmVerify": EvmVerifyAction,
 	}
 )
 
@@ -46,6 +47,18 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExecutorName, ForkEVMYoloV1, 9500000)
 	// EVM合约支持交易组
 	cfg.RegisterDappFork(ExecutorName, ForkEVMTxGroup, 0)
+	// EVM合约事件日志写入交易收据
+	cfg.RegisterDappFork(ExecutorName, ForkEVMEventLog, 10000000)
+	// EVM合约通过预编译合约使用chain33资产
+	cfg.RegisterDappFork(ExecutorName, ForkEVMERC20, 10000000)
+	// EVM支持Berlin/London版本的指令集和Gas计费规则
+	cfg.RegisterDappFork(ExecutorName, ForkEVMLondon, 10000000)
+	// EVM合约支持登记源码验证信息
+	cfg.RegisterDappFork(ExecutorName, ForkEVMVerify, 10000000)
+	// EVM合约支持accountmanager管理员和多重签名账户所有者代理调用
+	cfg.RegisterDappFork(ExecutorName, ForkEVMDelegate, 10000000)
+	// EVM支持以太坊钱包签名的交易
+	cfg.RegisterDappFork(ExecutorName, ForkEVMEthSign, 10000000)
 }
 
 //InitExecutor ...
@@ -84,6 +97,10 @@ func (evm EvmType) ActionName(tx *types.Transaction) string {
 	if strings.EqualFold(tx.To, address.ExecAddress(cfg.ExecName(ExecutorName))) {
 		return "createEvmContract"
 	}
+	var action EVMContractAction
+	if err := types.Decode(tx.Payload, &action); err == nil && action.Verify != nil {
+		return "verifyEvmContract"
+	}
 	return "callEvmContract"
 }
 
@@ -110,6 +127,26 @@ func (evm EvmType) Amount(tx *types.Transaction) (int64, error) {
 	return 0, nil
 }
 
+// GetCryptoDriver 获取签名算法，配置了ForkEVMEthSign时支持以太坊格式签名的交易，
+// 这里没有区块高度，分叉高度之前的交易由执行器的CheckTx拒绝
+func (evm *EvmType) GetCryptoDriver(ty int) (string, error) {
+	if ty == EthSignType {
+		if evm.GetConfig().GetDappFork(ExecutorName, ForkEVMEthSign) == types.MaxHeight {
+			return "", types.ErrNotSupport
+		}
+		return EthSignName, nil
+	}
+	return evm.ExecTypeBase.GetCryptoDriver(ty)
+}
+
+// GetCryptoType 获取签名类型
+func (evm *EvmType) GetCryptoType(name string) (int, error) {
+	if name == EthSignName {
+		return EthSignType, nil
+	}
+	return evm.ExecTypeBase.GetCryptoType(name)
+}
+
 // CreateTx 创建交易对象
 func (evm EvmType) CreateTx(action string, message json.RawMessage) (*types.Transaction, error) {
 	elog.Debug("evm.CreateTx", "action", action)
@@ -197,3 +234,13 @@ func createRawTx(cfg *types.Chain33Config, action proto.Message, name string, fe
 
 	return tx, nil
 }
+
+// DelegatePayload 多重签名账户代理调用合约时，owner需要先通过multisig合约的MultiSigSubmitTx确认此payload，
+// payload包含合约地址和调用内容，调用内容中代理信息的multiSigTxId置为0
+func DelegatePayload(to string, action *EVMContractAction) []byte {
+	act := proto.Clone(action).(*EVMContractAction)
+	if act.Delegate != nil {
+		act.Delegate.MultiSigTxId = 0
+	}
+	return types.Encode(&EVMDelegatePayload{To: to, Action: act})
+}
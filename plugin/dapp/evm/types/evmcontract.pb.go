@@ -245,10 +245,16 @@ type EVMContractAction struct {
 	// 交易备注
 	Note string `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
 	// 创建或调用合约时携带的ABI数据 ForkEVMABI
-	Abi                  string   `protobuf:"bytes,7,opt,name=abi,proto3" json:"abi,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Abi string `protobuf:"bytes,7,opt,name=abi,proto3" json:"abi,omitempty"`
+	// 预先访问的地址和存储 ForkEVMLondon
+	AccessList []*EVMAccessTuple `protobuf:"bytes,8,rep,name=accessList,proto3" json:"accessList,omitempty"`
+	// 登记合约源码验证信息，设置后不执行合约 ForkEVMVerify
+	Verify *EVMContractVerify `protobuf:"bytes,9,opt,name=verify,proto3" json:"verify,omitempty"`
+	// 代理其它账户创建或调用合约，合约中的调用者为被代理的账户 ForkEVMDelegate
+	Delegate             *EVMDelegateCall `protobuf:"bytes,10,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *EVMContractAction) Reset()         { *m = EVMContractAction{} }
@@ -325,6 +331,365 @@ func (m *EVMContractAction) GetAbi() string {
 	return ""
 }
 
+func (m *EVMContractAction) GetAccessList() []*EVMAccessTuple {
+	if m != nil {
+		return m.AccessList
+	}
+	return nil
+}
+
+func (m *EVMContractAction) GetVerify() *EVMContractVerify {
+	if m != nil {
+		return m.Verify
+	}
+	return nil
+}
+
+func (m *EVMContractAction) GetDelegate() *EVMDelegateCall {
+	if m != nil {
+		return m.Delegate
+	}
+	return nil
+}
+
+// 代理调用，由accountmanager管理员或者多重签名账户的所有者发起
+type EVMDelegateCall struct {
+	// 被代理的账户地址
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// 代理类型，1: accountmanager管理员，2: 多重签名账户所有者
+	Ty int32 `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
+	// 多重签名账户中已执行的MultiSigSubmitTx交易的txid，只能使用一次
+	MultiSigTxId         uint64   `protobuf:"varint,3,opt,name=multiSigTxId,proto3" json:"multiSigTxId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMDelegateCall) Reset()         { *m = EVMDelegateCall{} }
+func (m *EVMDelegateCall) String() string { return proto.CompactTextString(m) }
+func (*EVMDelegateCall) ProtoMessage()    {}
+func (*EVMDelegateCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{4}
+}
+
+func (m *EVMDelegateCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMDelegateCall.Unmarshal(m, b)
+}
+func (m *EVMDelegateCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMDelegateCall.Marshal(b, m, deterministic)
+}
+func (m *EVMDelegateCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMDelegateCall.Merge(m, src)
+}
+func (m *EVMDelegateCall) XXX_Size() int {
+	return xxx_messageInfo_EVMDelegateCall.Size(m)
+}
+func (m *EVMDelegateCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMDelegateCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMDelegateCall proto.InternalMessageInfo
+
+func (m *EVMDelegateCall) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EVMDelegateCall) GetTy() int32 {
+	if m != nil {
+		return m.Ty
+	}
+	return 0
+}
+
+func (m *EVMDelegateCall) GetMultiSigTxId() uint64 {
+	if m != nil {
+		return m.MultiSigTxId
+	}
+	return 0
+}
+
+// 多重签名账户代理调用时提交给multisig合约的payload
+type EVMDelegatePayload struct {
+	// 合约地址
+	To string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	// 调用内容，其中代理信息的multiSigTxId为0
+	Action               *EVMContractAction `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *EVMDelegatePayload) Reset()         { *m = EVMDelegatePayload{} }
+func (m *EVMDelegatePayload) String() string { return proto.CompactTextString(m) }
+func (*EVMDelegatePayload) ProtoMessage()    {}
+func (*EVMDelegatePayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{5}
+}
+
+func (m *EVMDelegatePayload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMDelegatePayload.Unmarshal(m, b)
+}
+func (m *EVMDelegatePayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMDelegatePayload.Marshal(b, m, deterministic)
+}
+func (m *EVMDelegatePayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMDelegatePayload.Merge(m, src)
+}
+func (m *EVMDelegatePayload) XXX_Size() int {
+	return xxx_messageInfo_EVMDelegatePayload.Size(m)
+}
+func (m *EVMDelegatePayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMDelegatePayload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMDelegatePayload proto.InternalMessageInfo
+
+func (m *EVMDelegatePayload) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EVMDelegatePayload) GetAction() *EVMContractAction {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+// EIP-2930访问列表中的地址和存储
+type EVMAccessTuple struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StorageKeys          []string `protobuf:"bytes,2,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMAccessTuple) Reset()         { *m = EVMAccessTuple{} }
+func (m *EVMAccessTuple) String() string { return proto.CompactTextString(m) }
+func (*EVMAccessTuple) ProtoMessage()    {}
+func (*EVMAccessTuple) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{6}
+}
+
+func (m *EVMAccessTuple) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMAccessTuple.Unmarshal(m, b)
+}
+func (m *EVMAccessTuple) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMAccessTuple.Marshal(b, m, deterministic)
+}
+func (m *EVMAccessTuple) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMAccessTuple.Merge(m, src)
+}
+func (m *EVMAccessTuple) XXX_Size() int {
+	return xxx_messageInfo_EVMAccessTuple.Size(m)
+}
+func (m *EVMAccessTuple) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMAccessTuple.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMAccessTuple proto.InternalMessageInfo
+
+func (m *EVMAccessTuple) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EVMAccessTuple) GetStorageKeys() []string {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
+// 合约源码验证信息，由合约创建者在链外编译源码后提交
+type EVMContractVerify struct {
+	// 编译器版本
+	CompilerVersion string `protobuf:"bytes,1,opt,name=compilerVersion,proto3" json:"compilerVersion,omitempty"`
+	// 编译参数，json格式
+	Settings string `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	// 源码哈希，十六进制格式
+	SourceHash string `protobuf:"bytes,3,opt,name=sourceHash,proto3" json:"sourceHash,omitempty"`
+	Abi        string `protobuf:"bytes,4,opt,name=abi,proto3" json:"abi,omitempty"`
+	// 编译生成的运行时代码，需要和合约部署后的代码一致
+	RuntimeCode          []byte   `protobuf:"bytes,5,opt,name=runtimeCode,proto3" json:"runtimeCode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMContractVerify) Reset()         { *m = EVMContractVerify{} }
+func (m *EVMContractVerify) String() string { return proto.CompactTextString(m) }
+func (*EVMContractVerify) ProtoMessage()    {}
+func (*EVMContractVerify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{7}
+}
+
+func (m *EVMContractVerify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMContractVerify.Unmarshal(m, b)
+}
+func (m *EVMContractVerify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMContractVerify.Marshal(b, m, deterministic)
+}
+func (m *EVMContractVerify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractVerify.Merge(m, src)
+}
+func (m *EVMContractVerify) XXX_Size() int {
+	return xxx_messageInfo_EVMContractVerify.Size(m)
+}
+func (m *EVMContractVerify) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMContractVerify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMContractVerify proto.InternalMessageInfo
+
+func (m *EVMContractVerify) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *EVMContractVerify) GetSettings() string {
+	if m != nil {
+		return m.Settings
+	}
+	return ""
+}
+
+func (m *EVMContractVerify) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
+func (m *EVMContractVerify) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *EVMContractVerify) GetRuntimeCode() []byte {
+	if m != nil {
+		return m.RuntimeCode
+	}
+	return nil
+}
+
+// 链上保存的合约源码验证信息
+type EVMContractVerifyInfo struct {
+	Addr            string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	CompilerVersion string `protobuf:"bytes,2,opt,name=compilerVersion,proto3" json:"compilerVersion,omitempty"`
+	Settings        string `protobuf:"bytes,3,opt,name=settings,proto3" json:"settings,omitempty"`
+	SourceHash      string `protobuf:"bytes,4,opt,name=sourceHash,proto3" json:"sourceHash,omitempty"`
+	Abi             string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+	// 合约部署后的代码哈希
+	CodeHash             string   `protobuf:"bytes,6,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	Submitter            string   `protobuf:"bytes,7,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	Height               int64    `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash               string   `protobuf:"bytes,9,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMContractVerifyInfo) Reset()         { *m = EVMContractVerifyInfo{} }
+func (m *EVMContractVerifyInfo) String() string { return proto.CompactTextString(m) }
+func (*EVMContractVerifyInfo) ProtoMessage()    {}
+func (*EVMContractVerifyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{8}
+}
+
+func (m *EVMContractVerifyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMContractVerifyInfo.Unmarshal(m, b)
+}
+func (m *EVMContractVerifyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMContractVerifyInfo.Marshal(b, m, deterministic)
+}
+func (m *EVMContractVerifyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMContractVerifyInfo.Merge(m, src)
+}
+func (m *EVMContractVerifyInfo) XXX_Size() int {
+	return xxx_messageInfo_EVMContractVerifyInfo.Size(m)
+}
+func (m *EVMContractVerifyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMContractVerifyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMContractVerifyInfo proto.InternalMessageInfo
+
+func (m *EVMContractVerifyInfo) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EVMContractVerifyInfo) GetCompilerVersion() string {
+	if m != nil {
+		return m.CompilerVersion
+	}
+	return ""
+}
+
+func (m *EVMContractVerifyInfo) GetSettings() string {
+	if m != nil {
+		return m.Settings
+	}
+	return ""
+}
+
+func (m *EVMContractVerifyInfo) GetSourceHash() string {
+	if m != nil {
+		return m.SourceHash
+	}
+	return ""
+}
+
+func (m *EVMContractVerifyInfo) GetAbi() string {
+	if m != nil {
+		return m.Abi
+	}
+	return ""
+}
+
+func (m *EVMContractVerifyInfo) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *EVMContractVerifyInfo) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *EVMContractVerifyInfo) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EVMContractVerifyInfo) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
 // 合约创建/调用日志
 type ReceiptEVMContract struct {
 	Caller       string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
@@ -344,7 +709,7 @@ func (m *ReceiptEVMContract) Reset()         { *m = ReceiptEVMContract{} }
 func (m *ReceiptEVMContract) String() string { return proto.CompactTextString(m) }
 func (*ReceiptEVMContract) ProtoMessage()    {}
 func (*ReceiptEVMContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{4}
+	return fileDescriptor_74353de561acd7c6, []int{9}
 }
 
 func (m *ReceiptEVMContract) XXX_Unmarshal(b []byte) error {
@@ -407,6 +772,62 @@ func (m *ReceiptEVMContract) GetJsonRet() string {
 	return ""
 }
 
+// 合约执行LOG指令生成的事件日志 ForkEVMEventLog
+type EVMLog struct {
+	ContractAddr         string   `protobuf:"bytes,1,opt,name=contractAddr,proto3" json:"contractAddr,omitempty"`
+	Topic                [][]byte `protobuf:"bytes,2,rep,name=topic,proto3" json:"topic,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMLog) Reset()         { *m = EVMLog{} }
+func (m *EVMLog) String() string { return proto.CompactTextString(m) }
+func (*EVMLog) ProtoMessage()    {}
+func (*EVMLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{10}
+}
+
+func (m *EVMLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMLog.Unmarshal(m, b)
+}
+func (m *EVMLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMLog.Marshal(b, m, deterministic)
+}
+func (m *EVMLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMLog.Merge(m, src)
+}
+func (m *EVMLog) XXX_Size() int {
+	return xxx_messageInfo_EVMLog.Size(m)
+}
+func (m *EVMLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMLog proto.InternalMessageInfo
+
+func (m *EVMLog) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *EVMLog) GetTopic() [][]byte {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *EVMLog) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 // 用于保存EVM只能合约中的状态数据变更
 type EVMStateChangeItem struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -421,7 +842,7 @@ func (m *EVMStateChangeItem) Reset()         { *m = EVMStateChangeItem{} }
 func (m *EVMStateChangeItem) String() string { return proto.CompactTextString(m) }
 func (*EVMStateChangeItem) ProtoMessage()    {}
 func (*EVMStateChangeItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{5}
+	return fileDescriptor_74353de561acd7c6, []int{11}
 }
 
 func (m *EVMStateChangeItem) XXX_Unmarshal(b []byte) error {
@@ -480,7 +901,7 @@ func (m *EVMContractDataCmd) Reset()         { *m = EVMContractDataCmd{} }
 func (m *EVMContractDataCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractDataCmd) ProtoMessage()    {}
 func (*EVMContractDataCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{6}
+	return fileDescriptor_74353de561acd7c6, []int{12}
 }
 
 func (m *EVMContractDataCmd) XXX_Unmarshal(b []byte) error {
@@ -558,7 +979,7 @@ func (m *EVMContractStateCmd) Reset()         { *m = EVMContractStateCmd{} }
 func (m *EVMContractStateCmd) String() string { return proto.CompactTextString(m) }
 func (*EVMContractStateCmd) ProtoMessage()    {}
 func (*EVMContractStateCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{7}
+	return fileDescriptor_74353de561acd7c6, []int{13}
 }
 
 func (m *EVMContractStateCmd) XXX_Unmarshal(b []byte) error {
@@ -625,7 +1046,7 @@ func (m *ReceiptEVMContractCmd) Reset()         { *m = ReceiptEVMContractCmd{} }
 func (m *ReceiptEVMContractCmd) String() string { return proto.CompactTextString(m) }
 func (*ReceiptEVMContractCmd) ProtoMessage()    {}
 func (*ReceiptEVMContractCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{8}
+	return fileDescriptor_74353de561acd7c6, []int{14}
 }
 
 func (m *ReceiptEVMContractCmd) XXX_Unmarshal(b []byte) error {
@@ -692,7 +1113,7 @@ func (m *CheckEVMAddrReq) Reset()         { *m = CheckEVMAddrReq{} }
 func (m *CheckEVMAddrReq) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrReq) ProtoMessage()    {}
 func (*CheckEVMAddrReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{9}
+	return fileDescriptor_74353de561acd7c6, []int{15}
 }
 
 func (m *CheckEVMAddrReq) XXX_Unmarshal(b []byte) error {
@@ -734,7 +1155,7 @@ func (m *CheckEVMAddrResp) Reset()         { *m = CheckEVMAddrResp{} }
 func (m *CheckEVMAddrResp) String() string { return proto.CompactTextString(m) }
 func (*CheckEVMAddrResp) ProtoMessage()    {}
 func (*CheckEVMAddrResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{10}
+	return fileDescriptor_74353de561acd7c6, []int{16}
 }
 
 func (m *CheckEVMAddrResp) XXX_Unmarshal(b []byte) error {
@@ -798,7 +1219,7 @@ func (m *EstimateEVMGasReq) Reset()         { *m = EstimateEVMGasReq{} }
 func (m *EstimateEVMGasReq) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasReq) ProtoMessage()    {}
 func (*EstimateEVMGasReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{11}
+	return fileDescriptor_74353de561acd7c6, []int{17}
 }
 
 func (m *EstimateEVMGasReq) XXX_Unmarshal(b []byte) error {
@@ -865,7 +1286,7 @@ func (m *EstimateEVMGasResp) Reset()         { *m = EstimateEVMGasResp{} }
 func (m *EstimateEVMGasResp) String() string { return proto.CompactTextString(m) }
 func (*EstimateEVMGasResp) ProtoMessage()    {}
 func (*EstimateEVMGasResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{12}
+	return fileDescriptor_74353de561acd7c6, []int{18}
 }
 
 func (m *EstimateEVMGasResp) XXX_Unmarshal(b []byte) error {
@@ -905,7 +1326,7 @@ func (m *EvmDebugReq) Reset()         { *m = EvmDebugReq{} }
 func (m *EvmDebugReq) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugReq) ProtoMessage()    {}
 func (*EvmDebugReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{13}
+	return fileDescriptor_74353de561acd7c6, []int{19}
 }
 
 func (m *EvmDebugReq) XXX_Unmarshal(b []byte) error {
@@ -944,7 +1365,7 @@ func (m *EvmDebugResp) Reset()         { *m = EvmDebugResp{} }
 func (m *EvmDebugResp) String() string { return proto.CompactTextString(m) }
 func (*EvmDebugResp) ProtoMessage()    {}
 func (*EvmDebugResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{14}
+	return fileDescriptor_74353de561acd7c6, []int{20}
 }
 
 func (m *EvmDebugResp) XXX_Unmarshal(b []byte) error {
@@ -983,7 +1404,7 @@ func (m *EvmQueryAbiReq) Reset()         { *m = EvmQueryAbiReq{} }
 func (m *EvmQueryAbiReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiReq) ProtoMessage()    {}
 func (*EvmQueryAbiReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{15}
+	return fileDescriptor_74353de561acd7c6, []int{21}
 }
 
 func (m *EvmQueryAbiReq) XXX_Unmarshal(b []byte) error {
@@ -1023,7 +1444,7 @@ func (m *EvmQueryAbiResp) Reset()         { *m = EvmQueryAbiResp{} }
 func (m *EvmQueryAbiResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryAbiResp) ProtoMessage()    {}
 func (*EvmQueryAbiResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{16}
+	return fileDescriptor_74353de561acd7c6, []int{22}
 }
 
 func (m *EvmQueryAbiResp) XXX_Unmarshal(b []byte) error {
@@ -1058,6 +1479,45 @@ func (m *EvmQueryAbiResp) GetAbi() string {
 	return ""
 }
 
+type EvmQueryVerifyReq struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmQueryVerifyReq) Reset()         { *m = EvmQueryVerifyReq{} }
+func (m *EvmQueryVerifyReq) String() string { return proto.CompactTextString(m) }
+func (*EvmQueryVerifyReq) ProtoMessage()    {}
+func (*EvmQueryVerifyReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{23}
+}
+
+func (m *EvmQueryVerifyReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmQueryVerifyReq.Unmarshal(m, b)
+}
+func (m *EvmQueryVerifyReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmQueryVerifyReq.Marshal(b, m, deterministic)
+}
+func (m *EvmQueryVerifyReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmQueryVerifyReq.Merge(m, src)
+}
+func (m *EvmQueryVerifyReq) XXX_Size() int {
+	return xxx_messageInfo_EvmQueryVerifyReq.Size(m)
+}
+func (m *EvmQueryVerifyReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmQueryVerifyReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmQueryVerifyReq proto.InternalMessageInfo
+
+func (m *EvmQueryVerifyReq) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 type EvmQueryReq struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Input                string   `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
@@ -1071,7 +1531,7 @@ func (m *EvmQueryReq) Reset()         { *m = EvmQueryReq{} }
 func (m *EvmQueryReq) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryReq) ProtoMessage()    {}
 func (*EvmQueryReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{17}
+	return fileDescriptor_74353de561acd7c6, []int{24}
 }
 
 func (m *EvmQueryReq) XXX_Unmarshal(b []byte) error {
@@ -1128,7 +1588,7 @@ func (m *EvmQueryResp) Reset()         { *m = EvmQueryResp{} }
 func (m *EvmQueryResp) String() string { return proto.CompactTextString(m) }
 func (*EvmQueryResp) ProtoMessage()    {}
 func (*EvmQueryResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{18}
+	return fileDescriptor_74353de561acd7c6, []int{25}
 }
 
 func (m *EvmQueryResp) XXX_Unmarshal(b []byte) error {
@@ -1202,7 +1662,7 @@ func (m *EvmContractCreateReq) Reset()         { *m = EvmContractCreateReq{} }
 func (m *EvmContractCreateReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractCreateReq) ProtoMessage()    {}
 func (*EvmContractCreateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{19}
+	return fileDescriptor_74353de561acd7c6, []int{26}
 }
 
 func (m *EvmContractCreateReq) XXX_Unmarshal(b []byte) error {
@@ -1298,7 +1758,7 @@ func (m *EvmContractCallReq) Reset()         { *m = EvmContractCallReq{} }
 func (m *EvmContractCallReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractCallReq) ProtoMessage()    {}
 func (*EvmContractCallReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{20}
+	return fileDescriptor_74353de561acd7c6, []int{27}
 }
 
 func (m *EvmContractCallReq) XXX_Unmarshal(b []byte) error {
@@ -1398,7 +1858,7 @@ func (m *EvmContractTransferReq) Reset()         { *m = EvmContractTransferReq{}
 func (m *EvmContractTransferReq) String() string { return proto.CompactTextString(m) }
 func (*EvmContractTransferReq) ProtoMessage()    {}
 func (*EvmContractTransferReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74353de561acd7c6, []int{21}
+	return fileDescriptor_74353de561acd7c6, []int{28}
 }
 
 func (m *EvmContractTransferReq) XXX_Unmarshal(b []byte) error {
@@ -1461,13 +1921,1248 @@ func (m *EvmContractTransferReq) GetParaName() string {
 	return ""
 }
 
+// 使用原始调用数据只读调用合约
+type EvmCallDataReq struct {
+	Caller               string   `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Amount               uint64   `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmCallDataReq) Reset()         { *m = EvmCallDataReq{} }
+func (m *EvmCallDataReq) String() string { return proto.CompactTextString(m) }
+func (*EvmCallDataReq) ProtoMessage()    {}
+func (*EvmCallDataReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{29}
+}
+
+func (m *EvmCallDataReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmCallDataReq.Unmarshal(m, b)
+}
+func (m *EvmCallDataReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmCallDataReq.Marshal(b, m, deterministic)
+}
+func (m *EvmCallDataReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCallDataReq.Merge(m, src)
+}
+func (m *EvmCallDataReq) XXX_Size() int {
+	return xxx_messageInfo_EvmCallDataReq.Size(m)
+}
+func (m *EvmCallDataReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCallDataReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCallDataReq proto.InternalMessageInfo
+
+func (m *EvmCallDataReq) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EvmCallDataReq) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EvmCallDataReq) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EvmCallDataReq) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type EvmCallDataResp struct {
+	Ret                  []byte   `protobuf:"bytes,1,opt,name=ret,proto3" json:"ret,omitempty"`
+	UsedGas              uint64   `protobuf:"varint,2,opt,name=usedGas,proto3" json:"usedGas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmCallDataResp) Reset()         { *m = EvmCallDataResp{} }
+func (m *EvmCallDataResp) String() string { return proto.CompactTextString(m) }
+func (*EvmCallDataResp) ProtoMessage()    {}
+func (*EvmCallDataResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{30}
+}
+
+func (m *EvmCallDataResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmCallDataResp.Unmarshal(m, b)
+}
+func (m *EvmCallDataResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmCallDataResp.Marshal(b, m, deterministic)
+}
+func (m *EvmCallDataResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmCallDataResp.Merge(m, src)
+}
+func (m *EvmCallDataResp) XXX_Size() int {
+	return xxx_messageInfo_EvmCallDataResp.Size(m)
+}
+func (m *EvmCallDataResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmCallDataResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmCallDataResp proto.InternalMessageInfo
+
+func (m *EvmCallDataResp) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *EvmCallDataResp) GetUsedGas() uint64 {
+	if m != nil {
+		return m.UsedGas
+	}
+	return 0
+}
+
+type EvmGetCodeResp struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Code                 []byte   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmGetCodeResp) Reset()         { *m = EvmGetCodeResp{} }
+func (m *EvmGetCodeResp) String() string { return proto.CompactTextString(m) }
+func (*EvmGetCodeResp) ProtoMessage()    {}
+func (*EvmGetCodeResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{31}
+}
+
+func (m *EvmGetCodeResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmGetCodeResp.Unmarshal(m, b)
+}
+func (m *EvmGetCodeResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmGetCodeResp.Marshal(b, m, deterministic)
+}
+func (m *EvmGetCodeResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmGetCodeResp.Merge(m, src)
+}
+func (m *EvmGetCodeResp) XXX_Size() int {
+	return xxx_messageInfo_EvmGetCodeResp.Size(m)
+}
+func (m *EvmGetCodeResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmGetCodeResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmGetCodeResp proto.InternalMessageInfo
+
+func (m *EvmGetCodeResp) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *EvmGetCodeResp) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+// 合约事件日志的本地索引记录
+type EVMLogRecord struct {
+	Height               int64    `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TxIndex              int32    `protobuf:"varint,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	LogIndex             int32    `protobuf:"varint,3,opt,name=logIndex,proto3" json:"logIndex,omitempty"`
+	TxHash               []byte   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	ContractAddr         string   `protobuf:"bytes,5,opt,name=contractAddr,proto3" json:"contractAddr,omitempty"`
+	Topic                [][]byte `protobuf:"bytes,6,rep,name=topic,proto3" json:"topic,omitempty"`
+	Data                 []byte   `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMLogRecord) Reset()         { *m = EVMLogRecord{} }
+func (m *EVMLogRecord) String() string { return proto.CompactTextString(m) }
+func (*EVMLogRecord) ProtoMessage()    {}
+func (*EVMLogRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{32}
+}
+
+func (m *EVMLogRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMLogRecord.Unmarshal(m, b)
+}
+func (m *EVMLogRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMLogRecord.Marshal(b, m, deterministic)
+}
+func (m *EVMLogRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMLogRecord.Merge(m, src)
+}
+func (m *EVMLogRecord) XXX_Size() int {
+	return xxx_messageInfo_EVMLogRecord.Size(m)
+}
+func (m *EVMLogRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMLogRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMLogRecord proto.InternalMessageInfo
+
+func (m *EVMLogRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EVMLogRecord) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *EVMLogRecord) GetLogIndex() int32 {
+	if m != nil {
+		return m.LogIndex
+	}
+	return 0
+}
+
+func (m *EVMLogRecord) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *EVMLogRecord) GetContractAddr() string {
+	if m != nil {
+		return m.ContractAddr
+	}
+	return ""
+}
+
+func (m *EVMLogRecord) GetTopic() [][]byte {
+	if m != nil {
+		return m.Topic
+	}
+	return nil
+}
+
+func (m *EVMLogRecord) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// 按区块范围、合约地址和主题查询事件日志
+type ReqEVMLogs struct {
+	FromBlock int64 `protobuf:"varint,1,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	// 小于等于0时查询到最新区块
+	ToBlock int64  `protobuf:"varint,2,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// 依次为topic0-topic3的过滤条件，为空时匹配任意主题
+	Topics []string `protobuf:"bytes,4,rep,name=topics,proto3" json:"topics,omitempty"`
+	Count  int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 分页查询时上一页返回的primaryKey
+	PrimaryKey           string   `protobuf:"bytes,6,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqEVMLogs) Reset()         { *m = ReqEVMLogs{} }
+func (m *ReqEVMLogs) String() string { return proto.CompactTextString(m) }
+func (*ReqEVMLogs) ProtoMessage()    {}
+func (*ReqEVMLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{33}
+}
+
+func (m *ReqEVMLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqEVMLogs.Unmarshal(m, b)
+}
+func (m *ReqEVMLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqEVMLogs.Marshal(b, m, deterministic)
+}
+func (m *ReqEVMLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqEVMLogs.Merge(m, src)
+}
+func (m *ReqEVMLogs) XXX_Size() int {
+	return xxx_messageInfo_ReqEVMLogs.Size(m)
+}
+func (m *ReqEVMLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqEVMLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqEVMLogs proto.InternalMessageInfo
+
+func (m *ReqEVMLogs) GetFromBlock() int64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *ReqEVMLogs) GetToBlock() int64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+func (m *ReqEVMLogs) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReqEVMLogs) GetTopics() []string {
+	if m != nil {
+		return m.Topics
+	}
+	return nil
+}
+
+func (m *ReqEVMLogs) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqEVMLogs) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+type ReplyEVMLogs struct {
+	Logs                 []*EVMLogRecord `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	PrimaryKey           string          `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyEVMLogs) Reset()         { *m = ReplyEVMLogs{} }
+func (m *ReplyEVMLogs) String() string { return proto.CompactTextString(m) }
+func (*ReplyEVMLogs) ProtoMessage()    {}
+func (*ReplyEVMLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{34}
+}
+
+func (m *ReplyEVMLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyEVMLogs.Unmarshal(m, b)
+}
+func (m *ReplyEVMLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyEVMLogs.Marshal(b, m, deterministic)
+}
+func (m *ReplyEVMLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyEVMLogs.Merge(m, src)
+}
+func (m *ReplyEVMLogs) XXX_Size() int {
+	return xxx_messageInfo_ReplyEVMLogs.Size(m)
+}
+func (m *ReplyEVMLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyEVMLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyEVMLogs proto.InternalMessageInfo
+
+func (m *ReplyEVMLogs) GetLogs() []*EVMLogRecord {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+func (m *ReplyEVMLogs) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+// 重放历史交易并跟踪合约执行过程
+type ReqEVMTrace struct {
+	// 交易哈希
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// callTracer 返回调用树，structLogger 返回指令执行记录，默认为callTracer
+	Tracer         string `protobuf:"bytes,2,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	DisableStack   bool   `protobuf:"varint,3,opt,name=disableStack,proto3" json:"disableStack,omitempty"`
+	DisableMemory  bool   `protobuf:"varint,4,opt,name=disableMemory,proto3" json:"disableMemory,omitempty"`
+	DisableStorage bool   `protobuf:"varint,5,opt,name=disableStorage,proto3" json:"disableStorage,omitempty"`
+	// structLogger最多记录的指令条数，0表示使用默认值
+	Limit                int32    `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqEVMTrace) Reset()         { *m = ReqEVMTrace{} }
+func (m *ReqEVMTrace) String() string { return proto.CompactTextString(m) }
+func (*ReqEVMTrace) ProtoMessage()    {}
+func (*ReqEVMTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{35}
+}
+
+func (m *ReqEVMTrace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqEVMTrace.Unmarshal(m, b)
+}
+func (m *ReqEVMTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqEVMTrace.Marshal(b, m, deterministic)
+}
+func (m *ReqEVMTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqEVMTrace.Merge(m, src)
+}
+func (m *ReqEVMTrace) XXX_Size() int {
+	return xxx_messageInfo_ReqEVMTrace.Size(m)
+}
+func (m *ReqEVMTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqEVMTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqEVMTrace proto.InternalMessageInfo
+
+func (m *ReqEVMTrace) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *ReqEVMTrace) GetTracer() string {
+	if m != nil {
+		return m.Tracer
+	}
+	return ""
+}
+
+func (m *ReqEVMTrace) GetDisableStack() bool {
+	if m != nil {
+		return m.DisableStack
+	}
+	return false
+}
+
+func (m *ReqEVMTrace) GetDisableMemory() bool {
+	if m != nil {
+		return m.DisableMemory
+	}
+	return false
+}
+
+func (m *ReqEVMTrace) GetDisableStorage() bool {
+	if m != nil {
+		return m.DisableStorage
+	}
+	return false
+}
+
+func (m *ReqEVMTrace) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// 合约调用树中的一次调用
+type EVMCallFrame struct {
+	Type                 string          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From                 string          `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string          `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value                uint64          `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas                  uint64          `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed              uint64          `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input                string          `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output               string          `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	Error                string          `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Calls                []*EVMCallFrame `protobuf:"bytes,10,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *EVMCallFrame) Reset()         { *m = EVMCallFrame{} }
+func (m *EVMCallFrame) String() string { return proto.CompactTextString(m) }
+func (*EVMCallFrame) ProtoMessage()    {}
+func (*EVMCallFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{36}
+}
+
+func (m *EVMCallFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMCallFrame.Unmarshal(m, b)
+}
+func (m *EVMCallFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMCallFrame.Marshal(b, m, deterministic)
+}
+func (m *EVMCallFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMCallFrame.Merge(m, src)
+}
+func (m *EVMCallFrame) XXX_Size() int {
+	return xxx_messageInfo_EVMCallFrame.Size(m)
+}
+func (m *EVMCallFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMCallFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMCallFrame proto.InternalMessageInfo
+
+func (m *EVMCallFrame) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *EVMCallFrame) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EVMCallFrame) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EVMCallFrame) GetValue() uint64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *EVMCallFrame) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *EVMCallFrame) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *EVMCallFrame) GetInput() string {
+	if m != nil {
+		return m.Input
+	}
+	return ""
+}
+
+func (m *EVMCallFrame) GetOutput() string {
+	if m != nil {
+		return m.Output
+	}
+	return ""
+}
+
+func (m *EVMCallFrame) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *EVMCallFrame) GetCalls() []*EVMCallFrame {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+// 一条指令执行前的状态
+type EVMStructLog struct {
+	Pc                   uint64            `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op                   string            `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas                  uint64            `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost              uint64            `protobuf:"varint,4,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	Depth                int32             `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Stack                []string          `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	Memory               []string          `protobuf:"bytes,7,rep,name=memory,proto3" json:"memory,omitempty"`
+	Storage              map[string]string `protobuf:"bytes,8,rep,name=storage,proto3" json:"storage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Error                string            `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EVMStructLog) Reset()         { *m = EVMStructLog{} }
+func (m *EVMStructLog) String() string { return proto.CompactTextString(m) }
+func (*EVMStructLog) ProtoMessage()    {}
+func (*EVMStructLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{37}
+}
+
+func (m *EVMStructLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMStructLog.Unmarshal(m, b)
+}
+func (m *EVMStructLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMStructLog.Marshal(b, m, deterministic)
+}
+func (m *EVMStructLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMStructLog.Merge(m, src)
+}
+func (m *EVMStructLog) XXX_Size() int {
+	return xxx_messageInfo_EVMStructLog.Size(m)
+}
+func (m *EVMStructLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMStructLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMStructLog proto.InternalMessageInfo
+
+func (m *EVMStructLog) GetPc() uint64 {
+	if m != nil {
+		return m.Pc
+	}
+	return 0
+}
+
+func (m *EVMStructLog) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *EVMStructLog) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *EVMStructLog) GetGasCost() uint64 {
+	if m != nil {
+		return m.GasCost
+	}
+	return 0
+}
+
+func (m *EVMStructLog) GetDepth() int32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *EVMStructLog) GetStack() []string {
+	if m != nil {
+		return m.Stack
+	}
+	return nil
+}
+
+func (m *EVMStructLog) GetMemory() []string {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *EVMStructLog) GetStorage() map[string]string {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *EVMStructLog) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ReplyEVMTrace struct {
+	GasUsed              uint64          `protobuf:"varint,1,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Failed               bool            `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	ReturnValue          string          `protobuf:"bytes,3,opt,name=returnValue,proto3" json:"returnValue,omitempty"`
+	Call                 *EVMCallFrame   `protobuf:"bytes,4,opt,name=call,proto3" json:"call,omitempty"`
+	StructLogs           []*EVMStructLog `protobuf:"bytes,5,rep,name=structLogs,proto3" json:"structLogs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReplyEVMTrace) Reset()         { *m = ReplyEVMTrace{} }
+func (m *ReplyEVMTrace) String() string { return proto.CompactTextString(m) }
+func (*ReplyEVMTrace) ProtoMessage()    {}
+func (*ReplyEVMTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{38}
+}
+
+func (m *ReplyEVMTrace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyEVMTrace.Unmarshal(m, b)
+}
+func (m *ReplyEVMTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyEVMTrace.Marshal(b, m, deterministic)
+}
+func (m *ReplyEVMTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyEVMTrace.Merge(m, src)
+}
+func (m *ReplyEVMTrace) XXX_Size() int {
+	return xxx_messageInfo_ReplyEVMTrace.Size(m)
+}
+func (m *ReplyEVMTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyEVMTrace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyEVMTrace proto.InternalMessageInfo
+
+func (m *ReplyEVMTrace) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ReplyEVMTrace) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+func (m *ReplyEVMTrace) GetReturnValue() string {
+	if m != nil {
+		return m.ReturnValue
+	}
+	return ""
+}
+
+func (m *ReplyEVMTrace) GetCall() *EVMCallFrame {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *ReplyEVMTrace) GetStructLogs() []*EVMStructLog {
+	if m != nil {
+		return m.StructLogs
+	}
+	return nil
+}
+
+// 查询合约存储
+type ReqEVMGetStorageAt struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 存储位置，十六进制格式
+	Slot string `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	// 查询指定高度的存储，0表示最新高度
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqEVMGetStorageAt) Reset()         { *m = ReqEVMGetStorageAt{} }
+func (m *ReqEVMGetStorageAt) String() string { return proto.CompactTextString(m) }
+func (*ReqEVMGetStorageAt) ProtoMessage()    {}
+func (*ReqEVMGetStorageAt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{39}
+}
+
+func (m *ReqEVMGetStorageAt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqEVMGetStorageAt.Unmarshal(m, b)
+}
+func (m *ReqEVMGetStorageAt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqEVMGetStorageAt.Marshal(b, m, deterministic)
+}
+func (m *ReqEVMGetStorageAt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqEVMGetStorageAt.Merge(m, src)
+}
+func (m *ReqEVMGetStorageAt) XXX_Size() int {
+	return xxx_messageInfo_ReqEVMGetStorageAt.Size(m)
+}
+func (m *ReqEVMGetStorageAt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqEVMGetStorageAt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqEVMGetStorageAt proto.InternalMessageInfo
+
+func (m *ReqEVMGetStorageAt) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReqEVMGetStorageAt) GetSlot() string {
+	if m != nil {
+		return m.Slot
+	}
+	return ""
+}
+
+func (m *ReqEVMGetStorageAt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// 合约存储项，key和value均为十六进制格式
+type EVMStorageItem struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EVMStorageItem) Reset()         { *m = EVMStorageItem{} }
+func (m *EVMStorageItem) String() string { return proto.CompactTextString(m) }
+func (*EVMStorageItem) ProtoMessage()    {}
+func (*EVMStorageItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{40}
+}
+
+func (m *EVMStorageItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EVMStorageItem.Unmarshal(m, b)
+}
+func (m *EVMStorageItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EVMStorageItem.Marshal(b, m, deterministic)
+}
+func (m *EVMStorageItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EVMStorageItem.Merge(m, src)
+}
+func (m *EVMStorageItem) XXX_Size() int {
+	return xxx_messageInfo_EVMStorageItem.Size(m)
+}
+func (m *EVMStorageItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_EVMStorageItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EVMStorageItem proto.InternalMessageInfo
+
+func (m *EVMStorageItem) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *EVMStorageItem) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+// 分页导出合约全部存储
+type ReqEVMDumpStorage struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 上一页返回的最后一个存储位置，为空时从头开始
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqEVMDumpStorage) Reset()         { *m = ReqEVMDumpStorage{} }
+func (m *ReqEVMDumpStorage) String() string { return proto.CompactTextString(m) }
+func (*ReqEVMDumpStorage) ProtoMessage()    {}
+func (*ReqEVMDumpStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{41}
+}
+
+func (m *ReqEVMDumpStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqEVMDumpStorage.Unmarshal(m, b)
+}
+func (m *ReqEVMDumpStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqEVMDumpStorage.Marshal(b, m, deterministic)
+}
+func (m *ReqEVMDumpStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqEVMDumpStorage.Merge(m, src)
+}
+func (m *ReqEVMDumpStorage) XXX_Size() int {
+	return xxx_messageInfo_ReqEVMDumpStorage.Size(m)
+}
+func (m *ReqEVMDumpStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqEVMDumpStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqEVMDumpStorage proto.InternalMessageInfo
+
+func (m *ReqEVMDumpStorage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReqEVMDumpStorage) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqEVMDumpStorage) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReplyEVMDumpStorage struct {
+	Storage []*EVMStorageItem `protobuf:"bytes,1,rep,name=storage,proto3" json:"storage,omitempty"`
+	// 还有更多数据时返回本页最后一个存储位置
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplyEVMDumpStorage) Reset()         { *m = ReplyEVMDumpStorage{} }
+func (m *ReplyEVMDumpStorage) String() string { return proto.CompactTextString(m) }
+func (*ReplyEVMDumpStorage) ProtoMessage()    {}
+func (*ReplyEVMDumpStorage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{42}
+}
+
+func (m *ReplyEVMDumpStorage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyEVMDumpStorage.Unmarshal(m, b)
+}
+func (m *ReplyEVMDumpStorage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyEVMDumpStorage.Marshal(b, m, deterministic)
+}
+func (m *ReplyEVMDumpStorage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyEVMDumpStorage.Merge(m, src)
+}
+func (m *ReplyEVMDumpStorage) XXX_Size() int {
+	return xxx_messageInfo_ReplyEVMDumpStorage.Size(m)
+}
+func (m *ReplyEVMDumpStorage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyEVMDumpStorage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyEVMDumpStorage proto.InternalMessageInfo
+
+func (m *ReplyEVMDumpStorage) GetStorage() []*EVMStorageItem {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *ReplyEVMDumpStorage) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+// 批量只读调用合约，所有调用在同一个状态快照上执行，互不影响
+type EvmMulticallReq struct {
+	Caller string              `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	Calls  []*EvmMulticallCall `protobuf:"bytes,2,rep,name=calls,proto3" json:"calls,omitempty"`
+	// 执行前覆盖地址的余额或者代码，只在本次查询中有效
+	Overrides            []*EvmStateOverride `protobuf:"bytes,3,rep,name=overrides,proto3" json:"overrides,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EvmMulticallReq) Reset()         { *m = EvmMulticallReq{} }
+func (m *EvmMulticallReq) String() string { return proto.CompactTextString(m) }
+func (*EvmMulticallReq) ProtoMessage()    {}
+func (*EvmMulticallReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{43}
+}
+
+func (m *EvmMulticallReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmMulticallReq.Unmarshal(m, b)
+}
+func (m *EvmMulticallReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmMulticallReq.Marshal(b, m, deterministic)
+}
+func (m *EvmMulticallReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmMulticallReq.Merge(m, src)
+}
+func (m *EvmMulticallReq) XXX_Size() int {
+	return xxx_messageInfo_EvmMulticallReq.Size(m)
+}
+func (m *EvmMulticallReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmMulticallReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmMulticallReq proto.InternalMessageInfo
+
+func (m *EvmMulticallReq) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *EvmMulticallReq) GetCalls() []*EvmMulticallCall {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+func (m *EvmMulticallReq) GetOverrides() []*EvmStateOverride {
+	if m != nil {
+		return m.Overrides
+	}
+	return nil
+}
+
+type EvmMulticallCall struct {
+	To   string `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// 为0时使用最大gas
+	GasLimit             uint64   `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmMulticallCall) Reset()         { *m = EvmMulticallCall{} }
+func (m *EvmMulticallCall) String() string { return proto.CompactTextString(m) }
+func (*EvmMulticallCall) ProtoMessage()    {}
+func (*EvmMulticallCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{44}
+}
+
+func (m *EvmMulticallCall) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmMulticallCall.Unmarshal(m, b)
+}
+func (m *EvmMulticallCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmMulticallCall.Marshal(b, m, deterministic)
+}
+func (m *EvmMulticallCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmMulticallCall.Merge(m, src)
+}
+func (m *EvmMulticallCall) XXX_Size() int {
+	return xxx_messageInfo_EvmMulticallCall.Size(m)
+}
+func (m *EvmMulticallCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmMulticallCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmMulticallCall proto.InternalMessageInfo
+
+func (m *EvmMulticallCall) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EvmMulticallCall) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EvmMulticallCall) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+type EvmStateOverride struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// 十进制的余额，为空时不覆盖
+	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	// 为空时不覆盖
+	Code                 []byte   `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmStateOverride) Reset()         { *m = EvmStateOverride{} }
+func (m *EvmStateOverride) String() string { return proto.CompactTextString(m) }
+func (*EvmStateOverride) ProtoMessage()    {}
+func (*EvmStateOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{45}
+}
+
+func (m *EvmStateOverride) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmStateOverride.Unmarshal(m, b)
+}
+func (m *EvmStateOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmStateOverride.Marshal(b, m, deterministic)
+}
+func (m *EvmStateOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmStateOverride.Merge(m, src)
+}
+func (m *EvmStateOverride) XXX_Size() int {
+	return xxx_messageInfo_EvmStateOverride.Size(m)
+}
+func (m *EvmStateOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmStateOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmStateOverride proto.InternalMessageInfo
+
+func (m *EvmStateOverride) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EvmStateOverride) GetBalance() string {
+	if m != nil {
+		return m.Balance
+	}
+	return ""
+}
+
+func (m *EvmStateOverride) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+type EvmMulticallResp struct {
+	Results              []*EvmMulticallResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *EvmMulticallResp) Reset()         { *m = EvmMulticallResp{} }
+func (m *EvmMulticallResp) String() string { return proto.CompactTextString(m) }
+func (*EvmMulticallResp) ProtoMessage()    {}
+func (*EvmMulticallResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{46}
+}
+
+func (m *EvmMulticallResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmMulticallResp.Unmarshal(m, b)
+}
+func (m *EvmMulticallResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmMulticallResp.Marshal(b, m, deterministic)
+}
+func (m *EvmMulticallResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmMulticallResp.Merge(m, src)
+}
+func (m *EvmMulticallResp) XXX_Size() int {
+	return xxx_messageInfo_EvmMulticallResp.Size(m)
+}
+func (m *EvmMulticallResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmMulticallResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmMulticallResp proto.InternalMessageInfo
+
+func (m *EvmMulticallResp) GetResults() []*EvmMulticallResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type EvmMulticallResult struct {
+	Success              bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Ret                  []byte   `protobuf:"bytes,2,opt,name=ret,proto3" json:"ret,omitempty"`
+	UsedGas              uint64   `protobuf:"varint,3,opt,name=usedGas,proto3" json:"usedGas,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvmMulticallResult) Reset()         { *m = EvmMulticallResult{} }
+func (m *EvmMulticallResult) String() string { return proto.CompactTextString(m) }
+func (*EvmMulticallResult) ProtoMessage()    {}
+func (*EvmMulticallResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74353de561acd7c6, []int{47}
+}
+
+func (m *EvmMulticallResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EvmMulticallResult.Unmarshal(m, b)
+}
+func (m *EvmMulticallResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EvmMulticallResult.Marshal(b, m, deterministic)
+}
+func (m *EvmMulticallResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmMulticallResult.Merge(m, src)
+}
+func (m *EvmMulticallResult) XXX_Size() int {
+	return xxx_messageInfo_EvmMulticallResult.Size(m)
+}
+func (m *EvmMulticallResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmMulticallResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmMulticallResult proto.InternalMessageInfo
+
+func (m *EvmMulticallResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *EvmMulticallResult) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *EvmMulticallResult) GetUsedGas() uint64 {
+	if m != nil {
+		return m.UsedGas
+	}
+	return 0
+}
+
+func (m *EvmMulticallResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EVMContractObject)(nil), "types.EVMContractObject")
 	proto.RegisterType((*EVMContractData)(nil), "types.EVMContractData")
 	proto.RegisterType((*EVMContractState)(nil), "types.EVMContractState")
 	proto.RegisterMapType((map[string][]byte)(nil), "types.EVMContractState.StorageEntry")
 	proto.RegisterType((*EVMContractAction)(nil), "types.EVMContractAction")
+	proto.RegisterType((*EVMDelegateCall)(nil), "types.EVMDelegateCall")
+	proto.RegisterType((*EVMDelegatePayload)(nil), "types.EVMDelegatePayload")
+	proto.RegisterType((*EVMAccessTuple)(nil), "types.EVMAccessTuple")
+	proto.RegisterType((*EVMContractVerify)(nil), "types.EVMContractVerify")
+	proto.RegisterType((*EVMContractVerifyInfo)(nil), "types.EVMContractVerifyInfo")
 	proto.RegisterType((*ReceiptEVMContract)(nil), "types.ReceiptEVMContract")
+	proto.RegisterType((*EVMLog)(nil), "types.EVMLog")
 	proto.RegisterType((*EVMStateChangeItem)(nil), "types.EVMStateChangeItem")
 	proto.RegisterType((*EVMContractDataCmd)(nil), "types.EVMContractDataCmd")
 	proto.RegisterType((*EVMContractStateCmd)(nil), "types.EVMContractStateCmd")
@@ -1481,11 +3176,32 @@ func init() {
 	proto.RegisterType((*EvmDebugResp)(nil), "types.EvmDebugResp")
 	proto.RegisterType((*EvmQueryAbiReq)(nil), "types.EvmQueryAbiReq")
 	proto.RegisterType((*EvmQueryAbiResp)(nil), "types.EvmQueryAbiResp")
+	proto.RegisterType((*EvmQueryVerifyReq)(nil), "types.EvmQueryVerifyReq")
 	proto.RegisterType((*EvmQueryReq)(nil), "types.EvmQueryReq")
 	proto.RegisterType((*EvmQueryResp)(nil), "types.EvmQueryResp")
 	proto.RegisterType((*EvmContractCreateReq)(nil), "types.EvmContractCreateReq")
 	proto.RegisterType((*EvmContractCallReq)(nil), "types.EvmContractCallReq")
 	proto.RegisterType((*EvmContractTransferReq)(nil), "types.EvmContractTransferReq")
+	proto.RegisterType((*EvmCallDataReq)(nil), "types.EvmCallDataReq")
+	proto.RegisterType((*EvmCallDataResp)(nil), "types.EvmCallDataResp")
+	proto.RegisterType((*EvmGetCodeResp)(nil), "types.EvmGetCodeResp")
+	proto.RegisterType((*EVMLogRecord)(nil), "types.EVMLogRecord")
+	proto.RegisterType((*ReqEVMLogs)(nil), "types.ReqEVMLogs")
+	proto.RegisterType((*ReplyEVMLogs)(nil), "types.ReplyEVMLogs")
+	proto.RegisterType((*ReqEVMTrace)(nil), "types.ReqEVMTrace")
+	proto.RegisterType((*EVMCallFrame)(nil), "types.EVMCallFrame")
+	proto.RegisterType((*EVMStructLog)(nil), "types.EVMStructLog")
+	proto.RegisterMapType((map[string]string)(nil), "types.EVMStructLog.StorageEntry")
+	proto.RegisterType((*ReplyEVMTrace)(nil), "types.ReplyEVMTrace")
+	proto.RegisterType((*ReqEVMGetStorageAt)(nil), "types.ReqEVMGetStorageAt")
+	proto.RegisterType((*EVMStorageItem)(nil), "types.EVMStorageItem")
+	proto.RegisterType((*ReqEVMDumpStorage)(nil), "types.ReqEVMDumpStorage")
+	proto.RegisterType((*ReplyEVMDumpStorage)(nil), "types.ReplyEVMDumpStorage")
+	proto.RegisterType((*EvmMulticallReq)(nil), "types.EvmMulticallReq")
+	proto.RegisterType((*EvmMulticallCall)(nil), "types.EvmMulticallCall")
+	proto.RegisterType((*EvmStateOverride)(nil), "types.EvmStateOverride")
+	proto.RegisterType((*EvmMulticallResp)(nil), "types.EvmMulticallResp")
+	proto.RegisterType((*EvmMulticallResult)(nil), "types.EvmMulticallResult")
 }
 
 func init() {
@@ -1493,68 +3209,137 @@ func init() {
 }
 
 var fileDescriptor_74353de561acd7c6 = []byte{
-	// 1004 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0x96, 0x63, 0x27, 0x1b, 0xbf, 0x0d, 0xed, 0xae, 0x29, 0x4b, 0xb4, 0x42, 0x28, 0xb2, 0x28,
-	0xac, 0x2a, 0xb1, 0x42, 0xe5, 0x82, 0x2a, 0x81, 0xb4, 0x4a, 0xa3, 0x82, 0xc4, 0xf2, 0x63, 0x0a,
-	0xe1, 0x3c, 0xb1, 0x67, 0xb3, 0x6e, 0xe3, 0x1f, 0xcc, 0x4c, 0xd2, 0xe6, 0xca, 0x99, 0x23, 0x47,
-	0x6e, 0x1c, 0xb9, 0x20, 0x71, 0xe3, 0xcc, 0x1f, 0xc1, 0x99, 0x13, 0x7f, 0x06, 0x7a, 0xcf, 0x63,
-	0x7b, 0xec, 0x4d, 0x2a, 0x90, 0x2a, 0xc4, 0x29, 0xf3, 0xcd, 0x3c, 0xcf, 0x7c, 0xdf, 0xbc, 0xef,
-	0xbd, 0x09, 0x1c, 0x8b, 0x4d, 0x1a, 0xe5, 0x99, 0x96, 0x3c, 0xd2, 0xe7, 0x85, 0xcc, 0x75, 0x1e,
-	0xf4, 0xf5, 0xb6, 0x10, 0x2a, 0xfc, 0xce, 0x81, 0xe3, 0xd9, 0xfc, 0x72, 0x6a, 0x16, 0x3f, 0x5f,
-	0x3c, 0x11, 0x91, 0x0e, 0x02, 0xf0, 0x78, 0x1c, 0xcb, 0xb1, 0x33, 0x71, 0xce, 0x7c, 0x46, 0xe3,
-	0xe0, 0x1e, 0x78, 0x31, 0xd7, 0x7c, 0xdc, 0x9b, 0x38, 0x67, 0x87, 0xf7, 0x4f, 0xce, 0xe9, 0xfb,
-	0x73, 0xeb, 0xdb, 0x87, 0x5c, 0x73, 0x46, 0x31, 0xc1, 0xbb, 0xd0, 0x57, 0x9a, 0x6b, 0x31, 0x76,
-	0x29, 0xf8, 0xf5, 0x9b, 0xc1, 0x8f, 0x71, 0x99, 0x95, 0x51, 0xe1, 0xcf, 0x0e, 0xdc, 0xee, 0x6c,
-	0x14, 0x8c, 0xe1, 0x20, 0x92, 0x82, 0xeb, 0xbc, 0x62, 0x51, 0x41, 0x24, 0x97, 0xf1, 0x54, 0x10,
-	0x11, 0x9f, 0xd1, 0x38, 0xb8, 0x03, 0x7d, 0xbe, 0x4a, 0xb8, 0xa2, 0x03, 0x7d, 0x56, 0x82, 0x5a,
-	0x86, 0x67, 0xc9, 0x08, 0xc0, 0x8b, 0xf2, 0x58, 0x8c, 0xfb, 0x13, 0xe7, 0x6c, 0xc4, 0x68, 0x1c,
-	0x9c, 0xc2, 0x10, 0x7f, 0x3f, 0xe6, 0xea, 0x7a, 0x3c, 0xa0, 0xf9, 0x1a, 0x07, 0x47, 0xe0, 0xf2,
-	0x45, 0x32, 0x3e, 0xa0, 0x2d, 0x70, 0x18, 0xfe, 0xe9, 0xc0, 0x51, 0x57, 0x09, 0x12, 0xc8, 0xf2,
-	0x2c, 0x12, 0x44, 0xd6, 0x63, 0x25, 0xc0, 0x8d, 0xd5, 0x3a, 0x89, 0x92, 0x58, 0xc4, 0x44, 0x77,
-	0xc8, 0x6a, 0x1c, 0x4c, 0xe0, 0x50, 0xe9, 0x5c, 0xf2, 0x65, 0x79, 0xae, 0x4b, 0xe7, 0xda, 0x53,
-	0xc1, 0x47, 0x70, 0x60, 0xe0, 0xd8, 0x9b, 0xb8, 0x67, 0x87, 0xf7, 0xdf, 0xda, 0x73, 0x8f, 0xe7,
-	0x8f, 0xcb, 0xb0, 0x59, 0xa6, 0xe5, 0x96, 0x55, 0x1f, 0x9d, 0x3e, 0x80, 0x91, 0xbd, 0x80, 0x52,
-	0x9e, 0x8a, 0xad, 0xb9, 0x4e, 0x1c, 0x22, 0xeb, 0x0d, 0x5f, 0xad, 0xcb, 0xbb, 0x1c, 0xb1, 0x12,
-	0x3c, 0xe8, 0x7d, 0xe0, 0x84, 0xbf, 0xb6, 0x7d, 0x71, 0x11, 0xe9, 0x24, 0xcf, 0x82, 0x13, 0x18,
-	0xf0, 0x34, 0x5f, 0x67, 0xda, 0xc8, 0x34, 0x08, 0x75, 0x2e, 0xb9, 0xfa, 0x34, 0x49, 0x13, 0x4d,
-	0x5b, 0x79, 0xac, 0xc6, 0x66, 0xed, 0x0b, 0x99, 0x44, 0xa5, 0x1d, 0x5e, 0x61, 0x35, 0xae, 0x93,
-	0xe1, 0x59, 0xc9, 0xa8, 0x53, 0xd9, 0xef, 0xa4, 0x32, 0xcb, 0xb5, 0xa0, 0xf4, 0x60, 0xd2, 0x73,
-	0x2d, 0x76, 0xa4, 0xe6, 0x37, 0x07, 0x02, 0x26, 0x22, 0x91, 0x14, 0xda, 0x22, 0x8f, 0xb4, 0x23,
-	0xbe, 0x5a, 0x89, 0xca, 0x4a, 0x06, 0x05, 0x21, 0x8c, 0xaa, 0xaa, 0xf8, 0xac, 0x71, 0x54, 0x6b,
-	0xce, 0x8e, 0xb9, 0x40, 0x2f, 0xb9, 0xed, 0x18, 0x9c, 0x43, 0xaf, 0xae, 0x95, 0x88, 0x1f, 0x71,
-	0x45, 0x4a, 0x3c, 0x56, 0x41, 0xa4, 0x28, 0x85, 0x36, 0x66, 0xc3, 0x21, 0xc6, 0x3e, 0x51, 0x79,
-	0xc6, 0x84, 0x36, 0x5a, 0x2a, 0x18, 0x5e, 0x41, 0x30, 0x9b, 0x5f, 0x52, 0x42, 0xa7, 0xd7, 0x3c,
-	0x5b, 0x8a, 0x4f, 0xb4, 0x48, 0x77, 0x24, 0xed, 0x14, 0x86, 0x85, 0x14, 0x73, 0x2b, 0x6f, 0x35,
-	0x26, 0xb6, 0x6b, 0x29, 0x45, 0xa6, 0xcb, 0xf5, 0xd2, 0x55, 0xad, 0xb9, 0xf0, 0x47, 0x87, 0x0e,
-	0xb2, 0xab, 0x6d, 0x9a, 0xc6, 0xff, 0x49, 0xc1, 0xf9, 0x7b, 0x0a, 0xce, 0x6f, 0x0a, 0x2e, 0xfc,
-	0xcb, 0x81, 0x57, 0xbb, 0x06, 0x47, 0x7e, 0x2f, 0xa5, 0xc2, 0xfc, 0x76, 0x85, 0x5d, 0x74, 0x2b,
-	0xec, 0x9d, 0x3d, 0x15, 0x36, 0x4d, 0xe3, 0x97, 0x53, 0x64, 0xbe, 0x5d, 0x64, 0x3f, 0x39, 0xf0,
-	0xda, 0x4d, 0xbb, 0xa2, 0xd8, 0xff, 0x85, 0x63, 0x7d, 0x72, 0x6c, 0x78, 0x17, 0x6e, 0x4f, 0xaf,
-	0x45, 0xf4, 0x74, 0x36, 0xbf, 0xc4, 0x6f, 0x99, 0xf8, 0x76, 0xd7, 0xfb, 0x10, 0xfe, 0xe0, 0xc0,
-	0x51, 0x3b, 0x4e, 0x15, 0x65, 0xa2, 0xcb, 0x73, 0x29, 0x78, 0xc8, 0x6a, 0x7c, 0x83, 0x67, 0x6f,
-	0x07, 0xcf, 0xae, 0x5e, 0x77, 0x87, 0xde, 0x37, 0xc0, 0x27, 0xf7, 0x51, 0x40, 0xe9, 0xbc, 0x66,
-	0x22, 0xdc, 0xc2, 0xf1, 0x4c, 0xe9, 0x24, 0xe5, 0x5a, 0xcc, 0xe6, 0x97, 0x8f, 0xb8, 0x42, 0xfe,
-	0xb7, 0xa0, 0xa7, 0x73, 0xc3, 0xbe, 0xa7, 0xf3, 0xda, 0xa3, 0x3d, 0xab, 0x0f, 0x35, 0x29, 0x70,
-	0x5b, 0x29, 0x68, 0x7a, 0xa0, 0xd7, 0xea, 0x81, 0xa6, 0x1b, 0xf5, 0x9b, 0x6e, 0xf4, 0x36, 0x04,
-	0xdd, 0xa3, 0x55, 0x81, 0x71, 0x4b, 0xae, 0x8c, 0x8b, 0x71, 0x18, 0xde, 0x85, 0xc3, 0xd9, 0x26,
-	0x7d, 0x28, 0x16, 0xeb, 0x25, 0x92, 0x3b, 0x81, 0x41, 0x5e, 0xa0, 0x0d, 0x29, 0xa6, 0xcf, 0x0c,
-	0x0a, 0xdf, 0x83, 0x51, 0x13, 0xa6, 0x0a, 0xb4, 0x77, 0x8c, 0x00, 0x0d, 0xba, 0x56, 0x46, 0x8d,
-	0x3d, 0x15, 0xde, 0x83, 0x5b, 0xb3, 0x4d, 0xfa, 0xe5, 0x5a, 0xc8, 0xed, 0xc5, 0x22, 0xc1, 0xbd,
-	0xc7, 0x70, 0x80, 0xc9, 0x12, 0xaa, 0x8a, 0xaf, 0x60, 0xf8, 0x21, 0xdc, 0x6e, 0xc5, 0xaa, 0x62,
-	0x7f, 0x70, 0xa5, 0xb5, 0xd7, 0x68, 0xfd, 0x9a, 0x34, 0xd0, 0xe7, 0x2f, 0x3c, 0x07, 0xab, 0x21,
-	0xc9, 0x8a, 0xb5, 0xae, 0xaa, 0x81, 0xc0, 0xbe, 0xcb, 0x0e, 0xbf, 0x77, 0x48, 0xb4, 0xd9, 0xf7,
-	0x85, 0x9c, 0xfe, 0xd5, 0xc6, 0xb8, 0x8f, 0xe4, 0xcf, 0xb0, 0xf7, 0x19, 0xcb, 0x54, 0x10, 0x2d,
-	0x8b, 0x1d, 0x99, 0x96, 0xca, 0x64, 0xd6, 0x38, 0xfc, 0xdd, 0x81, 0x3b, 0xb3, 0x4d, 0x5a, 0x57,
-	0x2a, 0x36, 0x48, 0x61, 0x0a, 0x82, 0x0c, 0xe4, 0x58, 0x4d, 0xee, 0x08, 0xdc, 0x2b, 0x51, 0x7a,
-	0xca, 0x65, 0x38, 0xac, 0x1f, 0x31, 0xd7, 0x7a, 0xc4, 0xea, 0x46, 0xea, 0xd9, 0x8d, 0xb4, 0xa1,
-	0xdd, 0x6f, 0xd1, 0x36, 0x17, 0x3f, 0xa8, 0x2f, 0x1e, 0x23, 0xc5, 0xf3, 0x22, 0x91, 0xc2, 0xbc,
-	0x83, 0x06, 0xd1, 0x2b, 0xc1, 0x25, 0xa7, 0xa2, 0x18, 0x96, 0x32, 0x2a, 0x1c, 0xfe, 0x81, 0x2f,
-	0x80, 0x25, 0x83, 0xaf, 0x56, 0xc6, 0x78, 0x3b, 0x5f, 0x77, 0xbb, 0x3a, 0x3a, 0xe2, 0xdc, 0x9b,
-	0xe2, 0x3c, 0x4b, 0xdc, 0x3f, 0x97, 0x11, 0x80, 0x27, 0x9e, 0x8b, 0xc8, 0x88, 0xa0, 0xb1, 0x25,
-	0x6d, 0xb8, 0x57, 0x9a, 0xdf, 0x91, 0xf6, 0x8b, 0x03, 0x27, 0x96, 0xb4, 0xaf, 0x24, 0xcf, 0xd4,
-	0x95, 0x90, 0x46, 0xde, 0xce, 0x9e, 0xda, 0xc8, 0x46, 0x81, 0x3d, 0x5b, 0x36, 0x51, 0x72, 0x77,
-	0x52, 0xf2, 0x5a, 0x94, 0xde, 0x04, 0x48, 0xd4, 0x37, 0x89, 0xbe, 0x8e, 0x25, 0x7f, 0x46, 0x62,
-	0x87, 0xcc, 0x9a, 0x69, 0x51, 0x1e, 0xb4, 0x29, 0x2f, 0x06, 0xf4, 0x87, 0xfc, 0xfd, 0xbf, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0x77, 0x17, 0xf5, 0x15, 0xa5, 0x0b, 0x00, 0x00,
+	// 2098 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xdc, 0xc6,
+	0xf5, 0x07, 0x7f, 0xec, 0x6a, 0xf7, 0x49, 0xb6, 0x25, 0xda, 0xd6, 0x77, 0xbf, 0x46, 0x10, 0x08,
+	0x44, 0x7e, 0xa8, 0x01, 0xec, 0x06, 0x36, 0x02, 0x04, 0x06, 0x5a, 0x40, 0x95, 0x55, 0xd5, 0x88,
+	0xd5, 0xa4, 0x23, 0x47, 0x41, 0x73, 0x1b, 0x91, 0xa3, 0x15, 0x63, 0x72, 0xc9, 0xcc, 0x0c, 0x15,
+	0xed, 0xb5, 0xe7, 0x16, 0xbd, 0xf4, 0xd8, 0x5b, 0x8f, 0x4d, 0x81, 0x9e, 0x7b, 0x4b, 0xd1, 0x3f,
+	0xa0, 0xc7, 0x9e, 0x7b, 0xea, 0x7f, 0xd0, 0x6b, 0xf1, 0xe6, 0x07, 0x39, 0xe4, 0x72, 0xe5, 0x02,
+	0x35, 0x8a, 0x9e, 0x34, 0x9f, 0x37, 0x6f, 0x67, 0xde, 0xef, 0xf7, 0x86, 0x82, 0x1d, 0x76, 0x55,
+	0x24, 0xe5, 0x42, 0x72, 0x9a, 0xc8, 0x47, 0x15, 0x2f, 0x65, 0x19, 0x8d, 0xe4, 0xb2, 0x62, 0x22,
+	0xfe, 0x85, 0x07, 0x3b, 0x47, 0x67, 0x27, 0x87, 0x66, 0xf3, 0xd3, 0xf3, 0xaf, 0x58, 0x22, 0xa3,
+	0x08, 0x42, 0x9a, 0xa6, 0x7c, 0xe6, 0xed, 0x79, 0xfb, 0x53, 0xa2, 0xd6, 0xd1, 0x07, 0x10, 0xa6,
+	0x54, 0xd2, 0x99, 0xbf, 0xe7, 0xed, 0x6f, 0x3e, 0xde, 0x7d, 0xa4, 0x7e, 0xff, 0xc8, 0xf9, 0xed,
+	0x33, 0x2a, 0x29, 0x51, 0x3c, 0xd1, 0x43, 0x18, 0x09, 0x49, 0x25, 0x9b, 0x05, 0x8a, 0xf9, 0xff,
+	0x56, 0x99, 0x4f, 0x71, 0x9b, 0x68, 0xae, 0xf8, 0xf7, 0x1e, 0xdc, 0xe9, 0x1d, 0x14, 0xcd, 0x60,
+	0x23, 0xe1, 0x8c, 0xca, 0xd2, 0x4a, 0x61, 0x21, 0x0a, 0xb7, 0xa0, 0x05, 0x53, 0x82, 0x4c, 0x89,
+	0x5a, 0x47, 0xf7, 0x60, 0x44, 0xf3, 0x8c, 0x0a, 0x75, 0xe1, 0x94, 0x68, 0xd0, 0xa8, 0x11, 0x3a,
+	0x6a, 0x44, 0x10, 0x26, 0x65, 0xca, 0x66, 0xa3, 0x3d, 0x6f, 0x7f, 0x8b, 0xa8, 0x75, 0xf4, 0x00,
+	0x26, 0xf8, 0xf7, 0x27, 0x54, 0x5c, 0xce, 0xc6, 0x8a, 0xde, 0xe0, 0x68, 0x1b, 0x02, 0x7a, 0x9e,
+	0xcd, 0x36, 0xd4, 0x11, 0xb8, 0x8c, 0xff, 0xee, 0xc1, 0x76, 0x5f, 0x13, 0x14, 0x60, 0x51, 0x2e,
+	0x12, 0xa6, 0x84, 0x0d, 0x89, 0x06, 0x78, 0xb0, 0xa8, 0xb3, 0x24, 0x4b, 0x59, 0xaa, 0xc4, 0x9d,
+	0x90, 0x06, 0x47, 0x7b, 0xb0, 0x29, 0x64, 0xc9, 0xe9, 0x5c, 0xdf, 0x1b, 0xa8, 0x7b, 0x5d, 0x52,
+	0xf4, 0x43, 0xd8, 0x30, 0x70, 0x16, 0xee, 0x05, 0xfb, 0x9b, 0x8f, 0xdf, 0x59, 0x63, 0xc7, 0x47,
+	0xa7, 0x9a, 0xed, 0x68, 0x21, 0xf9, 0x92, 0xd8, 0x1f, 0x3d, 0x78, 0x0a, 0x5b, 0xee, 0x06, 0xaa,
+	0xf2, 0x8a, 0x2d, 0x8d, 0x39, 0x71, 0x89, 0x52, 0x5f, 0xd1, 0xbc, 0xd6, 0xb6, 0xdc, 0x22, 0x1a,
+	0x3c, 0xf5, 0x3f, 0xf6, 0xe2, 0xbf, 0xfa, 0x9d, 0xb8, 0x38, 0x48, 0x64, 0x56, 0x2e, 0xa2, 0x5d,
+	0x18, 0xd3, 0xa2, 0xac, 0x17, 0xd2, 0xa8, 0x69, 0x10, 0xea, 0x39, 0xa7, 0xe2, 0x45, 0x56, 0x64,
+	0x52, 0x1d, 0x15, 0x92, 0x06, 0x9b, 0xbd, 0xcf, 0x78, 0x96, 0xe8, 0x70, 0xb8, 0x45, 0x1a, 0xdc,
+	0x38, 0x23, 0x74, 0x9c, 0xd1, 0xb8, 0x72, 0xd4, 0x73, 0xe5, 0xa2, 0x94, 0x6c, 0x36, 0x36, 0x4e,
+	0x2f, 0x25, 0x5b, 0x75, 0x4d, 0xf4, 0x11, 0x00, 0x4d, 0x12, 0x26, 0xc4, 0x8b, 0x4c, 0xc8, 0xd9,
+	0x44, 0x19, 0xed, 0x7e, 0x6b, 0xb4, 0x03, 0xb5, 0xf7, 0xb2, 0xae, 0x72, 0x46, 0x1c, 0xc6, 0xe8,
+	0x43, 0x18, 0x5f, 0x31, 0x9e, 0x5d, 0x2c, 0x67, 0x53, 0x15, 0xaf, 0xb3, 0x55, 0x3b, 0x9f, 0xa9,
+	0x7d, 0x62, 0xf8, 0xa2, 0xc7, 0x30, 0x49, 0x59, 0xce, 0xe6, 0x18, 0xe3, 0xd0, 0x4f, 0x88, 0x67,
+	0x66, 0xe7, 0x90, 0xe6, 0x39, 0x69, 0xf8, 0xe2, 0x9f, 0xc3, 0x9d, 0xde, 0x26, 0x6a, 0x75, 0xc1,
+	0xcb, 0xc2, 0xe6, 0x19, 0xae, 0xa3, 0xdb, 0xe0, 0xcb, 0xa5, 0xb2, 0xe2, 0x88, 0xf8, 0x72, 0x19,
+	0xc5, 0xb0, 0x55, 0xd4, 0xb9, 0xcc, 0x4e, 0xb3, 0xf9, 0xcb, 0xeb, 0xe7, 0xa9, 0xb2, 0x61, 0x48,
+	0x3a, 0xb4, 0xf8, 0x0c, 0x22, 0xe7, 0xe8, 0xcf, 0xe8, 0x32, 0x2f, 0x69, 0xaa, 0x4e, 0x2a, 0xcd,
+	0xd9, 0xbe, 0x2c, 0x51, 0x4d, 0xaa, 0xfc, 0x38, 0xf3, 0xd7, 0xa9, 0xa9, 0xfd, 0x4c, 0x0c, 0x5f,
+	0xfc, 0x02, 0x6e, 0x77, 0xcd, 0x86, 0x69, 0x89, 0x69, 0xc4, 0x84, 0xb0, 0x69, 0x69, 0xa0, 0x13,
+	0xcf, 0x9f, 0xb0, 0xa5, 0x98, 0xf9, 0x7b, 0xc1, 0xfe, 0x94, 0xb8, 0xa4, 0xf8, 0x0f, 0xdd, 0x5a,
+	0xa3, 0x4d, 0x1a, 0xed, 0xc3, 0x9d, 0xa4, 0x2c, 0xaa, 0x2c, 0x67, 0xfc, 0x8c, 0x71, 0x81, 0xe2,
+	0xe9, 0x93, 0xfb, 0x64, 0x95, 0x4d, 0x4c, 0xca, 0x6c, 0x31, 0x17, 0x26, 0xf9, 0x1b, 0x1c, 0xbd,
+	0x0d, 0x20, 0xca, 0x9a, 0x27, 0x6d, 0x32, 0x4d, 0x89, 0x43, 0xb1, 0xb1, 0x12, 0xb6, 0xb1, 0xb2,
+	0x07, 0x9b, 0xbc, 0x5e, 0xc8, 0xac, 0x60, 0x87, 0x6d, 0x3d, 0x70, 0x49, 0xf1, 0xaf, 0x7c, 0xb8,
+	0xbf, 0x22, 0xef, 0xf3, 0xc5, 0x45, 0x39, 0x58, 0x1f, 0x07, 0xf4, 0xf0, 0x5f, 0xaf, 0x47, 0x70,
+	0xa3, 0x1e, 0xe1, 0x3a, 0x3d, 0x46, 0xad, 0x1e, 0xfd, 0xe2, 0x35, 0x75, 0x8a, 0xd7, 0x5b, 0x30,
+	0x15, 0xf5, 0x79, 0x91, 0x49, 0xc9, 0xb8, 0xc9, 0x93, 0x96, 0x80, 0xd9, 0x7c, 0xc9, 0xb2, 0xf9,
+	0x25, 0x66, 0x8a, 0xb7, 0x1f, 0x10, 0x83, 0x90, 0x2e, 0xaf, 0xd5, 0x79, 0x53, 0xf5, 0x13, 0x83,
+	0xe2, 0x3f, 0x79, 0x10, 0x11, 0x96, 0xb0, 0xac, 0x92, 0x8e, 0x59, 0x90, 0x3d, 0xa1, 0x79, 0xce,
+	0xac, 0x39, 0x0c, 0xc2, 0xc0, 0xb5, 0x3d, 0xe7, 0xa7, 0x6d, 0xbd, 0xee, 0xd0, 0x5c, 0x9e, 0x03,
+	0x34, 0x68, 0xd0, 0xe5, 0x41, 0x1a, 0x86, 0x5c, 0x2d, 0x58, 0x7a, 0x4c, 0x85, 0xb2, 0x47, 0x48,
+	0x2c, 0x44, 0x63, 0x70, 0x26, 0x8d, 0xeb, 0x70, 0x89, 0xbc, 0x5f, 0x89, 0x72, 0x41, 0x98, 0x34,
+	0xb6, 0xb0, 0x30, 0x3e, 0x83, 0xf1, 0xd1, 0xd9, 0xc9, 0x8b, 0x72, 0xbe, 0x72, 0xa7, 0x37, 0x70,
+	0xe7, 0x3d, 0x18, 0xc9, 0xb2, 0xca, 0x12, 0x15, 0xc6, 0x5b, 0x44, 0x03, 0x74, 0xbb, 0x6a, 0x81,
+	0xba, 0x56, 0xab, 0x75, 0x7c, 0xa1, 0x52, 0x4f, 0x95, 0xe1, 0xc3, 0x4b, 0xba, 0x98, 0xb3, 0xe7,
+	0x92, 0x15, 0x03, 0xa5, 0xf6, 0x01, 0x4c, 0x2a, 0xce, 0xce, 0x9c, 0x6a, 0xdb, 0x60, 0x25, 0x51,
+	0xcd, 0x39, 0x5b, 0x48, 0xbd, 0xaf, 0xcf, 0xef, 0xd0, 0xe2, 0xdf, 0x7a, 0x10, 0x39, 0x56, 0xc7,
+	0x1e, 0x79, 0x58, 0xa4, 0xff, 0x95, 0x36, 0x39, 0x5d, 0xd3, 0x26, 0x9d, 0x48, 0x8b, 0xff, 0xe1,
+	0xc1, 0xdd, 0x7e, 0x5b, 0x42, 0xf9, 0xde, 0x48, 0x5f, 0x9c, 0x76, 0xfb, 0xe2, 0x41, 0xbf, 0x2f,
+	0xbe, 0xbf, 0xa6, 0x2f, 0x1e, 0x16, 0xe9, 0x9b, 0x69, 0x8d, 0x53, 0xb7, 0x35, 0xfe, 0xce, 0x83,
+	0xfb, 0xab, 0x69, 0x80, 0xca, 0xfe, 0x4f, 0x64, 0xc2, 0x54, 0x65, 0x42, 0xfc, 0x2e, 0xdc, 0x39,
+	0xbc, 0x64, 0xc9, 0x2b, 0xac, 0xdf, 0x69, 0xca, 0x09, 0xfb, 0x7a, 0xa8, 0x6a, 0xc5, 0xbf, 0xf1,
+	0x60, 0xbb, 0xcb, 0x27, 0x2a, 0xed, 0x68, 0x7d, 0xaf, 0x62, 0x9e, 0x90, 0x06, 0xaf, 0xc8, 0xe9,
+	0x0f, 0xc8, 0xd9, 0xd7, 0x37, 0x18, 0xd0, 0xf7, 0x2d, 0x98, 0xaa, 0xe8, 0x53, 0x0c, 0x3a, 0xf2,
+	0x5a, 0x42, 0xbc, 0x84, 0x9d, 0x23, 0x21, 0xb3, 0x82, 0x4a, 0x76, 0x74, 0x76, 0x72, 0x4c, 0x05,
+	0xca, 0xdf, 0xef, 0x67, 0x36, 0x46, 0x7d, 0x67, 0x7a, 0x68, 0x5d, 0x10, 0x74, 0x5c, 0xd0, 0x4e,
+	0x2e, 0x61, 0x67, 0x72, 0x59, 0xa9, 0xa7, 0xf1, 0x7b, 0x10, 0xf5, 0xaf, 0x16, 0x15, 0xf2, 0xcd,
+	0xa9, 0x30, 0x51, 0x8c, 0xcb, 0xf8, 0x5d, 0xd8, 0x3c, 0xba, 0x2a, 0x9e, 0xb1, 0xf3, 0x7a, 0x8e,
+	0xc2, 0xed, 0xc2, 0xb8, 0xac, 0x30, 0x0c, 0x15, 0xcf, 0x88, 0x18, 0x14, 0x7f, 0x08, 0x5b, 0x2d,
+	0x9b, 0xa8, 0x30, 0xbc, 0x53, 0x04, 0x18, 0xa0, 0xb5, 0x6d, 0xa2, 0x2e, 0x29, 0xfe, 0x00, 0x6e,
+	0x1f, 0x5d, 0x15, 0x3f, 0xab, 0x19, 0x5f, 0x1e, 0x9c, 0x67, 0x78, 0xf6, 0xda, 0xa6, 0x1b, 0xff,
+	0x00, 0xee, 0x74, 0x78, 0x45, 0xb5, 0x9e, 0xd9, 0xea, 0xea, 0xb7, 0xba, 0x3e, 0x84, 0x1d, 0xfb,
+	0x73, 0x33, 0xe0, 0xdc, 0x78, 0xdb, 0xe7, 0xb0, 0x69, 0xd9, 0x6f, 0x64, 0xc4, 0xe4, 0xc9, 0x16,
+	0x55, 0x2d, 0x6d, 0xf2, 0x28, 0xb0, 0xce, 0x37, 0xf1, 0x2f, 0x3d, 0xd8, 0x6a, 0xcf, 0x15, 0xd5,
+	0x9b, 0x3a, 0x18, 0xcf, 0xe1, 0xf4, 0x1b, 0x2c, 0x95, 0x26, 0xc2, 0x2c, 0xc4, 0x08, 0xc7, 0xc6,
+	0xa0, 0xb6, 0xb4, 0xef, 0x1b, 0x1c, 0xff, 0xc5, 0x83, 0x7b, 0x47, 0x57, 0x45, 0x93, 0xd8, 0x9c,
+	0x51, 0xc9, 0x4c, 0xfe, 0xa8, 0x78, 0xf3, 0x9c, 0x9a, 0xb8, 0x0d, 0xc1, 0x05, 0xd3, 0x21, 0x18,
+	0x10, 0x5c, 0x36, 0x93, 0x6a, 0xe0, 0x4c, 0xaa, 0x4d, 0xdd, 0x0d, 0xdd, 0xba, 0xdb, 0x8a, 0x3d,
+	0xea, 0x88, 0x6d, 0xfc, 0x34, 0x6e, 0x7b, 0xfc, 0x2e, 0x8c, 0xd9, 0x75, 0x95, 0x71, 0x66, 0x9a,
+	0xb8, 0x41, 0xaa, 0xa9, 0x50, 0x4e, 0x55, 0x0e, 0x4d, 0xb4, 0x1a, 0x16, 0xc7, 0x7f, 0xc3, 0x86,
+	0xe1, 0xa8, 0x81, 0xc3, 0xa8, 0x8e, 0xd3, 0xc1, 0x11, 0xde, 0x4d, 0xa6, 0x9e, 0x72, 0xc1, 0xaa,
+	0x72, 0xa1, 0xa3, 0xdc, 0xbf, 0xaf, 0x46, 0x04, 0x21, 0xbb, 0x66, 0x89, 0x51, 0x42, 0xad, 0x1d,
+	0xd5, 0x26, 0x6b, 0x55, 0x9b, 0xf6, 0x54, 0xfb, 0xa3, 0x07, 0xbb, 0x8e, 0x6a, 0x2f, 0x39, 0x5d,
+	0x88, 0x0b, 0xc6, 0x8d, 0x7a, 0x83, 0x25, 0xb8, 0x55, 0x1b, 0x15, 0xf4, 0x5d, 0xb5, 0x95, 0x48,
+	0xc1, 0xa0, 0x48, 0x61, 0x47, 0xa4, 0xb7, 0x01, 0x32, 0xf1, 0x45, 0x26, 0x2f, 0x53, 0x4e, 0xbf,
+	0x51, 0xca, 0x4e, 0x88, 0x43, 0xe9, 0x88, 0x3c, 0xee, 0x89, 0x9c, 0xaa, 0xa4, 0x46, 0x27, 0xa8,
+	0x67, 0xf2, 0x0d, 0x92, 0xea, 0x2a, 0xe7, 0xbb, 0x55, 0xae, 0x3f, 0x74, 0xac, 0xab, 0x66, 0xa6,
+	0x1c, 0xb4, 0xb7, 0x88, 0xca, 0x76, 0x06, 0xaf, 0x33, 0x23, 0xd9, 0x2e, 0xe2, 0x77, 0xba, 0x48,
+	0xfc, 0xb1, 0x12, 0xf2, 0x98, 0x49, 0x1c, 0x7f, 0xd5, 0xaf, 0x87, 0x06, 0xdd, 0x81, 0xb2, 0x1b,
+	0xff, 0x19, 0x53, 0x58, 0x8d, 0x57, 0x84, 0x25, 0x25, 0x4f, 0x9d, 0xd9, 0xd2, 0xeb, 0xcc, 0x96,
+	0x33, 0xd8, 0x90, 0xd7, 0xcf, 0x17, 0x29, 0xbb, 0x36, 0x4f, 0x1c, 0x0b, 0xd1, 0x7a, 0x79, 0x39,
+	0xd7, 0x5b, 0x81, 0xda, 0x6a, 0xb0, 0x33, 0x91, 0xea, 0x97, 0xa2, 0x41, 0x2b, 0xcd, 0x68, 0x74,
+	0xd3, 0x28, 0x37, 0x1e, 0x1a, 0xe5, 0x36, 0x9c, 0x51, 0xee, 0x5b, 0x0f, 0x80, 0xb0, 0xaf, 0xb5,
+	0x1e, 0x02, 0x3b, 0x14, 0x3e, 0xc8, 0x7e, 0x94, 0x97, 0xc9, 0x2b, 0xa3, 0x45, 0x4b, 0x50, 0x8a,
+	0x94, 0x7a, 0x4f, 0x27, 0xbf, 0x85, 0x6e, 0xf5, 0x0a, 0xba, 0xd5, 0x0b, 0xd5, 0xc0, 0xdb, 0x85,
+	0x9a, 0x5b, 0xa6, 0xc4, 0x20, 0x14, 0x31, 0x51, 0xde, 0x1c, 0x29, 0xbd, 0x35, 0xc0, 0x70, 0xab,
+	0x78, 0x56, 0x50, 0xbe, 0xfc, 0x84, 0x2d, 0x4d, 0x40, 0x39, 0x94, 0xf8, 0x0b, 0xd8, 0x22, 0xac,
+	0xca, 0x97, 0x56, 0xde, 0xf7, 0x21, 0xcc, 0xcb, 0x39, 0x96, 0x4c, 0x9c, 0x89, 0xee, 0xb6, 0x33,
+	0x51, 0xe3, 0x15, 0xa2, 0x18, 0x7a, 0x07, 0xfb, 0x2b, 0x07, 0x7f, 0xe7, 0xc1, 0xa6, 0xb6, 0xc3,
+	0x4b, 0x4e, 0xf5, 0x2b, 0xfd, 0x12, 0x6d, 0x6f, 0x82, 0x00, 0xd7, 0x4a, 0x15, 0xdc, 0xb4, 0x03,
+	0x80, 0x41, 0xe8, 0x91, 0x34, 0x13, 0xf4, 0x3c, 0x67, 0xa7, 0x92, 0x26, 0xaf, 0x94, 0x05, 0x26,
+	0xa4, 0x43, 0x8b, 0xde, 0x81, 0x5b, 0x06, 0x9f, 0xb0, 0xa2, 0xe4, 0x4b, 0xe5, 0xd4, 0x09, 0xe9,
+	0x12, 0xa3, 0xf7, 0xe0, 0x76, 0xf3, 0x2b, 0x3d, 0xec, 0xe9, 0x8c, 0xeb, 0x51, 0xd1, 0x78, 0xb9,
+	0xfa, 0xf0, 0x30, 0xd6, 0xc6, 0x53, 0x20, 0xfe, 0xa7, 0x0e, 0x48, 0x4c, 0x85, 0x1f, 0x73, 0x9c,
+	0x37, 0x22, 0x08, 0x9b, 0xee, 0x3c, 0x25, 0x6a, 0xdd, 0x3c, 0xbf, 0xfd, 0xde, 0xf3, 0xbb, 0x9c,
+	0x05, 0x4d, 0xfa, 0x35, 0x73, 0xa0, 0xce, 0x34, 0x0d, 0xec, 0x38, 0x30, 0x6a, 0xc6, 0x01, 0xf4,
+	0xfa, 0x9c, 0x8a, 0xcf, 0x05, 0x4b, 0x95, 0x20, 0x21, 0xb1, 0xb0, 0xed, 0x59, 0x1b, 0xbd, 0x9e,
+	0x55, 0xd6, 0x12, 0xc9, 0xa6, 0xee, 0x69, 0x84, 0xdc, 0x8c, 0xf3, 0x92, 0x9b, 0xa2, 0xa7, 0x41,
+	0xf4, 0x3d, 0x18, 0x61, 0x79, 0x10, 0x33, 0xe8, 0x3b, 0xb7, 0xd1, 0x90, 0x68, 0x8e, 0xf8, 0x5b,
+	0x5f, 0x69, 0x7e, 0x2a, 0x79, 0x9d, 0x48, 0x7c, 0xef, 0xdc, 0x06, 0xbf, 0x4a, 0x4c, 0xb5, 0xf7,
+	0xab, 0x04, 0x71, 0x59, 0xd9, 0x02, 0x53, 0x36, 0xa3, 0x4d, 0xd0, 0xd7, 0xe5, 0xb0, 0x14, 0xb6,
+	0xbe, 0x58, 0x88, 0xd2, 0xa5, 0xac, 0x92, 0x97, 0x36, 0x52, 0x15, 0x40, 0xaa, 0x50, 0xde, 0x1e,
+	0xab, 0xb0, 0xd6, 0x00, 0x35, 0x2c, 0xb4, 0x7f, 0x37, 0x74, 0xb4, 0x6b, 0x14, 0x3d, 0x6d, 0xc7,
+	0x77, 0xfd, 0x85, 0x66, 0xaf, 0xd5, 0xa6, 0x91, 0x7a, 0x78, 0x6e, 0x1f, 0xb6, 0xce, 0x7f, 0x34,
+	0xcd, 0x7f, 0xe7, 0xc1, 0x2d, 0x9b, 0x46, 0x3a, 0xdc, 0x1d, 0x4f, 0x7a, 0x5d, 0x4f, 0xee, 0xc2,
+	0xf8, 0x82, 0x66, 0x79, 0xf3, 0x68, 0x31, 0x48, 0x7d, 0x4a, 0x60, 0xb2, 0xe6, 0x8b, 0xf6, 0xf9,
+	0x36, 0x25, 0x2e, 0x09, 0x73, 0x13, 0xbd, 0xa3, 0xcc, 0xb9, 0xc6, 0x7d, 0x8a, 0x21, 0x7a, 0x02,
+	0x20, 0xac, 0x0d, 0x30, 0xbe, 0x7a, 0xde, 0x6e, 0xec, 0x43, 0x1c, 0xb6, 0xf8, 0x4b, 0x88, 0x74,
+	0xbe, 0x1e, 0x33, 0x69, 0x0c, 0x71, 0x20, 0x6f, 0x98, 0xa2, 0x22, 0x08, 0x45, 0x5e, 0xda, 0x21,
+	0x4a, 0xad, 0x9d, 0x82, 0x1d, 0xb8, 0x05, 0x5b, 0xf5, 0x84, 0xb3, 0x13, 0x73, 0xea, 0x9a, 0xb7,
+	0xed, 0xa0, 0x75, 0xe3, 0x04, 0x76, 0xb4, 0x54, 0xcf, 0xea, 0xa2, 0xb2, 0xd9, 0xba, 0x5e, 0xa8,
+	0xd7, 0x54, 0xa5, 0xb6, 0x48, 0x06, 0x4e, 0x91, 0x8c, 0x2f, 0xe0, 0xae, 0xf5, 0x9e, 0x7b, 0xcd,
+	0xf7, 0xdb, 0x18, 0xf3, 0xfa, 0x5f, 0x01, 0x1d, 0x5d, 0xda, 0xc0, 0x7a, 0x5d, 0x4d, 0xfc, 0xb5,
+	0xa7, 0x5a, 0xeb, 0x09, 0x7e, 0x75, 0x4b, 0xda, 0x51, 0x6a, 0xb0, 0x83, 0x3f, 0xb4, 0xc9, 0xea,
+	0xef, 0x05, 0xee, 0xd7, 0x6f, 0xe7, 0xe7, 0x6a, 0x1a, 0xd3, 0x5c, 0xd1, 0x47, 0x30, 0x2d, 0xaf,
+	0x18, 0xe7, 0x59, 0xca, 0x30, 0x0b, 0x7b, 0x3f, 0x51, 0x0f, 0xd9, 0x4f, 0xcd, 0x3e, 0x69, 0x39,
+	0x63, 0x02, 0xdb, 0xfd, 0x13, 0x87, 0x5e, 0x48, 0xcd, 0x37, 0x7b, 0x3b, 0x3b, 0xb8, 0xdf, 0x6a,
+	0x83, 0xee, 0xb7, 0xda, 0xf8, 0x4b, 0xd8, 0xee, 0x5f, 0x79, 0x83, 0xc7, 0x66, 0xb0, 0x71, 0x4e,
+	0x73, 0x8a, 0xaf, 0x7b, 0x6d, 0x30, 0x0b, 0x9b, 0x11, 0x21, 0x70, 0x46, 0x84, 0xe3, 0xae, 0xbc,
+	0x6a, 0xbc, 0x78, 0x02, 0x1b, 0x9c, 0x89, 0x3a, 0x97, 0xb6, 0x6b, 0xfd, 0xff, 0x80, 0xad, 0x88,
+	0xe2, 0x20, 0x96, 0x33, 0xae, 0x20, 0x5a, 0xdd, 0x46, 0x61, 0x44, 0x9d, 0x24, 0x56, 0xcc, 0x09,
+	0xb1, 0xd0, 0x4e, 0x40, 0xfe, 0xe0, 0x04, 0x14, 0x74, 0xdf, 0xd1, 0x4d, 0x7d, 0x09, 0x9d, 0xfa,
+	0x72, 0x3e, 0x56, 0xff, 0x32, 0x79, 0xf2, 0xaf, 0x01, 0x00, 0x5b, 0x34, 0x93, 0x91, 0x47, 0x19,
+	0x00, 0x00,
 }
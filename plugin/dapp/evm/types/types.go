@@ -15,6 +15,13 @@ const (
 	EvmCreateAction = 1
 	// EvmCallAction 调用合约
 	EvmCallAction = 2
+	// EvmVerifyAction 登记合约源码验证信息
+	EvmVerifyAction = 3
+
+	// DelegateAccountManager accountmanager管理员代理被管理的账户调用合约
+	DelegateAccountManager = 1
+	// DelegateMultiSig 多重签名账户的所有者代理多重签名账户调用合约
+	DelegateMultiSig = 2
 
 	// TyLogContractData  合约代码变更日志
 	TyLogContractData = 601
@@ -24,6 +31,10 @@ const (
 	TyLogCallContract = 603
 	// TyLogEVMStateChangeItem  合约状态数据变更项日志
 	TyLogEVMStateChangeItem = 604
+	// TyLogEVMEventData 合约LOG指令生成的事件日志
+	TyLogEVMEventData = 605
+	// TyLogEVMContractVerify 合约源码验证信息登记日志
+	TyLogEVMContractVerify = 606
 
 	// MaxGasLimit  最大Gas消耗上限
 	MaxGasLimit = 10000000
@@ -44,6 +55,18 @@ const (
 	ForkEVMYoloV1 = "ForkEVMYoloV1"
 	//ForkEVMTxGroup 交易组中的交易通过GAS检查
 	ForkEVMTxGroup = "ForkEVMTxGroup"
+	//ForkEVMEventLog 合约事件日志写入交易收据
+	ForkEVMEventLog = "ForkEVMEventLog"
+	//ForkEVMERC20 chain33资产通过预编译合约以ERC-20接口提供给合约使用
+	ForkEVMERC20 = "ForkEVMERC20"
+	//ForkEVMLondon 支持Berlin/London版本的指令集和Gas计费规则
+	ForkEVMLondon = "ForkEVMLondon"
+	//ForkEVMVerify 支持登记合约源码验证信息
+	ForkEVMVerify = "ForkEVMVerify"
+	//ForkEVMDelegate 支持代理其它账户调用合约
+	ForkEVMDelegate = "ForkEVMDelegate"
+	//ForkEVMEthSign 支持以太坊钱包签名的交易
+	ForkEVMEthSign = "ForkEVMEthSign"
 )
 
 var (
@@ -62,5 +85,7 @@ var (
 		TyLogContractData:       {Ty: reflect.TypeOf(EVMContractData{}), Name: "LogContractData"},
 		TyLogContractState:      {Ty: reflect.TypeOf(EVMContractState{}), Name: "LogContractState"},
 		TyLogEVMStateChangeItem: {Ty: reflect.TypeOf(EVMStateChangeItem{}), Name: "LogEVMStateChangeItem"},
+		TyLogEVMEventData:       {Ty: reflect.TypeOf(EVMLog{}), Name: "LogEVMEventData"},
+		TyLogEVMContractVerify:  {Ty: reflect.TypeOf(EVMContractVerifyInfo{}), Name: "LogEVMContractVerify"},
 	}
 )
@@ -77,9 +77,24 @@ func (e *exchange) CheckTx(tx *types.Transaction, index int) error {
 		if !CheckOp(op) {
 			return exchangetypes.ErrAssetOp
 		}
+		if err := CheckLimitOrderOption(limitOrder); err != nil {
+			return err
+		}
 	}
 	if exchange.Ty == exchangetypes.TyMarketOrderAction {
-		return types.ErrActionNotSupport
+		marketOrder := exchange.GetMarketOrder()
+		if !CheckExchangeAsset(marketOrder.GetLeftAsset(), marketOrder.GetRightAsset()) {
+			return exchangetypes.ErrAsset
+		}
+		if !CheckAmount(marketOrder.GetAmount()) {
+			return exchangetypes.ErrAssetAmount
+		}
+		if !CheckOp(marketOrder.GetOp()) {
+			return exchangetypes.ErrAssetOp
+		}
+		if !CheckSlippage(marketOrder.GetSlippage()) {
+			return exchangetypes.ErrSlippage
+		}
 	}
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/queue"
 	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -37,11 +38,16 @@ var (
 	}
 )
 
+func init() {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	Init(et.ExchangeX, cfg, nil)
+}
+
 func TestExchange(t *testing.T) {
 	//环境准备
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
 	cfg.SetTitleOnlyForTest("chain33")
-	Init(et.ExchangeX, cfg, nil)
 	total := 100 * types.Coin
 	accountA := types.Account{
 		Balance: total,
@@ -700,6 +706,24 @@ func CreateLimitOrder(limitOrder *et.LimitOrder, privKey string) (tx *types.Tran
 	}
 	return tx, nil
 }
+func CreateMarketOrder(marketOrder *et.MarketOrder, privKey string) (tx *types.Transaction, err error) {
+	ety := types.LoadExecutorType(et.ExchangeX)
+	tx, err = ety.Create("MarketOrder", marketOrder)
+	if err != nil {
+		return nil, err
+	}
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	tx, err = types.FormatTx(cfg, et.ExchangeX, tx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err = signTx(tx, privKey)
+	if err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
 func CreateRevokeOrder(orderID int64, privKey string) (tx *types.Transaction, err error) {
 	ety := types.LoadExecutorType(et.ExchangeX)
 	tx, err = ety.Create("RevokeOrder", &et.RevokeOrder{OrderID: orderID})
@@ -719,6 +743,572 @@ func CreateRevokeOrder(orderID int64, privKey string) (tx *types.Transaction, er
 	return tx, nil
 }
 
+func TestExchangeFee(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: Nodes[0]})
+	ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: Nodes[0]})
+	btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: Nodes[1]})
+
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	//挂单方万分之十,吃单方万分之二十
+	setManageConfig(stateDB, et.FeeCollectorKey, Nodes[3])
+	setManageConfig(stateDB, et.FeeRateKey(left, right), "10,20")
+
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	//A挂买单,B吃单
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	orderList, err := Exec_QueryOrderList(et.Ordered, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	orderID1 := orderList.List[0].OrderID
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyB, stateDB, kvdb, env)
+
+	order, err := Exec_QueryOrder(orderID1, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Completed), order.Status)
+	assert.Equal(t, int64(1000000), order.Fee)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2000000), orderList.List[0].Fee)
+
+	//挂单方收到的bty扣除千分之一,吃单方收到的CCNY扣除千分之二
+	acc := btyDB.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, 110*types.Coin-1000000, acc.Balance)
+	acc = ccnyDB.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 10*types.Coin-2000000, acc.Balance)
+	acc = btyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, int64(1000000), acc.Balance)
+	acc = ccnyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, int64(2000000), acc.Balance)
+}
+
+func TestMarketOrder(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	/*
+	  市价买单测试：
+	  用例说明:
+	    1.A挂价格为1,2,3数量都为10的卖单
+	    2.B花费35个CCNY市价买入,依次吃掉价格1,2的卖单,价格为3的卖单成交1.66666666个,剩余的CCNY退还
+	    3.C花费100个CCNY,滑点限制为50%,只能吃掉价格为3的卖单,剩余的CCNY退还
+	*/
+	for _, price := range []int64{1, 2, 3} {
+		Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: price * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	}
+	err := Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 35 * types.Coin, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Completed, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	order := orderList.List[0]
+	assert.Equal(t, int32(et.TyMarketOrderAction), order.Ty)
+	assert.Equal(t, int64(2166666666), order.Executed)
+	assert.Equal(t, int64(2), order.Balance)
+	acc := btyDB.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 100*types.Coin+2166666666, acc.Balance)
+	acc = ccnyDB.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 65*types.Coin+2, acc.Balance)
+	acc = btyDB.LoadExecAccount(Nodes[0], execAddr)
+	assert.Equal(t, 70*types.Coin, acc.Balance)
+	assert.Equal(t, 30*types.Coin-2166666666, acc.Frozen)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(marketDepthList.List))
+	assert.Equal(t, 3*types.Coin, marketDepthList.List[0].Price)
+	assert.Equal(t, 30*types.Coin-2166666666, marketDepthList.List[0].Amount)
+
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 5 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 100 * types.Coin, Op: et.OpBuy, Slippage: 5000}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc = ccnyDB.LoadExecAccount(Nodes[2], execAddr)
+	assert.Equal(t, 100*types.Coin-SafeMul(30*types.Coin-2166666666, 3*types.Coin), acc.Balance)
+	marketDepthList, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 5*types.Coin, marketDepthList.List[0].Price)
+
+	/*
+	  市价卖单测试：
+	  用例说明:
+	    1.D挂价格为2,数量为10的买单
+	    2.B市价卖出15个bty,成交10个,剩余5个退还
+	    3.没有对手盘时市价委托失败
+	*/
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: 10 * types.Coin, Op: et.OpBuy}, PrivKeyD, stateDB, kvdb, env)
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 15 * types.Coin, Op: et.OpSell}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 10*types.Coin, orderList.List[0].Executed)
+	assert.Equal(t, 5*types.Coin, orderList.List[0].Balance)
+	acc = ccnyDB.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 85*types.Coin+2, acc.Balance)
+	acc = btyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 110*types.Coin, acc.Balance)
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: 5 * types.Coin, Op: et.OpSell}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrMarketDepth, err)
+}
+
+func TestTokenRestriction(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	setFlag := func(key string, on bool) {
+		flag := &types.Int32{}
+		if on {
+			flag.Data = 1
+		}
+		stateDB.Set([]byte(key), types.Encode(flag))
+	}
+	/*
+	  token转账限制测试：
+	  用例说明:
+	    1.A挂价格为1数量为10的卖单后被冻结,B的买单不能与A的挂单成交,直接挂单
+	    2.B被冻结后不能下单
+	    3.token暂停转账后不能下单
+	*/
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	setFlag("mavl-token-frozen-CCNY-"+Nodes[0], true)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Ordered, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), orderList.List[0].Executed)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), orderList.List[0].Executed)
+
+	setFlag("mavl-token-frozen-CCNY-"+Nodes[1], true)
+	err = Exec_MarketOrder(t, &et.MarketOrder{LeftAsset: left, RightAsset: right, Amount: types.Coin, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, tokenty.ErrTokenAddrFrozen, err)
+
+	setFlag("mavl-token-paused-CCNY", true)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: types.Coin, Op: et.OpSell}, PrivKeyC, stateDB, kvdb, env)
+	assert.Equal(t, tokenty.ErrTokenPaused, err)
+}
+
+func TestTimeInForce(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	/*
+	  IOC测试：
+	  用例说明:
+	    1.A挂价格为1,数量为10的卖单
+	    2.B以IOC方式买入15个,成交10个,剩余5个直接取消,不冻结资金
+	    3.没有对手盘时IOC订单执行失败
+	*/
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 15 * types.Coin, Op: et.OpBuy, TimeInForce: et.ImmediateOrCancel}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Completed, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 10*types.Coin, orderList.List[0].Executed)
+	assert.Equal(t, 5*types.Coin, orderList.List[0].Balance)
+	acc := ccnyDB.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 90*types.Coin, acc.Balance)
+	assert.Equal(t, int64(0), acc.Frozen)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 15 * types.Coin, Op: et.OpBuy, TimeInForce: et.ImmediateOrCancel}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrMarketDepth, err)
+
+	/*
+	  FOK测试：
+	  用例说明:
+	    1.A挂价格为2,数量为10的卖单
+	    2.B以FOK方式按价格1买入10个,不能成交,交易失败
+	    3.B以FOK方式按价格2买入10个,全部成交
+	*/
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpBuy, TimeInForce: et.FillOrKill}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrFillOrKill, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: 10 * types.Coin, Op: et.OpBuy, TimeInForce: et.FillOrKill}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc = ccnyDB.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 70*types.Coin, acc.Balance)
+
+	/*
+	  postOnly测试：
+	  用例说明:
+	    1.A挂价格为3,数量为10的卖单
+	    2.B以postOnly方式挂价格为3的买单,会立即成交,交易失败
+	    3.B以postOnly方式挂价格为2的买单,挂单成功
+	*/
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 5 * types.Coin, Op: et.OpBuy, TimeInForce: et.PostOnly}, PrivKeyB, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrPostOnly, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: 5 * types.Coin, Op: et.OpBuy, TimeInForce: et.PostOnly}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[1], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orderList.List))
+	acc = ccnyDB.LoadExecAccount(Nodes[1], execAddr)
+	assert.Equal(t, 10*types.Coin, acc.Frozen)
+
+	/*
+	  GTH测试：
+	  用例说明:
+	    1.A挂价格为3,数量为10的卖单,下一个区块之后过期
+	    2.过期高度不大于当前高度时交易失败
+	    3.过期后C挂价格为3的买单,只与未过期的卖单成交,过期的卖单被撤销,冻结资金退还
+	*/
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell, TimeInForce: et.GoodTillHeight}, PrivKeyD, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrExpireHeight, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell, TimeInForce: et.GoodTillHeight, ExpireHeight: env.blockHeight + 1}, PrivKeyD, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrExpireHeight, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell, TimeInForce: et.GoodTillHeight, ExpireHeight: env.blockHeight + 2}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	orderID := orderList.List[0].OrderID
+	acc = btyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 10*types.Coin, acc.Frozen)
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: types.Coin, Op: et.OpSell}, PrivKeyC, stateDB, kvdb, env)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 20 * types.Coin, Op: et.OpBuy}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	order, err := Exec_QueryOrder(orderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Revoked), order.Status)
+	acc = btyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, 100*types.Coin, acc.Balance)
+	orderList, err = Exec_QueryOrderList(et.Ordered, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 10*types.Coin, orderList.List[0].Executed)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	assert.Nil(t, marketDepthList)
+
+	//止损单只支持GTC和GTH
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell, StopPrice: types.Coin, TimeInForce: et.ImmediateOrCancel}, PrivKeyD, stateDB, kvdb, env)
+	assert.Equal(t, et.ErrTimeInForce, err)
+}
+
+func TestExpireOrder(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	/*
+	  GTH订单过期清理测试：
+	  用例说明:
+	    1.D挂价格为3,数量为10的GTH卖单,以及触发价为5的GTH买入止损单,下一个区块之后过期
+	    2.过期之前的交易不会清理D的订单
+	    3.过期之后A挂一笔不会成交的买单,D的两笔订单在交易执行时被撤销,冻结资金退还,市场深度和止损单列表同步更新
+	*/
+	expireHeight := env.blockHeight + 3
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell, TimeInForce: et.GoodTillHeight, ExpireHeight: expireHeight}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 6 * types.Coin, Amount: 5 * types.Coin, Op: et.OpBuy, StopPrice: 5 * types.Coin, TimeInForce: et.GoodTillHeight, ExpireHeight: expireHeight}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc := btyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 10*types.Coin, acc.Frozen)
+	acc = ccnyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 30*types.Coin, acc.Frozen)
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	assert.Equal(t, expireHeight, env.blockHeight)
+	orderList, err := Exec_QueryOrderList(et.Ordered, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orderList.List))
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: types.Coin, Op: et.OpBuy}, PrivKeyA, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc = btyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, 100*types.Coin, acc.Balance)
+	acc = ccnyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, 100*types.Coin, acc.Balance)
+	_, err = Exec_QueryOrderList(et.Ordered, Nodes[3], "", stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	_, err = Exec_QueryOrderList(et.Untriggered, Nodes[3], "", stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(orderList.List))
+	_, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	//过期索引清理完成
+	heights := getExpireIndex(stateDB, calcExpireHeightsKey())
+	assert.Equal(t, 0, len(heights.Items))
+}
+
+func TestStopOrder(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	env := &execEnv{
+		10,
+		1,
+		1539918074,
+	}
+	/*
+	  止损单测试：
+	  用例说明:
+	    1.A挂价格为1,2,3数量都为10的卖单
+	    2.D挂触发价为2,价格为3,数量为5的买入止损单,冻结资金但不撮合
+	    3.B以价格1买入10个,最新成交价为1,不触发
+	    4.B以价格2买入5个,最新成交价为2,D的止损单被触发,与价格为2的卖单剩余部分成交
+	*/
+	for _, price := range []int64{1, 2, 3} {
+		Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: price * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	}
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: 5 * types.Coin, Op: et.OpBuy, StopPrice: 2 * types.Coin}, PrivKeyD, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err := Exec_QueryOrderList(et.Untriggered, Nodes[3], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(orderList.List))
+	orderID := orderList.List[0].OrderID
+	acc := ccnyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 15*types.Coin, acc.Frozen)
+
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	order, err := Exec_QueryOrder(orderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Untriggered), order.Status)
+
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: 5 * types.Coin, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	order, err = Exec_QueryOrder(orderID, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(et.Completed), order.Status)
+	assert.Equal(t, 2*types.Coin, order.AVGPrice)
+	_, err = Exec_QueryOrderList(et.Untriggered, Nodes[3], "", stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	acc = ccnyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	assert.Equal(t, 90*types.Coin, acc.Balance)
+	acc = btyDB.LoadExecAccount(Nodes[3], execAddr)
+	assert.Equal(t, 105*types.Coin, acc.Balance)
+	marketDepthList, err := Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(marketDepthList.List))
+	assert.Equal(t, 3*types.Coin, marketDepthList.List[0].Price)
+	orderList, err = Exec_QueryOrderList(et.Completed, Nodes[0], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(orderList.List))
+
+	/*
+	  用例说明:
+	    1.最新成交价已经满足触发条件的止损单直接撮合
+	    2.未触发的止损单可以撤销,冻结资金退还
+	*/
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 3 * types.Coin, Amount: types.Coin, Op: et.OpBuy, StopPrice: types.Coin}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	marketDepthList, err = Exec_QueryMarketDepth(&et.QueryMarketDepth{LeftAsset: left, RightAsset: right, Op: et.OpSell}, stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, 9*types.Coin, marketDepthList.List[0].Amount)
+
+	err = Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 5 * types.Coin, Op: et.OpSell, StopPrice: types.Coin}, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	orderList, err = Exec_QueryOrderList(et.Untriggered, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	orderID = orderList.List[0].OrderID
+	acc = btyDB.LoadExecAccount(Nodes[2], execAddr)
+	assert.Equal(t, 5*types.Coin, acc.Frozen)
+	err = Exec_RevokeOrder(t, orderID, PrivKeyC, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	acc = btyDB.LoadExecAccount(Nodes[2], execAddr)
+	assert.Equal(t, int64(0), acc.Frozen)
+	_, err = Exec_QueryOrderList(et.Untriggered, Nodes[2], "", stateDB, kvdb)
+	assert.Equal(t, types.ErrNotFound, err)
+	orderList, err = Exec_QueryOrderList(et.Revoked, Nodes[2], "", stateDB, kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, orderID, orderList.List[0].OrderID)
+}
+
+func TestKLine(t *testing.T) {
+	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
+	cfg.SetTitleOnlyForTest("chain33")
+	dir, stateDB, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, stateDB)
+	execAddr := address.ExecAddress(et.ExchangeX)
+
+	btyDB, _ := account.NewAccountDB(cfg, "coins", "bty", stateDB)
+	ccnyDB, _ := account.NewAccountDB(cfg, "token", "CCNY", stateDB)
+	for _, addr := range Nodes {
+		btyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+		ccnyDB.SaveExecAccount(execAddr, &types.Account{Balance: 100 * types.Coin, Addr: addr})
+	}
+	left := &et.Asset{Symbol: "bty", Execer: "coins"}
+	right := &et.Asset{Execer: "token", Symbol: "CCNY"}
+	//每个区块的时间间隔为20秒
+	env := &execEnv{
+		0,
+		1,
+		1539918074,
+	}
+	/*
+	  k线测试：
+	  用例说明:
+	    1.A挂价格为1,2数量都为10的卖单
+	    2.B以价格2买入15个,第60秒的1分钟k线开盘价1,收盘价2,成交量15
+	    3.C以价格2买入1个,回滚之后k线恢复原状
+	*/
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: 10 * types.Coin, Op: et.OpSell}, PrivKeyA, stateDB, kvdb, env)
+	err := Exec_LimitOrder(t, &et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: 15 * types.Coin, Op: et.OpBuy}, PrivKeyB, stateDB, kvdb, env)
+	assert.Nil(t, err)
+	expect := &et.KLine{LeftAsset: left, RightAsset: right, Period: et.KLine1m, StartTime: 60, Open: types.Coin, High: 2 * types.Coin,
+		Low: types.Coin, Close: 2 * types.Coin, Volume: 15 * types.Coin, Turnover: 20 * types.Coin, Count: 2}
+	klineList, err := QueryKLine(kvdb, left, right, et.KLine1m, "", 0, et.ListDESC)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(klineList.List))
+	assert.Equal(t, types.Encode(expect), types.Encode(klineList.List[0]))
+	klineList, err = QueryKLine(kvdb, left, right, et.KLine1d, "", 0, et.ListDESC)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), klineList.List[0].StartTime)
+	assert.Equal(t, 15*types.Coin, klineList.List[0].Volume)
+
+	tx, err := CreateLimitOrder(&et.LimitOrder{LeftAsset: left, RightAsset: right, Price: 2 * types.Coin, Amount: types.Coin, Op: et.OpBuy}, PrivKeyC)
+	assert.Nil(t, err)
+	exec := NewExchange()
+	q := queue.New("channel")
+	q.SetConfig(cfg)
+	api, _ := client.New(q.Client(), nil)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	exec.SetEnv(env.blockHeight+1, env.blockTime+20, env.difficulty)
+	receipt, err := exec.Exec(tx, 0)
+	assert.Nil(t, err)
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := exec.ExecLocal(tx, receiptData, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	klineList, err = QueryKLine(kvdb, left, right, et.KLine1m, "", 0, et.ListDESC)
+	assert.Nil(t, err)
+	assert.Equal(t, 16*types.Coin, klineList.List[0].Volume)
+	assert.Equal(t, int64(3), klineList.List[0].Count)
+
+	set, err = exec.ExecDelLocal(tx, receiptData, 0)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		kvdb.Set(kv.Key, kv.Value)
+	}
+	klineList, err = QueryKLine(kvdb, left, right, et.KLine1m, "", 0, et.ListDESC)
+	assert.Nil(t, err)
+	assert.Equal(t, types.Encode(expect), types.Encode(klineList.List[0]))
+
+	_, err = exec.Query(et.FuncNameGetKLine, types.Encode(&et.QueryKLine{LeftAsset: left, RightAsset: right, Period: 120}))
+	assert.Equal(t, et.ErrKLinePeriod, err)
+}
+
+func TestCalcSlippagePrice(t *testing.T) {
+	assert.Equal(t, int64(110000000), CalcSlippagePrice(et.OpBuy, types.Coin, 1000))
+	assert.Equal(t, int64(90000000), CalcSlippagePrice(et.OpSell, types.Coin, 1000))
+	assert.Equal(t, int64(1), CalcSlippagePrice(et.OpSell, types.Coin, 0))
+	assert.Equal(t, int64(50000000), CalcBuyAmount(types.Coin, 2*types.Coin))
+}
+
+func setManageConfig(stateDB db.DB, key, value string) {
+	item := &types.ConfigItem{
+		Key:   key,
+		Value: &types.ConfigItem_Arr{Arr: &types.ArrayConfig{Value: []string{value}}},
+	}
+	stateDB.Set([]byte(types.ManageKey(key)), types.Encode(item))
+}
+
+func TestParseFeeRate(t *testing.T) {
+	maker, taker, err := ParseFeeRate("10,20")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(10), maker)
+	assert.Equal(t, int32(20), taker)
+	_, _, err = ParseFeeRate("10")
+	assert.Equal(t, et.ErrFeeRate, err)
+	_, _, err = ParseFeeRate("10,10001")
+	assert.Equal(t, et.ErrFeeRate, err)
+	assert.Equal(t, int64(0), CalcFee(9999, 1))
+	assert.Equal(t, int64(20000), CalcFee(types.Coin, 2))
+}
+
 //模拟区块中交易得执行过程
 func Exec_Block(t *testing.T, stateDB db.DB, kvdb db.KVDB, env *execEnv, txs ...*types.Transaction) error {
 	cfg := types.NewChain33Config(types.GetDefaultCfgstring())
@@ -775,6 +1365,14 @@ func Exec_LimitOrder(t *testing.T, limitOrder *et.LimitOrder, privKey string, st
 	return Exec_Block(t, stateDB, kvdb, env, tx)
 }
 
+func Exec_MarketOrder(t *testing.T, marketOrder *et.MarketOrder, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
+	tx, err := CreateMarketOrder(marketOrder, privKey)
+	if err != nil {
+		return err
+	}
+	return Exec_Block(t, stateDB, kvdb, env, tx)
+}
+
 func Exec_RevokeOrder(t *testing.T, orderID int64, privKey string, stateDB db.DB, kvdb db.KVDB, env *execEnv) error {
 	tx, err := CreateRevokeOrder(orderID, privKey)
 	if err != nil {
@@ -3,6 +3,9 @@ package executor
 import (
 	"fmt"
 	"math/big"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
@@ -11,6 +14,7 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	et "github.com/33cn/plugin/plugin/dapp/exchange/types"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
 )
 
 // Action action struct
@@ -24,14 +28,34 @@ type Action struct {
 	localDB   dbm.KVDB
 	index     int
 	api       client.QueueProtocolAPI
+	//本笔交易中已经修改过的订单,localdb要等交易执行完成后才会更新
+	orders map[int64]*et.Order
+	//本笔交易累计撮合的订单数
+	matchCount int
+	//本笔交易已经生成的回执占用的索引数
+	indexOffset int64
+	//交易对最新成交价格
+	lastPrice int64
+	//有GTH订单过期的高度列表,本笔交易中修改后统一写入statedb
+	expireHeights *et.ExpireIndex
 }
 
 //NewAction ...
 func NewAction(e *exchange, tx *types.Transaction, index int) *Action {
 	hash := tx.Hash()
 	fromaddr := tx.From()
-	return &Action{e.GetStateDB(), hash, fromaddr,
-		e.GetBlockTime(), e.GetHeight(), dapp.ExecAddress(string(tx.Execer)), e.GetLocalDB(), index, e.GetAPI()}
+	return &Action{
+		statedb:   e.GetStateDB(),
+		txhash:    hash,
+		fromaddr:  fromaddr,
+		blocktime: e.GetBlockTime(),
+		height:    e.GetHeight(),
+		execaddr:  dapp.ExecAddress(string(tx.Execer)),
+		localDB:   e.GetLocalDB(),
+		index:     index,
+		api:       e.GetAPI(),
+		orders:    make(map[int64]*et.Order),
+	}
 }
 
 //GetIndex get index
@@ -40,6 +64,22 @@ func (a *Action) GetIndex() int64 {
 	return (a.height*types.MaxTxsPerBlock + int64(a.index)) * 1e4
 }
 
+//nextIndex 获取下一条回执的索引,同一笔交易中可能生成多条撮合回执
+func (a *Action) nextIndex() int64 {
+	return a.GetIndex() + a.indexOffset
+}
+
+//receiptLog 生成撮合回执,并为撮合订单预留索引
+func (a *Action) receiptLog(ty int32, re *et.ReceiptExchange) *types.ReceiptLog {
+	a.indexOffset += int64(len(re.MatchOrders)) + 1
+	return &types.ReceiptLog{Ty: ty, Log: types.Encode(re)}
+}
+
+//isForkV2 手续费,市价委托,止损单以及订单有效方式在ForkExchangeV2之后才生效
+func (a *Action) isForkV2() bool {
+	return a.api.GetConfig().IsDappFork(a.height, et.ExchangeX, et.ForkExchangeV2)
+}
+
 //GetKVSet get kv set
 func (a *Action) GetKVSet(order *et.Order) (kvset []*types.KeyValue) {
 	kvset = append(kvset, &types.KeyValue{Key: calcOrderKey(order.OrderID), Value: types.Encode(order)})
@@ -101,12 +141,52 @@ func CheckDirection(direction int32) bool {
 
 //CheckStatus ...
 func CheckStatus(status int32) bool {
-	if status == et.Ordered || status == et.Completed || status == et.Revoked {
+	if status == et.Ordered || status == et.Completed || status == et.Revoked || status == et.Untriggered {
 		return true
 	}
 	return false
 }
 
+//CheckSlippage 滑点取值范围0<=slippage<=10000
+func CheckSlippage(slippage int32) bool {
+	return slippage >= 0 && slippage <= et.MaxSlippage
+}
+
+//CheckKLinePeriod ...
+func CheckKLinePeriod(period int32) bool {
+	for _, p := range et.KLinePeriods {
+		if p == period {
+			return true
+		}
+	}
+	return false
+}
+
+//CheckTimeInForce ...
+func CheckTimeInForce(timeInForce int32) bool {
+	return timeInForce >= et.GoodTillCancel && timeInForce <= et.GoodTillHeight
+}
+
+//CheckLimitOrderOption 检查止损价和有效方式,止损单只支持GTC和GTH两种有效方式
+func CheckLimitOrderOption(payload *et.LimitOrder) error {
+	timeInForce := payload.GetTimeInForce()
+	if !CheckTimeInForce(timeInForce) {
+		return et.ErrTimeInForce
+	}
+	if (timeInForce == et.GoodTillHeight) != (payload.GetExpireHeight() > 0) {
+		return et.ErrExpireHeight
+	}
+	if payload.GetStopPrice() != 0 {
+		if !CheckPrice(payload.GetStopPrice()) {
+			return et.ErrStopPrice
+		}
+		if timeInForce != et.GoodTillCancel && timeInForce != et.GoodTillHeight {
+			return et.ErrTimeInForce
+		}
+	}
+	return nil
+}
+
 //CheckExchangeAsset 检查交易得资产是否合法
 func CheckExchangeAsset(left, right *et.Asset) bool {
 	if left.Execer == "" || left.Symbol == "" || right.Execer == "" || right.Symbol == "" {
@@ -136,6 +216,18 @@ func (a *Action) LimitOrder(payload *et.LimitOrder) (*types.Receipt, error) {
 	if !CheckOp(payload.GetOp()) {
 		return nil, et.ErrAssetOp
 	}
+	if err := CheckLimitOrderOption(payload); err != nil {
+		return nil, err
+	}
+	if !a.isForkV2() && (payload.GetStopPrice() != 0 || payload.GetTimeInForce() != et.GoodTillCancel || payload.GetExpireHeight() != 0) {
+		return nil, types.ErrActionNotSupport
+	}
+	if payload.GetTimeInForce() == et.GoodTillHeight && payload.GetExpireHeight() <= a.height {
+		return nil, et.ErrExpireHeight
+	}
+	if err := a.checkRestriction(leftAsset, rightAsset, a.fromaddr); err != nil {
+		return nil, err
+	}
 	//TODO 这里symbol
 	cfg := a.api.GetConfig()
 	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
@@ -169,6 +261,54 @@ func (a *Action) LimitOrder(payload *et.LimitOrder) (*types.Receipt, error) {
 	return nil, fmt.Errorf("unknow op")
 }
 
+//MarketOrder 市价委托
+func (a *Action) MarketOrder(payload *et.MarketOrder) (*types.Receipt, error) {
+	if !a.isForkV2() {
+		return nil, types.ErrActionNotSupport
+	}
+	leftAsset := payload.GetLeftAsset()
+	rightAsset := payload.GetRightAsset()
+	if !CheckExchangeAsset(leftAsset, rightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckAmount(payload.GetAmount()) {
+		return nil, et.ErrAssetAmount
+	}
+	if !CheckOp(payload.GetOp()) {
+		return nil, et.ErrAssetOp
+	}
+	if !CheckSlippage(payload.GetSlippage()) {
+		return nil, et.ErrSlippage
+	}
+	if err := a.checkRestriction(leftAsset, rightAsset, a.fromaddr); err != nil {
+		return nil, err
+	}
+	cfg := a.api.GetConfig()
+	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	//买单花费的是rightAsset,卖单卖出的是leftAsset
+	if payload.GetOp() == et.OpBuy {
+		rightAccount := rightAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+		if rightAccount.Balance < payload.GetAmount() {
+			elog.Error("market check right balance", "addr", a.fromaddr, "avail", rightAccount.Balance, "need", payload.GetAmount())
+			return nil, et.ErrAssetBalance
+		}
+	} else {
+		leftAccount := leftAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
+		if leftAccount.Balance < payload.GetAmount() {
+			elog.Error("market check left balance", "addr", a.fromaddr, "avail", leftAccount.Balance, "need", payload.GetAmount())
+			return nil, et.ErrAssetBalance
+		}
+	}
+	return a.matchMarketOrder(payload, leftAssetDB, rightAssetDB)
+}
+
 //RevokeOrder ...
 func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
@@ -177,6 +317,8 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 	if err != nil {
 		return nil, err
 	}
+	//撤销的订单不再参与本笔交易中的过期处理
+	a.orders[order.OrderID] = order
 	if order.Addr != a.fromaddr {
 		elog.Error("RevokeOrder.OrderCheck", "addr", a.fromaddr, "order.addr", order.Addr, "order.status", order.Status)
 		return nil, et.ErrAddr
@@ -187,82 +329,699 @@ func (a *Action) RevokeOrder(payload *et.RevokeOrder) (*types.Receipt, error) {
 	}
 	leftAsset := order.GetLimitOrder().GetLeftAsset()
 	rightAsset := order.GetLimitOrder().GetRightAsset()
-	price := order.GetLimitOrder().GetPrice()
-	balance := order.GetBalance()
 
 	cfg := a.api.GetConfig()
+	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, err
+	}
+	//未触发的止损单同样冻结了资金,撤单时一并退还
+	log, kv, err := a.unfreezeOrder(order, leftAssetDB, rightAssetDB)
+	if err != nil {
+		return nil, err
+	}
+	logs = append(logs, log...)
+	kvs = append(kvs, kv...)
+
+	//更新order状态
+	order.Status = et.Revoked
+	order.UpdateTime = a.blocktime
+	kvs = append(kvs, a.GetKVSet(order)...)
+	re := &et.ReceiptExchange{
+		Order: order,
+		Index: a.nextIndex(),
+	}
+	logs = append(logs, a.receiptLog(et.TyRevokeOrderLog, re))
+	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+	return receipts, nil
+
+}
+
+//unfreezeOrder 解冻订单未成交部分的资金,用于撤单以及订单过期
+func (a *Action) unfreezeOrder(order *et.Order, leftAccountDB, rightAccountDB *account.DB) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	op := order.GetLimitOrder().GetOp()
+	amount := CalcActualCost(op, order.GetBalance(), order.GetLimitOrder().GetPrice())
+	accountDB := leftAccountDB
+	if op == et.OpBuy {
+		accountDB = rightAccountDB
+	}
+	acc := accountDB.LoadExecAccount(order.Addr, a.execaddr)
+	if acc.Frozen < amount {
+		elog.Error("unfreezeOrder check frozen", "addr", order.Addr, "avail", acc.Frozen, "amount", amount)
+		return nil, nil, et.ErrAssetBalance
+	}
+	receipt, err := accountDB.ExecActive(order.Addr, a.execaddr, amount)
+	if err != nil {
+		elog.Error("unfreezeOrder.ExecActive", "addr", order.Addr, "amount", amount, "err", err.Error())
+		return nil, nil, err
+	}
+	return receipt.Logs, receipt.KV, nil
+}
+
+//isExpired GTH订单超过过期高度后失效
+func isExpired(order *et.Order, height int64) bool {
+	expireHeight := order.GetLimitOrder().GetExpireHeight()
+	return expireHeight > 0 && height > expireHeight
+}
+
+//expireOrder 撤销已经过期的订单,退还冻结的资金
+func (a *Action) expireOrder(order *et.Order, leftAccountDB, rightAccountDB *account.DB) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	logs, kvs, err := a.unfreezeOrder(order, leftAccountDB, rightAccountDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	elog.Info("expire order", "orderID", order.OrderID, "addr", order.Addr, "expireHeight", order.GetLimitOrder().GetExpireHeight())
+	order.Status = et.Revoked
+	order.UpdateTime = a.blocktime
+	a.orders[order.OrderID] = order
+	kvs = append(kvs, a.GetKVSet(order)...)
+	return logs, kvs, nil
+}
+
+//addExpireIndex 按过期高度记录GTH订单,过期后由sweepExpiredOrders自动撤销
+func (a *Action) addExpireIndex(order *et.Order) []*types.KeyValue {
+	var kvs []*types.KeyValue
+	expireHeight := order.GetLimitOrder().GetExpireHeight()
+	key := calcExpireOrdersKey(expireHeight)
+	orders := getExpireIndex(a.statedb, key)
+	orders.Items = append(orders.Items, order.OrderID)
+	kvs = append(kvs, &types.KeyValue{Key: key, Value: types.Encode(orders)})
+
+	heights := a.loadExpireHeights()
+	i := sort.Search(len(heights.Items), func(i int) bool { return heights.Items[i] >= expireHeight })
+	if i == len(heights.Items) || heights.Items[i] != expireHeight {
+		heights.Items = append(heights.Items, 0)
+		copy(heights.Items[i+1:], heights.Items[i:])
+		heights.Items[i] = expireHeight
+		kvs = append(kvs, &types.KeyValue{Key: calcExpireHeightsKey(), Value: types.Encode(heights)})
+	}
+	return kvs
+}
+
+//loadExpireHeights 本笔交易中修改过的过期高度列表以内存中的为准
+func (a *Action) loadExpireHeights() *et.ExpireIndex {
+	if a.expireHeights == nil {
+		a.expireHeights = getExpireIndex(a.statedb, calcExpireHeightsKey())
+	}
+	return a.expireHeights
+}
+
+func getExpireIndex(statedb dbm.KV, key []byte) *et.ExpireIndex {
+	var index et.ExpireIndex
+	data, err := statedb.Get(key)
+	if err != nil {
+		return &index
+	}
+	err = types.Decode(data, &index)
+	if err != nil {
+		elog.Error("getExpireIndex.Decode", "key", string(key), "err", err.Error())
+	}
+	return &index
+}
+
+//sweepExpiredOrders 每笔交易执行完成后撤销已经过期的GTH订单,退还冻结的资金
+//区块中第一笔exchange交易会处理之前所有区块中过期的订单,单笔交易最多处理MaxExpireCount个,剩余的由后续交易继续处理
+func (a *Action) sweepExpiredOrders(receipt *types.Receipt) (*types.Receipt, error) {
+	if !a.isForkV2() {
+		return receipt, nil
+	}
+	heights := a.loadExpireHeights()
+	changed := false
+	count := 0
+	for len(heights.Items) > 0 && heights.Items[0] < a.height && count < et.MaxExpireCount {
+		key := calcExpireOrdersKey(heights.Items[0])
+		orders := getExpireIndex(a.statedb, key)
+		for len(orders.Items) > 0 && count < et.MaxExpireCount {
+			orderID := orders.Items[0]
+			orders.Items = orders.Items[1:]
+			count++
+			order, err := a.getOrder(orderID)
+			if err != nil {
+				return nil, err
+			}
+			//已经成交,撤销或者在撮合中过期撤销的订单
+			if order.Status != et.Ordered && order.Status != et.Untriggered {
+				continue
+			}
+			logs, kvs, err := a.sweepOrder(order)
+			if err != nil {
+				return nil, err
+			}
+			receipt.Logs = append(receipt.Logs, logs...)
+			receipt.KV = append(receipt.KV, kvs...)
+		}
+		receipt.KV = append(receipt.KV, &types.KeyValue{Key: key, Value: types.Encode(orders)})
+		if len(orders.Items) > 0 {
+			break
+		}
+		heights.Items = heights.Items[1:]
+		changed = true
+	}
+	if changed {
+		receipt.KV = append(receipt.KV, &types.KeyValue{Key: calcExpireHeightsKey(), Value: types.Encode(heights)})
+	}
+	return receipt, nil
+}
+
+//sweepOrder 撤销过期的订单,生成回执用于更新本地索引
+func (a *Action) sweepOrder(order *et.Order) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	leftAsset := order.GetLimitOrder().GetLeftAsset()
+	rightAsset := order.GetLimitOrder().GetRightAsset()
+	cfg := a.api.GetConfig()
+	leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, nil, err
+	}
+	rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
+	if err != nil {
+		return nil, nil, err
+	}
+	logs, kvs, err := a.expireOrder(order, leftAssetDB, rightAssetDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	re := &et.ReceiptExchange{
+		Order: order,
+		Index: a.nextIndex(),
+	}
+	logs = append(logs, a.receiptLog(et.TyExpireOrderLog, re))
+	return logs, kvs, nil
+}
+
+//getOrder 根据订单号从状态数据库中查询订单,本笔交易中修改过的订单以内存中的为准
+func (a *Action) getOrder(orderID int64) (*et.Order, error) {
+	if order, ok := a.orders[orderID]; ok {
+		return order, nil
+	}
+	data, err := a.statedb.Get(calcOrderKey(orderID))
+	if err != nil {
+		elog.Error("getOrder.Get", "orderID", orderID, "err", err.Error())
+		return nil, err
+	}
+	var order et.Order
+	err = types.Decode(data, &order)
+	if err != nil {
+		elog.Error("getOrder.Decode", "orderID", orderID, "err", err.Error())
+		return nil, err
+	}
+	return &order, nil
+}
+
+//loadOrder 本笔交易中修改过的订单以内存中的为准
+func (a *Action) loadOrder(order *et.Order) *et.Order {
+	if cached, ok := a.orders[order.OrderID]; ok {
+		return cached
+	}
+	return order
+}
+
+//撮合交易逻辑方法
+// 规则：
+//1.买单高于市场价，按价格由低往高撮合。
+//2.卖单低于市场价，按价格由高往低进行撮合。
+//3.价格相同按先进先出的原则进行撮合
+//4.买家获利得原则
+//5.止损单在最新成交价达到触发价格之前只冻结资金,不参与撮合
+func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAccountDB *account.DB) (*types.Receipt, error) {
+	or := &et.Order{
+		OrderID:    a.GetIndex(),
+		Value:      &et.Order_LimitOrder{LimitOrder: payload},
+		Ty:         et.TyLimitOrderAction,
+		Executed:   0,
+		AVGPrice:   0,
+		Balance:    payload.GetAmount(),
+		Status:     et.Ordered,
+		Addr:       a.fromaddr,
+		UpdateTime: a.blocktime,
+		Index:      a.GetIndex(),
+	}
+	lastPrice := getLastPrice(a.statedb, payload.GetLeftAsset(), payload.GetRightAsset())
+	a.lastPrice = lastPrice
+	if payload.GetStopPrice() > 0 && !isTriggered(payload, lastPrice) {
+		return a.placeStopOrder(or, leftAccountDB, rightAccountDB)
+	}
+	logs, kvs, err := a.matchLimit(or, leftAccountDB, rightAccountDB, et.TyLimitOrderLog)
+	if err != nil {
+		return nil, err
+	}
+	log, kv, err := a.triggerStopOrders(payload.GetLeftAsset(), payload.GetRightAsset(), leftAccountDB, rightAccountDB, lastPrice)
+	if err != nil {
+		return nil, err
+	}
+	logs = append(logs, log...)
+	kvs = append(kvs, kv...)
+	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+	return receipts, nil
+}
+
+//matchLimit 限价单撮合,新挂的限价单和被触发的止损单都作为吃单方在这里撮合
+func (a *Action) matchLimit(or *et.Order, leftAccountDB, rightAccountDB *account.DB, ty int32) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	var orderKey string
+	var priceKey string
+
+	payload := or.GetLimitOrder()
+	re := &et.ReceiptExchange{
+		Order: or,
+		Index: a.nextIndex(),
+	}
+	fee := a.getFeeConfig(payload.GetLeftAsset(), payload.GetRightAsset())
+
+	//单笔交易最多撮合100笔历史订单,最大可撮合得深度，系统得自我防护
+	//迭代已有挂单价格
+Match:
+	for {
+		//当撮合深度大于最大深度时跳出
+		if a.matchCount >= et.MaxMatchCount {
+			break
+		}
+		//获取现有市场挂单价格信息
+		marketDepthList, err := QueryMarketDepth(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), a.OpSwap(payload.Op), priceKey, et.Count)
+		if err == types.ErrNotFound {
+			break
+		}
+		for _, marketDepth := range marketDepthList.List {
+			// 卖单价大于买单价
+			if payload.Op == et.OpBuy && marketDepth.Price > payload.GetPrice() {
+				continue
+			}
+			// 买单价小于卖单价
+			if payload.Op == et.OpSell && marketDepth.Price < payload.GetPrice() {
+				continue
+			}
+			//根据价格进行迭代,ForkExchangeV2之前沿用上一个价格的翻页位置,保证撮合结果不变
+			if a.isForkV2() {
+				orderKey = ""
+			}
+			for {
+				//当撮合深度大于等于最大深度时跳出
+				if a.matchCount >= et.MaxMatchCount {
+					break Match
+				}
+				orderList, err := findOrderIDListByPrice(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), marketDepth.Price, a.OpSwap(payload.Op), et.ListASC, orderKey)
+				if err == types.ErrNotFound {
+					break
+				}
+
+				for _, matchorder := range orderList.List {
+					//当撮合深度大于最大深度时跳出
+					if a.matchCount >= et.MaxMatchCount {
+						break Match
+					}
+					matchorder = a.loadOrder(matchorder)
+					//本笔交易中已经成交或者撤销的订单
+					if matchorder.Status != et.Ordered {
+						continue
+					}
+					//同地址不能交易
+					if matchorder.Addr == or.Addr {
+						continue
+					}
+					//受token转账限制的挂单不能成交
+					if a.checkRestriction(payload.GetLeftAsset(), payload.GetRightAsset(), matchorder.Addr) != nil {
+						continue
+					}
+					//过期的订单直接撤销,退还冻结的资金
+					if isExpired(matchorder, a.height) {
+						log, kv, err := a.expireOrder(matchorder, leftAccountDB, rightAccountDB)
+						if err != nil {
+							return nil, nil, err
+						}
+						logs = append(logs, log...)
+						kvs = append(kvs, kv...)
+						re.MatchOrders = append(re.MatchOrders, matchorder)
+						a.matchCount++
+						continue
+					}
+					//只做挂单的订单不能与已有挂单成交
+					if payload.GetTimeInForce() == et.PostOnly {
+						return nil, nil, et.ErrPostOnly
+					}
+					//撮合,指针传递
+					log, kv, err := a.matchModel(leftAccountDB, rightAccountDB, payload, matchorder, or, re, fee) // payload, or redundant
+					if err != nil {
+						return nil, nil, err
+					}
+					logs = append(logs, log...)
+					kvs = append(kvs, kv...)
+					a.orders[matchorder.OrderID] = matchorder
+					//TODO 这里得逻辑是否需要调整?当匹配的单数过多，会导致receipt日志数量激增，理论上存在日志存储攻击，需要加下最大匹配深度，防止这种攻击发生
+					//撮合深度计数
+					a.matchCount++
+					//订单完成,直接返回，如果没有完成，则继续撮合，直到count等于
+					if or.Status == et.Completed {
+						break Match
+					}
+				}
+				//查询数据不满足10条说明没有了,跳出循环
+				if orderList.PrimaryKey == "" {
+					break
+				}
+				orderKey = orderList.PrimaryKey
+			}
+		}
+
+		//查询的数据如果没有primaryKey说明没有后续数据了,跳出循环
+		if marketDepthList.PrimaryKey == "" {
+			break
+		}
+		priceKey = marketDepthList.PrimaryKey
+	}
+
+	if or.Status != et.Completed {
+		switch payload.GetTimeInForce() {
+		case et.FillOrKill:
+			return nil, nil, et.ErrFillOrKill
+		case et.ImmediateOrCancel:
+			if or.Executed == 0 {
+				return nil, nil, et.ErrMarketDepth
+			}
+			//未成交的部分直接取消,不冻结资金,balance为取消的数量
+			or.Status = et.Completed
+		default:
+			//未完成的订单需要冻结剩余未成交的资金
+			log, kv, err := a.freezeOrder(or, leftAccountDB, rightAccountDB)
+			if err != nil {
+				return nil, nil, err
+			}
+			logs = append(logs, log...)
+			kvs = append(kvs, kv...)
+			//新挂的GTH订单记录过期索引,触发的止损单在挂单时已经记录过
+			if ty == et.TyLimitOrderLog && payload.GetTimeInForce() == et.GoodTillHeight {
+				kvs = append(kvs, a.addExpireIndex(or)...)
+			}
+		}
+	}
+	//更新order状态
+	kvs = append(kvs, a.GetKVSet(or)...)
+	re.Order = or
+	logs = append(logs, a.receiptLog(ty, re))
+	return logs, kvs, nil
+}
+
+//freezeOrder 冻结订单未成交部分的资金
+func (a *Action) freezeOrder(order *et.Order, leftAccountDB, rightAccountDB *account.DB) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	op := order.GetLimitOrder().GetOp()
+	amount := CalcActualCost(op, order.GetBalance(), order.GetLimitOrder().GetPrice())
+	accountDB := leftAccountDB
+	if op == et.OpBuy {
+		accountDB = rightAccountDB
+	}
+	receipt, err := accountDB.ExecFrozen(order.Addr, a.execaddr, amount)
+	if err != nil {
+		elog.Error("LimitOrder.ExecFrozen", "addr", order.Addr, "amount", amount, "err", err.Error())
+		return nil, nil, err
+	}
+	return receipt.Logs, receipt.KV, nil
+}
+
+//placeStopOrder 未触发的止损单只冻结资金,等待最新成交价达到触发价格
+func (a *Action) placeStopOrder(or *et.Order, leftAccountDB, rightAccountDB *account.DB) (*types.Receipt, error) {
+	logs, kvs, err := a.freezeOrder(or, leftAccountDB, rightAccountDB)
+	if err != nil {
+		return nil, err
+	}
+	or.Status = et.Untriggered
+	kvs = append(kvs, a.GetKVSet(or)...)
+	if or.GetLimitOrder().GetTimeInForce() == et.GoodTillHeight {
+		kvs = append(kvs, a.addExpireIndex(or)...)
+	}
+	re := &et.ReceiptExchange{
+		Order: or,
+		Index: a.nextIndex(),
+	}
+	logs = append(logs, a.receiptLog(et.TyStopOrderLog, re))
+	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
+	return receipts, nil
+}
+
+//isTriggered 买入止损单在最新成交价不低于触发价格时触发,卖出止损单在最新成交价不高于触发价格时触发
+func isTriggered(payload *et.LimitOrder, lastPrice int64) bool {
+	if lastPrice <= 0 {
+		return false
+	}
+	if payload.GetOp() == et.OpBuy {
+		return lastPrice >= payload.GetStopPrice()
+	}
+	return lastPrice <= payload.GetStopPrice()
+}
+
+//triggerStopOrders 撮合完成后按最新成交价触发止损单,触发后撮合产生的新成交价可能继续触发其他止损单
+func (a *Action) triggerStopOrders(left, right *et.Asset, leftAccountDB, rightAccountDB *account.DB, lastPrice int64) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	if a.lastPrice <= 0 || !a.isForkV2() {
+		return nil, nil, nil
+	}
+	for i := 0; i < et.MaxTriggerCount; i++ {
+		order := a.nextStopOrder(left, right)
+		if order == nil {
+			break
+		}
+		log, kv, err := a.triggerStopOrder(order, leftAccountDB, rightAccountDB)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+	}
+	//记录最新成交价格
+	if a.lastPrice != lastPrice {
+		kvs = append(kvs, &types.KeyValue{Key: calcLastPriceKey(left, right), Value: types.Encode(&types.Int64{Data: a.lastPrice})})
+	}
+	return logs, kvs, nil
+}
+
+//checkRestriction 在合约内部转移token时检查token的暂停, 冻结和白名单限制, 其他资产不检查
+func (a *Action) checkRestriction(left, right *et.Asset, addr string) error {
+	cfg := a.api.GetConfig()
+	for _, asset := range []*et.Asset{left, right} {
+		if err := tokenexec.CheckTransferRestriction(cfg, a.statedb, a.height, asset.GetExecer(), asset.GetSymbol(), addr, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-	if order.GetLimitOrder().GetOp() == et.OpBuy {
-		rightAssetDB, err := account.NewAccountDB(cfg, rightAsset.GetExecer(), rightAsset.GetSymbol(), a.statedb)
+//nextStopOrder 查找下一个满足触发条件的止损单,买入止损单优先
+func (a *Action) nextStopOrder(left, right *et.Asset) *et.Order {
+	for _, op := range []int32{et.OpBuy, et.OpSell} {
+		orderList, err := findStopOrderList(a.localDB, left, right, op)
 		if err != nil {
-			return nil, err
+			continue
 		}
-		amount := CalcActualCost(et.OpBuy, balance, price)
-		rightAccount := rightAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
-		if rightAccount.Frozen < amount {
-			elog.Error("revoke check right frozen", "addr", a.fromaddr, "avail", rightAccount.Frozen, "amount", amount)
-			return nil, et.ErrAssetBalance
+		for _, order := range orderList.List {
+			//本笔交易中已经触发过的订单
+			if _, ok := a.orders[order.OrderID]; ok {
+				continue
+			}
+			//按触发价格由近到远排列,不满足条件时后面的订单也不会满足
+			if !isTriggered(order.GetLimitOrder(), a.lastPrice) {
+				break
+			}
+			//受token转账限制的止损单暂不触发
+			if a.checkRestriction(left, right, order.Addr) != nil {
+				continue
+			}
+			return order
 		}
-		receipt, err := rightAssetDB.ExecActive(a.fromaddr, a.execaddr, amount)
+	}
+	return nil
+}
+
+//triggerStopOrder 触发止损单,已经过期的直接撤销,否则解冻资金后按限价单撮合
+func (a *Action) triggerStopOrder(order *et.Order, leftAccountDB, rightAccountDB *account.DB) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	a.orders[order.OrderID] = order
+	//触发后的订单按本次触发重新生成history表的索引
+	order.Index = a.nextIndex()
+	if isExpired(order, a.height) {
+		logs, kvs, err := a.expireOrder(order, leftAccountDB, rightAccountDB)
 		if err != nil {
-			elog.Error("RevokeOrder.ExecActive", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
+			return nil, nil, err
+		}
+		re := &et.ReceiptExchange{
+			Order: order,
+			Index: order.Index,
+		}
+		logs = append(logs, a.receiptLog(et.TyTriggerOrderLog, re))
+		return logs, kvs, nil
+	}
+	elog.Info("trigger stop order", "orderID", order.OrderID, "addr", order.Addr, "stopPrice", order.GetLimitOrder().GetStopPrice(), "lastPrice", a.lastPrice)
+	logs, kvs, err := a.unfreezeOrder(order, leftAccountDB, rightAccountDB)
+	if err != nil {
+		return nil, nil, err
+	}
+	order.Status = et.Ordered
+	order.UpdateTime = a.blocktime
+	log, kv, err := a.matchLimit(order, leftAccountDB, rightAccountDB, et.TyTriggerOrderLog)
+	if err != nil {
+		return nil, nil, err
+	}
+	logs = append(logs, log...)
+	kvs = append(kvs, kv...)
+	return logs, kvs, nil
+}
+
+//交易撮合模型
+func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *et.LimitOrder, matchorder *et.Order, or *et.Order, re *et.ReceiptExchange, fee *feeConfig) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	var logs []*types.ReceiptLog
+	var kvs []*types.KeyValue
+	var matched int64
+
+	if matchorder.GetBalance() >= or.GetBalance() {
+		matched = or.GetBalance()
+	} else {
+		matched = matchorder.GetBalance()
+	}
+
+	elog.Info("try match", "activeId", or.OrderID, "passiveId", matchorder.OrderID, "activeAddr", or.Addr, "passiveAddr",
+		matchorder.Addr, "amount", matched, "price", payload.Price)
+
+	if payload.Op == et.OpSell {
+		//转移冻结资产
+		amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, payload.Price)
+		receipt, err := rightAccountDB.ExecTransferFrozen(matchorder.Addr, or.Addr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("matchModel.ExecTransferFrozen", "from", matchorder.Addr, "to", or.Addr, "amount", amount, "err", err)
+			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kvs = append(kvs, receipt.KV...)
-	}
-	if order.GetLimitOrder().GetOp() == et.OpSell {
-		leftAssetDB, err := account.NewAccountDB(cfg, leftAsset.GetExecer(), leftAsset.GetSymbol(), a.statedb)
+		//解冻多余资金
+		if payload.Price < matchorder.GetLimitOrder().Price {
+			amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, matchorder.GetLimitOrder().Price-payload.Price)
+			receipt, err := rightAccountDB.ExecActive(matchorder.Addr, a.execaddr, amount)
+			if err != nil {
+				elog.Error("matchModel.ExecActive", "addr", matchorder.Addr, "amount", amount, "err", err.Error())
+				return nil, nil, err
+			}
+			logs = append(logs, receipt.Logs...)
+			kvs = append(kvs, receipt.KV...)
+		}
+		//将达成交易的相应资产结算
+		amount = CalcActualCost(payload.Op, matched, payload.Price)
+		receipt, err = leftAccountDB.ExecTransfer(or.Addr, matchorder.Addr, a.execaddr, amount)
 		if err != nil {
-			return nil, err
+			elog.Error("matchModel.ExecTransfer", "from", or.Addr, "to", matchorder.Addr, "amount", amount, "err", err.Error())
+			return nil, nil, err
 		}
-		amount := CalcActualCost(et.OpSell, balance, price)
-		leftAccount := leftAssetDB.LoadExecAccount(a.fromaddr, a.execaddr)
-		if leftAccount.Frozen < amount {
-			elog.Error("revoke check left frozen", "addr", a.fromaddr, "avail", leftAccount.Frozen, "amount", amount)
-			return nil, et.ErrAssetBalance
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+		//收取手续费,吃单方收到right资产,挂单方收到left资产
+		log, kv, err := a.chargeFee(rightAccountDB, payload.GetRightAsset(), or, CalcActualCost(et.OpBuy, matched, payload.Price), true, fee, re)
+		if err != nil {
+			return nil, nil, err
 		}
-		receipt, err := leftAssetDB.ExecActive(a.fromaddr, a.execaddr, amount)
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+		log, kv, err = a.chargeFee(leftAccountDB, payload.GetLeftAsset(), matchorder, matched, false, fee, re)
 		if err != nil {
-			elog.Error("RevokeOrder.ExecActive", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+
+		//卖单成交得平均价格始终与自身挂单价格相同
+		or.AVGPrice = payload.Price
+		a.lastPrice = payload.Price
+		//计算matchOrder平均成交价格
+		matchorder.AVGPrice = caclAVGPrice(matchorder, payload.Price, matched) //TODO
+	}
+	if payload.Op == et.OpBuy {
+		//转移冻结资产
+		amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, matchorder.GetLimitOrder().Price)
+		receipt, err := leftAccountDB.ExecTransferFrozen(matchorder.Addr, or.Addr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("matchModel.ExecTransferFrozen2", "from", matchorder.Addr, "to", or.Addr, "amount", amount, "err", err.Error())
+			return nil, nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kvs = append(kvs, receipt.KV...)
+		//将达成交易的相应资产结算
+		amount = CalcActualCost(payload.Op, matched, matchorder.GetLimitOrder().Price)
+		receipt, err = rightAccountDB.ExecTransfer(or.Addr, matchorder.Addr, a.execaddr, amount)
+		if err != nil {
+			elog.Error("matchModel.ExecTransfer2", "from", or.Addr, "to", matchorder.Addr, "amount", amount, "err", err.Error())
+			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kvs = append(kvs, receipt.KV...)
+		//收取手续费,吃单方收到left资产,挂单方收到right资产
+		log, kv, err := a.chargeFee(leftAccountDB, payload.GetLeftAsset(), or, matched, true, fee, re)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+		log, kv, err = a.chargeFee(rightAccountDB, payload.GetRightAsset(), matchorder, amount, false, fee, re)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+
+		//买单得话，价格选取卖单的价格
+		or.AVGPrice = matchorder.GetLimitOrder().Price
+		a.lastPrice = matchorder.GetLimitOrder().Price
+		//计算matchOrder平均成交价格
+		matchorder.AVGPrice = caclAVGPrice(matchorder, matchorder.GetLimitOrder().Price, matched) //TODO
 	}
 
-	//更新order状态
-	order.Status = et.Revoked
-	order.UpdateTime = a.blocktime
-	kvs = append(kvs, a.GetKVSet(order)...)
-	re := &et.ReceiptExchange{
-		Order: order,
-		Index: a.GetIndex(),
+	if matched == matchorder.GetBalance() {
+		matchorder.Status = et.Completed
+	} else {
+		matchorder.Status = et.Ordered
 	}
-	receiptlog := &types.ReceiptLog{Ty: et.TyRevokeOrderLog, Log: types.Encode(re)}
-	logs = append(logs, receiptlog)
-	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
-	return receipts, nil
 
+	if matched == or.GetBalance() {
+		or.Status = et.Completed
+	} else {
+		or.Status = et.Ordered
+	}
+
+	if matched == or.GetBalance() {
+		matchorder.Balance -= matched
+		matchorder.Executed = matched
+		kvs = append(kvs, a.GetKVSet(matchorder)...)
+
+		or.Executed += matched
+		or.Balance = 0
+		kvs = append(kvs, a.GetKVSet(or)...) //or complete
+	} else {
+		or.Balance -= matched
+		or.Executed += matched
+
+		matchorder.Executed = matched
+		matchorder.Balance = 0
+		kvs = append(kvs, a.GetKVSet(matchorder)...) //matchorder complete
+	}
+
+	re.Order = or
+	re.MatchOrders = append(re.MatchOrders, matchorder)
+	return logs, kvs, nil
 }
 
-//撮合交易逻辑方法
+//市价委托撮合逻辑
 // 规则：
-//1.买单高于市场价，按价格由低往高撮合。
-//2.卖单低于市场价，按价格由高往低进行撮合。
-//3.价格相同按先进先出的原则进行撮合
-//4.买家获利得原则
-func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAccountDB *account.DB) (*types.Receipt, error) {
+//1.按对手盘价格由优到劣依次撮合,价格相同按先进先出的原则进行撮合
+//2.以对手盘最优价格为基准,超出滑点限制的价格不再撮合
+//3.市价委托不挂单,资金直接从可用余额中结算,未成交的部分无需退还
+func (a *Action) matchMarketOrder(payload *et.MarketOrder, leftAccountDB, rightAccountDB *account.DB) (*types.Receipt, error) {
 	var logs []*types.ReceiptLog
 	var kvs []*types.KeyValue
 	var orderKey string
 	var priceKey string
-	var count int
+	var limitPrice int64
 
 	or := &et.Order{
 		OrderID:    a.GetIndex(),
-		Value:      &et.Order_LimitOrder{LimitOrder: payload},
-		Ty:         et.TyLimitOrderAction,
+		Value:      &et.Order_MarketOrder{MarketOrder: payload},
+		Ty:         et.TyMarketOrderAction,
 		Executed:   0,
 		AVGPrice:   0,
 		Balance:    payload.GetAmount(),
@@ -273,225 +1032,350 @@ func (a *Action) matchLimitOrder(payload *et.LimitOrder, leftAccountDB, rightAcc
 	}
 	re := &et.ReceiptExchange{
 		Order: or,
-		Index: a.GetIndex(),
+		Index: a.nextIndex(),
 	}
+	fee := a.getFeeConfig(payload.GetLeftAsset(), payload.GetRightAsset())
+	lastPrice := getLastPrice(a.statedb, payload.GetLeftAsset(), payload.GetRightAsset())
+	a.lastPrice = lastPrice
 
-	//单笔交易最多撮合100笔历史订单,最大可撮合得深度，系统得自我防护
-	//迭代已有挂单价格
+Match:
 	for {
-		//当撮合深度大于最大深度时跳出
-		if count >= et.MaxMatchCount {
+		if a.matchCount >= et.MaxMatchCount {
 			break
 		}
-		//获取现有市场挂单价格信息
 		marketDepthList, err := QueryMarketDepth(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), a.OpSwap(payload.Op), priceKey, et.Count)
 		if err == types.ErrNotFound {
 			break
 		}
 		for _, marketDepth := range marketDepthList.List {
-			if count >= et.MaxMatchCount {
-				break
+			//以对手盘最优价格计算滑点限制价格
+			if limitPrice == 0 {
+				limitPrice = CalcSlippagePrice(payload.Op, marketDepth.Price, payload.Slippage)
 			}
-			// 卖单价大于买单价
-			if payload.Op == et.OpBuy && marketDepth.Price > payload.GetPrice() {
-				continue
+			//市场深度按价格由优到劣排列,超出限制价格后不再撮合
+			if payload.Op == et.OpBuy && marketDepth.Price > limitPrice {
+				break Match
 			}
-			// 买单价小于卖单价
-			if payload.Op == et.OpSell && marketDepth.Price < payload.GetPrice() {
-				continue
+			if payload.Op == et.OpSell && marketDepth.Price < limitPrice {
+				break Match
 			}
-			//根据价格进行迭代
+			orderKey = ""
 			for {
-				//当撮合深度大于等于最大深度时跳出
-				if count >= et.MaxMatchCount {
-					break
+				if a.matchCount >= et.MaxMatchCount {
+					break Match
 				}
 				orderList, err := findOrderIDListByPrice(a.localDB, payload.GetLeftAsset(), payload.GetRightAsset(), marketDepth.Price, a.OpSwap(payload.Op), et.ListASC, orderKey)
 				if err == types.ErrNotFound {
 					break
 				}
-
 				for _, matchorder := range orderList.List {
-					//当撮合深度大于最大深度时跳出
-					if count >= et.MaxMatchCount {
-						break
+					if a.matchCount >= et.MaxMatchCount {
+						break Match
+					}
+					matchorder = a.loadOrder(matchorder)
+					if matchorder.Status != et.Ordered {
+						continue
 					}
 					//同地址不能交易
 					if matchorder.Addr == a.fromaddr {
 						continue
 					}
-					//撮合,指针传递
-					log, kv, err := a.matchModel(leftAccountDB, rightAccountDB, payload, matchorder, or, re) // payload, or redundant
+					//受token转账限制的挂单不能成交
+					if a.checkRestriction(payload.GetLeftAsset(), payload.GetRightAsset(), matchorder.Addr) != nil {
+						continue
+					}
+					if isExpired(matchorder, a.height) {
+						log, kv, err := a.expireOrder(matchorder, leftAccountDB, rightAccountDB)
+						if err != nil {
+							return nil, err
+						}
+						logs = append(logs, log...)
+						kvs = append(kvs, kv...)
+						re.MatchOrders = append(re.MatchOrders, matchorder)
+						a.matchCount++
+						continue
+					}
+					log, kv, err := a.matchMarketModel(leftAccountDB, rightAccountDB, payload, matchorder, or, re, fee)
 					if err != nil {
 						return nil, err
 					}
 					logs = append(logs, log...)
 					kvs = append(kvs, kv...)
-					//订单完成,直接返回，如果没有完成，则继续撮合，直到count等于
+					a.orders[matchorder.OrderID] = matchorder
+					//剩余资金不足以成交时结束撮合
 					if or.Status == et.Completed {
-						receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
-						logs = append(logs, receiptlog)
-						receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
-						return receipts, nil
+						break Match
 					}
-					//TODO 这里得逻辑是否需要调整?当匹配的单数过多，会导致receipt日志数量激增，理论上存在日志存储攻击，需要加下最大匹配深度，防止这种攻击发生
-					//撮合深度计数
-					count = count + 1
+					a.matchCount++
 				}
-				//查询数据不满足10条说明没有了,跳出循环
 				if orderList.PrimaryKey == "" {
 					break
 				}
 				orderKey = orderList.PrimaryKey
 			}
 		}
-
-		//查询的数据如果没有primaryKey说明没有后续数据了,跳出循环
 		if marketDepthList.PrimaryKey == "" {
 			break
 		}
 		priceKey = marketDepthList.PrimaryKey
 	}
-
-	//未完成的订单需要冻结剩余未成交的资金
-	if payload.Op == et.OpBuy {
-		amount := CalcActualCost(et.OpBuy, or.Balance, payload.Price)
-		receipt, err := rightAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("LimitOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
-	}
-	if payload.Op == et.OpSell {
-		amount := CalcActualCost(et.OpSell, or.Balance, payload.Price)
-		receipt, err := leftAccountDB.ExecFrozen(a.fromaddr, a.execaddr, amount)
-		if err != nil {
-			elog.Error("LimitOrder.ExecFrozen", "addr", a.fromaddr, "amount", amount, "err", err.Error())
-			return nil, err
-		}
-		logs = append(logs, receipt.Logs...)
-		kvs = append(kvs, receipt.KV...)
+	if or.Executed == 0 {
+		elog.Error("MarketOrder.match", "addr", a.fromaddr, "left", payload.GetLeftAsset(), "right", payload.GetRightAsset(), "op", payload.Op)
+		return nil, et.ErrMarketDepth
 	}
-	//更新order状态
+	//市价委托不挂单,剩余部分视为已退还
+	or.Status = et.Completed
 	kvs = append(kvs, a.GetKVSet(or)...)
 	re.Order = or
-	receiptlog := &types.ReceiptLog{Ty: et.TyLimitOrderLog, Log: types.Encode(re)}
-	logs = append(logs, receiptlog)
+	logs = append(logs, a.receiptLog(et.TyMarketOrderLog, re))
+	log, kv, err := a.triggerStopOrders(payload.GetLeftAsset(), payload.GetRightAsset(), leftAccountDB, rightAccountDB, lastPrice)
+	if err != nil {
+		return nil, err
+	}
+	logs = append(logs, log...)
+	kvs = append(kvs, kv...)
 	receipts := &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}
 	return receipts, nil
 }
 
-//交易撮合模型
-func (a *Action) matchModel(leftAccountDB, rightAccountDB *account.DB, payload *et.LimitOrder, matchorder *et.Order, or *et.Order, re *et.ReceiptExchange) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+//市价委托撮合模型,始终以挂单价格成交
+func (a *Action) matchMarketModel(leftAccountDB, rightAccountDB *account.DB, payload *et.MarketOrder, matchorder *et.Order, or *et.Order, re *et.ReceiptExchange, fee *feeConfig) ([]*types.ReceiptLog, []*types.KeyValue, error) {
 	var logs []*types.ReceiptLog
 	var kvs []*types.KeyValue
-	var matched int64
+	price := matchorder.GetLimitOrder().Price
 
-	if matchorder.GetBalance() >= or.GetBalance() {
-		matched = or.GetBalance()
+	var matched int64
+	if payload.Op == et.OpBuy {
+		//按剩余的rightAsset计算能够买入的数量
+		matched = CalcBuyAmount(or.GetBalance(), price)
 	} else {
+		matched = or.GetBalance()
+	}
+	if matched > matchorder.GetBalance() {
 		matched = matchorder.GetBalance()
 	}
+	cost := CalcActualCost(et.OpBuy, matched, price)
+	if matched <= 0 || cost <= 0 {
+		or.Status = et.Completed
+		return nil, nil, nil
+	}
 
-	elog.Info("try match", "activeId", or.OrderID, "passiveId", matchorder.OrderID, "activeAddr", or.Addr, "passiveAddr",
-		matchorder.Addr, "amount", matched, "price", payload.Price)
+	elog.Info("try market match", "activeId", or.OrderID, "passiveId", matchorder.OrderID, "activeAddr", or.Addr, "passiveAddr",
+		matchorder.Addr, "amount", matched, "price", price)
 
-	if payload.Op == et.OpSell {
-		//转移冻结资产
-		amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, payload.Price)
-		receipt, err := rightAccountDB.ExecTransferFrozen(matchorder.Addr, a.fromaddr, a.execaddr, amount)
+	if payload.Op == et.OpBuy {
+		receipt, err := leftAccountDB.ExecTransferFrozen(matchorder.Addr, a.fromaddr, a.execaddr, matched)
 		if err != nil {
-			elog.Error("matchModel.ExecTransferFrozen", "from", matchorder.Addr, "to", a.fromaddr, "amount", amount, "err", err)
+			elog.Error("matchMarketModel.ExecTransferFrozen", "from", matchorder.Addr, "to", a.fromaddr, "amount", matched, "err", err.Error())
 			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kvs = append(kvs, receipt.KV...)
-		//解冻多余资金
-		if payload.Price < matchorder.GetLimitOrder().Price {
-			amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, matchorder.GetLimitOrder().Price-payload.Price)
-			receipt, err := rightAccountDB.ExecActive(matchorder.Addr, a.execaddr, amount)
-			if err != nil {
-				elog.Error("matchModel.ExecActive", "addr", matchorder.Addr, "amount", amount, "err", err.Error())
-				return nil, nil, err
-			}
-			logs = append(logs, receipt.Logs...)
-			kvs = append(kvs, receipt.KV...)
-		}
-		//将达成交易的相应资产结算
-		amount = CalcActualCost(payload.Op, matched, payload.Price)
-		receipt, err = leftAccountDB.ExecTransfer(a.fromaddr, matchorder.Addr, a.execaddr, amount)
+		receipt, err = rightAccountDB.ExecTransfer(a.fromaddr, matchorder.Addr, a.execaddr, cost)
 		if err != nil {
-			elog.Error("matchModel.ExecTransfer", "from", a.fromaddr, "to", matchorder.Addr, "amount", amount, "err", err.Error())
+			elog.Error("matchMarketModel.ExecTransfer", "from", a.fromaddr, "to", matchorder.Addr, "amount", cost, "err", err.Error())
 			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kvs = append(kvs, receipt.KV...)
-
-		//卖单成交得平均价格始终与自身挂单价格相同
-		or.AVGPrice = payload.Price
-		//计算matchOrder平均成交价格
-		matchorder.AVGPrice = caclAVGPrice(matchorder, payload.Price, matched) //TODO
-	}
-	if payload.Op == et.OpBuy {
-		//转移冻结资产
-		amount := CalcActualCost(matchorder.GetLimitOrder().Op, matched, matchorder.GetLimitOrder().Price)
-		receipt, err := leftAccountDB.ExecTransferFrozen(matchorder.Addr, a.fromaddr, a.execaddr, amount)
+		log, kv, err := a.chargeFee(leftAccountDB, payload.GetLeftAsset(), or, matched, true, fee, re)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+		log, kv, err = a.chargeFee(rightAccountDB, payload.GetRightAsset(), matchorder, cost, false, fee, re)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+		or.Balance -= cost
+	} else {
+		receipt, err := rightAccountDB.ExecTransferFrozen(matchorder.Addr, a.fromaddr, a.execaddr, cost)
 		if err != nil {
-			elog.Error("matchModel.ExecTransferFrozen2", "from", matchorder.Addr, "to", a.fromaddr, "amount", amount, "err", err.Error())
+			elog.Error("matchMarketModel.ExecTransferFrozen", "from", matchorder.Addr, "to", a.fromaddr, "amount", cost, "err", err.Error())
 			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kvs = append(kvs, receipt.KV...)
-		//将达成交易的相应资产结算
-		amount = CalcActualCost(payload.Op, matched, matchorder.GetLimitOrder().Price)
-		receipt, err = rightAccountDB.ExecTransfer(a.fromaddr, matchorder.Addr, a.execaddr, amount)
+		receipt, err = leftAccountDB.ExecTransfer(a.fromaddr, matchorder.Addr, a.execaddr, matched)
 		if err != nil {
-			elog.Error("matchModel.ExecTransfer2", "from", a.fromaddr, "to", matchorder.Addr, "amount", amount, "err", err.Error())
+			elog.Error("matchMarketModel.ExecTransfer", "from", a.fromaddr, "to", matchorder.Addr, "amount", matched, "err", err.Error())
 			return nil, nil, err
 		}
 		logs = append(logs, receipt.Logs...)
 		kvs = append(kvs, receipt.KV...)
+		log, kv, err := a.chargeFee(rightAccountDB, payload.GetRightAsset(), or, cost, true, fee, re)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+		log, kv, err = a.chargeFee(leftAccountDB, payload.GetLeftAsset(), matchorder, matched, false, fee, re)
+		if err != nil {
+			return nil, nil, err
+		}
+		logs = append(logs, log...)
+		kvs = append(kvs, kv...)
+		or.Balance -= matched
+	}
 
-		//买单得话，价格选取卖单的价格
-		or.AVGPrice = matchorder.GetLimitOrder().Price
-		//计算matchOrder平均成交价格
-		matchorder.AVGPrice = caclAVGPrice(matchorder, matchorder.GetLimitOrder().Price, matched) //TODO
+	a.lastPrice = price
+	//市价委托的executed记录累计成交的leftAsset数量
+	or.AVGPrice = calcAVGPrice(or.AVGPrice, or.Executed, price, matched)
+	or.Executed += matched
+	if or.Balance == 0 {
+		or.Status = et.Completed
 	}
 
-	if matched == matchorder.GetBalance() {
+	matchorder.AVGPrice = caclAVGPrice(matchorder, price, matched)
+	matchorder.Balance -= matched
+	matchorder.Executed = matched
+	if matchorder.Balance == 0 {
 		matchorder.Status = et.Completed
 	} else {
 		matchorder.Status = et.Ordered
 	}
+	kvs = append(kvs, a.GetKVSet(matchorder)...)
 
-	if matched == or.GetBalance() {
-		or.Status = et.Completed
-	} else {
-		or.Status = et.Ordered
+	re.Order = or
+	re.MatchOrders = append(re.MatchOrders, matchorder)
+	return logs, kvs, nil
+}
+
+//CalcSlippagePrice 计算滑点限制价格,买单向上浮动,卖单向下浮动
+func CalcSlippagePrice(op int32, price int64, slippage int32) int64 {
+	if slippage == 0 {
+		if op == et.OpBuy {
+			return types.MaxCoin
+		}
+		return 1
+	}
+	rate := int64(et.MaxSlippage) + int64(slippage)
+	if op == et.OpSell {
+		rate = int64(et.MaxSlippage) - int64(slippage)
 	}
+	res := big.NewInt(0).Mul(big.NewInt(price), big.NewInt(rate))
+	res = big.NewInt(0).Div(res, big.NewInt(et.MaxSlippage))
+	return res.Int64()
+}
 
-	if matched == or.GetBalance() {
-		matchorder.Balance -= matched
-		matchorder.Executed = matched
-		kvs = append(kvs, a.GetKVSet(matchorder)...)
+//CalcBuyAmount 计算指定数量的rightAsset按价格能够买入的leftAsset数量
+func CalcBuyAmount(cost int64, price int64) int64 {
+	res := big.NewInt(0).Mul(big.NewInt(cost), big.NewInt(types.Coin))
+	res = big.NewInt(0).Div(res, big.NewInt(price))
+	return res.Int64()
+}
 
-		or.Executed += matched
-		or.Balance = 0
-		kvs = append(kvs, a.GetKVSet(or)...) //or complete
-	} else {
-		or.Balance -= matched
-		or.Executed += matched
+//feeConfig 交易对的手续费配置
+type feeConfig struct {
+	collector string
+	maker     int32
+	taker     int32
+}
 
-		matchorder.Executed = matched
-		matchorder.Balance = 0
-		kvs = append(kvs, a.GetKVSet(matchorder)...) //matchorder complete
+//getFeeConfig 从manage配置中读取手续费收取地址和交易对费率,未配置收取地址或者费率时不收取手续费
+func (a *Action) getFeeConfig(left, right *et.Asset) *feeConfig {
+	fee := &feeConfig{}
+	if !a.isForkV2() {
+		return fee
 	}
+	collector, err := getManageValue(a.statedb, et.FeeCollectorKey)
+	if err != nil || collector == "" {
+		return fee
+	}
+	value, err := getManageValue(a.statedb, et.FeeRateKey(left, right))
+	if err != nil {
+		return fee
+	}
+	maker, taker, err := ParseFeeRate(value)
+	if err != nil {
+		elog.Error("getFeeConfig.ParseFeeRate", "key", et.FeeRateKey(left, right), "value", value, "err", err.Error())
+		return fee
+	}
+	fee.collector = collector
+	fee.maker = maker
+	fee.taker = taker
+	return fee
+}
 
-	re.Order = or
-	re.MatchOrders = append(re.MatchOrders, matchorder)
-	return logs, kvs, nil
+//chargeFee 从订单成交收到的资产中扣除手续费,转入手续费收取地址
+func (a *Action) chargeFee(accountDB *account.DB, asset *et.Asset, order *et.Order, income int64, isTaker bool, fee *feeConfig, re *et.ReceiptExchange) ([]*types.ReceiptLog, []*types.KeyValue, error) {
+	rate := fee.maker
+	if isTaker {
+		rate = fee.taker
+	}
+	amount := CalcFee(income, rate)
+	if amount <= 0 {
+		return nil, nil, nil
+	}
+	receipt, err := accountDB.ExecTransfer(order.Addr, fee.collector, a.execaddr, amount)
+	if err != nil {
+		elog.Error("chargeFee.ExecTransfer", "from", order.Addr, "to", fee.collector, "amount", amount, "err", err.Error())
+		return nil, nil, err
+	}
+	order.Fee += amount
+	re.Fees = append(re.Fees, &et.ExchangeFee{
+		OrderID:   order.OrderID,
+		Addr:      order.Addr,
+		Asset:     asset,
+		Fee:       amount,
+		Rate:      rate,
+		IsTaker:   isTaker,
+		Collector: fee.collector,
+	})
+	return receipt.Logs, receipt.KV, nil
+}
+
+//ParseFeeRate 解析"maker,taker"格式的费率配置,单位为万分之一
+func ParseFeeRate(value string) (maker int32, taker int32, err error) {
+	rates := strings.Split(value, ",")
+	if len(rates) != 2 {
+		return 0, 0, et.ErrFeeRate
+	}
+	m, err := strconv.ParseInt(strings.TrimSpace(rates[0]), 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	t, err := strconv.ParseInt(strings.TrimSpace(rates[1]), 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	if m < 0 || m > et.MaxFeeRate || t < 0 || t > et.MaxFeeRate {
+		return 0, 0, et.ErrFeeRate
+	}
+	return int32(m), int32(t), nil
+}
+
+//CalcFee 按万分之一费率计算手续费,向下取整
+func CalcFee(amount int64, rate int32) int64 {
+	if amount <= 0 || rate <= 0 {
+		return 0
+	}
+	res := big.NewInt(0).Mul(big.NewInt(amount), big.NewInt(int64(rate)))
+	res = big.NewInt(0).Div(res, big.NewInt(et.MaxFeeRate))
+	return res.Int64()
+}
+
+//getManageValue 获取manage合约中配置项的最新值
+func getManageValue(db dbm.KV, key string) (string, error) {
+	value, err := db.Get([]byte(types.ManageKey(key)))
+	if err != nil {
+		return "", err
+	}
+	var item types.ConfigItem
+	err = types.Decode(value, &item)
+	if err != nil {
+		elog.Error("getManageValue.Decode", "key", key, "err", err.Error())
+		return "", err
+	}
+	values := item.GetArr().GetValue()
+	if len(values) == 0 {
+		return "", types.ErrNotFound
+	}
+	//取数组最后一位，作为最新配置项的值
+	return values[len(values)-1], nil
 }
 
 //根据订单号查询，分为两步，优先去localdb中查询，如没有则再去状态数据库中查询
@@ -538,7 +1422,9 @@ func findOrderIDListByPrice(localdb dbm.KV, left, right *et.Asset, price int64,
 	for _, row := range rows {
 		order := row.Data.(*et.Order)
 		//替换已经成交得量
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		if limitOrder := order.GetLimitOrder(); limitOrder != nil {
+			order.Executed = limitOrder.Amount - order.Balance
+		}
 		orderList.List = append(orderList.List, order)
 	}
 	//设置主键索引
@@ -548,6 +1434,40 @@ func findOrderIDListByPrice(localdb dbm.KV, left, right *et.Asset, price int64,
 	return &orderList, nil
 }
 
+//findStopOrderList 按触发价格由近到远查询止损单,买入止损单触发价由低到高,卖出止损单触发价由高到低
+func findStopOrderList(localdb dbm.KV, left, right *et.Asset, op int32) (*et.OrderList, error) {
+	table := NewStopOrderTable(localdb)
+	prefix := []byte(fmt.Sprintf("%s:%s:%d:", left.GetSymbol(), right.GetSymbol(), op))
+	direction := et.ListASC
+	if op == et.OpSell {
+		direction = et.ListDESC
+	}
+	rows, err := table.ListIndex("trigger", prefix, nil, et.Count, direction)
+	if err != nil {
+		return nil, err
+	}
+	var orderList et.OrderList
+	for _, row := range rows {
+		orderList.List = append(orderList.List, row.Data.(*et.Order))
+	}
+	return &orderList, nil
+}
+
+//getLastPrice 获取交易对的最新成交价格,还没有成交时返回0
+func getLastPrice(statedb dbm.KV, left, right *et.Asset) int64 {
+	data, err := statedb.Get(calcLastPriceKey(left, right))
+	if err != nil {
+		return 0
+	}
+	var price types.Int64
+	err = types.Decode(data, &price)
+	if err != nil {
+		elog.Error("getLastPrice.Decode", "left", left, "right", right, "err", err.Error())
+		return 0
+	}
+	return price.Data
+}
+
 //Direction 买单深度是按价格倒序，由高到低
 func Direction(op int32) int32 {
 	if op == et.OpBuy {
@@ -617,7 +1537,9 @@ HERE:
 			continue
 		}
 		//替换已经成交得量
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		if limitOrder := order.GetLimitOrder(); limitOrder != nil {
+			order.Executed = limitOrder.Amount - order.Balance
+		}
 		orderList.List = append(orderList.List, order)
 		if len(orderList.List) == int(count) {
 			//设置主键索引
@@ -637,6 +1559,8 @@ func QueryOrderList(localdb dbm.KV, addr string, status, count, direction int32,
 	var table *tab.Table
 	if status == et.Completed || status == et.Revoked {
 		table = NewHistoryOrderTable(localdb)
+	} else if status == et.Untriggered {
+		table = NewStopOrderTable(localdb)
 	} else {
 		table = NewMarketOrderTable(localdb)
 	}
@@ -660,7 +1584,9 @@ func QueryOrderList(localdb dbm.KV, addr string, status, count, direction int32,
 	for _, row := range rows {
 		order := row.Data.(*et.Order)
 		//替换已经成交得量
-		order.Executed = order.GetLimitOrder().Amount - order.Balance
+		if limitOrder := order.GetLimitOrder(); limitOrder != nil {
+			order.Executed = limitOrder.Amount - order.Balance
+		}
 		orderList.List = append(orderList.List, order)
 	}
 	//设置主键索引
@@ -670,6 +1596,35 @@ func QueryOrderList(localdb dbm.KV, addr string, status, count, direction int32,
 	return &orderList, nil
 }
 
+//QueryKLine 查询交易对某个周期的k线
+func QueryKLine(localdb dbm.KV, left, right *et.Asset, period int32, primaryKey string, count, direction int32) (*et.KLineList, error) {
+	table := NewKLineTable(localdb)
+	prefix := []byte(fmt.Sprintf("%s:%s:%d:", left.GetSymbol(), right.GetSymbol(), period))
+	if count == 0 {
+		count = et.Count
+	}
+	var rows []*tab.Row
+	var err error
+	if primaryKey == "" { //第一次查询,默认展示最新的k线
+		rows, err = table.ListIndex("time", prefix, nil, count, direction)
+	} else {
+		rows, err = table.ListIndex("time", prefix, []byte(primaryKey), count, direction)
+	}
+	if err != nil {
+		elog.Error("QueryKLine.", "left", left, "right", right, "period", period, "err", err.Error())
+		return nil, err
+	}
+	var list et.KLineList
+	for _, row := range rows {
+		list.List = append(list.List, row.Data.(*et.KLine))
+	}
+	//设置主键索引
+	if len(rows) == int(count) {
+		list.PrimaryKey = string(rows[len(rows)-1].Primary)
+	}
+	return &list, nil
+}
+
 func queryMarketDepth(localdb dbm.KV, left, right *et.Asset, op int32, price int64) (*et.MarketDepth, error) {
 	table := NewMarketDepthTable(localdb)
 	primaryKey := []byte(fmt.Sprintf("%s:%s:%d:%016d", left.GetSymbol(), right.GetSymbol(), op, price))
@@ -689,10 +1644,15 @@ func SafeMul(x, y int64) int64 {
 
 //计算平均成交价格
 func caclAVGPrice(order *et.Order, price int64, amount int64) int64 {
-	x := big.NewInt(0).Mul(big.NewInt(order.AVGPrice), big.NewInt(order.GetLimitOrder().Amount-order.GetBalance()))
+	return calcAVGPrice(order.AVGPrice, order.GetLimitOrder().Amount-order.GetBalance(), price, amount)
+}
+
+//根据已成交的数量和均价,计算新成交后的平均价格
+func calcAVGPrice(avgPrice, executed, price, amount int64) int64 {
+	x := big.NewInt(0).Mul(big.NewInt(avgPrice), big.NewInt(executed))
 	y := big.NewInt(0).Mul(big.NewInt(price), big.NewInt(amount))
 	total := big.NewInt(0).Add(x, y)
-	div := big.NewInt(0).Add(big.NewInt(order.GetLimitOrder().Amount-order.GetBalance()), big.NewInt(amount))
+	div := big.NewInt(0).Add(big.NewInt(executed), big.NewInt(amount))
 	avg := big.NewInt(0).Div(total, div)
 	return avg.Int64()
 }
@@ -12,15 +12,27 @@ import (
 
 func (e *exchange) Exec_LimitOrder(payload *exchangetypes.LimitOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
 	action := NewAction(e, tx, index)
-	return action.LimitOrder(payload)
+	receipt, err := action.LimitOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	return action.sweepExpiredOrders(receipt)
 }
 
 func (e *exchange) Exec_MarketOrder(payload *exchangetypes.MarketOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
-	//TODO marketOrder
-	return nil, types.ErrActionNotSupport
+	action := NewAction(e, tx, index)
+	receipt, err := action.MarketOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	return action.sweepExpiredOrders(receipt)
 }
 
 func (e *exchange) Exec_RevokeOrder(payload *exchangetypes.RevokeOrder, tx *types.Transaction, index int) (*types.Receipt, error) {
 	action := NewAction(e, tx, index)
-	return action.RevokeOrder(payload)
+	receipt, err := action.RevokeOrder(payload)
+	if err != nil {
+		return nil, err
+	}
+	return action.sweepExpiredOrders(receipt)
 }
@@ -1,6 +1,9 @@
 package executor
 
 import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/common/db/table"
 	"github.com/33cn/chain33/types"
 	ety "github.com/33cn/plugin/plugin/dapp/exchange/types"
@@ -14,17 +17,11 @@ import (
 func (e *exchange) ExecLocal_LimitOrder(payload *ety.LimitOrder, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	dbSet := &types.LocalDBSet{}
 	if receiptData.Ty == types.ExecOk {
-		for _, log := range receiptData.Logs {
-			switch log.Ty {
-			case ety.TyLimitOrderLog:
-				receipt := &ety.ReceiptExchange{}
-				if err := types.Decode(log.Log, receipt); err != nil {
-					return nil, err
-				}
-				kv := e.updateIndex(receipt)
-				dbSet.KV = append(dbSet.KV, kv...)
-			}
+		kv, err := e.updateIndexes(receiptData)
+		if err != nil {
+			return nil, err
 		}
+		dbSet.KV = append(dbSet.KV, kv...)
 	}
 	return e.addAutoRollBack(tx, dbSet.KV), nil
 }
@@ -32,17 +29,11 @@ func (e *exchange) ExecLocal_LimitOrder(payload *ety.LimitOrder, tx *types.Trans
 func (e *exchange) ExecLocal_MarketOrder(payload *ety.MarketOrder, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	dbSet := &types.LocalDBSet{}
 	if receiptData.Ty == types.ExecOk {
-		for _, log := range receiptData.Logs {
-			switch log.Ty {
-			case ety.TyMarketOrderLog:
-				receipt := &ety.ReceiptExchange{}
-				if err := types.Decode(log.Log, receipt); err != nil {
-					return nil, err
-				}
-				kv := e.updateIndex(receipt)
-				dbSet.KV = append(dbSet.KV, kv...)
-			}
+		kv, err := e.updateIndexes(receiptData)
+		if err != nil {
+			return nil, err
 		}
+		dbSet.KV = append(dbSet.KV, kv...)
 	}
 	return e.addAutoRollBack(tx, dbSet.KV), nil
 }
@@ -50,17 +41,11 @@ func (e *exchange) ExecLocal_MarketOrder(payload *ety.MarketOrder, tx *types.Tra
 func (e *exchange) ExecLocal_RevokeOrder(payload *ety.RevokeOrder, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	dbSet := &types.LocalDBSet{}
 	if receiptData.Ty == types.ExecOk {
-		for _, log := range receiptData.Logs {
-			switch log.Ty {
-			case ety.TyRevokeOrderLog:
-				receipt := &ety.ReceiptExchange{}
-				if err := types.Decode(log.Log, receipt); err != nil {
-					return nil, err
-				}
-				kv := e.updateIndex(receipt)
-				dbSet.KV = append(dbSet.KV, kv...)
-			}
+		kv, err := e.updateIndexes(receiptData)
+		if err != nil {
+			return nil, err
 		}
+		dbSet.KV = append(dbSet.KV, kv...)
 	}
 	return e.addAutoRollBack(tx, dbSet.KV), nil
 }
@@ -72,60 +57,204 @@ func (e *exchange) addAutoRollBack(tx *types.Transaction, kv []*types.KeyValue)
 	return dbSet
 }
 
-func (e *exchange) updateIndex(receipt *ety.ReceiptExchange) (kvs []*types.KeyValue) {
-	historyTable := NewHistoryOrderTable(e.GetLocalDB())
-	marketTable := NewMarketDepthTable(e.GetLocalDB())
-	orderTable := NewMarketOrderTable(e.GetLocalDB())
-	switch receipt.Order.Status {
-	case ety.Ordered:
-		err := e.updateOrder(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetIndex())
-		if err != nil {
-			return nil
+//orderTables 同一笔交易中触发的止损单会产生多条回执,这些回执共用一组表按顺序更新本地索引
+type orderTables struct {
+	localdb dbm.KV
+	market  *table.Table
+	order   *table.Table
+	history *table.Table
+	stop    *table.Table
+	kline   *table.Table
+	//表中缓存的数据不能直接查询,市场深度和k线在这里单独缓存
+	depth  map[string]*ety.MarketDepth
+	klines map[string]*ety.KLine
+}
+
+func newOrderTables(localdb dbm.KV) *orderTables {
+	return &orderTables{
+		localdb: localdb,
+		market:  NewMarketDepthTable(localdb),
+		order:   NewMarketOrderTable(localdb),
+		history: NewHistoryOrderTable(localdb),
+		stop:    NewStopOrderTable(localdb),
+		kline:   NewKLineTable(localdb),
+		depth:   make(map[string]*ety.MarketDepth),
+		klines:  make(map[string]*ety.KLine),
+	}
+}
+
+//queryDepth 查询市场深度,优先读取本笔交易中已经修改过的数据
+func (t *orderTables) queryDepth(left, right *ety.Asset, op int32, price int64) (*ety.MarketDepth, error) {
+	key := fmt.Sprintf("%s:%s:%d:%016d", left.GetSymbol(), right.GetSymbol(), op, price)
+	if depth, ok := t.depth[key]; ok {
+		if depth == nil {
+			return nil, types.ErrNotFound
 		}
-		err = e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetIndex())
+		return depth, nil
+	}
+	return queryMarketDepth(t.localdb, left, right, op, price)
+}
+
+//replaceDepth 更新市场深度,数量为0时删除
+func (t *orderTables) replaceDepth(depth *ety.MarketDepth) error {
+	key := fmt.Sprintf("%s:%s:%d:%016d", depth.LeftAsset.GetSymbol(), depth.RightAsset.GetSymbol(), depth.Op, depth.Price)
+	if depth.Amount <= 0 {
+		err := t.market.DelRow(depth)
 		if err != nil {
-			return nil
+			elog.Error("updateIndex", "marketTable.DelRow", err.Error())
+			return err
 		}
-	case ety.Completed:
-		err := e.updateOrder(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetIndex())
-		if err != nil {
-			return nil
+		t.depth[key] = nil
+		return nil
+	}
+	err := t.market.Replace(depth)
+	if err != nil {
+		elog.Error("updateIndex", "marketTable.Replace", err.Error())
+		return err
+	}
+	t.depth[key] = depth
+	return nil
+}
+
+//updateKLine 按区块时间把回执中的每一笔成交计入各个周期的k线
+func (t *orderTables) updateKLine(receipt *ety.ReceiptExchange, blocktime int64) error {
+	order := receipt.GetOrder()
+	left, right := order.GetAssets()
+	for _, matchOrder := range receipt.GetMatchOrders() {
+		//过期撤销的订单没有成交
+		if matchOrder.Status == ety.Revoked || matchOrder.Executed <= 0 {
+			continue
 		}
-		err = e.updateMatchOrders(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetMatchOrders(), receipt.GetIndex())
-		if err != nil {
-			return nil
+		//限价卖单按卖单价格成交,其余情况按挂单价格成交
+		price := matchOrder.GetLimitOrder().GetPrice()
+		if limitOrder := order.GetLimitOrder(); limitOrder != nil && limitOrder.Op == ety.OpSell {
+			price = limitOrder.Price
 		}
-	case ety.Revoked:
-		err := e.updateOrder(marketTable, orderTable, historyTable, receipt.GetOrder(), receipt.GetIndex())
-		if err != nil {
-			return nil
+		for _, period := range ety.KLinePeriods {
+			err := t.addTrade(left, right, period, blocktime, price, matchOrder.Executed)
+			if err != nil {
+				return err
+			}
 		}
 	}
+	return nil
+}
 
-	//刷新KV
-	kv, err := marketTable.Save()
-	if err != nil {
-		elog.Error("updateIndex", "marketTable.Save", err.Error())
-		return nil
+//addTrade 更新某个周期的k线,该周期还没有k线时以本次成交价开盘
+func (t *orderTables) addTrade(left, right *ety.Asset, period int32, blocktime, price, amount int64) error {
+	startTime := blocktime - blocktime%int64(period)
+	key := fmt.Sprintf("%s:%s:%d:%016d", left.GetSymbol(), right.GetSymbol(), period, startTime)
+	kline, ok := t.klines[key]
+	if !ok {
+		row, err := t.kline.GetData([]byte(key))
+		if err == nil {
+			kline = row.Data.(*ety.KLine)
+		} else if err == types.ErrNotFound {
+			kline = &ety.KLine{LeftAsset: left, RightAsset: right, Period: period, StartTime: startTime, Open: price, High: price, Low: price}
+		} else {
+			elog.Error("updateIndex", "klineTable.GetData", err.Error())
+			return err
+		}
 	}
-	kvs = append(kvs, kv...)
-	kv, err = orderTable.Save()
-	if err != nil {
-		elog.Error("updateIndex", "orderTable.Save", err.Error())
-		return nil
+	if price > kline.High {
+		kline.High = price
+	}
+	if price < kline.Low {
+		kline.Low = price
 	}
-	kvs = append(kvs, kv...)
-	kv, err = historyTable.Save()
+	kline.Close = price
+	kline.Volume += amount
+	kline.Turnover += SafeMul(amount, price)
+	kline.Count++
+	err := t.kline.Replace(kline)
 	if err != nil {
-		elog.Error("updateIndex", "historyTable.Save", err.Error())
-		return nil
+		elog.Error("updateIndex", "klineTable.Replace", err.Error())
+		return err
+	}
+	t.klines[key] = kline
+	return nil
+}
+
+func (t *orderTables) save() (kvs []*types.KeyValue, err error) {
+	for _, tab := range []*table.Table{t.market, t.order, t.history, t.stop, t.kline} {
+		kv, err := tab.Save()
+		if err != nil {
+			elog.Error("updateIndex", "table.Save", err.Error())
+			return nil, err
+		}
+		kvs = append(kvs, kv...)
 	}
-	kvs = append(kvs, kv...)
+	return kvs, nil
+}
 
-	return
+func (e *exchange) updateIndexes(receiptData *types.ReceiptData) ([]*types.KeyValue, error) {
+	tables := newOrderTables(e.GetLocalDB())
+	//k线在ForkExchangeV2之后开始统计
+	kline := e.GetAPI().GetConfig().IsDappFork(e.GetHeight(), ety.ExchangeX, ety.ForkExchangeV2)
+	for _, log := range receiptData.Logs {
+		switch log.Ty {
+		case ety.TyLimitOrderLog, ety.TyMarketOrderLog, ety.TyRevokeOrderLog, ety.TyStopOrderLog, ety.TyTriggerOrderLog, ety.TyExpireOrderLog:
+			receipt := &ety.ReceiptExchange{}
+			if err := types.Decode(log.Log, receipt); err != nil {
+				return nil, err
+			}
+			//k线要在更新订单索引之前统计,更新索引时会修改撮合订单的数据
+			if kline {
+				if err := tables.updateKLine(receipt, e.GetBlockTime()); err != nil {
+					return nil, err
+				}
+			}
+			e.updateIndex(tables, log.Ty, receipt)
+		}
+	}
+	return tables.save()
 }
 
-func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Table, order *ety.Order, index int64) error {
+func (e *exchange) updateIndex(tables *orderTables, ty int32, receipt *ety.ReceiptExchange) {
+	order := receipt.GetOrder()
+	switch ty {
+	case ety.TyStopOrderLog:
+		//未触发的止损单只记录在stop表中
+		err := tables.stop.Replace(order)
+		if err != nil {
+			elog.Error("updateIndex", "stopTable.Replace", err.Error())
+		}
+		return
+	case ety.TyTriggerOrderLog, ety.TyRevokeOrderLog, ety.TyExpireOrderLog:
+		//止损单触发,撤销或者过期后从stop表中删除
+		err := tables.stop.Del([]byte(fmt.Sprintf("%022d", order.OrderID)))
+		if err == nil && order.Status == ety.Revoked {
+			order.Index = receipt.GetIndex()
+			err = tables.history.Replace(order)
+			if err != nil {
+				elog.Error("updateIndex", "historyTable.Replace", err.Error())
+			}
+			return
+		}
+		if err != nil && err != types.ErrNotFound {
+			elog.Error("updateIndex", "stopTable.Del", err.Error())
+			return
+		}
+	}
+	switch order.Status {
+	case ety.Ordered, ety.Completed:
+		err := e.updateOrder(tables, order, receipt.GetIndex())
+		if err != nil {
+			return
+		}
+		err = e.updateMatchOrders(tables, order, receipt.GetMatchOrders(), receipt.GetIndex())
+		if err != nil {
+			return
+		}
+	case ety.Revoked:
+		err := e.updateOrder(tables, order, receipt.GetIndex())
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (e *exchange) updateOrder(tables *orderTables, order *ety.Order, index int64) error {
 	left := order.GetLimitOrder().GetLeftAsset()
 	right := order.GetLimitOrder().GetRightAsset()
 	op := order.GetLimitOrder().GetOp()
@@ -133,7 +262,7 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 	switch order.Status {
 	case ety.Ordered:
 		var markDepth ety.MarketDepth
-		depth, err := queryMarketDepth(e.GetLocalDB(), left, right, op, price)
+		depth, err := tables.queryDepth(left, right, op, price)
 		if err == types.ErrNotFound {
 			markDepth.Price = price
 			markDepth.LeftAsset = left
@@ -148,51 +277,41 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 			markDepth.Amount = depth.Amount + order.Balance
 		}
 		//marketDepth
-		err = marketTable.Replace(&markDepth)
+		err = tables.replaceDepth(&markDepth)
 		if err != nil {
-			elog.Error("updateIndex", "marketTable.Replace", err.Error())
 			return err
 		}
-		err = orderTable.Replace(order)
+		err = tables.order.Replace(order)
 		if err != nil {
 			elog.Error("updateIndex", "orderTable.Replace", err.Error())
 			return err
 		}
 
 	case ety.Completed:
-		err := historyTable.Replace(order)
+		err := tables.history.Replace(order)
 		if err != nil {
 			elog.Error("updateIndex", "historyTable.Replace", err.Error())
 			return err
 		}
 	case ety.Revoked:
 		//只有状态时ordered状态的订单才能被撤回
-		var marketDepth ety.MarketDepth
-		depth, err := queryMarketDepth(e.GetLocalDB(), left, right, op, price)
+		depth, err := tables.queryDepth(left, right, op, price)
 		if err == nil {
 			//marketDepth
+			var marketDepth ety.MarketDepth
 			marketDepth.Price = price
 			marketDepth.LeftAsset = left
 			marketDepth.RightAsset = right
 			marketDepth.Op = op
 			marketDepth.Amount = depth.Amount - order.Balance
-			err = marketTable.Replace(&marketDepth)
+			err = tables.replaceDepth(&marketDepth)
 			if err != nil {
-				elog.Error("updateIndex", "marketTable.Replace", err.Error())
-				return err
-			}
-		}
-		if marketDepth.Amount <= 0 {
-			//删除
-			err = marketTable.DelRow(&marketDepth)
-			if err != nil {
-				elog.Error("updateIndex", "marketTable.DelRow", err.Error())
 				return err
 			}
 		}
 		//删除原有状态orderID
 		order.Status = ety.Ordered
-		err = orderTable.DelRow(order)
+		err = tables.order.DelRow(order)
 		if err != nil {
 			elog.Error("updateIndex", "orderTable.DelRow", err.Error())
 			return err
@@ -200,7 +319,7 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 		order.Status = ety.Revoked
 		order.Index = index
 		//添加撤销的订单
-		err = historyTable.Replace(order)
+		err = tables.history.Replace(order)
 		if err != nil {
 			elog.Error("updateIndex", "historyTable.Replace", err.Error())
 			return err
@@ -208,26 +327,27 @@ func (e *exchange) updateOrder(marketTable, orderTable, historyTable *table.Tabl
 	}
 	return nil
 }
-func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *table.Table, order *ety.Order, matchOrders []*ety.Order, index int64) error {
-	left := order.GetLimitOrder().GetLeftAsset()
-	right := order.GetLimitOrder().GetRightAsset()
-	op := order.GetLimitOrder().GetOp()
+func (e *exchange) updateMatchOrders(tables *orderTables, order *ety.Order, matchOrders []*ety.Order, index int64) error {
+	left, right := order.GetAssets()
+	op := order.GetOp()
 	if len(matchOrders) > 0 {
 		//撮合交易更新
 		cache := make(map[int64]int64)
 		for i, matchOrder := range matchOrders {
-			if matchOrder.Status == ety.Completed {
+			//成交完成或者过期撤销的订单,从挂单中移到历史订单
+			if matchOrder.Status == ety.Completed || matchOrder.Status == ety.Revoked {
+				status := matchOrder.Status
 				// 删除原有状态orderID
 				matchOrder.Status = ety.Ordered
-				err := orderTable.DelRow(matchOrder)
+				err := tables.order.DelRow(matchOrder)
 				if err != nil {
 					elog.Error("updateIndex", "orderTable.DelRow", err.Error())
 					return err
 				}
 				//索引index,改为当前的index
-				matchOrder.Status = ety.Completed
+				matchOrder.Status = status
 				matchOrder.Index = index + int64(i+1)
-				err = historyTable.Replace(matchOrder)
+				err = tables.history.Replace(matchOrder)
 				if err != nil {
 					elog.Error("updateIndex", "historyTable.Replace", err.Error())
 					return err
@@ -235,21 +355,26 @@ func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *tabl
 			}
 			if matchOrder.Status == ety.Ordered {
 				//更新数据
-				err := orderTable.Replace(matchOrder)
+				err := tables.order.Replace(matchOrder)
 				if err != nil {
 					elog.Error("updateIndex", "orderTable.Replace", err.Error())
 					return err
 				}
 			}
 			executed := cache[matchOrder.GetLimitOrder().Price]
-			executed = executed + matchOrder.Executed
+			if matchOrder.Status == ety.Revoked {
+				//过期订单剩余的数量全部从市场深度中扣除
+				executed = executed + matchOrder.Balance
+			} else {
+				executed = executed + matchOrder.Executed
+			}
 			cache[matchOrder.GetLimitOrder().Price] = executed
 		}
 
 		//更改匹配市场深度
 		for pr, executed := range cache {
 			var matchDepth ety.MarketDepth
-			depth, err := queryMarketDepth(e.GetLocalDB(), left, right, OpSwap(op), pr)
+			depth, err := tables.queryDepth(left, right, OpSwap(op), pr)
 			if err == types.ErrNotFound {
 				continue
 			} else {
@@ -260,19 +385,10 @@ func (e *exchange) updateMatchOrders(marketTable, orderTable, historyTable *tabl
 				matchDepth.Amount = depth.Amount - executed
 			}
 			//marketDepth
-			err = marketTable.Replace(&matchDepth)
+			err = tables.replaceDepth(&matchDepth)
 			if err != nil {
-				elog.Error("updateIndex", "marketTable.Replace", err.Error())
 				return err
 			}
-			if matchDepth.Amount <= 0 {
-				//删除
-				err = marketTable.DelRow(&matchDepth)
-				if err != nil {
-					elog.Error("updateIndex", "marketTable.DelRow", err.Error())
-					return err
-				}
-			}
 		}
 	}
 	return nil
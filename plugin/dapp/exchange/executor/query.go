@@ -61,3 +61,20 @@ func (s *exchange) Query_QueryOrderList(in *et.QueryOrderList) (types.Message, e
 	}
 	return QueryOrderList(s.GetLocalDB(), in.Address, in.Status, in.Count, in.Direction, in.PrimaryKey)
 }
+
+//按周期分页查询交易对的k线,默认从最新的k线开始
+func (s *exchange) Query_GetKLine(in *et.QueryKLine) (types.Message, error) {
+	if !CheckExchangeAsset(in.LeftAsset, in.RightAsset) {
+		return nil, et.ErrAsset
+	}
+	if !CheckKLinePeriod(in.Period) {
+		return nil, et.ErrKLinePeriod
+	}
+	if !CheckCount(in.Count) {
+		return nil, et.ErrCount
+	}
+	if !CheckDirection(in.Direction) {
+		return nil, et.ErrDirection
+	}
+	return QueryKLine(s.GetLocalDB(), in.LeftAsset, in.RightAsset, in.Period, in.PrimaryKey, in.Count, in.Direction)
+}
@@ -28,6 +28,23 @@ func calcOrderKey(orderID int64) []byte {
 	return []byte(key)
 }
 
+//状态数据库中存储交易对的最新成交价格,用于触发止损单
+func calcLastPriceKey(left, right *ety.Asset) []byte {
+	key := fmt.Sprintf("%s"+"lastPrice:%s:%s", KeyPrefixStateDB, left.GetSymbol(), right.GetSymbol())
+	return []byte(key)
+}
+
+//状态数据库中记录有GTH订单过期的高度列表,按高度升序排列
+func calcExpireHeightsKey() []byte {
+	return []byte(KeyPrefixStateDB + "expireHeights")
+}
+
+//状态数据库中记录在某个高度过期的GTH订单号
+func calcExpireOrdersKey(height int64) []byte {
+	key := fmt.Sprintf("%s"+"expireOrders:%d", KeyPrefixStateDB, height)
+	return []byte(key)
+}
+
 var opt_exchange_depth = &table.Option{
 	Prefix:  KeyPrefixLocalDB,
 	Name:    "depth",
@@ -50,6 +67,22 @@ var opt_exchange_history = &table.Option{
 	Index:   []string{"name", "addr_status"},
 }
 
+//等待触发的止损单,触发后从该表中删除
+var opt_exchange_stop = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "stop",
+	Primary: "orderID",
+	Index:   []string{"trigger", "addr_status"},
+}
+
+//k线,每个交易对每个周期一条记录
+var opt_exchange_kline = &table.Option{
+	Prefix:  KeyPrefixLocalDB,
+	Name:    "kline",
+	Primary: "time",
+	Index:   nil,
+}
+
 //NewMarketDepthTable 新建表
 func NewMarketDepthTable(kvdb db.KV) *table.Table {
 	rowmeta := NewMarketDepthRow()
@@ -80,6 +113,26 @@ func NewHistoryOrderTable(kvdb db.KV) *table.Table {
 	return table
 }
 
+//NewStopOrderTable ...
+func NewStopOrderTable(kvdb db.KV) *table.Table {
+	rowmeta := NewOrderRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_stop)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+//NewKLineTable ...
+func NewKLineTable(kvdb db.KV) *table.Table {
+	rowmeta := NewKLineRow()
+	table, err := table.NewTable(rowmeta, kvdb, opt_exchange_kline)
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
 //OrderRow table meta 结构
 type OrderRow struct {
 	*ety.Order
@@ -112,6 +165,8 @@ func (r *OrderRow) Get(key string) ([]byte, error) {
 		return []byte(fmt.Sprintf("%s:%s:%d:%016d", r.GetLimitOrder().LeftAsset.GetSymbol(), r.GetLimitOrder().RightAsset.GetSymbol(), r.GetLimitOrder().Op, r.GetLimitOrder().Price)), nil
 	} else if key == "addr_status" {
 		return []byte(fmt.Sprintf("%s:%d", r.Addr, r.Status)), nil
+	} else if key == "trigger" {
+		return []byte(fmt.Sprintf("%s:%s:%d:%016d", r.GetLimitOrder().LeftAsset.GetSymbol(), r.GetLimitOrder().RightAsset.GetSymbol(), r.GetLimitOrder().Op, r.GetLimitOrder().StopPrice)), nil
 	}
 	return nil, types.ErrNotFound
 }
@@ -145,7 +200,8 @@ func (m *HistoryOrderRow) Get(key string) ([]byte, error) {
 	if key == "index" {
 		return []byte(fmt.Sprintf("%022d", m.Index)), nil
 	} else if key == "name" {
-		return []byte(fmt.Sprintf("%s:%s", m.GetLimitOrder().LeftAsset.GetSymbol(), m.GetLimitOrder().RightAsset.GetSymbol())), nil
+		left, right := m.GetAssets()
+		return []byte(fmt.Sprintf("%s:%s", left.GetSymbol(), right.GetSymbol())), nil
 	} else if key == "addr_status" {
 		return []byte(fmt.Sprintf("%s:%d", m.Addr, m.Status)), nil
 	}
@@ -183,3 +239,35 @@ func (m *MarketDepthRow) Get(key string) ([]byte, error) {
 	}
 	return nil, types.ErrNotFound
 }
+
+//KLineRow table meta 结构
+type KLineRow struct {
+	*ety.KLine
+}
+
+//NewKLineRow 新建一个meta 结构
+func NewKLineRow() *KLineRow {
+	return &KLineRow{KLine: &ety.KLine{}}
+}
+
+//CreateRow ...
+func (m *KLineRow) CreateRow() *table.Row {
+	return &table.Row{Data: &ety.KLine{}}
+}
+
+//SetPayload 设置数据
+func (m *KLineRow) SetPayload(data types.Message) error {
+	if txdata, ok := data.(*ety.KLine); ok {
+		m.KLine = txdata
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+//Get 按照indexName 查询 indexValue
+func (m *KLineRow) Get(key string) ([]byte, error) {
+	if key == "time" {
+		return []byte(fmt.Sprintf("%s:%s:%d:%016d", m.LeftAsset.GetSymbol(), m.RightAsset.GetSymbol(), m.Period, m.StartTime)), nil
+	}
+	return nil, types.ErrNotFound
+}
@@ -13,6 +13,15 @@ var (
 	ErrAsset        = fmt.Errorf("%s", "The asset's execer or symbol can't be nil,The same assets cannot be exchanged!")
 	ErrCount        = fmt.Errorf("%s", "The param count can't large  20")
 	ErrDirection    = fmt.Errorf("%s", "The direction only 0 or 1!")
-	ErrStatus       = fmt.Errorf("%s", "The status only in  0 , 1, 2!")
+	ErrStatus       = fmt.Errorf("%s", "The status only in  0 , 1, 2, 3!")
 	ErrOrderID      = fmt.Errorf("%s", "Wrong OrderID!")
+	ErrFeeRate      = fmt.Errorf("%s", "The fee rate must be formatted as maker,taker and in [0, 10000]!")
+	ErrSlippage     = fmt.Errorf("%s", "The slippage only in [0, 10000]!")
+	ErrMarketDepth  = fmt.Errorf("%s", "No order in the market can be matched!")
+	ErrStopPrice    = fmt.Errorf("%s", "The stop price is not valid!")
+	ErrTimeInForce  = fmt.Errorf("%s", "The time in force is not valid!")
+	ErrExpireHeight = fmt.Errorf("%s", "The expire height only for GTH order and must be greater than current height!")
+	ErrPostOnly     = fmt.Errorf("%s", "The post-only order would be matched immediately!")
+	ErrFillOrKill   = fmt.Errorf("%s", "The fill-or-kill order can't be filled completely!")
+	ErrKLinePeriod  = fmt.Errorf("%s", "The kline period only in 60, 300, 3600, 86400!")
 )
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"reflect"
 
 	"github.com/33cn/chain33/types"
@@ -27,6 +28,7 @@ const (
 	FuncNameQueryHistoryOrderList = "QueryHistoryOrderList"
 	FuncNameQueryOrder            = "QueryOrder"
 	FuncNameQueryOrderList        = "QueryOrderList"
+	FuncNameGetKLine              = "GetKLine"
 )
 
 // log类型id值
@@ -35,6 +37,9 @@ const (
 	TyLimitOrderLog
 	TyMarketOrderLog
 	TyRevokeOrderLog
+	TyStopOrderLog
+	TyTriggerOrderLog
+	TyExpireOrderLog
 )
 
 // OP
@@ -48,6 +53,21 @@ const (
 	Ordered = iota
 	Completed
 	Revoked
+	Untriggered
+)
+
+//time in force,订单的有效方式
+const (
+	//GoodTillCancel 一直有效,直到成交或者撤单
+	GoodTillCancel = iota
+	//ImmediateOrCancel 立即成交,未成交的部分直接取消
+	ImmediateOrCancel
+	//FillOrKill 必须全部立即成交,否则交易失败
+	FillOrKill
+	//PostOnly 只做挂单,会与已有挂单成交时交易失败
+	PostOnly
+	//GoodTillHeight 在指定高度之前一直有效
+	GoodTillHeight
 )
 
 //const
@@ -62,8 +82,40 @@ const (
 	Count = int32(10)
 	//MaxMatchCount 系统最大撮合深度
 	MaxMatchCount = 100
+	//MaxTriggerCount 单笔交易最多触发的止损单数量
+	MaxTriggerCount = 10
+	//MaxExpireCount 单笔交易最多处理的过期订单数量
+	MaxExpireCount = 100
+)
+
+//ForkExchangeV2 手续费,市价委托,止损单,订单有效方式以及k线从该高度开始生效
+const ForkExchangeV2 = "ForkExchangeV2"
+
+
+//手续费相关配置,通过manage合约进行配置
+const (
+	//FeeCollectorKey 手续费收取地址配置项
+	FeeCollectorKey = "exchange-fee-collector"
+	//FeeRateKeyPrefix 交易对手续费率配置项前缀,配置值格式为"maker,taker",单位为万分之一
+	FeeRateKeyPrefix = "exchange-fee-rate-"
+	//MaxFeeRate 费率上限,即100%
+	MaxFeeRate = 10000
+)
+
+//k线周期,单位为秒
+const (
+	KLine1m = 60
+	KLine5m = 300
+	KLine1h = 3600
+	KLine1d = 86400
 )
 
+//KLinePeriods 每笔成交都会更新的k线周期
+var KLinePeriods = []int32{KLine1m, KLine5m, KLine1h, KLine1d}
+
+//MaxSlippage 市价委托的滑点上限,单位万分之一
+const MaxSlippage = 10000
+
 var (
 	//ExchangeX 执行器名称定义
 	ExchangeX = "exchange"
@@ -75,13 +127,38 @@ var (
 	}
 	//定义log的id和具体log类型及名称，填入具体自定义log类型
 	logMap = map[int64]*types.LogInfo{
-		TyLimitOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyLimitOrderLog"},
-		TyMarketOrderLog: {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyMarketOrderLog"},
-		TyRevokeOrderLog: {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyRevokeOrderLog"},
+		TyLimitOrderLog:   {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyLimitOrderLog"},
+		TyMarketOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyMarketOrderLog"},
+		TyRevokeOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyRevokeOrderLog"},
+		TyStopOrderLog:    {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyStopOrderLog"},
+		TyTriggerOrderLog: {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyTriggerOrderLog"},
+		TyExpireOrderLog:  {Ty: reflect.TypeOf(ReceiptExchange{}), Name: "TyExpireOrderLog"},
 	}
 	//tlog = log.New("module", "exchange.types")
 )
 
+//FeeRateKey 交易对手续费率配置项,由{leftExecer}.{leftSymbol}:{rightExecer}.{rightSymbol}构成
+func FeeRateKey(left, right *Asset) string {
+	return fmt.Sprintf("%s%s.%s:%s.%s", FeeRateKeyPrefix, left.GetExecer(), left.GetSymbol(), right.GetExecer(), right.GetSymbol())
+}
+
+//GetAssets 获取订单的交易对资产,兼容限价单和市价单
+func (m *Order) GetAssets() (left, right *Asset) {
+	if order := m.GetLimitOrder(); order != nil {
+		return order.GetLeftAsset(), order.GetRightAsset()
+	}
+	order := m.GetMarketOrder()
+	return order.GetLeftAsset(), order.GetRightAsset()
+}
+
+//GetOp 获取订单的买卖方向,兼容限价单和市价单
+func (m *Order) GetOp() int32 {
+	if order := m.GetLimitOrder(); order != nil {
+		return order.GetOp()
+	}
+	return m.GetMarketOrder().GetOp()
+}
+
 // init defines a register function
 func init() {
 	types.AllowUserExec = append(types.AllowUserExec, []byte(ExchangeX))
@@ -93,6 +170,7 @@ func init() {
 // InitFork defines register fork
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ExchangeX, "Enable", 0)
+	cfg.RegisterDappFork(ExchangeX, ForkExchangeV2, 10000000)
 }
 
 // InitExecutor defines register executor
@@ -168,7 +168,13 @@ type LimitOrder struct {
 	//总量
 	Amount int64 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	//操作， 1为买，2为卖
-	Op                   int32    `protobuf:"varint,5,opt,name=op,proto3" json:"op,omitempty"`
+	Op int32 `protobuf:"varint,5,opt,name=op,proto3" json:"op,omitempty"`
+	//止损触发价格,不为0时为止损限价单,最新成交价达到触发价格后才会挂单撮合
+	StopPrice int64 `protobuf:"varint,6,opt,name=stopPrice,proto3" json:"stopPrice,omitempty"`
+	//有效方式,0 GTC一直有效, 1 IOC立即成交剩余取消, 2 FOK全部成交否则失败, 3 postOnly只做挂单, 4 GTH指定高度前有效
+	TimeInForce int32 `protobuf:"varint,7,opt,name=timeInForce,proto3" json:"timeInForce,omitempty"`
+	//过期高度,仅GTH有效,超过该高度后订单会被自动撤销并退还冻结资金
+	ExpireHeight         int64    `protobuf:"varint,8,opt,name=expireHeight,proto3" json:"expireHeight,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -234,16 +240,39 @@ func (m *LimitOrder) GetOp() int32 {
 	return 0
 }
 
-//市价委托
+func (m *LimitOrder) GetStopPrice() int64 {
+	if m != nil {
+		return m.StopPrice
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetTimeInForce() int32 {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return 0
+}
+
+func (m *LimitOrder) GetExpireHeight() int64 {
+	if m != nil {
+		return m.ExpireHeight
+	}
+	return 0
+}
+
+//市价委托,按对手盘价格由优到劣依次成交,未成交的部分直接退还,不会挂单
 type MarketOrder struct {
 	//资产1
 	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
 	//资产2
 	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
-	//总量
+	//总量,买单为愿意花费的rightAsset数量,卖单为卖出的leftAsset数量
 	Amount int64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	//操作， 1为买，2为卖
-	Op                   int32    `protobuf:"varint,4,opt,name=op,proto3" json:"op,omitempty"`
+	Op int32 `protobuf:"varint,4,opt,name=op,proto3" json:"op,omitempty"`
+	//最大滑点,相对于对手盘最优价格,单位为万分之一,0表示不限制
+	Slippage             int32    `protobuf:"varint,5,opt,name=slippage,proto3" json:"slippage,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -302,6 +331,13 @@ func (m *MarketOrder) GetOp() int32 {
 	return 0
 }
 
+func (m *MarketOrder) GetSlippage() int32 {
+	if m != nil {
+		return m.Slippage
+	}
+	return 0
+}
+
 //撤回订单
 type RevokeOrder struct {
 	//订单号
@@ -406,14 +442,16 @@ type Order struct {
 	AVGPrice int64 `protobuf:"varint,6,opt,name=AVG_price,json=AVGPrice,proto3" json:"AVG_price,omitempty"`
 	//余额
 	Balance int64 `protobuf:"varint,7,opt,name=balance,proto3" json:"balance,omitempty"`
-	//状态,0 挂单中ordered， 1 完成completed， 2撤回 revoked
+	//状态,0 挂单中ordered， 1 完成completed， 2撤回 revoked， 3 等待触发的止损单untriggered
 	Status int32 `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
 	//用户地址
 	Addr string `protobuf:"bytes,9,opt,name=addr,proto3" json:"addr,omitempty"`
 	//更新时间
 	UpdateTime int64 `protobuf:"varint,10,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
 	//索引
-	Index                int64    `protobuf:"varint,11,opt,name=index,proto3" json:"index,omitempty"`
+	Index int64 `protobuf:"varint,11,opt,name=index,proto3" json:"index,omitempty"`
+	//累计支付的手续费,以成交时收到的资产计价
+	Fee                  int64    `protobuf:"varint,12,opt,name=fee,proto3" json:"fee,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -544,6 +582,13 @@ func (m *Order) GetIndex() int64 {
 	return 0
 }
 
+func (m *Order) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Order) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -877,7 +922,7 @@ type QueryOrderList struct {
 	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	//用户地址信息，必填
 	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// 主键索引
+	//主键索引
 	PrimaryKey string `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
 	//单页返回多少条记录，默认返回10条,为了系统安全最多单次只能返回20条
 	Count int32 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
@@ -996,21 +1041,287 @@ func (m *OrderList) GetPrimaryKey() string {
 	return ""
 }
 
-// exchange执行票据日志
-type ReceiptExchange struct {
-	Order                *Order   `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
-	MatchOrders          []*Order `protobuf:"bytes,2,rep,name=matchOrders,proto3" json:"matchOrders,omitempty"`
-	Index                int64    `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+//查询k线
+type QueryKLine struct {
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//k线周期,单位为秒,支持60,300,3600,86400
+	Period int32 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	//主键索引
+	PrimaryKey string `protobuf:"bytes,4,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	//单页返回多少条记录，默认返回10条,为了系统安全最多单次只能返回20条
+	Count int32 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	// 0降序，1升序，默认降序
+	Direction            int32    `protobuf:"varint,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryKLine) Reset()         { *m = QueryKLine{} }
+func (m *QueryKLine) String() string { return proto.CompactTextString(m) }
+func (*QueryKLine) ProtoMessage()    {}
+func (*QueryKLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{14}
+}
+
+func (m *QueryKLine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryKLine.Unmarshal(m, b)
+}
+func (m *QueryKLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryKLine.Marshal(b, m, deterministic)
+}
+func (m *QueryKLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKLine.Merge(m, src)
+}
+func (m *QueryKLine) XXX_Size() int {
+	return xxx_messageInfo_QueryKLine.Size(m)
+}
+func (m *QueryKLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKLine proto.InternalMessageInfo
+
+func (m *QueryKLine) GetLeftAsset() *Asset {
+	if m != nil {
+		return m.LeftAsset
+	}
+	return nil
+}
+
+func (m *QueryKLine) GetRightAsset() *Asset {
+	if m != nil {
+		return m.RightAsset
+	}
+	return nil
+}
+
+func (m *QueryKLine) GetPeriod() int32 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *QueryKLine) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *QueryKLine) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueryKLine) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+//k线,按区块时间统计交易对在一个周期内的成交情况
+type KLine struct {
+	//资产1
+	LeftAsset *Asset `protobuf:"bytes,1,opt,name=leftAsset,proto3" json:"leftAsset,omitempty"`
+	//资产2
+	RightAsset *Asset `protobuf:"bytes,2,opt,name=rightAsset,proto3" json:"rightAsset,omitempty"`
+	//k线周期,单位为秒
+	Period int32 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	//周期开始时间
+	StartTime int64 `protobuf:"varint,4,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	//开盘价
+	Open int64 `protobuf:"varint,5,opt,name=open,proto3" json:"open,omitempty"`
+	//最高价
+	High int64 `protobuf:"varint,6,opt,name=high,proto3" json:"high,omitempty"`
+	//最低价
+	Low int64 `protobuf:"varint,7,opt,name=low,proto3" json:"low,omitempty"`
+	//收盘价
+	Close int64 `protobuf:"varint,8,opt,name=close,proto3" json:"close,omitempty"`
+	//成交量,leftAsset数量
+	Volume int64 `protobuf:"varint,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	//成交额,rightAsset数量
+	Turnover int64 `protobuf:"varint,10,opt,name=turnover,proto3" json:"turnover,omitempty"`
+	//成交笔数
+	Count                int64    `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
+func (m *KLine) Reset()         { *m = KLine{} }
+func (m *KLine) String() string { return proto.CompactTextString(m) }
+func (*KLine) ProtoMessage()    {}
+func (*KLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{15}
+}
+
+func (m *KLine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KLine.Unmarshal(m, b)
+}
+func (m *KLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KLine.Marshal(b, m, deterministic)
+}
+func (m *KLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KLine.Merge(m, src)
+}
+func (m *KLine) XXX_Size() int {
+	return xxx_messageInfo_KLine.Size(m)
+}
+func (m *KLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_KLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KLine proto.InternalMessageInfo
+
+func (m *KLine) GetLeftAsset() *Asset {
+	if m != nil {
+		return m.LeftAsset
+	}
+	return nil
+}
+
+func (m *KLine) GetRightAsset() *Asset {
+	if m != nil {
+		return m.RightAsset
+	}
+	return nil
+}
+
+func (m *KLine) GetPeriod() int32 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *KLine) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *KLine) GetOpen() int64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *KLine) GetHigh() int64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *KLine) GetLow() int64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *KLine) GetClose() int64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *KLine) GetVolume() int64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *KLine) GetTurnover() int64 {
+	if m != nil {
+		return m.Turnover
+	}
+	return 0
+}
+
+func (m *KLine) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+//k线列表
+type KLineList struct {
+	List                 []*KLine `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *KLineList) Reset()         { *m = KLineList{} }
+func (m *KLineList) String() string { return proto.CompactTextString(m) }
+func (*KLineList) ProtoMessage()    {}
+func (*KLineList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{16}
+}
+
+func (m *KLineList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KLineList.Unmarshal(m, b)
+}
+func (m *KLineList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KLineList.Marshal(b, m, deterministic)
+}
+func (m *KLineList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KLineList.Merge(m, src)
+}
+func (m *KLineList) XXX_Size() int {
+	return xxx_messageInfo_KLineList.Size(m)
+}
+func (m *KLineList) XXX_DiscardUnknown() {
+	xxx_messageInfo_KLineList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KLineList proto.InternalMessageInfo
+
+func (m *KLineList) GetList() []*KLine {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+func (m *KLineList) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+// exchange执行票据日志
+type ReceiptExchange struct {
+	Order                *Order         `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	MatchOrders          []*Order       `protobuf:"bytes,2,rep,name=matchOrders,proto3" json:"matchOrders,omitempty"`
+	Index                int64          `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Fees                 []*ExchangeFee `protobuf:"bytes,4,rep,name=fees,proto3" json:"fees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
 func (m *ReceiptExchange) Reset()         { *m = ReceiptExchange{} }
 func (m *ReceiptExchange) String() string { return proto.CompactTextString(m) }
 func (*ReceiptExchange) ProtoMessage()    {}
 func (*ReceiptExchange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0328a4f16f87ea1, []int{14}
+	return fileDescriptor_e0328a4f16f87ea1, []int{17}
 }
 
 func (m *ReceiptExchange) XXX_Unmarshal(b []byte) error {
@@ -1052,6 +1363,148 @@ func (m *ReceiptExchange) GetIndex() int64 {
 	return 0
 }
 
+func (m *ReceiptExchange) GetFees() []*ExchangeFee {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+//单笔撮合产生的手续费明细
+type ExchangeFee struct {
+	//支付手续费的订单号
+	OrderID int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	//支付手续费的地址
+	Addr string `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	//手续费资产,即该订单成交时收到的资产
+	Asset *Asset `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	//手续费数额
+	Fee int64 `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	//手续费费率,单位为万分之一
+	Rate int32 `protobuf:"varint,5,opt,name=rate,proto3" json:"rate,omitempty"`
+	//是否为吃单方
+	IsTaker bool `protobuf:"varint,6,opt,name=isTaker,proto3" json:"isTaker,omitempty"`
+	//手续费收取地址
+	Collector            string   `protobuf:"bytes,7,opt,name=collector,proto3" json:"collector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExchangeFee) Reset()         { *m = ExchangeFee{} }
+func (m *ExchangeFee) String() string { return proto.CompactTextString(m) }
+func (*ExchangeFee) ProtoMessage()    {}
+func (*ExchangeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{18}
+}
+
+func (m *ExchangeFee) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeFee.Unmarshal(m, b)
+}
+func (m *ExchangeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeFee.Marshal(b, m, deterministic)
+}
+func (m *ExchangeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeFee.Merge(m, src)
+}
+func (m *ExchangeFee) XXX_Size() int {
+	return xxx_messageInfo_ExchangeFee.Size(m)
+}
+func (m *ExchangeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeFee proto.InternalMessageInfo
+
+func (m *ExchangeFee) GetOrderID() int64 {
+	if m != nil {
+		return m.OrderID
+	}
+	return 0
+}
+
+func (m *ExchangeFee) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ExchangeFee) GetAsset() *Asset {
+	if m != nil {
+		return m.Asset
+	}
+	return nil
+}
+
+func (m *ExchangeFee) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *ExchangeFee) GetRate() int32 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *ExchangeFee) GetIsTaker() bool {
+	if m != nil {
+		return m.IsTaker
+	}
+	return false
+}
+
+func (m *ExchangeFee) GetCollector() string {
+	if m != nil {
+		return m.Collector
+	}
+	return ""
+}
+
+//GTH订单的过期索引,记录有订单过期的高度列表以及每个高度过期的订单号
+type ExpireIndex struct {
+	Items                []int64  `protobuf:"varint,1,rep,packed,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExpireIndex) Reset()         { *m = ExpireIndex{} }
+func (m *ExpireIndex) String() string { return proto.CompactTextString(m) }
+func (*ExpireIndex) ProtoMessage()    {}
+func (*ExpireIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0328a4f16f87ea1, []int{19}
+}
+
+func (m *ExpireIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExpireIndex.Unmarshal(m, b)
+}
+func (m *ExpireIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExpireIndex.Marshal(b, m, deterministic)
+}
+func (m *ExpireIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpireIndex.Merge(m, src)
+}
+func (m *ExpireIndex) XXX_Size() int {
+	return xxx_messageInfo_ExpireIndex.Size(m)
+}
+func (m *ExpireIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpireIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpireIndex proto.InternalMessageInfo
+
+func (m *ExpireIndex) GetItems() []int64 {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Exchange)(nil), "types.Exchange")
 	proto.RegisterType((*ExchangeAction)(nil), "types.ExchangeAction")
@@ -1067,7 +1520,12 @@ func init() {
 	proto.RegisterType((*QueryOrder)(nil), "types.QueryOrder")
 	proto.RegisterType((*QueryOrderList)(nil), "types.QueryOrderList")
 	proto.RegisterType((*OrderList)(nil), "types.OrderList")
+	proto.RegisterType((*QueryKLine)(nil), "types.QueryKLine")
+	proto.RegisterType((*KLine)(nil), "types.KLine")
+	proto.RegisterType((*KLineList)(nil), "types.KLineList")
 	proto.RegisterType((*ReceiptExchange)(nil), "types.ReceiptExchange")
+	proto.RegisterType((*ExchangeFee)(nil), "types.ExchangeFee")
+	proto.RegisterType((*ExpireIndex)(nil), "types.ExpireIndex")
 }
 
 func init() {
@@ -1075,49 +1533,67 @@ func init() {
 }
 
 var fileDescriptor_e0328a4f16f87ea1 = []byte{
-	// 667 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdd, 0x6e, 0xd3, 0x4a,
-	0x10, 0xae, 0xed, 0x38, 0xa9, 0x27, 0x47, 0xe9, 0x39, 0xab, 0x73, 0x8e, 0x2c, 0x40, 0xa8, 0xf2,
-	0x45, 0xa9, 0x10, 0xca, 0x45, 0x2b, 0xc1, 0x75, 0x50, 0x51, 0x8b, 0x68, 0x05, 0xac, 0x50, 0x25,
-	0xae, 0x90, 0x6b, 0x0f, 0xcd, 0xaa, 0x49, 0x6c, 0xad, 0x37, 0x55, 0x2d, 0x1e, 0x82, 0x3b, 0x9e,
-	0x00, 0x5e, 0x80, 0x77, 0xe0, 0x96, 0x27, 0xe0, 0x61, 0xd0, 0xce, 0xae, 0xe3, 0x4d, 0x5b, 0xd4,
-	0x0a, 0x94, 0x3b, 0x7f, 0xf3, 0xb3, 0xfe, 0x66, 0xe6, 0x9b, 0x5d, 0x18, 0xe0, 0x45, 0x36, 0x4e,
-	0x67, 0xa7, 0x38, 0x2c, 0x65, 0xa1, 0x0a, 0x16, 0xaa, 0xba, 0xc4, 0x2a, 0x01, 0x58, 0x7f, 0x66,
-	0x1d, 0xc9, 0x77, 0x0f, 0x06, 0x0d, 0x18, 0x65, 0x4a, 0x14, 0x33, 0xb6, 0x0b, 0x30, 0x11, 0x53,
-	0xa1, 0x5e, 0xca, 0x1c, 0x65, 0xec, 0x6d, 0x7a, 0xdb, 0xfd, 0x9d, 0x7f, 0x86, 0x94, 0x3a, 0x3c,
-	0x5c, 0x38, 0x0e, 0xd6, 0xb8, 0x13, 0xc6, 0x1e, 0x43, 0x7f, 0x9a, 0xca, 0x33, 0xb4, 0x59, 0x3e,
-	0x65, 0x31, 0x9b, 0x75, 0xd4, 0x7a, 0x0e, 0xd6, 0xb8, 0x1b, 0xa8, 0xf3, 0x24, 0x9e, 0x17, 0x67,
-	0x68, 0xf2, 0x82, 0xa5, 0x3c, 0xde, 0x7a, 0x74, 0x9e, 0x13, 0xc8, 0x06, 0xe0, 0xab, 0x3a, 0xee,
-	0x6e, 0x7a, 0xdb, 0x21, 0xf7, 0x55, 0xfd, 0xb4, 0x07, 0xe1, 0x79, 0x3a, 0x99, 0x63, 0xf2, 0xd9,
-	0x03, 0x68, 0x59, 0xb2, 0x87, 0x10, 0x4d, 0xf0, 0xbd, 0x1a, 0x55, 0x15, 0x2a, 0x5b, 0xcb, 0x5f,
-	0xf6, 0xf4, 0x54, 0xdb, 0x78, 0xeb, 0x66, 0x8f, 0x00, 0xa4, 0x38, 0x1d, 0xdb, 0x60, 0xff, 0x9a,
-	0x60, 0xc7, 0xcf, 0xfe, 0x85, 0xb0, 0x94, 0x22, 0x43, 0xe2, 0x1c, 0x70, 0x03, 0xd8, 0xff, 0xd0,
-	0x4d, 0xa7, 0xc5, 0x7c, 0xa6, 0xe2, 0x0e, 0x99, 0x2d, 0xd2, 0x7c, 0x8b, 0x32, 0x0e, 0x0d, 0xdf,
-	0xa2, 0x4c, 0x3e, 0x7a, 0xd0, 0x77, 0xda, 0xb2, 0x42, 0x9e, 0x2d, 0xa3, 0xe0, 0x1a, 0x46, 0x9d,
-	0x05, 0xa3, 0x07, 0xd0, 0x77, 0xfa, 0xcd, 0x62, 0xe8, 0x15, 0xfa, 0xe3, 0xf9, 0x1e, 0xd1, 0x09,
-	0x78, 0x03, 0x93, 0x27, 0x10, 0xa6, 0xcd, 0xc9, 0x78, 0x81, 0x99, 0x15, 0x49, 0xc4, 0x2d, 0xd2,
-	0xf6, 0xaa, 0x9e, 0x9e, 0x14, 0x13, 0xe2, 0x16, 0x71, 0x8b, 0x92, 0x1f, 0x3e, 0x84, 0x37, 0x1c,
-	0x7e, 0x49, 0x7c, 0xfe, 0x6f, 0x89, 0x2f, 0xb8, 0xad, 0xf8, 0x8c, 0x88, 0x3a, 0x8d, 0x88, 0xd8,
-	0x1d, 0x58, 0xd7, 0x25, 0xcc, 0x15, 0xe6, 0x34, 0xaa, 0x80, 0x2f, 0x30, 0xbb, 0x0b, 0xd1, 0xe8,
-	0x78, 0xff, 0x9d, 0x19, 0x79, 0xd7, 0x38, 0x47, 0xc7, 0xfb, 0xaf, 0x68, 0xea, 0x31, 0xf4, 0x4e,
-	0xd2, 0x49, 0x3a, 0xcb, 0x30, 0xee, 0x99, 0x7a, 0x2c, 0xa4, 0x5e, 0xa8, 0x54, 0xcd, 0xab, 0x78,
-	0x9d, 0x7e, 0x63, 0x11, 0x63, 0xd0, 0x49, 0xf3, 0x5c, 0xc6, 0x11, 0x75, 0x88, 0xbe, 0xd9, 0x7d,
-	0x80, 0x79, 0x99, 0xa7, 0x0a, 0xdf, 0x88, 0x29, 0xc6, 0x40, 0x07, 0x39, 0x16, 0xad, 0x38, 0x31,
-	0xcb, 0xf1, 0x22, 0xee, 0x1b, 0xc5, 0x11, 0x68, 0x95, 0xff, 0xd5, 0x83, 0xbf, 0x5f, 0xcf, 0x51,
-	0xd6, 0xa6, 0xe2, 0x3d, 0x2c, 0xd5, 0x78, 0x85, 0xba, 0x32, 0xfa, 0x09, 0x1a, 0xfd, 0x68, 0xf6,
-	0xa5, 0x14, 0xd3, 0x54, 0xd6, 0x2f, 0xd0, 0x34, 0x35, 0xe2, 0x8e, 0x45, 0xb3, 0xcf, 0x48, 0x86,
-	0x66, 0x09, 0x0c, 0x48, 0xbe, 0x2c, 0xf6, 0x60, 0xd5, 0x7c, 0xff, 0x6c, 0x5f, 0xdf, 0xc2, 0x86,
-	0x43, 0xf3, 0x50, 0x54, 0x8a, 0x6d, 0x41, 0x67, 0x22, 0x2a, 0xcd, 0x32, 0xb8, 0x22, 0x37, 0x8a,
-	0xe2, 0xe4, 0xbf, 0xd4, 0x18, 0xff, 0x72, 0x63, 0x92, 0x6f, 0x1e, 0xfc, 0x47, 0x73, 0x3b, 0x10,
-	0x95, 0x2a, 0x64, 0x4d, 0xda, 0xa4, 0x3f, 0xac, 0xae, 0x19, 0xcb, 0x9c, 0x82, 0x5f, 0x0f, 0xab,
-	0xe3, 0x0c, 0x8b, 0xdd, 0x83, 0x28, 0x17, 0x12, 0xe9, 0x99, 0xb0, 0xbd, 0x69, 0x0d, 0xc9, 0x16,
-	0x00, 0x95, 0x71, 0xd3, 0xfd, 0xf1, 0xc9, 0x83, 0x41, 0x1b, 0x48, 0x85, 0xb6, 0x5b, 0xe2, 0x2d,
-	0x6d, 0x49, 0x0c, 0x3d, 0xbd, 0x19, 0x58, 0x55, 0xb6, 0x6f, 0x0d, 0x5c, 0x49, 0x01, 0x47, 0x10,
-	0xb5, 0x94, 0x36, 0x97, 0xa6, 0xdb, 0x74, 0x92, 0xfc, 0xb7, 0x9c, 0xeb, 0x07, 0xd8, 0xe0, 0x98,
-	0xa1, 0x28, 0x55, 0xf3, 0xc0, 0xb2, 0x04, 0xc2, 0xc2, 0x79, 0x55, 0x97, 0x4f, 0x35, 0x2e, 0x36,
-	0xd4, 0x97, 0x99, 0xca, 0xc6, 0x64, 0xd4, 0x75, 0x5f, 0xfd, 0xbf, 0x1b, 0xd0, 0xde, 0x0a, 0x81,
-	0x73, 0x2b, 0xec, 0x80, 0xbe, 0xca, 0xcc, 0x5f, 0x4f, 0xba, 0xf4, 0xfa, 0xef, 0xfe, 0x0c, 0x00,
-	0x00, 0xff, 0xff, 0xf2, 0x3c, 0xac, 0xed, 0x0f, 0x08, 0x00, 0x00,
+	// 952 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x6e, 0x1c, 0x45,
+	0x10, 0xce, 0xfc, 0xed, 0xee, 0xd4, 0x5a, 0x4e, 0x68, 0x01, 0x1a, 0x01, 0x8a, 0xac, 0x41, 0x32,
+	0x11, 0x42, 0x3e, 0x24, 0x12, 0x9c, 0x8d, 0x42, 0x62, 0x2b, 0x8e, 0x80, 0x56, 0x14, 0x89, 0x13,
+	0x1a, 0xcf, 0x96, 0xbd, 0x2d, 0xcf, 0x4c, 0x8f, 0x7a, 0x7a, 0x8d, 0xf7, 0x0d, 0x78, 0x02, 0x5e,
+	0x00, 0x71, 0xe2, 0x04, 0x77, 0x8e, 0x5c, 0x39, 0xf0, 0x20, 0x3c, 0x03, 0xea, 0xea, 0x9e, 0x9d,
+	0x1e, 0x27, 0x24, 0x56, 0xd0, 0x2a, 0xb7, 0xfe, 0xaa, 0xba, 0x66, 0xaa, 0xbe, 0xfe, 0xba, 0xaa,
+	0x61, 0x17, 0xaf, 0xca, 0x65, 0xd1, 0x9c, 0xe3, 0x41, 0xab, 0xa4, 0x96, 0x2c, 0xd1, 0xeb, 0x16,
+	0xbb, 0x1c, 0x60, 0xf6, 0x95, 0x73, 0xe4, 0x7f, 0x05, 0xb0, 0xdb, 0x83, 0xc3, 0x52, 0x0b, 0xd9,
+	0xb0, 0x07, 0x00, 0x95, 0xa8, 0x85, 0xfe, 0x5a, 0x2d, 0x50, 0x65, 0xc1, 0x5e, 0x70, 0x6f, 0x7e,
+	0xff, 0x9d, 0x03, 0x0a, 0x3d, 0x38, 0xd9, 0x38, 0x8e, 0x6e, 0x71, 0x6f, 0x1b, 0xfb, 0x1c, 0xe6,
+	0x75, 0xa1, 0x2e, 0xd0, 0x45, 0x85, 0x14, 0xc5, 0x5c, 0xd4, 0xd3, 0xc1, 0x73, 0x74, 0x8b, 0xfb,
+	0x1b, 0x4d, 0x9c, 0xc2, 0x4b, 0x79, 0x81, 0x36, 0x2e, 0x1a, 0xc5, 0xf1, 0xc1, 0x63, 0xe2, 0xbc,
+	0x8d, 0x6c, 0x17, 0x42, 0xbd, 0xce, 0x26, 0x7b, 0xc1, 0xbd, 0x84, 0x87, 0x7a, 0xfd, 0xe5, 0x14,
+	0x92, 0xcb, 0xa2, 0x5a, 0x61, 0xfe, 0x63, 0x08, 0x30, 0x64, 0xc9, 0x3e, 0x85, 0xb4, 0xc2, 0x33,
+	0x7d, 0xd8, 0x75, 0xa8, 0x5d, 0x2d, 0x3b, 0xee, 0xeb, 0x85, 0xb1, 0xf1, 0xc1, 0xcd, 0x3e, 0x03,
+	0x50, 0xe2, 0x7c, 0xe9, 0x36, 0x87, 0x2f, 0xd9, 0xec, 0xf9, 0xd9, 0xbb, 0x90, 0xb4, 0x4a, 0x94,
+	0x48, 0x39, 0x47, 0xdc, 0x02, 0xf6, 0x3e, 0x4c, 0x8a, 0x5a, 0xae, 0x1a, 0x9d, 0xc5, 0x64, 0x76,
+	0xc8, 0xe4, 0x2b, 0xdb, 0x2c, 0xb1, 0xf9, 0xca, 0x96, 0x7d, 0x04, 0x69, 0xa7, 0x65, 0xfb, 0x0d,
+	0x7d, 0x61, 0x42, 0x5b, 0x07, 0x03, 0xdb, 0x83, 0xb9, 0x16, 0x35, 0x1e, 0x37, 0x8f, 0xa4, 0x2a,
+	0x31, 0x9b, 0x52, 0x98, 0x6f, 0x62, 0x39, 0xec, 0xe0, 0x55, 0x2b, 0x14, 0x1e, 0xa1, 0x49, 0x29,
+	0x9b, 0xd1, 0x27, 0x46, 0xb6, 0xfc, 0xd7, 0x00, 0xe6, 0x1e, 0xf5, 0x5b, 0xe4, 0x62, 0xa8, 0x3a,
+	0x7a, 0x49, 0xd5, 0xf1, 0xa6, 0xea, 0x0f, 0x60, 0xd6, 0x55, 0xa2, 0x6d, 0x8b, 0x73, 0x74, 0x5c,
+	0x6c, 0x70, 0xfe, 0x09, 0xcc, 0xbd, 0xf3, 0x66, 0x19, 0x4c, 0xa5, 0x59, 0x1c, 0x3f, 0xa4, 0x54,
+	0x23, 0xde, 0xc3, 0xfc, 0x0b, 0x48, 0x8a, 0xfe, 0xaf, 0x78, 0x85, 0xa5, 0x13, 0x69, 0xca, 0x1d,
+	0x32, 0xf6, 0x6e, 0x5d, 0x9f, 0xca, 0x8a, 0xf2, 0x4e, 0xb9, 0x43, 0xf9, 0x3f, 0x21, 0x24, 0xaf,
+	0xf9, 0xf8, 0x35, 0xf1, 0x87, 0x6f, 0x24, 0xfe, 0xe8, 0xa6, 0xe2, 0xb7, 0x22, 0x8e, 0x7b, 0x11,
+	0x1b, 0x7a, 0x4c, 0x09, 0x2b, 0x8d, 0x0b, 0xa2, 0x27, 0xe2, 0x1b, 0xcc, 0x3e, 0x84, 0xf4, 0xf0,
+	0xf9, 0xe3, 0xef, 0x5b, 0x4f, 0x30, 0xb3, 0xc3, 0xe7, 0x8f, 0xad, 0x5e, 0x32, 0x98, 0x9e, 0x16,
+	0x55, 0xd1, 0x38, 0xad, 0x44, 0xbc, 0x87, 0xc4, 0x85, 0x2e, 0xf4, 0xaa, 0x23, 0x85, 0x24, 0xdc,
+	0x21, 0xc6, 0x20, 0x2e, 0x16, 0x0b, 0x95, 0xa5, 0xc4, 0x10, 0xad, 0xd9, 0x5d, 0x80, 0x55, 0xbb,
+	0x28, 0x34, 0x3e, 0x13, 0x35, 0x66, 0x40, 0x1f, 0xf2, 0x2c, 0x46, 0xf1, 0xa2, 0x59, 0xe0, 0x55,
+	0x36, 0xb7, 0x8a, 0x27, 0xc0, 0xee, 0x40, 0x74, 0x86, 0x98, 0xed, 0x90, 0xcd, 0x2c, 0x87, 0xbb,
+	0xf8, 0x7b, 0x00, 0x77, 0xbe, 0x5d, 0xa1, 0x5a, 0x5b, 0x0e, 0x1e, 0x62, 0xab, 0x97, 0x5b, 0x54,
+	0xa1, 0x55, 0x5b, 0xb4, 0x51, 0xdb, 0x5d, 0x80, 0x56, 0x89, 0xba, 0x50, 0xeb, 0x27, 0x68, 0x69,
+	0x4e, 0xb9, 0x67, 0x31, 0xf5, 0x94, 0x24, 0x5a, 0x2b, 0x45, 0x0b, 0xf2, 0x5f, 0x36, 0xb7, 0x66,
+	0xdb, 0xf9, 0xfe, 0xaf, 0x0e, 0x92, 0x7f, 0x07, 0xb7, 0xbd, 0x34, 0x4f, 0x44, 0xa7, 0xd9, 0x3e,
+	0xc4, 0x95, 0xe8, 0x4c, 0x96, 0xd1, 0x0b, 0x02, 0xa4, 0x5d, 0x9c, 0xfc, 0xd7, 0x88, 0x09, 0xaf,
+	0x13, 0x93, 0xff, 0x19, 0xc0, 0x7b, 0x74, 0x6e, 0x47, 0xa2, 0xd3, 0x52, 0xad, 0x49, 0xad, 0xf4,
+	0x87, 0xed, 0x91, 0x31, 0xce, 0x29, 0xfa, 0xef, 0xc3, 0x8a, 0xbd, 0xc3, 0x32, 0x6d, 0x74, 0x21,
+	0x14, 0xd2, 0xe0, 0x72, 0xdc, 0x0c, 0x86, 0x7c, 0x1f, 0x80, 0xca, 0x78, 0x5d, 0x47, 0xf9, 0x29,
+	0x80, 0xdd, 0x61, 0x23, 0x15, 0x3a, 0xdc, 0x9b, 0x60, 0x74, 0x6f, 0x32, 0x98, 0x9a, 0xbb, 0x82,
+	0x5d, 0xe7, 0x78, 0xeb, 0xe1, 0x56, 0x0a, 0x78, 0x0a, 0xe9, 0x90, 0xd2, 0xde, 0xe8, 0x74, 0x7b,
+	0x26, 0xc9, 0x7f, 0xc3, 0x73, 0xfd, 0x3b, 0x70, 0x84, 0x3c, 0x39, 0x11, 0x0d, 0x6e, 0x77, 0x1e,
+	0xb4, 0xa8, 0x84, 0x5c, 0xb8, 0xdb, 0xe8, 0xd0, 0x9b, 0xdd, 0xc8, 0x31, 0x47, 0x93, 0xeb, 0x1c,
+	0xfd, 0x16, 0x42, 0xf2, 0xb6, 0xea, 0xa1, 0x29, 0x5e, 0x28, 0x4d, 0x0d, 0x33, 0xee, 0xa7, 0xb8,
+	0x33, 0x98, 0x1e, 0x2b, 0x5b, 0x6c, 0x5c, 0x2b, 0xa7, 0xb5, 0xb1, 0x2d, 0xc5, 0xf9, 0xd2, 0x75,
+	0x70, 0x5a, 0x9b, 0x0e, 0x5a, 0xc9, 0x1f, 0x5c, 0xe7, 0x36, 0x4b, 0xe2, 0xa1, 0x92, 0x1d, 0xba,
+	0xb1, 0x6e, 0x81, 0xc9, 0xe2, 0x52, 0x56, 0xab, 0x1a, 0xa9, 0x6b, 0x47, 0xdc, 0x21, 0x33, 0x36,
+	0xf4, 0x4a, 0x35, 0xf2, 0x12, 0x95, 0xeb, 0xda, 0x1b, 0x3c, 0x30, 0xea, 0x7a, 0x36, 0x01, 0xa3,
+	0x2b, 0xa2, 0xec, 0x15, 0xba, 0x22, 0xff, 0x0d, 0x75, 0xf5, 0x73, 0x00, 0xb7, 0x39, 0x96, 0x28,
+	0x5a, 0xdd, 0xbf, 0x25, 0x59, 0x0e, 0x89, 0xf4, 0x1e, 0x90, 0x63, 0xb9, 0x5a, 0x17, 0x3b, 0x30,
+	0x73, 0x53, 0x97, 0x4b, 0x32, 0x9a, 0x0b, 0xf5, 0xa2, 0xb0, 0xfd, 0x0d, 0xc3, 0x00, 0x8a, 0xfc,
+	0x01, 0xb4, 0x0f, 0xf1, 0x19, 0x62, 0x97, 0xc5, 0xa3, 0xae, 0xd7, 0x27, 0xf2, 0x08, 0x91, 0x93,
+	0x3f, 0xff, 0x23, 0x80, 0xb9, 0x67, 0x7d, 0xc5, 0x23, 0xa0, 0x1f, 0x8e, 0xa1, 0x37, 0x1c, 0x73,
+	0xf7, 0xea, 0x70, 0xd3, 0x7d, 0xac, 0x15, 0xeb, 0xea, 0x47, 0x61, 0xbc, 0x19, 0x85, 0xe6, 0x4b,
+	0xaa, 0xd0, 0xfd, 0x63, 0x87, 0xd6, 0xe6, 0xbf, 0xa2, 0x7b, 0x56, 0x5c, 0xa0, 0x22, 0x15, 0xcc,
+	0x78, 0x0f, 0x8d, 0x9c, 0x4a, 0x59, 0x55, 0x58, 0x6a, 0xa9, 0x48, 0x0e, 0x29, 0x1f, 0x0c, 0xf9,
+	0xc7, 0x26, 0x7d, 0xf3, 0xbc, 0x3b, 0xa6, 0xb2, 0x0d, 0x19, 0x1a, 0xeb, 0x8e, 0xce, 0x2d, 0xe2,
+	0x16, 0xdc, 0x07, 0xf3, 0x84, 0xb0, 0x35, 0x9e, 0x4e, 0xe8, 0xd5, 0xff, 0xe0, 0xdf, 0x01, 0x00,
+	0x2e, 0x11, 0xa2, 0x13, 0x07, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
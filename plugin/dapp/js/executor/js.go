@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/33cn/chain33/common"
 	drivers "github.com/33cn/chain33/system/dapp"
@@ -122,7 +123,7 @@ func (u *js) callVM(prefix string, payload *jsproto.Call, tx *types.Transaction,
 	}
 	vm.Set("args", payload.Args)
 	callfunc := "callcode(context, f, args, loglist)"
-	jsvalue, err := vm.Run(callfunc)
+	jsvalue, err := u.runVM(vm, callfunc, prefix, tx)
 	//除非你知道怎么做，不要返回这样的操作，这会引起整个区块执行失败，从而引起严重的安全问题。
 	//要保证不能人工的创造这样的条件，也就是调用接口的输入，不能用户可以任意修改的。
 	if u.GetExecutorAPI().IsErr() {
@@ -144,6 +145,82 @@ func (u *js) callVM(prefix string, payload *jsproto.Call, tx *types.Transaction,
 	return jsvalue.Object(), nil
 }
 
+// 执行合约代码，分叉之后交易执行的步数上限由交易费决定，查询另外限制执行时间
+func (u *js) runVM(vm *otto.Otto, src interface{}, prefix string, tx *types.Transaction) (otto.Value, error) {
+	cfg := u.GetAPI().GetConfig()
+	if !cfg.IsDappFork(u.GetHeight(), ptypes.JsX, ptypes.ForkJsStepLimit) {
+		return vm.Run(src)
+	}
+	maxSteps := int64(ptypes.MaxSteps)
+	var timeout time.Duration
+	switch prefix {
+	case "init", "exec":
+		if tx.Fee/ptypes.StepPrice < maxSteps {
+			maxSteps = tx.Fee / ptypes.StepPrice
+		}
+	case "query":
+		//超时和节点的性能有关，不能用于交易的执行
+		timeout = ptypes.QueryTimeout * time.Second
+	}
+	return runWithLimit(vm, src, maxSteps, timeout)
+}
+
+// 通过 otto 的中断通道计算执行的步数，otto 每执行一个语句或表达式会从通道中取出一个函数执行，
+// 这个函数计数后再把自己放回通道，超过步数上限或超时时中断执行
+func runWithLimit(vm *otto.Otto, src interface{}, maxSteps int64, timeout time.Duration) (value otto.Value, err error) {
+	var steps int64
+	var expired int32
+	var step func()
+	step = func() {
+		steps++
+		if steps > maxSteps {
+			panic(ptypes.ErrJsStepLimit)
+		}
+		if atomic.LoadInt32(&expired) == 1 {
+			panic(ptypes.ErrJsTimeout)
+		}
+		vm.Interrupt <- step
+	}
+	vm.Interrupt = make(chan func(), 1)
+	vm.Interrupt <- step
+	if timeout > 0 {
+		timer := time.AfterFunc(timeout, func() {
+			atomic.StoreInt32(&expired, 1)
+		})
+		defer timer.Stop()
+	}
+	defer func() {
+		vm.Interrupt = nil
+		if caught := recover(); caught != nil {
+			if caught == ptypes.ErrJsStepLimit || caught == ptypes.ErrJsTimeout {
+				value, err = otto.UndefinedValue(), caught.(error)
+				return
+			}
+			panic(caught)
+		}
+	}()
+	return vm.Run(src)
+}
+
+// 分叉之后禁用结果不确定的全局对象，合约可以通过 context 获取区块时间，通过 randnum 获取随机数
+func (u *js) disableNondeterminism(vm *otto.Otto) error {
+	cfg := u.GetAPI().GetConfig()
+	if !cfg.IsDappFork(u.GetHeight(), ptypes.JsX, ptypes.ForkJsStepLimit) {
+		return nil
+	}
+	err := vm.Set("Date", otto.UndefinedValue())
+	if err != nil {
+		return err
+	}
+	math, err := vm.Get("Math")
+	if err != nil {
+		return err
+	}
+	return math.Object().Set("random", func(call otto.FunctionCall) otto.Value {
+		panic(vm.MakeCustomError("Error", "Math.random is not allowed, use randnum instead"))
+	})
+}
+
 type jslogInfo struct {
 	Log    string `json:"log"`
 	Ty     int32  `json:"ty"`
@@ -306,10 +383,20 @@ func (u *js) createVM(name string, tx *types.Transaction, index int) (*otto.Otto
 		}
 		//cache 合约代码部分，不会cache 具体执行
 		cachevm := basevm.Copy()
-		cachevm.Run(code)
+		if err := u.disableNondeterminism(cachevm); err != nil {
+			return nil, err
+		}
+		//合约代码的其它错误在调用函数时返回
+		if _, err := u.runVM(cachevm, code, "load", nil); err == ptypes.ErrJsStepLimit {
+			return nil, err
+		}
 		codecache.Add(name, cachevm)
 		vm = cachevm.Copy()
 	}
+	//缓存的代码可能是分叉之前加载的
+	if err := u.disableNondeterminism(vm); err != nil {
+		return nil, err
+	}
 	vm.Set("context", string(data))
 	u.statedbFunc(vm, name)
 	u.localdbFunc(vm, name)
@@ -60,7 +60,7 @@ func createCodeTx(name, jscode string) (*jsproto.Create, *types.Transaction) {
 		Code: jscode,
 		Name: name,
 	}
-	return data, &types.Transaction{Execer: []byte(ptypes.JsX), Payload: types.Encode(data)}
+	return data, &types.Transaction{Execer: []byte(ptypes.JsX), Payload: types.Encode(data), Fee: 1e6}
 }
 
 func callCodeTx(name, f, args string) (*jsproto.Call, *types.Transaction) {
@@ -69,7 +69,7 @@ func callCodeTx(name, f, args string) (*jsproto.Call, *types.Transaction) {
 		Name:     name,
 		Args:     args,
 	}
-	return data, &types.Transaction{Execer: []byte("user." + ptypes.JsX + "." + name), Payload: types.Encode(data)}
+	return data, &types.Transaction{Execer: []byte("user." + ptypes.JsX + "." + name), Payload: types.Encode(data), Fee: 1e6}
 }
 
 func TestCallcode(t *testing.T) {
@@ -148,6 +148,55 @@ func TestCallError(t *testing.T) {
 	assert.Equal(t, true, strings.Contains(err.Error(), ptypes.ErrFuncNotFound.Error()))
 }
 
+func TestStepLimit(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	code := jscode + `
+Exec.prototype.loop = function(args) {
+    while (true) {}
+}
+
+Exec.prototype.random = function(args) {
+    return Math.random()
+}
+
+Exec.prototype.date = function(args) {
+    return new Date()
+}
+`
+	//其它测试缓存了同名合约的代码
+	codecache.Purge()
+	defer codecache.Purge()
+	e := initExec(ldb, kvdb, code, t)
+	//步数上限由交易费决定
+	call, tx := callCodeTx("test", "loop", "")
+	tx.Fee = 1e4
+	_, err := e.Exec_Call(call, tx, 0)
+	assert.Equal(t, ptypes.ErrJsStepLimit, err)
+	call, tx = callCodeTx("test", "hello", `{"hello":"world"}`)
+	tx.Fee = 10
+	_, err = e.Exec_Call(call, tx, 0)
+	assert.Equal(t, ptypes.ErrJsStepLimit, err)
+
+	//禁用不确定的全局对象
+	call, tx = callCodeTx("test", "random", "")
+	_, err = e.Exec_Call(call, tx, 0)
+	assert.True(t, strings.Contains(err.Error(), "Math.random is not allowed"))
+	call, tx = callCodeTx("test", "date", "")
+	_, err = e.Exec_Call(call, tx, 0)
+	assert.NotNil(t, err)
+
+	//查询超时
+	vm := otto.New()
+	_, err = runWithLimit(vm, "while (true) {}", ptypes.MaxSteps, 100*time.Millisecond)
+	assert.Equal(t, ptypes.ErrJsTimeout, err)
+	assert.Nil(t, vm.Interrupt)
+	value, err := runWithLimit(vm, "1 + 1", ptypes.MaxSteps, time.Second)
+	assert.Nil(t, err)
+	n, _ := value.ToInteger()
+	assert.Equal(t, int64(2), n)
+}
+
 //数字非常大的数字的处理
 func TestBigInt(t *testing.T) {
 	dir, ldb, kvdb := util.CreateTestDB()
@@ -22,6 +22,19 @@ const (
 // JsCreator 配置项 创建js合约的管理员
 const JsCreator = "js-creator"
 
+// ForkJsStepLimit 限制合约执行的步数，并禁用 Date 和 Math.random
+const ForkJsStepLimit = "ForkJsStepLimit"
+
+//合约执行的限制，每执行一个语句或表达式计一步
+const (
+	//StepPrice 交易执行合约每一步消耗的手续费，步数上限为交易费除以StepPrice
+	StepPrice = 1
+	//MaxSteps 执行一次合约的最大步数
+	MaxSteps = 1e7
+	//QueryTimeout 查询合约的超时时间(秒)，只用于查询，不影响交易执行的结果
+	QueryTimeout = 10
+)
+
 var (
 	typeMap = map[string]int32{
 		"Create": jsActionCreate,
@@ -52,6 +65,10 @@ var (
 	ErrDBType       = errors.New("chain33.js: ErrDBType")
 	// ErrJsCreator
 	ErrJsCreator = errors.New("ErrJsCreator")
+	//ErrJsStepLimit 合约执行的步数超过上限
+	ErrJsStepLimit = errors.New("chain33.js: step limit exceeded")
+	//ErrJsTimeout 合约执行超时
+	ErrJsTimeout = errors.New("chain33.js: execution timeout")
 )
 
 func init() {
@@ -63,6 +80,7 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(JsX, "Enable", 0)
+	cfg.RegisterDappFork(JsX, ForkJsStepLimit, 10000000)
 }
 
 //InitExecutor ...
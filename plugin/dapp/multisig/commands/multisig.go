@@ -12,6 +12,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -45,6 +46,7 @@ func MultiSigAccountCmd() *cobra.Command {
 		CreateMultiSigAccCreateCmd(),
 		CreateMultiSigAccWeightModifyCmd(),
 		CreateMultiSigAccDailyLimitModifyCmd(),
+		CreateMultiSigAccExecDelayModifyCmd(),
 		GetMultiSigAccCountCmd(),
 		GetMultiSigAccountsCmd(),
 		GetMultiSigAccountInfoCmd(),
@@ -81,6 +83,9 @@ func MultiSigTxCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		CreateMultiSigConfirmTxCmd(),
+		CreateMultiSigExecuteTxCmd(),
+		CreateMultiSigCancelTxCmd(),
+		CreateMultiSigSubmitTxCmd(),
 		CreateMultiSigAccTransferInCmd(),
 		CreateMultiSigAccTransferOutCmd(),
 		GetMultiSigAccTxCountCmd(),
@@ -121,6 +126,8 @@ func createMultiSigAccTransferFlags(cmd *cobra.Command) {
 
 	cmd.Flags().Float64P("daily_limit", "d", 0, "daily_limit of assets ")
 	cmd.MarkFlagRequired("daily_limit")
+
+	cmd.Flags().Uint64P("exec_delay", "l", 0, "blocks to wait before a confirmed tx can be executed, 0 means execute immediately")
 }
 
 func createMultiSigAccTransfer(cmd *cobra.Command, args []string) {
@@ -185,10 +192,13 @@ func createMultiSigAccTransfer(cmd *cobra.Command, args []string) {
 		DailyLimit: uint64(math.Trunc((dailylimit+0.0000001)*1e4)) * 1e4,
 	}
 
+	execDelay, _ := cmd.Flags().GetUint64("exec_delay")
+
 	params := &mty.MultiSigAccCreate{
 		Owners:         owners,
 		RequiredWeight: requiredweight,
 		DailyLimit:     symboldailylimit,
+		ExecDelay:      execDelay,
 	}
 	var res string
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccCreateTx", params, &res)
@@ -433,6 +443,39 @@ func createMultiSigAccDailyLimitModifyTransfer(cmd *cobra.Command, args []string
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigAccExecDelayModifyCmd create raw MultiSigAccExecDelayModify transaction
+func CreateMultiSigAccExecDelayModifyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec_delay",
+		Short: "Create a modify exec delay transaction",
+		Run:   createMultiSigAccExecDelayModifyTransfer,
+	}
+	createMultiSigAccExecDelayModifyTransferFlags(cmd)
+	return cmd
+}
+
+func createMultiSigAccExecDelayModifyTransferFlags(cmd *cobra.Command) {
+
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+	cmd.Flags().Uint64P("delay", "l", 0, "new exec delay in blocks, 0 means execute immediately")
+}
+
+func createMultiSigAccExecDelayModifyTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	delay, _ := cmd.Flags().GetUint64("delay")
+
+	params := &mty.MultiSigAccOperate{
+		MultiSigAccAddr: multiSigAddr,
+		NewExecDelay:    delay,
+		ExecDelayOp:     true,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigAccOperateTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
 // CreateMultiSigConfirmTxCmd create raw MultiSigConfirmTxCmd transaction
 func CreateMultiSigConfirmTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -478,6 +521,104 @@ func createMultiSigConfirmTransfer(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateMultiSigExecuteTxCmd create raw MultiSigExecute transaction
+func CreateMultiSigExecuteTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute",
+		Short: "Create a transaction to execute a confirmed tx after its exec delay",
+		Run:   createMultiSigExecuteTransfer,
+	}
+	createMultiSigTxidFlags(cmd)
+	return cmd
+}
+
+func createMultiSigExecuteTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	params := &mty.MultiSigExecute{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigExecuteTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigCancelTxCmd create raw MultiSigCancelTx transaction
+func CreateMultiSigCancelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Create a transaction to cancel a pending tx",
+		Run:   createMultiSigCancelTransfer,
+	}
+	createMultiSigTxidFlags(cmd)
+	return cmd
+}
+
+func createMultiSigCancelTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+
+	params := &mty.MultiSigCancelTx{
+		MultiSigAccAddr: multiSigAddr,
+		TxId:            txid,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigCancelTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateMultiSigSubmitTxCmd create raw MultiSigSubmitTx transaction
+func CreateMultiSigSubmitTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Create a transaction to submit a payload of other executor",
+		Run:   createMultiSigSubmitTransfer,
+	}
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().StringP("execer", "e", "", "target executor of the payload")
+	cmd.MarkFlagRequired("execer")
+
+	cmd.Flags().StringP("payload", "p", "", "hex encoded payload executed by the target executor")
+	cmd.MarkFlagRequired("payload")
+	return cmd
+}
+
+func createMultiSigSubmitTransfer(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	execer, _ := cmd.Flags().GetString("execer")
+	payload, _ := cmd.Flags().GetString("payload")
+
+	payloadByte, err := common.FromHex(payload)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := &mty.MultiSigSubmitTx{
+		MultiSigAccAddr: multiSigAddr,
+		Execer:          execer,
+		Payload:         payloadByte,
+	}
+	var res string
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "multisig.MultiSigSubmitTx", params, &res)
+	ctx.RunWithoutMarshal()
+}
+
+func createMultiSigTxidFlags(cmd *cobra.Command) {
+
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().Uint64P("txid", "i", 0, "txid of  multisig transaction")
+	cmd.MarkFlagRequired("txid")
+}
+
 // CreateMultiSigAccTransferInCmd create raw MultiSigAccTransferInCmd transaction
 func CreateMultiSigAccTransferInCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -718,6 +859,7 @@ func parseAccInfo(view interface{}) (interface{}, error) {
 		DailyLimits:    dailyLimitResults,
 		TxCount:        res.TxCount,
 		RequiredWeight: res.RequiredWeight,
+		ExecDelay:      res.ExecDelay,
 	}
 
 	return result, nil
@@ -783,6 +925,8 @@ func getMultiSigTxidsCmdFlags(cmd *cobra.Command) {
 
 	cmd.Flags().StringP("executed", "x", "t", "whether executed tx (0/f/false for No; 1/t/true for Yes)")
 
+	cmd.Flags().StringP("cancelled", "c", "f", "whether cancelled tx (0/f/false for No; 1/t/true for Yes)")
+
 }
 
 func getMultiSigTxids(cmd *cobra.Command, args []string) {
@@ -810,12 +954,20 @@ func getMultiSigTxids(cmd *cobra.Command, args []string) {
 		return
 	}
 
+	cancelled, _ := cmd.Flags().GetString("cancelled")
+	cancelledBool, err := strconv.ParseBool(cancelled)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
 	req := mty.ReqMultiSigTxids{
 		MultiSigAddr: addr,
 		FromTxId:     start,
 		ToTxId:       end,
 		Pending:      pendingBool,
 		Executed:     executedBool,
+		Cancelled:    cancelledBool,
 	}
 
 	var params rpctypes.Query4Jrpc
@@ -15,6 +15,19 @@ package multisig
 
 账户交易的确认和撤销：当交易提交者的权重不能满足权重要求时，需要其余的owner来一起确认。owner可以撤销自己对某笔交易的确认，但此交易必须是没有被执行。已执行的不应许撤销
 
+执行延迟：账户可以设置执行延迟的区块数，交易的确认权重达到要求后不会立即执行，需要等待执行延迟之后由owner发送execute交易执行；
+		 超过每日限额的转出以及账户属性的修改都需要等待执行延迟，执行之前任意owner都可以取消此交易，被取消的交易不能再确认和执行
+
+提交其他执行器的交易(MultiSigSubmitTx)：owner提交目标执行器和payload，合约只记录payload的哈希。
+		 权重满足并且过了执行延迟之后交易被标记为已执行，不使用每日限额，相当于多重签名账户签发的一次性授权。
+		 chain33中交易的发起者由签名公钥计算，多重签名地址没有对应的公钥，本合约无法直接以多重签名地址调用其他执行器；
+		 目标执行器收到owner发送的携带此txid和payload的交易后，通过executor.UseSubmitTx校验授权，
+		 然后以多重签名地址作为发起者执行payload，并在自己的状态数据库中记录已使用的txid，防止重复执行。
+		 提交时的执行器名字不带平行链前缀，目前支持的执行器：
+			evm: EVMContractAction中的delegate，以多重签名地址调用合约
+			token: TokenMultiSigExec，多重签名地址作为token的owner增发、销毁、修改属性以及设置转账限制
+			paracross: MultiSigExec，多重签名地址作为超级管理员配置节点和节点组
+
 多重签名账户的转入和转出：转入时，to地址必须是多重签名地址，from地址必须是非多重签名地址；
 					 转出时，from地址必须是多重签名地址，to地址必须是非多重签名地址； 传出交易需要校验权重
 
@@ -33,6 +46,7 @@ Available Commands:
   create      Create a multisig account transaction
   creator     get all multisig accounts created by the address
   dailylimit  Create a modify assets dailylimit transaction
+  exec_delay  Create a modify exec delay transaction
   info        get multisig account info
   owner       get multisig accounts by the owner
   unspent     get assets unspent today amount
@@ -47,9 +61,11 @@ Available Commands:
 
 cli multisig  tx
 Available Commands:
+  cancel           Create a transaction to cancel a pending tx
   confirm          Create a confirm transaction
   confirmed_weight get the weight of the transaction confirmed.
   count            get multisig tx count
+  execute          Create a transaction to execute a confirmed tx after its exec delay
   info             get multisig account tx info
   transfer_in      Create a transfer to multisig account transaction
   transfer_out     Create a transfer from multisig account transaction
@@ -9,6 +9,7 @@ import (
 
 	"github.com/33cn/chain33/account"
 	"github.com/33cn/chain33/client"
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
@@ -69,12 +70,17 @@ func (a *action) MultiSigAccCreate(accountCreate *mty.MultiSigAccCreate) (*types
 	if ownerCount > mty.MaxOwnersCount {
 		return nil, mty.ErrMaxOwnerCount
 	}
+	//执行延迟在分叉之后才支持
+	if accountCreate.ExecDelay > 0 && !a.isTimelockFork() {
+		return nil, types.ErrActionNotSupport
+	}
 
 	multiSigAccount := &mty.MultiSig{}
 	multiSigAccount.CreateAddr = a.fromaddr
 	multiSigAccount.Owners = accountCreate.Owners
 	multiSigAccount.TxCount = 0
 	multiSigAccount.RequiredWeight = accountCreate.RequiredWeight
+	multiSigAccount.ExecDelay = accountCreate.ExecDelay
 
 	//获取资产的每日限额设置
 	if accountCreate.DailyLimit != nil {
@@ -138,8 +144,12 @@ func (a *action) MultiSigAccOperate(AccountOperate *mty.MultiSigAccOperate) (*ty
 		return nil, mty.ErrIsNotOwner
 	}
 
+	//执行延迟的修改在分叉之后才支持
+	if AccountOperate.ExecDelayOp && !a.isTimelockFork() {
+		return nil, types.ErrActionNotSupport
+	}
 	//dailylimit每日限额属性的修改需要校验assets资产的合法性
-	if !AccountOperate.OperateFlag {
+	if !AccountOperate.ExecDelayOp && !AccountOperate.OperateFlag {
 		execer := AccountOperate.DailyLimit.Execer
 		symbol := AccountOperate.DailyLimit.Symbol
 		err := mty.IsAssetsInvalid(execer, symbol)
@@ -330,10 +340,13 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 		multisiglog.Error("MultiSigConfirmTx:getMultiSigAccTxFromDb", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "err", err)
 		return nil, mty.ErrTxidNotExist
 	}
-	//已经被执行的交易不可以再确认/撤销
+	//已经被执行或者取消的交易不可以再确认/撤销
 	if multiSigTx.Executed {
 		return nil, mty.ErrTxHasExecuted
 	}
+	if multiSigTx.Cancelled {
+		return nil, mty.ErrTxHasCancelled
+	}
 	//此owneraddr是否已经确认过此txid对应的交易
 	findindex, exist := isOwnerConfirmedTx(multiSigTx, owneraddr)
 
@@ -359,6 +372,11 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	multiSigTxOwner := &mty.MultiSigTxOwner{MultiSigAddr: multiSigAccAddr, Txid: ConfirmTx.TxId, ConfirmedOwner: owner}
 	isConfirm := isConfirmed(multiSigAcc.RequiredWeight, multiSigTx)
 
+	//设置了执行延迟的账户，确认和撤销只更新确认列表以及权重达到要求时的区块高度，交易通过MultiSigExecute执行
+	if multiSigTx.ConfirmedHeight > 0 || a.isTimelocked(multiSigAcc) {
+		return a.confirmTimelockTx(multiSigTx, multiSigTxOwner, ConfirmTx.ConfirmOrRevoke, isConfirm)
+	}
+
 	//权重未达到要求或者撤销确认交易，构造MultiSigConfirmTx的receiptLog
 	if !isConfirm || !ConfirmTx.ConfirmOrRevoke {
 		return a.confirmTransaction(multiSigTx, multiSigTxOwner, ConfirmTx.ConfirmOrRevoke)
@@ -383,6 +401,8 @@ func (a *action) MultiSigConfirmTx(ConfirmTx *mty.MultiSigConfirmTx) (*types.Rec
 	} else if multiSigTx.TxType == mty.TransferOperate {
 		transfer := payload.GetMultiSigExecTransferFrom()
 		return a.executeTransferTx(multiSigAcc, multiSigTx, transfer, owner, mty.IsConfirm)
+	} else if multiSigTx.TxType == mty.SubmitOperate {
+		return a.executeSubmitTx(multiSigAcc, multiSigTx, owner, mty.IsConfirm)
 	}
 	multisiglog.Error("MultiSigConfirmTx:GetMultiSigTx", "multiSigAccAddr", multiSigAccAddr, "Confirm TxId", ConfirmTx.TxId, "TxType unknown", multiSigTx.TxType)
 	return nil, mty.ErrTxTypeNoMatch
@@ -718,6 +738,8 @@ func (a *action) receiptMultiSigTx(multiSigTx *mty.MultiSigTx, owner *mty.Owner,
 	if subOrConfirm {
 		receiptLogTx.TxHash = multiSigTx.TxHash
 		receiptLogTx.TxType = multiSigTx.TxType
+		receiptLogTx.Execer = multiSigTx.Execer
+		receiptLogTx.PayloadHash = multiSigTx.PayloadHash
 	}
 
 	receiptLog.Ty = mty.TyLogMultiSigTx
@@ -753,6 +775,13 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
 	underLimit, newlastday := isUnderLimit(a.blocktime, uint64(amount), curDailyLimit)
 
+	//设置了执行延迟的账户，超过每日限额的交易在权重达到要求时只记录区块高度
+	var statusLog *types.ReceiptLog
+	if confirmed && !underLimit && a.isTimelocked(multiSigAcc) {
+		confirmed = false
+		statusLog = a.startTimelock(newMultiSigTx, confOwner)
+	}
+
 	//新的一天更新lastday和spenttoday的值
 	if newlastday != 0 {
 		curDailyLimit.LastDay = newlastday
@@ -768,18 +797,8 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	if confirmed || underLimit {
 
 		//执行此交易，从多重签名账户转币到指定账户，在multiSig合约中转账
-		symbol := getRealSymbol(transfer.Symbol)
-		cfg := a.api.GetConfig()
-		execerAccDB, err := account.NewAccountDB(cfg, transfer.Execname, symbol, a.db)
+		receiptFromMultiSigAcc, err := a.transferFrozen(transfer)
 		if err != nil {
-			multisiglog.Error("executeTransaction:NewAccountDB", "From", transfer.From, "To", transfer.To,
-				"execaddr", a.execaddr, "amount", amount, "Execer", transfer.Execname, "Symbol", transfer.Symbol, "error", err)
-			return nil, err
-		}
-		receiptFromMultiSigAcc, err := execerAccDB.ExecTransferFrozen(transfer.From, transfer.To, a.execaddr, amount)
-		if err != nil {
-			multisiglog.Error("executeTransaction:ExecTransferFrozen", "From", transfer.From, "To", transfer.To,
-				"execaddr", a.execaddr, "amount", amount, "Execer", transfer.Execname, "Symbol", transfer.Symbol, "error", err)
 			return nil, err
 		}
 		logs = append(logs, receiptFromMultiSigAcc.Logs...)
@@ -816,6 +835,9 @@ func (a *action) executeTransferTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty
 	logs = append(logs, receiptlogtx)
 	kv = append(kv, keyvalue)
 	kv = append(kv, keyvaluetx)
+	if statusLog != nil {
+		logs = append(logs, statusLog)
+	}
 
 	//test
 	multisiglog.Error("executeTransferTx", "multiSigAcc", multiSigAcc, "newMultiSigTx", newMultiSigTx)
@@ -834,28 +856,21 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
 	prevExecuted := newMultiSigTx.Executed
 
+	//设置了执行延迟的账户，权重达到要求时只记录区块高度
+	var statusLog *types.ReceiptLog
+	if confirmed && a.isTimelocked(multiSigAcc) {
+		confirmed = false
+		statusLog = a.startTimelock(newMultiSigTx, confOwner)
+	}
+
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	var accAttrkv *types.KeyValue
-	var accAttrReceiptLog *types.ReceiptLog
-	var err error
-
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
 	if confirmed {
-		//修改账户RequiredWeight的操作
-		if accountOperate.OperateFlag {
-			accAttrkv, accAttrReceiptLog, err = a.multiSigWeightModify(multiSigAcc.MultiSigAddr, accountOperate.NewRequiredWeight)
-			if err != nil {
-				multisiglog.Error("executeAccOperateTx", "multiSigWeightModify", err)
-				return nil, err
-			}
-		} else { //资产每日限额的修改
-			accAttrkv, accAttrReceiptLog, err = a.multiSigDailyLimitOperate(multiSigAcc.MultiSigAddr, accountOperate.DailyLimit)
-			if err != nil {
-				multisiglog.Error("executeAccOperateTx", "multiSigDailyLimitOperate", err)
-				return nil, err
-			}
+		accAttrkv, accAttrReceiptLog, err := a.accOperate(multiSigAcc.MultiSigAddr, accountOperate)
+		if err != nil {
+			return nil, err
 		}
 		logs = append(logs, accAttrReceiptLog)
 		kv = append(kv, accAttrkv)
@@ -876,6 +891,83 @@ func (a *action) executeAccOperateTx(multiSigAcc *mty.MultiSig, newMultiSigTx *m
 	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
 	logs = append(logs, receiptlogtx)
 	kv = append(kv, keyvaluetx)
+	if statusLog != nil {
+		logs = append(logs, statusLog)
+	}
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   kv,
+		Logs: logs,
+	}, nil
+}
+
+//MultiSigSubmitTx 提交其他执行器的交易，只记录目标执行器和payload的哈希
+//此类交易不使用每日限额，确认权重达到要求并且过了执行延迟之后标记为已执行，
+//之后由目标执行器通过UseSubmitTx校验，以多重签名地址作为发起者执行一次payload
+func (a *action) MultiSigSubmitTx(submit *mty.MultiSigSubmitTx) (*types.Receipt, error) {
+	if !a.api.GetConfig().IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigSubmitTxX) {
+		return nil, types.ErrActionNotSupport
+	}
+	multiSigAccAddr := submit.MultiSigAccAddr
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("MultiSigSubmitTx", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, err
+	}
+	//校验交易提交者是否是本账户的owner
+	owneraddr := a.fromaddr
+	ownerWeight, isowner := isOwner(multiSigAcc, owneraddr)
+	if !isowner {
+		return nil, mty.ErrIsNotOwner
+	}
+
+	//生成新的txid,并将此交易信息添加到Txs列表中
+	newMultiSigTx := &mty.MultiSigTx{}
+	newMultiSigTx.Txid = multiSigAcc.TxCount
+	newMultiSigTx.TxHash = hex.EncodeToString(a.txhash)
+	newMultiSigTx.Executed = false
+	newMultiSigTx.TxType = mty.SubmitOperate
+	newMultiSigTx.MultiSigAddr = multiSigAccAddr
+	newMultiSigTx.Execer = submit.Execer
+	newMultiSigTx.PayloadHash = common.Sha256(submit.Payload)
+	confirmOwner := &mty.Owner{OwnerAddr: owneraddr, Weight: ownerWeight}
+	newMultiSigTx.ConfirmedOwner = append(newMultiSigTx.ConfirmedOwner, confirmOwner)
+
+	return a.executeSubmitTx(multiSigAcc, newMultiSigTx, confirmOwner, mty.IsSubmit)
+}
+
+//确认提交的其他执行器的交易：区分submitTx和confirmtx阶段。权重满足并且没有执行延迟时直接标记为已执行
+func (a *action) executeSubmitTx(multiSigAcc *mty.MultiSig, newMultiSigTx *mty.MultiSigTx, confOwner *mty.Owner, subOrConfirm bool) (*types.Receipt, error) {
+	confirmed := isConfirmed(multiSigAcc.RequiredWeight, newMultiSigTx)
+	prevExecuted := newMultiSigTx.Executed
+
+	//设置了执行延迟的账户，权重达到要求时只记录区块高度
+	var statusLog *types.ReceiptLog
+	if confirmed && a.isTimelocked(multiSigAcc) {
+		confirmed = false
+		statusLog = a.startTimelock(newMultiSigTx, confOwner)
+	}
+	if confirmed {
+		newMultiSigTx.Executed = true
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	//更新multiSigAcc状态:txcount有增加在submit阶段
+	if subOrConfirm {
+		keyvalue, receiptlog, err := a.receiptTxCountUpdate(multiSigAcc.MultiSigAddr)
+		if err != nil {
+			return nil, err
+		}
+		kv = append(kv, keyvalue)
+		logs = append(logs, receiptlog)
+	}
+	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
+	logs = append(logs, receiptlogtx)
+	kv = append(kv, keyvaluetx)
+	if statusLog != nil {
+		logs = append(logs, statusLog)
+	}
 	return &types.Receipt{
 		Ty:   types.ExecOk,
 		KV:   kv,
@@ -888,46 +980,22 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	var logs []*types.ReceiptLog
 	var kv []*types.KeyValue
 
-	var multiSigkv *types.KeyValue
-	var receiptLog *types.ReceiptLog
-	var err error
 	//确认权重是否已达到要求
 	confirmed := isConfirmed(multiSigAccount.RequiredWeight, newMultiSigTx)
 	prevExecuted := newMultiSigTx.Executed
 
-	flag := accountOperate.OperateFlag
+	//设置了执行延迟的账户，权重达到要求时只记录区块高度
+	var statusLog *types.ReceiptLog
+	if confirmed && a.isTimelocked(multiSigAccount) {
+		confirmed = false
+		statusLog = a.startTimelock(newMultiSigTx, confOwner)
+	}
 
 	//权重满足允许执行此交易，需要继续更新多重签名账户和tx列表的状态信息
 	if confirmed {
-		//add
-		if mty.OwnerAdd == flag {
-			multiSigkv, receiptLog, err = a.multiSigOwnerAdd(multiSigAccount.MultiSigAddr, accountOperate)
-			if err != nil {
-				multisiglog.Error("MultiSigAccountOwnerOperate", "multiSigOwnerAdd err", err)
-				return nil, err
-			}
-
-		} else if mty.OwnerDel == flag {
-			multiSigkv, receiptLog, err = a.multiSigOwnerDel(multiSigAccount.MultiSigAddr, accountOperate)
-			if err != nil {
-				multisiglog.Error("MultiSigAccountOwnerOperate", "multiSigOwnerAdd err", err)
-				return nil, err
-			}
-		} else if mty.OwnerModify == flag { //modify owner
-			multiSigkv, receiptLog, err = a.multiSigOwnerModify(multiSigAccount.MultiSigAddr, accountOperate)
-			if err != nil {
-				multisiglog.Error("MultiSigAccountOwnerOperate", "multiSigOwnerModify err", err)
-				return nil, err
-			}
-		} else if mty.OwnerReplace == flag { //replace owner
-			multiSigkv, receiptLog, err = a.multiSigOwnerReplace(multiSigAccount.MultiSigAddr, accountOperate)
-			if err != nil {
-				multisiglog.Error("MultiSigAccountOwnerOperate", "multiSigOwnerReplace err", err)
-				return nil, err
-			}
-		} else {
-			multisiglog.Error("MultiSigAccountOwnerOperate", "OperateFlag", flag)
-			return nil, mty.ErrOperateType
+		multiSigkv, receiptLog, err := a.ownerOperate(multiSigAccount.MultiSigAddr, accountOperate)
+		if err != nil {
+			return nil, err
 		}
 		logs = append(logs, receiptLog)
 		kv = append(kv, multiSigkv)
@@ -949,6 +1017,9 @@ func (a *action) executeOwnerOperateTx(multiSigAccount *mty.MultiSig, newMultiSi
 	keyvaluetx, receiptlogtx := a.receiptMultiSigTx(newMultiSigTx, confOwner, prevExecuted, subOrConfirm)
 	logs = append(logs, receiptlogtx)
 	kv = append(kv, keyvaluetx)
+	if statusLog != nil {
+		logs = append(logs, statusLog)
+	}
 
 	//test
 	multisiglog.Error("executeOwnerOperateTx", "multiSigAccount", multiSigAccount, "newMultiSigTx", newMultiSigTx)
@@ -982,3 +1053,264 @@ func (a *action) confirmTransaction(multiSigTx *mty.MultiSigTx, multiSigTxOwner
 		Logs: []*types.ReceiptLog{receiptLog},
 	}, nil
 }
+
+//MultiSigExecute 执行延迟已到期的交易，任意owner都可以发起
+func (a *action) MultiSigExecute(execute *mty.MultiSigExecute) (*types.Receipt, error) {
+	if !a.isTimelockFork() {
+		return nil, types.ErrActionNotSupport
+	}
+	multiSigAcc, multiSigTx, owner, err := a.getPendingTx(execute.MultiSigAccAddr, execute.TxId)
+	if err != nil {
+		return nil, err
+	}
+	//权重需要仍然满足要求，账户属性修改后可能已经不满足
+	if multiSigTx.ConfirmedHeight == 0 || !isConfirmed(multiSigAcc.RequiredWeight, multiSigTx) {
+		return nil, mty.ErrTxNotConfirmed
+	}
+	if a.height < multiSigTx.ConfirmedHeight+int64(multiSigAcc.ExecDelay) {
+		multisiglog.Error("MultiSigExecute", "MultiSigAccAddr", execute.MultiSigAccAddr, "TxId", execute.TxId,
+			"ConfirmedHeight", multiSigTx.ConfirmedHeight, "ExecDelay", multiSigAcc.ExecDelay, "height", a.height)
+		return nil, mty.ErrTxTimelocked
+	}
+
+	//获取txhash对应交易详细信息
+	tx, err := getTxByHash(a.api, multiSigTx.TxHash)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := getMultiSigTxPayload(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	switch multiSigTx.TxType {
+	case mty.OwnerOperate:
+		opkv, oplog, err := a.ownerOperate(multiSigAcc.MultiSigAddr, payload.GetMultiSigOwnerOperate())
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, oplog)
+		kv = append(kv, opkv)
+	case mty.AccountOperate:
+		opkv, oplog, err := a.accOperate(multiSigAcc.MultiSigAddr, payload.GetMultiSigAccOperate())
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, oplog)
+		kv = append(kv, opkv)
+	case mty.TransferOperate:
+		receipt, err := a.transferFrozen(payload.GetMultiSigExecTransferFrom())
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, receipt.Logs...)
+		kv = append(kv, receipt.KV...)
+	case mty.SubmitOperate:
+		//只标记为已执行，payload由目标执行器以多重签名地址的身份执行
+	default:
+		multisiglog.Error("MultiSigExecute", "MultiSigAccAddr", execute.MultiSigAccAddr, "TxId", execute.TxId, "TxType unknown", multiSigTx.TxType)
+		return nil, mty.ErrTxTypeNoMatch
+	}
+
+	status := newTxStatus(multiSigTx, owner)
+	multiSigTx.Executed = true
+	key, value := setMultiSigAccTxToDb(a.db, multiSigTx)
+	kv = append(kv, &types.KeyValue{Key: key, Value: value})
+	logs = append(logs, receiptTxStatus(status, multiSigTx))
+
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   kv,
+		Logs: logs,
+	}, nil
+}
+
+//MultiSigCancelTx 取消还未执行的交易，任意owner都可以发起
+func (a *action) MultiSigCancelTx(cancel *mty.MultiSigCancelTx) (*types.Receipt, error) {
+	if !a.isTimelockFork() {
+		return nil, types.ErrActionNotSupport
+	}
+	_, multiSigTx, owner, err := a.getPendingTx(cancel.MultiSigAccAddr, cancel.TxId)
+	if err != nil {
+		return nil, err
+	}
+
+	status := newTxStatus(multiSigTx, owner)
+	multiSigTx.Cancelled = true
+	key, value := setMultiSigAccTxToDb(a.db, multiSigTx)
+
+	return &types.Receipt{
+		Ty:   types.ExecOk,
+		KV:   []*types.KeyValue{{Key: key, Value: value}},
+		Logs: []*types.ReceiptLog{receiptTxStatus(status, multiSigTx)},
+	}, nil
+}
+
+//获取还未执行也没有被取消的交易，交易发起者需要是账户的owner
+func (a *action) getPendingTx(multiSigAccAddr string, txid uint64) (*mty.MultiSig, *mty.MultiSigTx, *mty.Owner, error) {
+	multiSigAcc, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("getPendingTx:getMultiSigAccFromDb", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, nil, nil, err
+	}
+	ownerWeight, isowner := isOwner(multiSigAcc, a.fromaddr)
+	if !isowner {
+		return nil, nil, nil, mty.ErrIsNotOwner
+	}
+	if txid > multiSigAcc.TxCount {
+		return nil, nil, nil, mty.ErrInvalidTxid
+	}
+	multiSigTx, err := getMultiSigAccTxFromDb(a.db, multiSigAccAddr, txid)
+	if err != nil {
+		multisiglog.Error("getPendingTx:getMultiSigAccTxFromDb", "MultiSigAccAddr", multiSigAccAddr, "TxId", txid, "err", err)
+		return nil, nil, nil, mty.ErrTxidNotExist
+	}
+	if multiSigTx.Executed {
+		return nil, nil, nil, mty.ErrTxHasExecuted
+	}
+	if multiSigTx.Cancelled {
+		return nil, nil, nil, mty.ErrTxHasCancelled
+	}
+	return multiSigAcc, multiSigTx, &mty.Owner{OwnerAddr: a.fromaddr, Weight: ownerWeight}, nil
+}
+
+//设置了执行延迟的账户上交易的确认和撤销，权重达到要求时记录区块高度，不再满足时清零
+func (a *action) confirmTimelockTx(multiSigTx *mty.MultiSigTx, multiSigTxOwner *mty.MultiSigTxOwner, confirmOrRevoke, isConfirm bool) (*types.Receipt, error) {
+	status := newTxStatus(multiSigTx, multiSigTxOwner.ConfirmedOwner)
+	if isConfirm && multiSigTx.ConfirmedHeight == 0 {
+		multiSigTx.ConfirmedHeight = a.height
+	} else if !isConfirm {
+		multiSigTx.ConfirmedHeight = 0
+	}
+	receipt, err := a.confirmTransaction(multiSigTx, multiSigTxOwner, confirmOrRevoke)
+	if err != nil {
+		return nil, err
+	}
+	if status.PrevConfirmedHeight != multiSigTx.ConfirmedHeight {
+		receipt.Logs = append(receipt.Logs, receiptTxStatus(status, multiSigTx))
+	}
+	return receipt, nil
+}
+
+//权重达到要求时开始计算执行延迟，返回交易状态变化的receiptLog
+func (a *action) startTimelock(multiSigTx *mty.MultiSigTx, owner *mty.Owner) *types.ReceiptLog {
+	status := newTxStatus(multiSigTx, owner)
+	multiSigTx.ConfirmedHeight = a.height
+	return receiptTxStatus(status, multiSigTx)
+}
+
+func (a *action) isTimelockFork() bool {
+	return a.api.GetConfig().IsDappFork(a.height, mty.MultiSigX, mty.ForkMultiSigTimelockX)
+}
+
+//账户是否设置了执行延迟
+func (a *action) isTimelocked(multiSigAcc *mty.MultiSig) bool {
+	return multiSigAcc.ExecDelay > 0 && a.isTimelockFork()
+}
+
+//记录交易修改前的状态
+func newTxStatus(multiSigTx *mty.MultiSigTx, owner *mty.Owner) *mty.ReceiptMultiSigTxStatus {
+	return &mty.ReceiptMultiSigTxStatus{
+		MultiSigTxOwner:     &mty.MultiSigTxOwner{MultiSigAddr: multiSigTx.MultiSigAddr, Txid: multiSigTx.Txid, ConfirmedOwner: owner},
+		PrevExecuted:        multiSigTx.Executed,
+		PrevConfirmedHeight: multiSigTx.ConfirmedHeight,
+		PrevCancelled:       multiSigTx.Cancelled,
+	}
+}
+
+//记录交易修改后的状态并组装receiptLog
+func receiptTxStatus(status *mty.ReceiptMultiSigTxStatus, multiSigTx *mty.MultiSigTx) *types.ReceiptLog {
+	status.CurExecuted = multiSigTx.Executed
+	status.CurConfirmedHeight = multiSigTx.ConfirmedHeight
+	status.CurCancelled = multiSigTx.Cancelled
+	return &types.ReceiptLog{Ty: mty.TyLogMultiSigTxStatus, Log: types.Encode(status)}
+}
+
+//从多重签名账户转币到指定账户，在multiSig合约中转账
+func (a *action) transferFrozen(transfer *mty.MultiSigExecTransferFrom) (*types.Receipt, error) {
+	symbol := getRealSymbol(transfer.Symbol)
+	cfg := a.api.GetConfig()
+	execerAccDB, err := account.NewAccountDB(cfg, transfer.Execname, symbol, a.db)
+	if err != nil {
+		multisiglog.Error("transferFrozen:NewAccountDB", "From", transfer.From, "To", transfer.To,
+			"execaddr", a.execaddr, "amount", transfer.Amount, "Execer", transfer.Execname, "Symbol", transfer.Symbol, "error", err)
+		return nil, err
+	}
+	receipt, err := execerAccDB.ExecTransferFrozen(transfer.From, transfer.To, a.execaddr, transfer.Amount)
+	if err != nil {
+		multisiglog.Error("transferFrozen:ExecTransferFrozen", "From", transfer.From, "To", transfer.To,
+			"execaddr", a.execaddr, "amount", transfer.Amount, "Execer", transfer.Execname, "Symbol", transfer.Symbol, "error", err)
+		return nil, err
+	}
+	return receipt, nil
+}
+
+//修改账户属性：RequiredWeight，资产每日限额或者执行延迟
+func (a *action) accOperate(multiSigAccAddr string, accountOperate *mty.MultiSigAccOperate) (*types.KeyValue, *types.ReceiptLog, error) {
+	var kv *types.KeyValue
+	var receiptLog *types.ReceiptLog
+	var err error
+	if accountOperate.ExecDelayOp {
+		kv, receiptLog, err = a.multiSigExecDelayModify(multiSigAccAddr, accountOperate.NewExecDelay)
+		if err != nil {
+			multisiglog.Error("accOperate", "multiSigExecDelayModify", err)
+		}
+	} else if accountOperate.OperateFlag {
+		kv, receiptLog, err = a.multiSigWeightModify(multiSigAccAddr, accountOperate.NewRequiredWeight)
+		if err != nil {
+			multisiglog.Error("accOperate", "multiSigWeightModify", err)
+		}
+	} else {
+		kv, receiptLog, err = a.multiSigDailyLimitOperate(multiSigAccAddr, accountOperate.DailyLimit)
+		if err != nil {
+			multisiglog.Error("accOperate", "multiSigDailyLimitOperate", err)
+		}
+	}
+	return kv, receiptLog, err
+}
+
+//修改账户的owner：add/del/modify/replace
+func (a *action) ownerOperate(multiSigAccAddr string, ownerOperate *mty.MultiSigOwnerOperate) (*types.KeyValue, *types.ReceiptLog, error) {
+	var kv *types.KeyValue
+	var receiptLog *types.ReceiptLog
+	var err error
+	flag := ownerOperate.OperateFlag
+	switch flag {
+	case mty.OwnerAdd:
+		kv, receiptLog, err = a.multiSigOwnerAdd(multiSigAccAddr, ownerOperate)
+	case mty.OwnerDel:
+		kv, receiptLog, err = a.multiSigOwnerDel(multiSigAccAddr, ownerOperate)
+	case mty.OwnerModify:
+		kv, receiptLog, err = a.multiSigOwnerModify(multiSigAccAddr, ownerOperate)
+	case mty.OwnerReplace:
+		kv, receiptLog, err = a.multiSigOwnerReplace(multiSigAccAddr, ownerOperate)
+	default:
+		err = mty.ErrOperateType
+	}
+	if err != nil {
+		multisiglog.Error("ownerOperate", "OperateFlag", flag, "err", err)
+	}
+	return kv, receiptLog, err
+}
+
+//多重签名账户执行延迟的修改,返回新的KeyValue对和ReceiptLog信息
+func (a *action) multiSigExecDelayModify(multiSigAccAddr string, newExecDelay uint64) (*types.KeyValue, *types.ReceiptLog, error) {
+	multiSigAccount, err := getMultiSigAccFromDb(a.db, multiSigAccAddr)
+	if err != nil {
+		multisiglog.Error("multiSigExecDelayModify", "MultiSigAccAddr", multiSigAccAddr, "err", err)
+		return nil, nil, err
+	}
+
+	receiptDelay := &mty.ReceiptExecDelayModify{
+		MultiSigAddr: multiSigAccount.MultiSigAddr,
+		PrevDelay:    multiSigAccount.ExecDelay,
+		CurrentDelay: newExecDelay,
+	}
+	multiSigAccount.ExecDelay = newExecDelay
+	receiptLog := &types.ReceiptLog{Ty: mty.TyLogMultiSigAccExecDelayModify, Log: types.Encode(receiptDelay)}
+
+	key, value := setMultiSigAccToDb(a.db, multiSigAccount)
+	return &types.KeyValue{Key: key, Value: value}, receiptLog, nil
+}
@@ -44,3 +44,21 @@ func (m *MultiSig) Exec_MultiSigExecTransferFrom(payload *mty.MultiSigExecTransf
 	action := newAction(m, tx, int32(index))
 	return action.MultiSigExecTransferFrom(payload)
 }
+
+//Exec_MultiSigExecute 执行延迟已到期的交易
+func (m *MultiSig) Exec_MultiSigExecute(payload *mty.MultiSigExecute, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigExecute(payload)
+}
+
+//Exec_MultiSigSubmitTx 提交以多重签名地址身份执行的其他执行器的交易
+func (m *MultiSig) Exec_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigSubmitTx(payload)
+}
+
+//Exec_MultiSigCancelTx 取消多重签名账户上还未执行的交易
+func (m *MultiSig) Exec_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newAction(m, tx, int32(index))
+	return action.MultiSigCancelTx(payload)
+}
@@ -86,3 +86,42 @@ func (m *MultiSig) ExecDelLocal_MultiSigExecTransferFrom(payload *mty.MultiSigEx
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecDelLocal_MultiSigExecute 执行延迟已到期的交易
+func (m *MultiSig) ExecDelLocal_MultiSigExecute(payload *mty.MultiSigExecute, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecDelLocal_MultiSigCancelTx 取消多重签名账户上还未执行的交易
+func (m *MultiSig) ExecDelLocal_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecDelLocal_MultiSigSubmitTx 提交以多重签名地址身份执行的其他执行器的交易
+func (m *MultiSig) ExecDelLocal_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
@@ -91,3 +91,45 @@ func (m *MultiSig) ExecLocal_MultiSigExecTransferFrom(payload *mty.MultiSigExecT
 	}
 	return &types.LocalDBSet{KV: kv}, nil
 }
+
+//ExecLocal_MultiSigExecute 执行延迟已到期的交易
+func (m *MultiSig) ExecLocal_MultiSigExecute(payload *mty.MultiSigExecute, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigExecute", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecLocal_MultiSigCancelTx 取消多重签名账户上还未执行的交易
+func (m *MultiSig) ExecLocal_MultiSigCancelTx(payload *mty.MultiSigCancelTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigCancelTx", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
+
+//ExecLocal_MultiSigSubmitTx 提交以多重签名地址身份执行的其他执行器的交易
+func (m *MultiSig) ExecLocal_MultiSigSubmitTx(payload *mty.MultiSigSubmitTx, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	if receiptData.GetTy() != types.ExecOk {
+		return &types.LocalDBSet{}, nil
+	}
+
+	kv, err := m.execLocalMultiSigReceipt(receiptData, tx, true)
+	if err != nil {
+		multisiglog.Error("ExecLocal_MultiSigSubmitTx", "err", err)
+		return nil, err
+	}
+	return &types.LocalDBSet{KV: kv}, nil
+}
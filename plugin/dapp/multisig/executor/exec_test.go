@@ -1016,3 +1016,257 @@ func multiSigExecTransferFrom(parm *mty.MultiSigExecTransferFrom, fromOrTo bool)
 	}
 	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
 }
+
+//设置了执行延迟的账户：权重达到要求后需要等待执行延迟，再通过MultiSigExecute执行，未执行的交易可以被取消
+func TestMultiSigTimelock(t *testing.T) {
+	total := int64(100000)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    AddrA,
+	}
+	env := execEnv{
+		1539918074,
+		chainTestCfg.GetDappFork("multisig", mty.ForkMultiSigTimelockX) + 10,
+		2,
+		1539918074,
+		"hash",
+	}
+	execDelay := uint64(3)
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	memDB, _ := dbm.NewGoMemDB("local", "local", 100)
+	localDB := dbm.NewKVDB(memDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	accA := account.NewCoinsAccount(chainTestCfg)
+	accA.SetDB(stateDB)
+	accA.SaveExecAccount(address.ExecAddress("multisig"), &accountA)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	execTx := func(tx *types.Transaction) (*types.Receipt, error) {
+		receipt, err := driver.Exec(tx, env.index)
+		if err != nil {
+			return nil, err
+		}
+		receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+		_, err = driver.ExecLocal(tx, receiptData, env.index)
+		assert.Nil(t, err)
+		return receipt, nil
+	}
+	//提交交易并mock交易详情的查询接口
+	submitTx := func(tx *types.Transaction) uint64 {
+		receipt, err := execTx(tx)
+		assert.Nil(t, err)
+		txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+		api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+
+		var receiptTx mty.ReceiptMultiSigTx
+		var status mty.ReceiptMultiSigTxStatus
+		for _, log := range receipt.Logs {
+			if log.Ty == mty.TyLogMultiSigTx {
+				assert.Nil(t, types.Decode(log.Log, &receiptTx))
+			}
+			if log.Ty == mty.TyLogMultiSigTxStatus {
+				assert.Nil(t, types.Decode(log.Log, &status))
+			}
+		}
+		//权重已经达到要求，但交易没有被执行
+		assert.False(t, receiptTx.CurExecuted)
+		assert.Equal(t, env.blockHeight, status.CurConfirmedHeight)
+		return receiptTx.MultiSigTxOwner.Txid
+	}
+
+	//创建执行延迟为3个区块的账户，AddrD的权重可以单独确认交易
+	createParam := &mty.MultiSigAccCreate{
+		Owners:         []*mty.Owner{{OwnerAddr: AddrC, Weight: AddrCWeight}, {OwnerAddr: AddrD, Weight: AddrDWeight}},
+		RequiredWeight: Requiredweight,
+		DailyLimit:     &mty.SymbolDailyLimit{Symbol: Symbol, Execer: Asset, DailyLimit: CoinsBtyDailylimit},
+		ExecDelay:      execDelay,
+	}
+	tx, _ := multiSigAccCreate(createParam)
+	tx, _ = signTx(tx, PrivKeyA)
+	_, err := execTx(tx)
+	assert.Nil(t, err)
+	multiSigAddr := address.MultiSignAddress(tx.Hash())
+
+	//修改RequiredWeight的交易需要等待执行延迟
+	tx, _ = multiSigAccOperate(&mty.MultiSigAccOperate{
+		MultiSigAccAddr:   multiSigAddr,
+		NewRequiredWeight: NewRequiredweight,
+		OperateFlag:       mty.AccWeightOp,
+	})
+	tx, _ = signTx(tx, PrivKeyD)
+	txid := submitTx(tx)
+
+	execute, _ := multiSigExecute(&mty.MultiSigExecute{MultiSigAccAddr: multiSigAddr, TxId: txid})
+	execute, _ = signTx(execute, PrivKeyC)
+	_, err = execTx(execute)
+	assert.Equal(t, mty.ErrTxTimelocked, err)
+
+	driver.SetEnv(env.blockHeight+int64(execDelay), env.blockTime, env.difficulty)
+	_, err = execTx(execute)
+	assert.Nil(t, err)
+	multiSigAcc, err := getMultiSigAccFromDb(stateDB, multiSigAddr)
+	assert.Nil(t, err)
+	assert.Equal(t, NewRequiredweight, multiSigAcc.RequiredWeight)
+	localTx, err := getMultiSigTx(localDB, multiSigAddr, txid)
+	assert.Nil(t, err)
+	assert.True(t, localTx.Executed)
+
+	_, err = execTx(execute)
+	assert.Equal(t, mty.ErrTxHasExecuted, err)
+
+	//取消还未执行的交易
+	env.blockHeight += int64(execDelay)
+	tx, _ = multiSigOwnerOperate(&mty.MultiSigOwnerOperate{
+		MultiSigAccAddr: multiSigAddr,
+		NewOwner:        AddrB,
+		NewWeight:       AddrBWeight,
+		OperateFlag:     mty.OwnerAdd,
+	})
+	tx, _ = signTx(tx, PrivKeyD)
+	txid = submitTx(tx)
+
+	cancel, _ := multiSigCancelTx(&mty.MultiSigCancelTx{MultiSigAccAddr: multiSigAddr, TxId: txid})
+	cancel, _ = signTx(cancel, PrivKeyA)
+	_, err = execTx(cancel)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+	cancel, _ = signTx(cancel, PrivKeyC)
+	_, err = execTx(cancel)
+	assert.Nil(t, err)
+
+	driver.SetEnv(env.blockHeight+int64(execDelay), env.blockTime, env.difficulty)
+	execute, _ = multiSigExecute(&mty.MultiSigExecute{MultiSigAccAddr: multiSigAddr, TxId: txid})
+	execute, _ = signTx(execute, PrivKeyD)
+	_, err = execTx(execute)
+	assert.Equal(t, mty.ErrTxHasCancelled, err)
+	confirm, _ := multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: txid, ConfirmOrRevoke: true})
+	confirm, _ = signTx(confirm, PrivKeyC)
+	_, err = execTx(confirm)
+	assert.Equal(t, mty.ErrTxHasCancelled, err)
+
+	//被取消的交易不再是pending状态
+	reply, err := driver.Query("MultiSigTxids", types.Encode(&mty.ReqMultiSigTxids{MultiSigAddr: multiSigAddr, FromTxId: 0, ToTxId: txid, Pending: true}))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reply.(*mty.ReplyMultiSigTxids).Txids))
+	reply, err = driver.Query("MultiSigTxids", types.Encode(&mty.ReqMultiSigTxids{MultiSigAddr: multiSigAddr, FromTxId: 0, ToTxId: txid, Cancelled: true}))
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{txid}, reply.(*mty.ReplyMultiSigTxids).Txids)
+}
+
+func multiSigExecute(parm *mty.MultiSigExecute) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigExecute,
+		Value: &mty.MultiSigAction_MultiSigExecute{MultiSigExecute: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+func multiSigCancelTx(parm *mty.MultiSigCancelTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigCancelTx,
+		Value: &mty.MultiSigAction_MultiSigCancelTx{MultiSigCancelTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
+
+func TestMultiSigSubmitTx(t *testing.T) {
+	env := execEnv{
+		1539918074,
+		chainTestCfg.GetDappFork("multisig", mty.ForkMultiSigSubmitTxX) + 10,
+		2,
+		1539918074,
+		"hash",
+	}
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 100)
+	memDB, _ := dbm.NewGoMemDB("local", "local", 100)
+	localDB := dbm.NewKVDB(memDB)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chainTestCfg, nil)
+
+	driver := newMultiSig()
+	driver.SetEnv(env.blockHeight, env.blockTime, env.difficulty)
+	driver.SetAPI(api)
+	driver.SetStateDB(stateDB)
+	driver.SetLocalDB(localDB)
+
+	execTx := func(tx *types.Transaction, priv string) (*types.Receipt, error) {
+		tx, _ = signTx(tx, priv)
+		receipt, err := driver.Exec(tx, env.index)
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range receipt.KV {
+			stateDB.Set(kv.Key, kv.Value)
+		}
+		_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+		assert.Nil(t, err)
+		txDetails := &types.TransactionDetails{Txs: []*types.TransactionDetail{{Tx: tx}}}
+		api.On("GetTransactionByHash", &types.ReqHashes{Hashes: [][]byte{tx.Hash()}}).Return(txDetails, nil)
+		return receipt, nil
+	}
+
+	tx, _ := multiSigAccCreate(&mty.MultiSigAccCreate{
+		Owners:         []*mty.Owner{{OwnerAddr: AddrC, Weight: AddrCWeight}, {OwnerAddr: AddrD, Weight: AddrDWeight}},
+		RequiredWeight: Requiredweight,
+		DailyLimit:     &mty.SymbolDailyLimit{Symbol: Symbol, Execer: Asset, DailyLimit: CoinsBtyDailylimit},
+	})
+	tx, _ = signTx(tx, PrivKeyA)
+	_, err := execTx(tx, PrivKeyA)
+	assert.Nil(t, err)
+	multiSigAddr := address.MultiSignAddress(tx.Hash())
+
+	payload := []byte("evm payload")
+	submit := &mty.MultiSigSubmitTx{MultiSigAccAddr: multiSigAddr, Execer: "evm", Payload: payload}
+	tx, _ = multiSigSubmitTx(submit)
+	_, err = execTx(tx, PrivKeyA)
+	assert.Equal(t, mty.ErrIsNotOwner, err)
+
+	//AddrC的权重不满足要求，交易等待确认
+	_, err = execTx(tx, PrivKeyC)
+	assert.Nil(t, err)
+	txid := uint64(0)
+	_, err = GetExecutedSubmitTx(stateDB, multiSigAddr, txid, "evm", payload)
+	assert.Equal(t, mty.ErrTxNotConfirmed, err)
+
+	confirm, _ := multiSigConfirmTx(&mty.MultiSigConfirmTx{MultiSigAccAddr: multiSigAddr, TxId: txid, ConfirmOrRevoke: true})
+	_, err = execTx(confirm, PrivKeyD)
+	assert.Nil(t, err)
+	multiSigTx, err := GetExecutedSubmitTx(stateDB, multiSigAddr, txid, "evm", payload)
+	assert.Nil(t, err)
+	assert.True(t, multiSigTx.Executed)
+	localTx, err := getMultiSigTx(localDB, multiSigAddr, txid)
+	assert.Nil(t, err)
+	assert.True(t, localTx.Executed)
+	assert.Equal(t, "evm", localTx.Execer)
+
+	//目标执行器和payload需要和提交时一致
+	_, err = GetExecutedSubmitTx(stateDB, multiSigAddr, txid, "token", payload)
+	assert.Equal(t, mty.ErrSubmitTxNoMatch, err)
+	_, err = GetExecutedSubmitTx(stateDB, multiSigAddr, txid, "evm", []byte("other payload"))
+	assert.Equal(t, mty.ErrSubmitTxNoMatch, err)
+}
+
+func multiSigSubmitTx(parm *mty.MultiSigSubmitTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	multiSig := &mty.MultiSigAction{
+		Ty:    mty.ActionMultiSigSubmitTx,
+		Value: &mty.MultiSigAction_MultiSigSubmitTx{MultiSigSubmitTx: parm},
+	}
+	return types.CreateFormatTx(chainTestCfg, chainTestCfg.ExecName(mty.MultiSigX), types.Encode(multiSig))
+}
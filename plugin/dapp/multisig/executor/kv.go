@@ -24,6 +24,12 @@ const (
 	MultiSigAccCreate   = "create"
 )
 
+//目标执行器中记录已经使用的MultiSigSubmitTx："mavl-execer-multisig-submit-accaddr-000000000000"
+//key需要以目标执行器的名字为前缀，才能由目标执行器写入
+func calcSubmitTxUsedKey(execer, multiSigAccAddr string, txid uint64) (key []byte) {
+	return []byte(fmt.Sprintf("mavl-%s-multisig-submit-%s-%018d", execer, multiSigAccAddr, txid))
+}
+
 //statedb中账户和交易的存储格式
 func calcMultiSigAccountKey(multiSigAccAddr string) (key []byte) {
 	return []byte(fmt.Sprintf(MultiSigPrefix+"%s", multiSigAccAddr))
@@ -105,6 +105,30 @@ func (m *MultiSig) CheckTx(tx *types.Transaction, index int) error {
 		}
 		return nil
 	}
+	//MultiSigExecute  交易的检测
+	if ato, ok := payload.(*mty.MultiSigExecute); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
+	//MultiSigCancelTx  交易的检测
+	if ato, ok := payload.(*mty.MultiSigCancelTx); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		return nil
+	}
+	//MultiSigSubmitTx  交易的检测
+	if ato, ok := payload.(*mty.MultiSigSubmitTx); ok {
+		if err := address.CheckMultiSignAddress(ato.GetMultiSigAccAddr()); err != nil {
+			return types.ErrInvalidAddress
+		}
+		if len(ato.GetExecer()) == 0 || ato.GetExecer() == mty.MultiSigX || len(ato.GetPayload()) == 0 {
+			return types.ErrInvalidParam
+		}
+		return nil
+	}
 
 	//MultiSigExecTransferTo 交易的检测
 	if ato, ok := payload.(*mty.MultiSigExecTransferTo); ok {
@@ -223,6 +247,10 @@ func checkAccountOperateTx(ato *mty.MultiSigAccOperate) error {
 	if err := address.CheckMultiSignAddress(MultiSigAccAddr); err != nil {
 		return types.ErrInvalidAddress
 	}
+	//执行延迟的修改不需要校验权重和资产
+	if ato.ExecDelayOp {
+		return nil
+	}
 
 	if ato.OperateFlag == mty.AccWeightOp {
 		NewWeight := ato.GetNewRequiredWeight()
@@ -364,6 +392,41 @@ func (m *MultiSig) execLocalMultiSigReceipt(receiptData *types.ReceiptData, tx *
 					set = append(set, kv2...)
 				}
 			}
+		case mty.TyLogMultiSigTxStatus: //设置执行延迟的账户上交易状态的变化
+			{
+				var receipt mty.ReceiptMultiSigTxStatus
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv1, err := m.saveMultiSigTxStatus(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv1...)
+
+				//转账交易通过MultiSigExecute执行时需要更新账户的amount统计计数
+				if receipt.CurExecuted && !receipt.PrevExecuted {
+					kv2, err := m.saveMultiSigTransfer(tx, mty.IsConfirm, addOrRollback)
+					if err != nil {
+						return nil, err
+					}
+					set = append(set, kv2...)
+				}
+			}
+		case mty.TyLogMultiSigAccExecDelayModify:
+			{
+				var receipt mty.ReceiptExecDelayModify
+				err := types.Decode(log.Log, &receipt)
+				if err != nil {
+					return nil, err
+				}
+				kv, err := m.saveMultiSigAccExecDelay(receipt, addOrRollback)
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, kv...)
+			}
 		case mty.TyLogTxCountUpdate:
 			{
 				var receipt mty.ReceiptTxCountUpdate
@@ -417,12 +480,20 @@ func (m *MultiSig) saveMultiSigTransfer(tx *types.Transaction, SubmitOrConfirm,
 			return set, nil
 		}
 	} else {
-		if action.Ty != mty.ActionMultiSigConfirmTx || action.GetMultiSigConfirmTx() == nil {
+		//交易通过MultiSigConfirmTx或者MultiSigExecute被执行
+		var multiSigAccAddr string
+		var txid uint64
+		if action.Ty == mty.ActionMultiSigConfirmTx && action.GetMultiSigConfirmTx() != nil {
+			multiSigAccAddr = action.GetMultiSigConfirmTx().MultiSigAccAddr
+			txid = action.GetMultiSigConfirmTx().TxId
+		} else if action.Ty == mty.ActionMultiSigExecute && action.GetMultiSigExecute() != nil {
+			multiSigAccAddr = action.GetMultiSigExecute().MultiSigAccAddr
+			txid = action.GetMultiSigExecute().TxId
+		} else {
 			return nil, mty.ErrActionTyNoMatch
 		}
 		//通过需要确认的txid从数据库中获取对应的multiSigTx信息，然后根据txhash查询具体的交易详情
-		multiSigConfirmTx := action.GetMultiSigConfirmTx()
-		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigConfirmTx.MultiSigAccAddr, multiSigConfirmTx.TxId)
+		multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAccAddr, txid)
 		if err != nil {
 			return set, err
 		}
@@ -739,6 +810,8 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 	temMultiSigTx.Txid = txid
 	temMultiSigTx.TxHash = execTx.TxHash
 	temMultiSigTx.TxType = execTx.TxType
+	temMultiSigTx.Execer = execTx.Execer
+	temMultiSigTx.PayloadHash = execTx.PayloadHash
 	temMultiSigTx.Executed = false
 	//获取多重签名交易信息从db中
 	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAddr, txid)
@@ -800,6 +873,68 @@ func (m *MultiSig) saveMultiSigTx(execTx mty.ReceiptMultiSigTx, addOrRollback bo
 	return kvs, nil
 }
 
+//设置执行延迟的账户上交易的确认高度，执行和取消状态的变化
+func (m *MultiSig) saveMultiSigTxStatus(status mty.ReceiptMultiSigTxStatus, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSigAddr := status.MultiSigTxOwner.MultiSigAddr
+	txid := status.MultiSigTxOwner.Txid
+
+	multiSigTx, err := getMultiSigTx(m.GetLocalDB(), multiSigAddr, txid)
+	if err != nil {
+		return nil, err
+	}
+	if multiSigTx == nil {
+		//submit交易回滚时txid已经被删除
+		if !addOrRollback {
+			return nil, nil
+		}
+		multisiglog.Error("saveMultiSigTxStatus", "addOrRollback", addOrRollback, "status", status)
+		return nil, mty.ErrTxidNotExist
+	}
+	if addOrRollback { //正常添加交易
+		multiSigTx.Executed = status.CurExecuted
+		multiSigTx.ConfirmedHeight = status.CurConfirmedHeight
+		multiSigTx.Cancelled = status.CurCancelled
+	} else { //回滚删除交易
+		multiSigTx.Executed = status.PrevExecuted
+		multiSigTx.ConfirmedHeight = status.PrevConfirmedHeight
+		multiSigTx.Cancelled = status.PrevCancelled
+	}
+
+	err = setMultiSigTx(m.GetLocalDB(), multiSigTx, true)
+	if err != nil {
+		return nil, err
+	}
+	txkv := getMultiSigTxKV(multiSigTx, true)
+
+	var kvs []*types.KeyValue
+	kvs = append(kvs, txkv)
+	return kvs, nil
+}
+
+//账户执行延迟的mod操作
+func (m *MultiSig) saveMultiSigAccExecDelay(accountOp mty.ReceiptExecDelayModify, addOrRollback bool) ([]*types.KeyValue, error) {
+	multiSig, err := getMultiSigAccount(m.GetLocalDB(), accountOp.MultiSigAddr)
+
+	if err != nil || multiSig == nil {
+		return nil, err
+	}
+	if addOrRollback { //正常添加交易
+		multiSig.ExecDelay = accountOp.CurrentDelay
+	} else { //回滚删除交易
+		multiSig.ExecDelay = accountOp.PrevDelay
+	}
+
+	err = setMultiSigAccount(m.GetLocalDB(), multiSig, true)
+	if err != nil {
+		return nil, err
+	}
+	accountkv := getMultiSigAccountKV(multiSig, true)
+
+	var kvs []*types.KeyValue
+	kvs = append(kvs, accountkv)
+	return kvs, nil
+}
+
 //多重签名账户交易被确认执行，更新对应资产的每日限额信息，以及txcount计数
 func (m *MultiSig) saveDailyLimitUpdate(execTransfer mty.ReceiptAccDailyLimitUpdate, addOrRollback bool) ([]*types.KeyValue, error) {
 	multiSigAddr := execTransfer.MultiSigAddr
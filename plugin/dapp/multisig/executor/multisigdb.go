@@ -5,6 +5,9 @@
 package executor
 
 import (
+	"bytes"
+
+	"github.com/33cn/chain33/common"
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
@@ -46,6 +49,48 @@ func setMultiSigAccToDb(db dbm.KV, multiSigAcc *mty.MultiSig) ([]byte, []byte) {
 	return key, value
 }
 
+// GetMultiSigAccount 从statedb中获取多重签名账户信息，供其它执行器校验所有者权重
+func GetMultiSigAccount(db dbm.KV, multiSigAddr string) (*mty.MultiSig, error) {
+	return getMultiSigAccFromDb(db, multiSigAddr)
+}
+
+//GetExecutedSubmitTx 获取已经执行的MultiSigSubmitTx交易，目标执行器和payload需要和提交时一致
+//目标执行器以多重签名地址的身份执行payload，并且需要自己记录已经使用过的txid，同一笔交易只能执行一次
+func GetExecutedSubmitTx(db dbm.KV, multiSigAddr string, txid uint64, execer string, payload []byte) (*mty.MultiSigTx, error) {
+	multiSigTx, err := getMultiSigAccTxFromDb(db, multiSigAddr, txid)
+	if err != nil {
+		return nil, mty.ErrTxidNotExist
+	}
+	if multiSigTx.TxType != mty.SubmitOperate || multiSigTx.Execer != execer || !bytes.Equal(multiSigTx.PayloadHash, common.Sha256(payload)) {
+		return nil, mty.ErrSubmitTxNoMatch
+	}
+	if !multiSigTx.Executed {
+		return nil, mty.ErrTxNotConfirmed
+	}
+	return multiSigTx, nil
+}
+
+//UseSubmitTx 目标执行器以多重签名地址的身份执行payload之前调用：sender需要是多重签名账户的owner，
+//txid对应的MultiSigSubmitTx需要已经执行并且和execer、payload一致；execer为目标执行器的名字(不带平行链前缀)，
+//返回记录此txid已经使用的kv，由目标执行器写入自己的状态数据库，同一笔交易在同一个执行器中只能使用一次
+func UseSubmitTx(db dbm.KV, execer, sender, multiSigAddr string, txid uint64, payload []byte) (*types.KeyValue, error) {
+	multiSig, err := getMultiSigAccFromDb(db, multiSigAddr)
+	if err != nil {
+		return nil, err
+	}
+	if _, isOwner := isOwner(multiSig, sender); !isOwner {
+		return nil, mty.ErrIsNotOwner
+	}
+	key := calcSubmitTxUsedKey(execer, multiSigAddr, txid)
+	if _, err := db.Get(key); err == nil {
+		return nil, mty.ErrSubmitTxUsed
+	}
+	if _, err := GetExecutedSubmitTx(db, multiSigAddr, txid, execer, payload); err != nil {
+		return nil, err
+	}
+	return &types.KeyValue{Key: key, Value: types.Encode(&types.Int64{Data: int64(txid)})}, nil
+}
+
 //获取db中指定多重签名地址上的txid对应的交易信息
 func getMultiSigAccTxFromDb(db dbm.KV, multiSigAddr string, txid uint64) (*mty.MultiSigTx, error) {
 
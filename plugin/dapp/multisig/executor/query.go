@@ -116,7 +116,7 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 	return &mty.Uint64{Data: multiSigAcc.TxCount}, nil
 }
 
-//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed
+//Query_MultiSigTxids 获取txids通过设置的过滤条件和区间，pending, executed, cancelled
 //输入：
 //message ReqMultiSigTxids {
 //  string multisigaddr = 1;
@@ -124,6 +124,7 @@ func (m *MultiSig) Query_MultiSigAccTxCount(in *mty.ReqMultiSigAccInfo) (types.M
 //	uint64 totxid = 3;
 //	bool   pending = 4;
 //	bool   executed	= 5;
+//	bool   cancelled = 6;
 // 返回:
 //message ReplyMultiSigTxids {
 //  string 			multisigaddr = 1;
@@ -156,8 +157,9 @@ func (m *MultiSig) Query_MultiSigTxids(in *mty.ReqMultiSigTxids) (types.Message,
 			continue
 		}
 		findTxid := txid
-		//查找Pending/Executed的交易txid
-		if in.Pending && !multiSigTx.Executed || in.Executed && multiSigTx.Executed {
+		//查找Pending/Executed/Cancelled的交易txid，被取消的交易不再是pending状态
+		pending := !multiSigTx.Executed && !multiSigTx.Cancelled
+		if in.Pending && pending || in.Executed && multiSigTx.Executed || in.Cancelled && multiSigTx.Cancelled {
 			multiSigTxids.Txids = append(multiSigTxids.Txids, findTxid)
 		}
 	}
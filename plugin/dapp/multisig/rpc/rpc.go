@@ -67,6 +67,48 @@ func (c *Jrpc) MultiSigConfirmTx(param *mty.MultiSigConfirmTx, result *interface
 	return nil
 }
 
+// MultiSigExecuteTx :构造执行延迟到期后执行交易的交易
+func (c *Jrpc) MultiSigExecuteTx(param *mty.MultiSigExecute, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigExecute", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigCancelTx :构造取消多重签名账户上未执行交易的交易
+func (c *Jrpc) MultiSigCancelTx(param *mty.MultiSigCancelTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigCancelTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// MultiSigSubmitTx :构造提交其他执行器交易的交易，执行后由目标执行器以多重签名地址执行payload
+func (c *Jrpc) MultiSigSubmitTx(param *mty.MultiSigSubmitTx, result *interface{}) error {
+	if param == nil {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(mty.MultiSigX), "MultiSigSubmitTx", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
 // MultiSigAccTransferInTx :构造在多重签名合约中转账到多重签名账户的交易
 func (c *Jrpc) MultiSigAccTransferInTx(param *mty.MultiSigExecTransferTo, result *interface{}) error {
 	if param == nil {
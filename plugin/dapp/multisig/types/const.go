@@ -24,6 +24,7 @@ var (
 	OwnerOperate    uint64 = 1
 	AccountOperate  uint64 = 2
 	TransferOperate uint64 = 3
+	SubmitOperate   uint64 = 4
 	//IsSubmit ：
 	IsSubmit  = true
 	IsConfirm = false
@@ -35,6 +36,11 @@ var (
 	MaxOwnersCount       = 20 //一个多重签名的账户最多拥有20个owner
 
 	Multisiglog = log15.New("module", MultiSigX)
+
+	//ForkMultiSigTimelockX 支持交易执行延迟和取消交易的分叉
+	ForkMultiSigTimelockX = "ForkMultiSigTimelock"
+	//ForkMultiSigSubmitTxX 支持提交其他执行器交易的分叉
+	ForkMultiSigSubmitTxX = "ForkMultiSigSubmitTx"
 )
 
 // MultiSig 交易的actionid
@@ -45,6 +51,9 @@ const (
 	ActionMultiSigConfirmTx        = 10003
 	ActionMultiSigExecTransferTo   = 10004
 	ActionMultiSigExecTransferFrom = 10005
+	ActionMultiSigExecute          = 10006
+	ActionMultiSigCancelTx         = 10007
+	ActionMultiSigSubmitTx         = 10008
 )
 
 //多重签名账户执行输出的logid
@@ -67,6 +76,9 @@ const (
 	TyLogMultiSigTx       = 10011 //在Submit提交交易阶段才会有更新
 	TyLogTxCountUpdate    = 10012 //txcount只在在Submit阶段提交新的交易是才会增加计数
 
+	TyLogMultiSigTxStatus           = 10013 //设置执行延迟的账户上交易的确认高度，执行和取消状态的变化
+	TyLogMultiSigAccExecDelayModify = 10014 //输出修改前后执行延迟的区块数
+
 )
 
 //AccAssetsResult 账户资产cli的显示，主要是amount需要转换成浮点型字符串
@@ -97,6 +109,7 @@ type MultiSigResult struct {
 	DailyLimits    []*DailyLimitResult `json:"dailyLimits,omitempty"`
 	TxCount        uint64              `json:"txCount,omitempty"`
 	RequiredWeight uint64              `json:"requiredWeight,omitempty"`
+	ExecDelay      uint64              `json:"execDelay,omitempty"`
 }
 
 //UnSpentAssetsResult 每日限额之内未花费额度的显示cli
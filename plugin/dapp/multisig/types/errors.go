@@ -41,4 +41,9 @@ var (
 	ErrInvalidExec          = errors.New("ErrInvalidExec")
 	ErrInvalidWeight        = errors.New("ErrInvalidWeight")
 	ErrInvalidDailyLimit    = errors.New("ErrInvalidDailyLimit")
+	ErrTxHasCancelled       = errors.New("ErrTxHasCancelled")
+	ErrTxNotConfirmed       = errors.New("ErrTxNotConfirmed")
+	ErrTxTimelocked         = errors.New("ErrTxTimelocked")
+	ErrSubmitTxNoMatch      = errors.New("ErrSubmitTxNoMatch")
+	ErrSubmitTxUsed         = errors.New("ErrSubmitTxUsed")
 )
@@ -32,6 +32,7 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 // DailyLimit: 不同资产的每日限额，通过symbol来区分，本连的原生币，以及跨链过来的其他链的原生币
 // txCount:记录此多重签名地址上提交的withdraw交易数
 // requiredweight:确认一笔withdraw交易需要的权重。
+// execDelay:交易确认权重达到要求之后需要等待的区块数，为0时立即执行
 type MultiSig struct {
 	CreateAddr           string        `protobuf:"bytes,1,opt,name=createAddr,proto3" json:"createAddr,omitempty"`
 	MultiSigAddr         string        `protobuf:"bytes,2,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
@@ -39,6 +40,7 @@ type MultiSig struct {
 	DailyLimits          []*DailyLimit `protobuf:"bytes,4,rep,name=dailyLimits,proto3" json:"dailyLimits,omitempty"`
 	TxCount              uint64        `protobuf:"varint,5,opt,name=txCount,proto3" json:"txCount,omitempty"`
 	RequiredWeight       uint64        `protobuf:"varint,6,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	ExecDelay            uint64        `protobuf:"varint,7,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -111,6 +113,13 @@ func (m *MultiSig) GetRequiredWeight() uint64 {
 	return 0
 }
 
+func (m *MultiSig) GetExecDelay() uint64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
 //这个地址是否已经确认某个交易
 type ConfirmedOwner struct {
 	ConfirmedOwner       []*Owner `protobuf:"bytes,1,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
@@ -153,6 +162,9 @@ func (m *ConfirmedOwner) GetConfirmedOwner() []*Owner {
 
 //记录提交的交易详情，在满足确认条件后执行data中的交易
 // txHash:用于存贮提交的确认交易。存贮在localdb中，通过txhash可以获取
+// confirmedHeight:设置了执行延迟的账户上，交易确认权重达到要求时的区块高度
+// cancelled:交易已经被owner取消，不能再确认和执行
+// execer,payloadHash:MultiSigSubmitTx提交的目标执行器和payload的哈希，执行之后由目标执行器以多重签名地址的身份执行一次
 type MultiSigTx struct {
 	Txid                 uint64   `protobuf:"varint,1,opt,name=txid,proto3" json:"txid,omitempty"`
 	TxHash               string   `protobuf:"bytes,2,opt,name=txHash,proto3" json:"txHash,omitempty"`
@@ -160,6 +172,10 @@ type MultiSigTx struct {
 	TxType               uint64   `protobuf:"varint,4,opt,name=txType,proto3" json:"txType,omitempty"`
 	MultiSigAddr         string   `protobuf:"bytes,5,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	ConfirmedOwner       []*Owner `protobuf:"bytes,6,rep,name=confirmedOwner,proto3" json:"confirmedOwner,omitempty"`
+	ConfirmedHeight      int64    `protobuf:"varint,7,opt,name=confirmedHeight,proto3" json:"confirmedHeight,omitempty"`
+	Cancelled            bool     `protobuf:"varint,8,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
+	Execer               string   `protobuf:"bytes,9,opt,name=execer,proto3" json:"execer,omitempty"`
+	PayloadHash          []byte   `protobuf:"bytes,10,opt,name=payloadHash,proto3" json:"payloadHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -232,6 +248,34 @@ func (m *MultiSigTx) GetConfirmedOwner() []*Owner {
 	return nil
 }
 
+func (m *MultiSigTx) GetConfirmedHeight() int64 {
+	if m != nil {
+		return m.ConfirmedHeight
+	}
+	return 0
+}
+
+func (m *MultiSigTx) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
+func (m *MultiSigTx) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigTx) GetPayloadHash() []byte {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return nil
+}
+
 // owner 结构体：owner账户地址，以及权重
 type Owner struct {
 	OwnerAddr            string   `protobuf:"bytes,1,opt,name=ownerAddr,proto3" json:"ownerAddr,omitempty"`
@@ -418,6 +462,9 @@ type MultiSigAction struct {
 	//	*MultiSigAction_MultiSigConfirmTx
 	//	*MultiSigAction_MultiSigExecTransferTo
 	//	*MultiSigAction_MultiSigExecTransferFrom
+	//	*MultiSigAction_MultiSigExecute
+	//	*MultiSigAction_MultiSigCancelTx
+	//	*MultiSigAction_MultiSigSubmitTx
 	Value                isMultiSigAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                  `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
@@ -478,6 +525,18 @@ type MultiSigAction_MultiSigExecTransferFrom struct {
 	MultiSigExecTransferFrom *MultiSigExecTransferFrom `protobuf:"bytes,6,opt,name=multiSigExecTransferFrom,proto3,oneof"`
 }
 
+type MultiSigAction_MultiSigExecute struct {
+	MultiSigExecute *MultiSigExecute `protobuf:"bytes,8,opt,name=multiSigExecute,proto3,oneof"`
+}
+
+type MultiSigAction_MultiSigCancelTx struct {
+	MultiSigCancelTx *MultiSigCancelTx `protobuf:"bytes,9,opt,name=multiSigCancelTx,proto3,oneof"`
+}
+
+type MultiSigAction_MultiSigSubmitTx struct {
+	MultiSigSubmitTx *MultiSigSubmitTx `protobuf:"bytes,10,opt,name=multiSigSubmitTx,proto3,oneof"`
+}
+
 func (*MultiSigAction_MultiSigAccCreate) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigOwnerOperate) isMultiSigAction_Value() {}
@@ -490,6 +549,12 @@ func (*MultiSigAction_MultiSigExecTransferTo) isMultiSigAction_Value() {}
 
 func (*MultiSigAction_MultiSigExecTransferFrom) isMultiSigAction_Value() {}
 
+func (*MultiSigAction_MultiSigExecute) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_MultiSigCancelTx) isMultiSigAction_Value() {}
+
+func (*MultiSigAction_MultiSigSubmitTx) isMultiSigAction_Value() {}
+
 func (m *MultiSigAction) GetValue() isMultiSigAction_Value {
 	if m != nil {
 		return m.Value
@@ -539,6 +604,27 @@ func (m *MultiSigAction) GetMultiSigExecTransferFrom() *MultiSigExecTransferFrom
 	return nil
 }
 
+func (m *MultiSigAction) GetMultiSigExecute() *MultiSigExecute {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigExecute); ok {
+		return x.MultiSigExecute
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetMultiSigCancelTx() *MultiSigCancelTx {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigCancelTx); ok {
+		return x.MultiSigCancelTx
+	}
+	return nil
+}
+
+func (m *MultiSigAction) GetMultiSigSubmitTx() *MultiSigSubmitTx {
+	if x, ok := m.GetValue().(*MultiSigAction_MultiSigSubmitTx); ok {
+		return x.MultiSigSubmitTx
+	}
+	return nil
+}
+
 func (m *MultiSigAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -555,6 +641,9 @@ func (*MultiSigAction) XXX_OneofWrappers() []interface{} {
 		(*MultiSigAction_MultiSigConfirmTx)(nil),
 		(*MultiSigAction_MultiSigExecTransferTo)(nil),
 		(*MultiSigAction_MultiSigExecTransferFrom)(nil),
+		(*MultiSigAction_MultiSigExecute)(nil),
+		(*MultiSigAction_MultiSigCancelTx)(nil),
+		(*MultiSigAction_MultiSigSubmitTx)(nil),
 	}
 }
 
@@ -563,6 +652,7 @@ type MultiSigAccCreate struct {
 	Owners               []*Owner          `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
 	RequiredWeight       uint64            `protobuf:"varint,2,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
 	DailyLimit           *SymbolDailyLimit `protobuf:"bytes,3,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
+	ExecDelay            uint64            `protobuf:"varint,4,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -614,6 +704,13 @@ func (m *MultiSigAccCreate) GetDailyLimit() *SymbolDailyLimit {
 	return nil
 }
 
+func (m *MultiSigAccCreate) GetExecDelay() uint64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
 //对MultiSigAccount账户owner的操作：add/del/replace/modify
 type MultiSigOwnerOperate struct {
 	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
@@ -688,11 +785,14 @@ func (m *MultiSigOwnerOperate) GetOperateFlag() uint64 {
 
 //对MultiSigAccount账户的操作：modify/add:SymbolDailyLimit,requiredweight
 //修改或者添加每日限额，或者请求权重的值。
+// execDelayOp为true时修改账户的执行延迟区块数newExecDelay，忽略operateFlag
 type MultiSigAccOperate struct {
 	MultiSigAccAddr      string            `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
 	DailyLimit           *SymbolDailyLimit `protobuf:"bytes,2,opt,name=dailyLimit,proto3" json:"dailyLimit,omitempty"`
 	NewRequiredWeight    uint64            `protobuf:"varint,3,opt,name=newRequiredWeight,proto3" json:"newRequiredWeight,omitempty"`
 	OperateFlag          bool              `protobuf:"varint,4,opt,name=operateFlag,proto3" json:"operateFlag,omitempty"`
+	NewExecDelay         uint64            `protobuf:"varint,5,opt,name=newExecDelay,proto3" json:"newExecDelay,omitempty"`
+	ExecDelayOp          bool              `protobuf:"varint,6,opt,name=execDelayOp,proto3" json:"execDelayOp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -751,6 +851,20 @@ func (m *MultiSigAccOperate) GetOperateFlag() bool {
 	return false
 }
 
+func (m *MultiSigAccOperate) GetNewExecDelay() uint64 {
+	if m != nil {
+		return m.NewExecDelay
+	}
+	return 0
+}
+
+func (m *MultiSigAccOperate) GetExecDelayOp() bool {
+	if m != nil {
+		return m.ExecDelayOp
+	}
+	return false
+}
+
 //多重签名合约中账户之间转币操作:增加一个from的字段实现MultiSigAddr--->addr之间的转账
 //需要判断from地址是否是多重签名地址
 //将MultiSig合约中from地址上execname+symbol的资产转移到to地址
@@ -965,6 +1079,159 @@ func (m *MultiSigConfirmTx) GetConfirmOrRevoke() bool {
 	return false
 }
 
+//执行确认权重已经达到要求，并且已经过了延迟区块数的交易
+type MultiSigExecute struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigExecute) Reset()         { *m = MultiSigExecute{} }
+func (m *MultiSigExecute) String() string { return proto.CompactTextString(m) }
+func (*MultiSigExecute) ProtoMessage()    {}
+func (*MultiSigExecute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{13}
+}
+
+func (m *MultiSigExecute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigExecute.Unmarshal(m, b)
+}
+func (m *MultiSigExecute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigExecute.Marshal(b, m, deterministic)
+}
+func (m *MultiSigExecute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigExecute.Merge(m, src)
+}
+func (m *MultiSigExecute) XXX_Size() int {
+	return xxx_messageInfo_MultiSigExecute.Size(m)
+}
+func (m *MultiSigExecute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigExecute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigExecute proto.InternalMessageInfo
+
+func (m *MultiSigExecute) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigExecute) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+//取消多重签名账户上还没有执行的交易
+type MultiSigCancelTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	TxId                 uint64   `protobuf:"varint,2,opt,name=txId,proto3" json:"txId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigCancelTx) Reset()         { *m = MultiSigCancelTx{} }
+func (m *MultiSigCancelTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigCancelTx) ProtoMessage()    {}
+func (*MultiSigCancelTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{14}
+}
+
+func (m *MultiSigCancelTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigCancelTx.Unmarshal(m, b)
+}
+func (m *MultiSigCancelTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigCancelTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigCancelTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigCancelTx.Merge(m, src)
+}
+func (m *MultiSigCancelTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigCancelTx.Size(m)
+}
+func (m *MultiSigCancelTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigCancelTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigCancelTx proto.InternalMessageInfo
+
+func (m *MultiSigCancelTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigCancelTx) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+//提交其他执行器的交易，确认权重达到要求并且过了执行延迟之后，由目标执行器以多重签名地址作为发起者执行payload
+// execer:目标执行器，payload:目标执行器交易的payload
+type MultiSigSubmitTx struct {
+	MultiSigAccAddr      string   `protobuf:"bytes,1,opt,name=multiSigAccAddr,proto3" json:"multiSigAccAddr,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiSigSubmitTx) Reset()         { *m = MultiSigSubmitTx{} }
+func (m *MultiSigSubmitTx) String() string { return proto.CompactTextString(m) }
+func (*MultiSigSubmitTx) ProtoMessage()    {}
+func (*MultiSigSubmitTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{15}
+}
+
+func (m *MultiSigSubmitTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiSigSubmitTx.Unmarshal(m, b)
+}
+func (m *MultiSigSubmitTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiSigSubmitTx.Marshal(b, m, deterministic)
+}
+func (m *MultiSigSubmitTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiSigSubmitTx.Merge(m, src)
+}
+func (m *MultiSigSubmitTx) XXX_Size() int {
+	return xxx_messageInfo_MultiSigSubmitTx.Size(m)
+}
+func (m *MultiSigSubmitTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiSigSubmitTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiSigSubmitTx proto.InternalMessageInfo
+
+func (m *MultiSigSubmitTx) GetMultiSigAccAddr() string {
+	if m != nil {
+		return m.MultiSigAccAddr
+	}
+	return ""
+}
+
+func (m *MultiSigSubmitTx) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *MultiSigSubmitTx) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 //获取所有多重签名账号
 type ReqMultiSigAccs struct {
 	Start                int64    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
@@ -978,7 +1245,7 @@ func (m *ReqMultiSigAccs) Reset()         { *m = ReqMultiSigAccs{} }
 func (m *ReqMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccs) ProtoMessage()    {}
 func (*ReqMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{13}
+	return fileDescriptor_62b8b91adf3febfa, []int{16}
 }
 
 func (m *ReqMultiSigAccs) XXX_Unmarshal(b []byte) error {
@@ -1024,7 +1291,7 @@ func (m *ReplyMultiSigAccs) Reset()         { *m = ReplyMultiSigAccs{} }
 func (m *ReplyMultiSigAccs) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccs) ProtoMessage()    {}
 func (*ReplyMultiSigAccs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{14}
+	return fileDescriptor_62b8b91adf3febfa, []int{17}
 }
 
 func (m *ReplyMultiSigAccs) XXX_Unmarshal(b []byte) error {
@@ -1064,7 +1331,7 @@ func (m *ReqMultiSigAccInfo) Reset()         { *m = ReqMultiSigAccInfo{} }
 func (m *ReqMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccInfo) ProtoMessage()    {}
 func (*ReqMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{15}
+	return fileDescriptor_62b8b91adf3febfa, []int{18}
 }
 
 func (m *ReqMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
@@ -1100,6 +1367,7 @@ type ReplyMultiSigAccInfo struct {
 	DailyLimits          []*DailyLimit `protobuf:"bytes,4,rep,name=dailyLimits,proto3" json:"dailyLimits,omitempty"`
 	TxCount              uint64        `protobuf:"varint,5,opt,name=txCount,proto3" json:"txCount,omitempty"`
 	RequiredWeight       uint64        `protobuf:"varint,6,opt,name=requiredWeight,proto3" json:"requiredWeight,omitempty"`
+	ExecDelay            uint64        `protobuf:"varint,7,opt,name=execDelay,proto3" json:"execDelay,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -1109,7 +1377,7 @@ func (m *ReplyMultiSigAccInfo) Reset()         { *m = ReplyMultiSigAccInfo{} }
 func (m *ReplyMultiSigAccInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigAccInfo) ProtoMessage()    {}
 func (*ReplyMultiSigAccInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{16}
+	return fileDescriptor_62b8b91adf3febfa, []int{19}
 }
 
 func (m *ReplyMultiSigAccInfo) XXX_Unmarshal(b []byte) error {
@@ -1172,6 +1440,13 @@ func (m *ReplyMultiSigAccInfo) GetRequiredWeight() uint64 {
 	return 0
 }
 
+func (m *ReplyMultiSigAccInfo) GetExecDelay() uint64 {
+	if m != nil {
+		return m.ExecDelay
+	}
+	return 0
+}
+
 //获取txids设置过滤条件和区间，pending, executed
 type ReqMultiSigTxids struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
@@ -1179,6 +1454,7 @@ type ReqMultiSigTxids struct {
 	ToTxId               uint64   `protobuf:"varint,3,opt,name=toTxId,proto3" json:"toTxId,omitempty"`
 	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
 	Executed             bool     `protobuf:"varint,5,opt,name=executed,proto3" json:"executed,omitempty"`
+	Cancelled            bool     `protobuf:"varint,6,opt,name=cancelled,proto3" json:"cancelled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1188,7 +1464,7 @@ func (m *ReqMultiSigTxids) Reset()         { *m = ReqMultiSigTxids{} }
 func (m *ReqMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxids) ProtoMessage()    {}
 func (*ReqMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{17}
+	return fileDescriptor_62b8b91adf3febfa, []int{20}
 }
 
 func (m *ReqMultiSigTxids) XXX_Unmarshal(b []byte) error {
@@ -1244,6 +1520,13 @@ func (m *ReqMultiSigTxids) GetExecuted() bool {
 	return false
 }
 
+func (m *ReqMultiSigTxids) GetCancelled() bool {
+	if m != nil {
+		return m.Cancelled
+	}
+	return false
+}
+
 type ReplyMultiSigTxids struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	Txids                []uint64 `protobuf:"varint,2,rep,packed,name=txids,proto3" json:"txids,omitempty"`
@@ -1256,7 +1539,7 @@ func (m *ReplyMultiSigTxids) Reset()         { *m = ReplyMultiSigTxids{} }
 func (m *ReplyMultiSigTxids) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxids) ProtoMessage()    {}
 func (*ReplyMultiSigTxids) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{18}
+	return fileDescriptor_62b8b91adf3febfa, []int{21}
 }
 
 func (m *ReplyMultiSigTxids) XXX_Unmarshal(b []byte) error {
@@ -1304,7 +1587,7 @@ func (m *ReqMultiSigTxInfo) Reset()         { *m = ReqMultiSigTxInfo{} }
 func (m *ReqMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigTxInfo) ProtoMessage()    {}
 func (*ReqMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{19}
+	return fileDescriptor_62b8b91adf3febfa, []int{22}
 }
 
 func (m *ReqMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1350,7 +1633,7 @@ func (m *ReplyMultiSigTxInfo) Reset()         { *m = ReplyMultiSigTxInfo{} }
 func (m *ReplyMultiSigTxInfo) String() string { return proto.CompactTextString(m) }
 func (*ReplyMultiSigTxInfo) ProtoMessage()    {}
 func (*ReplyMultiSigTxInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{20}
+	return fileDescriptor_62b8b91adf3febfa, []int{23}
 }
 
 func (m *ReplyMultiSigTxInfo) XXX_Unmarshal(b []byte) error {
@@ -1392,7 +1675,7 @@ func (m *ReqMultiSigAccUnSpentToday) Reset()         { *m = ReqMultiSigAccUnSpen
 func (m *ReqMultiSigAccUnSpentToday) String() string { return proto.CompactTextString(m) }
 func (*ReqMultiSigAccUnSpentToday) ProtoMessage()    {}
 func (*ReqMultiSigAccUnSpentToday) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{21}
+	return fileDescriptor_62b8b91adf3febfa, []int{24}
 }
 
 func (m *ReqMultiSigAccUnSpentToday) XXX_Unmarshal(b []byte) error {
@@ -1445,7 +1728,7 @@ func (m *ReplyUnSpentAssets) Reset()         { *m = ReplyUnSpentAssets{} }
 func (m *ReplyUnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyUnSpentAssets) ProtoMessage()    {}
 func (*ReplyUnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{22}
+	return fileDescriptor_62b8b91adf3febfa, []int{25}
 }
 
 func (m *ReplyUnSpentAssets) XXX_Unmarshal(b []byte) error {
@@ -1485,7 +1768,7 @@ func (m *UnSpentAssets) Reset()         { *m = UnSpentAssets{} }
 func (m *UnSpentAssets) String() string { return proto.CompactTextString(m) }
 func (*UnSpentAssets) ProtoMessage()    {}
 func (*UnSpentAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{23}
+	return fileDescriptor_62b8b91adf3febfa, []int{26}
 }
 
 func (m *UnSpentAssets) XXX_Unmarshal(b []byte) error {
@@ -1532,7 +1815,7 @@ func (m *ReceiptMultiSig) Reset()         { *m = ReceiptMultiSig{} }
 func (m *ReceiptMultiSig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSig) ProtoMessage()    {}
 func (*ReceiptMultiSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{24}
+	return fileDescriptor_62b8b91adf3febfa, []int{27}
 }
 
 func (m *ReceiptMultiSig) XXX_Unmarshal(b []byte) error {
@@ -1573,7 +1856,7 @@ func (m *ReceiptOwnerAddOrDel) Reset()         { *m = ReceiptOwnerAddOrDel{} }
 func (m *ReceiptOwnerAddOrDel) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerAddOrDel) ProtoMessage()    {}
 func (*ReceiptOwnerAddOrDel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{25}
+	return fileDescriptor_62b8b91adf3febfa, []int{28}
 }
 
 func (m *ReceiptOwnerAddOrDel) XXX_Unmarshal(b []byte) error {
@@ -1631,7 +1914,7 @@ func (m *ReceiptOwnerModOrRep) Reset()         { *m = ReceiptOwnerModOrRep{} }
 func (m *ReceiptOwnerModOrRep) String() string { return proto.CompactTextString(m) }
 func (*ReceiptOwnerModOrRep) ProtoMessage()    {}
 func (*ReceiptOwnerModOrRep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{26}
+	return fileDescriptor_62b8b91adf3febfa, []int{29}
 }
 
 func (m *ReceiptOwnerModOrRep) XXX_Unmarshal(b []byte) error {
@@ -1695,7 +1978,7 @@ func (m *ReceiptWeightModify) Reset()         { *m = ReceiptWeightModify{} }
 func (m *ReceiptWeightModify) String() string { return proto.CompactTextString(m) }
 func (*ReceiptWeightModify) ProtoMessage()    {}
 func (*ReceiptWeightModify) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{27}
+	return fileDescriptor_62b8b91adf3febfa, []int{30}
 }
 
 func (m *ReceiptWeightModify) XXX_Unmarshal(b []byte) error {
@@ -1737,6 +2020,62 @@ func (m *ReceiptWeightModify) GetCurrentWeight() uint64 {
 	return 0
 }
 
+// TyLogMultiSigAccExecDelayModify  = 10014 //输出修改前后执行延迟的区块数
+type ReceiptExecDelayModify struct {
+	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	PrevDelay            uint64   `protobuf:"varint,2,opt,name=prevDelay,proto3" json:"prevDelay,omitempty"`
+	CurrentDelay         uint64   `protobuf:"varint,3,opt,name=currentDelay,proto3" json:"currentDelay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptExecDelayModify) Reset()         { *m = ReceiptExecDelayModify{} }
+func (m *ReceiptExecDelayModify) String() string { return proto.CompactTextString(m) }
+func (*ReceiptExecDelayModify) ProtoMessage()    {}
+func (*ReceiptExecDelayModify) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{31}
+}
+
+func (m *ReceiptExecDelayModify) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptExecDelayModify.Unmarshal(m, b)
+}
+func (m *ReceiptExecDelayModify) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptExecDelayModify.Marshal(b, m, deterministic)
+}
+func (m *ReceiptExecDelayModify) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptExecDelayModify.Merge(m, src)
+}
+func (m *ReceiptExecDelayModify) XXX_Size() int {
+	return xxx_messageInfo_ReceiptExecDelayModify.Size(m)
+}
+func (m *ReceiptExecDelayModify) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptExecDelayModify.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptExecDelayModify proto.InternalMessageInfo
+
+func (m *ReceiptExecDelayModify) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ReceiptExecDelayModify) GetPrevDelay() uint64 {
+	if m != nil {
+		return m.PrevDelay
+	}
+	return 0
+}
+
+func (m *ReceiptExecDelayModify) GetCurrentDelay() uint64 {
+	if m != nil {
+		return m.CurrentDelay
+	}
+	return 0
+}
+
 // TyLogMultiSigAccDailyLimitAdd    = 10006 //输出add的DailyLimit：Symbol和DailyLimit
 // TyLogMultiSigAccDailyLimitModify = 10007 //输出modify的DailyLimit：preDailyLimit以及currentDailyLimit
 type ReceiptDailyLimitOperate struct {
@@ -1753,7 +2092,7 @@ func (m *ReceiptDailyLimitOperate) Reset()         { *m = ReceiptDailyLimitOpera
 func (m *ReceiptDailyLimitOperate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptDailyLimitOperate) ProtoMessage()    {}
 func (*ReceiptDailyLimitOperate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{28}
+	return fileDescriptor_62b8b91adf3febfa, []int{32}
 }
 
 func (m *ReceiptDailyLimitOperate) XXX_Unmarshal(b []byte) error {
@@ -1817,7 +2156,7 @@ func (m *ReceiptConfirmTx) Reset()         { *m = ReceiptConfirmTx{} }
 func (m *ReceiptConfirmTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptConfirmTx) ProtoMessage()    {}
 func (*ReceiptConfirmTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{29}
+	return fileDescriptor_62b8b91adf3febfa, []int{33}
 }
 
 func (m *ReceiptConfirmTx) XXX_Unmarshal(b []byte) error {
@@ -1866,7 +2205,7 @@ func (m *ReceiptAccDailyLimitUpdate) Reset()         { *m = ReceiptAccDailyLimit
 func (m *ReceiptAccDailyLimitUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptAccDailyLimitUpdate) ProtoMessage()    {}
 func (*ReceiptAccDailyLimitUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{30}
+	return fileDescriptor_62b8b91adf3febfa, []int{34}
 }
 
 func (m *ReceiptAccDailyLimitUpdate) XXX_Unmarshal(b []byte) error {
@@ -1916,6 +2255,8 @@ type ReceiptMultiSigTx struct {
 	SubmitOrConfirm      bool             `protobuf:"varint,4,opt,name=submitOrConfirm,proto3" json:"submitOrConfirm,omitempty"`
 	TxHash               string           `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
 	TxType               uint64           `protobuf:"varint,6,opt,name=txType,proto3" json:"txType,omitempty"`
+	Execer               string           `protobuf:"bytes,7,opt,name=execer,proto3" json:"execer,omitempty"`
+	PayloadHash          []byte           `protobuf:"bytes,8,opt,name=payloadHash,proto3" json:"payloadHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -1925,7 +2266,7 @@ func (m *ReceiptMultiSigTx) Reset()         { *m = ReceiptMultiSigTx{} }
 func (m *ReceiptMultiSigTx) String() string { return proto.CompactTextString(m) }
 func (*ReceiptMultiSigTx) ProtoMessage()    {}
 func (*ReceiptMultiSigTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{31}
+	return fileDescriptor_62b8b91adf3febfa, []int{35}
 }
 
 func (m *ReceiptMultiSigTx) XXX_Unmarshal(b []byte) error {
@@ -1988,6 +2329,108 @@ func (m *ReceiptMultiSigTx) GetTxType() uint64 {
 	return 0
 }
 
+func (m *ReceiptMultiSigTx) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+func (m *ReceiptMultiSigTx) GetPayloadHash() []byte {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return nil
+}
+
+// TyLogMultiSigTxStatus = 10013 //交易的执行状态，确认高度或者取消状态的变化
+type ReceiptMultiSigTxStatus struct {
+	MultiSigTxOwner      *MultiSigTxOwner `protobuf:"bytes,1,opt,name=multiSigTxOwner,proto3" json:"multiSigTxOwner,omitempty"`
+	PrevExecuted         bool             `protobuf:"varint,2,opt,name=prevExecuted,proto3" json:"prevExecuted,omitempty"`
+	CurExecuted          bool             `protobuf:"varint,3,opt,name=curExecuted,proto3" json:"curExecuted,omitempty"`
+	PrevConfirmedHeight  int64            `protobuf:"varint,4,opt,name=prevConfirmedHeight,proto3" json:"prevConfirmedHeight,omitempty"`
+	CurConfirmedHeight   int64            `protobuf:"varint,5,opt,name=curConfirmedHeight,proto3" json:"curConfirmedHeight,omitempty"`
+	PrevCancelled        bool             `protobuf:"varint,6,opt,name=prevCancelled,proto3" json:"prevCancelled,omitempty"`
+	CurCancelled         bool             `protobuf:"varint,7,opt,name=curCancelled,proto3" json:"curCancelled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReceiptMultiSigTxStatus) Reset()         { *m = ReceiptMultiSigTxStatus{} }
+func (m *ReceiptMultiSigTxStatus) String() string { return proto.CompactTextString(m) }
+func (*ReceiptMultiSigTxStatus) ProtoMessage()    {}
+func (*ReceiptMultiSigTxStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62b8b91adf3febfa, []int{36}
+}
+
+func (m *ReceiptMultiSigTxStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptMultiSigTxStatus.Unmarshal(m, b)
+}
+func (m *ReceiptMultiSigTxStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptMultiSigTxStatus.Marshal(b, m, deterministic)
+}
+func (m *ReceiptMultiSigTxStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptMultiSigTxStatus.Merge(m, src)
+}
+func (m *ReceiptMultiSigTxStatus) XXX_Size() int {
+	return xxx_messageInfo_ReceiptMultiSigTxStatus.Size(m)
+}
+func (m *ReceiptMultiSigTxStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptMultiSigTxStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptMultiSigTxStatus proto.InternalMessageInfo
+
+func (m *ReceiptMultiSigTxStatus) GetMultiSigTxOwner() *MultiSigTxOwner {
+	if m != nil {
+		return m.MultiSigTxOwner
+	}
+	return nil
+}
+
+func (m *ReceiptMultiSigTxStatus) GetPrevExecuted() bool {
+	if m != nil {
+		return m.PrevExecuted
+	}
+	return false
+}
+
+func (m *ReceiptMultiSigTxStatus) GetCurExecuted() bool {
+	if m != nil {
+		return m.CurExecuted
+	}
+	return false
+}
+
+func (m *ReceiptMultiSigTxStatus) GetPrevConfirmedHeight() int64 {
+	if m != nil {
+		return m.PrevConfirmedHeight
+	}
+	return 0
+}
+
+func (m *ReceiptMultiSigTxStatus) GetCurConfirmedHeight() int64 {
+	if m != nil {
+		return m.CurConfirmedHeight
+	}
+	return 0
+}
+
+func (m *ReceiptMultiSigTxStatus) GetPrevCancelled() bool {
+	if m != nil {
+		return m.PrevCancelled
+	}
+	return false
+}
+
+func (m *ReceiptMultiSigTxStatus) GetCurCancelled() bool {
+	if m != nil {
+		return m.CurCancelled
+	}
+	return false
+}
+
 type ReceiptTxCountUpdate struct {
 	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
 	CurTxCount           uint64   `protobuf:"varint,2,opt,name=curTxCount,proto3" json:"curTxCount,omitempty"`
@@ -2000,7 +2443,7 @@ func (m *ReceiptTxCountUpdate) Reset()         { *m = ReceiptTxCountUpdate{} }
 func (m *ReceiptTxCountUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTxCountUpdate) ProtoMessage()    {}
 func (*ReceiptTxCountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{32}
+	return fileDescriptor_62b8b91adf3febfa, []int{37}
 }
 
 func (m *ReceiptTxCountUpdate) XXX_Unmarshal(b []byte) error {
@@ -2048,7 +2491,7 @@ func (m *MultiSigTxOwner) Reset()         { *m = MultiSigTxOwner{} }
 func (m *MultiSigTxOwner) String() string { return proto.CompactTextString(m) }
 func (*MultiSigTxOwner) ProtoMessage()    {}
 func (*MultiSigTxOwner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{33}
+	return fileDescriptor_62b8b91adf3febfa, []int{38}
 }
 
 func (m *MultiSigTxOwner) XXX_Unmarshal(b []byte) error {
@@ -2101,7 +2544,7 @@ func (m *Uint64) Reset()         { *m = Uint64{} }
 func (m *Uint64) String() string { return proto.CompactTextString(m) }
 func (*Uint64) ProtoMessage()    {}
 func (*Uint64) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{34}
+	return fileDescriptor_62b8b91adf3febfa, []int{39}
 }
 
 func (m *Uint64) XXX_Unmarshal(b []byte) error {
@@ -2143,7 +2586,7 @@ func (m *AccountAssets) Reset()         { *m = AccountAssets{} }
 func (m *AccountAssets) String() string { return proto.CompactTextString(m) }
 func (*AccountAssets) ProtoMessage()    {}
 func (*AccountAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{35}
+	return fileDescriptor_62b8b91adf3febfa, []int{40}
 }
 
 func (m *AccountAssets) XXX_Unmarshal(b []byte) error {
@@ -2199,7 +2642,7 @@ func (m *ReqAccAssets) Reset()         { *m = ReqAccAssets{} }
 func (m *ReqAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAccAssets) ProtoMessage()    {}
 func (*ReqAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{36}
+	return fileDescriptor_62b8b91adf3febfa, []int{41}
 }
 
 func (m *ReqAccAssets) XXX_Unmarshal(b []byte) error {
@@ -2252,7 +2695,7 @@ func (m *ReplyAccAssets) Reset()         { *m = ReplyAccAssets{} }
 func (m *ReplyAccAssets) String() string { return proto.CompactTextString(m) }
 func (*ReplyAccAssets) ProtoMessage()    {}
 func (*ReplyAccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{37}
+	return fileDescriptor_62b8b91adf3febfa, []int{42}
 }
 
 func (m *ReplyAccAssets) XXX_Unmarshal(b []byte) error {
@@ -2294,7 +2737,7 @@ func (m *AccAssets) Reset()         { *m = AccAssets{} }
 func (m *AccAssets) String() string { return proto.CompactTextString(m) }
 func (*AccAssets) ProtoMessage()    {}
 func (*AccAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{38}
+	return fileDescriptor_62b8b91adf3febfa, []int{43}
 }
 
 func (m *AccAssets) XXX_Unmarshal(b []byte) error {
@@ -2348,7 +2791,7 @@ func (m *Assets) Reset()         { *m = Assets{} }
 func (m *Assets) String() string { return proto.CompactTextString(m) }
 func (*Assets) ProtoMessage()    {}
 func (*Assets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{39}
+	return fileDescriptor_62b8b91adf3febfa, []int{44}
 }
 
 func (m *Assets) XXX_Unmarshal(b []byte) error {
@@ -2395,7 +2838,7 @@ func (m *AccAddress) Reset()         { *m = AccAddress{} }
 func (m *AccAddress) String() string { return proto.CompactTextString(m) }
 func (*AccAddress) ProtoMessage()    {}
 func (*AccAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{40}
+	return fileDescriptor_62b8b91adf3febfa, []int{45}
 }
 
 func (m *AccAddress) XXX_Unmarshal(b []byte) error {
@@ -2437,7 +2880,7 @@ func (m *OwnerAttr) Reset()         { *m = OwnerAttr{} }
 func (m *OwnerAttr) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttr) ProtoMessage()    {}
 func (*OwnerAttr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{41}
+	return fileDescriptor_62b8b91adf3febfa, []int{46}
 }
 
 func (m *OwnerAttr) XXX_Unmarshal(b []byte) error {
@@ -2490,7 +2933,7 @@ func (m *OwnerAttrs) Reset()         { *m = OwnerAttrs{} }
 func (m *OwnerAttrs) String() string { return proto.CompactTextString(m) }
 func (*OwnerAttrs) ProtoMessage()    {}
 func (*OwnerAttrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_62b8b91adf3febfa, []int{42}
+	return fileDescriptor_62b8b91adf3febfa, []int{47}
 }
 
 func (m *OwnerAttrs) XXX_Unmarshal(b []byte) error {
@@ -2532,6 +2975,9 @@ func init() {
 	proto.RegisterType((*MultiSigExecTransferFrom)(nil), "types.MultiSigExecTransferFrom")
 	proto.RegisterType((*MultiSigExecTransferTo)(nil), "types.MultiSigExecTransferTo")
 	proto.RegisterType((*MultiSigConfirmTx)(nil), "types.MultiSigConfirmTx")
+	proto.RegisterType((*MultiSigExecute)(nil), "types.MultiSigExecute")
+	proto.RegisterType((*MultiSigCancelTx)(nil), "types.MultiSigCancelTx")
+	proto.RegisterType((*MultiSigSubmitTx)(nil), "types.MultiSigSubmitTx")
 	proto.RegisterType((*ReqMultiSigAccs)(nil), "types.ReqMultiSigAccs")
 	proto.RegisterType((*ReplyMultiSigAccs)(nil), "types.ReplyMultiSigAccs")
 	proto.RegisterType((*ReqMultiSigAccInfo)(nil), "types.ReqMultiSigAccInfo")
@@ -2547,10 +2993,12 @@ func init() {
 	proto.RegisterType((*ReceiptOwnerAddOrDel)(nil), "types.ReceiptOwnerAddOrDel")
 	proto.RegisterType((*ReceiptOwnerModOrRep)(nil), "types.ReceiptOwnerModOrRep")
 	proto.RegisterType((*ReceiptWeightModify)(nil), "types.ReceiptWeightModify")
+	proto.RegisterType((*ReceiptExecDelayModify)(nil), "types.ReceiptExecDelayModify")
 	proto.RegisterType((*ReceiptDailyLimitOperate)(nil), "types.ReceiptDailyLimitOperate")
 	proto.RegisterType((*ReceiptConfirmTx)(nil), "types.ReceiptConfirmTx")
 	proto.RegisterType((*ReceiptAccDailyLimitUpdate)(nil), "types.ReceiptAccDailyLimitUpdate")
 	proto.RegisterType((*ReceiptMultiSigTx)(nil), "types.ReceiptMultiSigTx")
+	proto.RegisterType((*ReceiptMultiSigTxStatus)(nil), "types.ReceiptMultiSigTxStatus")
 	proto.RegisterType((*ReceiptTxCountUpdate)(nil), "types.ReceiptTxCountUpdate")
 	proto.RegisterType((*MultiSigTxOwner)(nil), "types.MultiSigTxOwner")
 	proto.RegisterType((*Uint64)(nil), "types.Uint64")
@@ -2569,104 +3017,122 @@ func init() {
 }
 
 var fileDescriptor_62b8b91adf3febfa = []byte{
-	// 1572 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x18, 0xdd, 0x6e, 0xdc, 0x44,
-	0x37, 0xf6, 0xfe, 0x24, 0x7b, 0x92, 0x6c, 0x93, 0xf9, 0xa2, 0x7c, 0x26, 0x94, 0x12, 0x8d, 0x4a,
-	0xb5, 0xaa, 0x20, 0x42, 0x69, 0xa1, 0x14, 0x09, 0xd4, 0xa5, 0x69, 0x95, 0xaa, 0xa4, 0x29, 0xd3,
-	0xad, 0x2a, 0x21, 0x71, 0xe1, 0xd8, 0x93, 0xd4, 0x62, 0xd7, 0xde, 0xda, 0xde, 0x64, 0x17, 0x90,
-	0xca, 0x25, 0x2f, 0x00, 0x17, 0x5c, 0x20, 0x1e, 0x82, 0x0b, 0x9e, 0x00, 0x5e, 0x80, 0xb7, 0xe0,
-	0x1a, 0x71, 0x8b, 0xe6, 0xcf, 0x9e, 0xb1, 0xbd, 0x91, 0x2b, 0x15, 0x84, 0xb8, 0xf3, 0x39, 0x73,
-	0xe6, 0xfc, 0xff, 0x79, 0xa0, 0x3b, 0x9a, 0x0c, 0xd3, 0x20, 0x09, 0x4e, 0x76, 0xc6, 0x71, 0x94,
-	0x46, 0xa8, 0x95, 0xce, 0xc6, 0x34, 0xd9, 0x5a, 0x75, 0x3d, 0x2f, 0x9a, 0x84, 0xa9, 0xc0, 0xe2,
-	0xdf, 0x2d, 0x58, 0x3a, 0x60, 0x84, 0x8f, 0x82, 0x13, 0x74, 0x09, 0xc0, 0x8b, 0xa9, 0x9b, 0xd2,
-	0xbe, 0xef, 0xc7, 0x8e, 0xb5, 0x6d, 0xf5, 0x3a, 0x44, 0xc3, 0x20, 0x0c, 0x2b, 0x23, 0x49, 0xcb,
-	0x29, 0x6c, 0x4e, 0x61, 0xe0, 0xd0, 0x65, 0x68, 0x47, 0x67, 0x21, 0x8d, 0x13, 0xa7, 0xb1, 0xdd,
-	0xe8, 0x2d, 0xef, 0xae, 0xec, 0x70, 0xb9, 0x3b, 0x87, 0x0c, 0x49, 0xe4, 0x19, 0xba, 0x06, 0xcb,
-	0xbe, 0x1b, 0x0c, 0x67, 0x1f, 0x07, 0xa3, 0x20, 0x4d, 0x9c, 0x26, 0x27, 0x5d, 0x97, 0xa4, 0x7b,
-	0xd9, 0x09, 0xd1, 0xa9, 0x90, 0x03, 0x8b, 0xe9, 0xf4, 0x36, 0x53, 0xde, 0x69, 0x6d, 0x5b, 0xbd,
-	0x26, 0x51, 0x20, 0xba, 0x02, 0xdd, 0x98, 0x3e, 0x9b, 0x04, 0x31, 0xf5, 0x9f, 0xd0, 0xe0, 0xe4,
-	0x69, 0xea, 0xb4, 0x39, 0x41, 0x01, 0x8b, 0xef, 0x42, 0xf7, 0x76, 0x14, 0x1e, 0x07, 0xf1, 0x88,
-	0xfa, 0x5c, 0x21, 0x74, 0x1d, 0xba, 0x9e, 0x81, 0x71, 0xac, 0x0a, 0xb5, 0x0b, 0x34, 0xf8, 0x17,
-	0x0b, 0x40, 0x79, 0x6d, 0x30, 0x45, 0x08, 0x9a, 0xe9, 0x34, 0xf0, 0xb9, 0xc7, 0x9a, 0x84, 0x7f,
-	0xa3, 0x4d, 0x68, 0xa7, 0xd3, 0x7d, 0x37, 0x79, 0x2a, 0xbd, 0x24, 0x21, 0xb4, 0x05, 0x4b, 0x74,
-	0x4a, 0xbd, 0x49, 0x4a, 0x7d, 0xa7, 0xb1, 0x6d, 0xf5, 0x96, 0x48, 0x06, 0x8b, 0x3b, 0x83, 0xd9,
-	0x98, 0x3a, 0x4d, 0xce, 0x49, 0x42, 0x25, 0xbf, 0xb7, 0x2a, 0xfc, 0x5e, 0x36, 0xa4, 0x5d, 0xc3,
-	0x90, 0x0f, 0xa0, 0x25, 0xfc, 0x70, 0x11, 0x3a, 0x3c, 0x34, 0x5a, 0xe4, 0x73, 0x04, 0x53, 0xec,
-	0x4c, 0xf8, 0xd5, 0x16, 0x8a, 0x09, 0x08, 0x7f, 0x67, 0x01, 0xe4, 0xd1, 0x62, 0x64, 0xc9, 0x6c,
-	0x74, 0x14, 0x0d, 0x25, 0x07, 0x09, 0x31, 0x3c, 0xb3, 0x91, 0xaa, 0x8c, 0x91, 0x10, 0xcb, 0xb7,
-	0x3c, 0xbe, 0xdc, 0x1b, 0x4d, 0xa2, 0x61, 0xd8, 0x79, 0x32, 0xa6, 0x61, 0x3a, 0x88, 0x7c, 0x77,
-	0x26, 0x7d, 0xa2, 0x61, 0x58, 0x42, 0x0c, 0xdd, 0x24, 0xdd, 0x73, 0x67, 0xdc, 0x25, 0x0d, 0xa2,
-	0x40, 0x7c, 0x04, 0x6b, 0x8f, 0xb8, 0xec, 0xbf, 0x4f, 0x3b, 0xfc, 0x6d, 0x13, 0xba, 0x2a, 0x09,
-	0xfa, 0x5e, 0x1a, 0x44, 0x21, 0xda, 0x87, 0xf5, 0x2c, 0x28, 0x9e, 0x77, 0x9b, 0x57, 0x0e, 0x97,
-	0xb6, 0xbc, 0xeb, 0xc8, 0x38, 0x1c, 0x14, 0xcf, 0xf7, 0x17, 0x48, 0xf9, 0x12, 0xfa, 0x04, 0x36,
-	0x14, 0x92, 0x07, 0xe8, 0x70, 0x4c, 0x63, 0xc6, 0xcc, 0xe6, 0xcc, 0x5e, 0x2d, 0x30, 0xd3, 0x49,
-	0xf6, 0x17, 0x48, 0xe5, 0x55, 0x74, 0x1f, 0x90, 0x26, 0x47, 0x31, 0x6c, 0x70, 0x86, 0xaf, 0x94,
-	0xb5, 0xcb, 0xd9, 0x55, 0x5c, 0xd3, 0x2d, 0x95, 0x15, 0x35, 0x98, 0xf2, 0x08, 0x95, 0x2d, 0xcd,
-	0xce, 0x75, 0x4b, 0x33, 0x24, 0x7a, 0x02, 0x9b, 0x0a, 0x79, 0x67, 0x4a, 0xbd, 0x41, 0xec, 0x86,
-	0xc9, 0x31, 0x8d, 0x07, 0x11, 0x8f, 0xe9, 0xf2, 0xee, 0x6b, 0x05, 0x76, 0x26, 0xd1, 0xfe, 0x02,
-	0x99, 0x73, 0x1d, 0x7d, 0x06, 0x4e, 0xd5, 0xc9, 0xdd, 0x38, 0x1a, 0xf1, 0xf6, 0xb0, 0xbc, 0xfb,
-	0xfa, 0x39, 0xac, 0x19, 0xd9, 0xfe, 0x02, 0x99, 0xcb, 0x02, 0x75, 0xc1, 0x1e, 0xcc, 0x9c, 0xc5,
-	0x6d, 0xab, 0xd7, 0x22, 0xf6, 0x60, 0xf6, 0xd1, 0x22, 0xb4, 0x4e, 0xdd, 0xe1, 0x84, 0xe2, 0xef,
-	0x2d, 0x58, 0x2f, 0x45, 0x59, 0xeb, 0x8b, 0xd6, 0x39, 0x7d, 0xb1, 0xdc, 0xc8, 0xec, 0xaa, 0x46,
-	0x86, 0x6e, 0x94, 0x72, 0x73, 0x79, 0xf7, 0xff, 0x92, 0x63, 0x31, 0xf1, 0x8d, 0xa4, 0xfd, 0xd9,
-	0x82, 0x8d, 0xaa, 0xac, 0x41, 0x3d, 0xb8, 0xa0, 0x85, 0x59, 0x6b, 0x03, 0x45, 0x34, 0xeb, 0x60,
-	0xd1, 0x50, 0xf6, 0x18, 0x51, 0x31, 0x19, 0xcc, 0xce, 0x42, 0x7a, 0x26, 0xce, 0x1a, 0xe2, 0x4c,
-	0xc1, 0xac, 0xc5, 0x84, 0xf4, 0x4c, 0x9a, 0x25, 0x8a, 0x39, 0x47, 0xa0, 0x6d, 0x58, 0x8e, 0x84,
-	0x2a, 0x77, 0x87, 0xee, 0x89, 0x6c, 0xf0, 0x3a, 0x0a, 0xff, 0x6a, 0x01, 0x2a, 0xe7, 0xe7, 0x0b,
-	0x28, 0x6e, 0x3a, 0xcd, 0xae, 0xed, 0x34, 0xf4, 0x26, 0xac, 0x87, 0xf4, 0x8c, 0x98, 0x81, 0x11,
-	0x0d, 0xa1, 0x7c, 0x50, 0xb4, 0xa4, 0xc9, 0x9b, 0xbc, 0x61, 0xc9, 0x0f, 0x16, 0x38, 0xf3, 0x72,
-	0xee, 0xbc, 0x36, 0xe5, 0x8e, 0xf8, 0xf0, 0xb3, 0x79, 0xaf, 0x93, 0x10, 0x1b, 0x3e, 0x61, 0x24,
-	0x0b, 0xb9, 0x43, 0xf8, 0xb7, 0x1a, 0x32, 0xa1, 0x3b, 0x12, 0xa3, 0xa4, 0x43, 0x32, 0x98, 0xe5,
-	0x6d, 0x1a, 0xc9, 0x11, 0x62, 0xa7, 0x11, 0xbb, 0x7f, 0xac, 0x4a, 0xa2, 0x43, 0xf8, 0x37, 0xfe,
-	0xc6, 0x82, 0xcd, 0xea, 0x7a, 0xfb, 0xa7, 0xd5, 0xc3, 0x5f, 0xe6, 0xc5, 0x94, 0xf7, 0x8c, 0xfa,
-	0x31, 0xe7, 0xa3, 0xf9, 0x9e, 0x2f, 0xcb, 0x88, 0x7f, 0xb3, 0xdb, 0x72, 0x0c, 0x1e, 0xc6, 0x84,
-	0x9e, 0x46, 0x9f, 0x53, 0x39, 0x89, 0x8b, 0x68, 0x7c, 0x13, 0x2e, 0x10, 0xfa, 0x4c, 0x4b, 0xba,
-	0x04, 0x6d, 0x40, 0x2b, 0x49, 0xdd, 0x38, 0xe5, 0x02, 0x1b, 0x44, 0x00, 0x68, 0x0d, 0x1a, 0x34,
-	0xf4, 0xa5, 0xe9, 0xec, 0x13, 0xbf, 0x05, 0xeb, 0x84, 0x8e, 0x87, 0x33, 0xe3, 0xb2, 0x03, 0x8b,
-	0xae, 0xef, 0xc7, 0x34, 0x11, 0x5d, 0xa0, 0x43, 0x14, 0x88, 0x3f, 0x04, 0x64, 0x4a, 0xba, 0x17,
-	0x1e, 0x47, 0xf5, 0xed, 0xc4, 0x7f, 0x5a, 0xb0, 0x51, 0x94, 0xc7, 0x59, 0xfc, 0xe7, 0x77, 0xba,
-	0x1f, 0x2d, 0x58, 0xd3, 0x5c, 0x37, 0x98, 0x06, 0x7e, 0x52, 0xb2, 0xca, 0xaa, 0xb0, 0x6a, 0x0b,
-	0x96, 0x58, 0xb2, 0x0f, 0xf2, 0xf4, 0xc8, 0x60, 0xbe, 0x89, 0x45, 0xfc, 0xa4, 0x21, 0x37, 0x31,
-	0x0e, 0x31, 0x75, 0xc7, 0x34, 0xf4, 0x83, 0x50, 0xd5, 0xb5, 0x02, 0x8d, 0xbd, 0xae, 0x65, 0xee,
-	0x75, 0xf8, 0x01, 0x0b, 0xae, 0x16, 0x9b, 0xfa, 0x3a, 0x6e, 0x40, 0x8b, 0x6d, 0x93, 0x89, 0x63,
-	0x6f, 0x37, 0x7a, 0x4d, 0x22, 0x00, 0x7c, 0x9f, 0xe5, 0x96, 0x66, 0x31, 0x0f, 0x74, 0x1d, 0x76,
-	0x15, 0xd5, 0x80, 0x1f, 0xc2, 0xff, 0x0a, 0xca, 0x71, 0x76, 0x37, 0xe5, 0x0f, 0x44, 0x86, 0x91,
-	0x7b, 0xcc, 0x7a, 0x61, 0x66, 0x0e, 0xa6, 0xa4, 0x40, 0x88, 0xc7, 0xb0, 0x65, 0xe6, 0xf2, 0xe3,
-	0xf0, 0x51, 0xbe, 0xb4, 0xd5, 0xd1, 0x73, 0xde, 0x4a, 0x96, 0x37, 0x9f, 0x86, 0xde, 0x7c, 0xf0,
-	0x43, 0xe9, 0x60, 0x29, 0xa8, 0x9f, 0x24, 0x34, 0x4d, 0xd0, 0xfb, 0xb0, 0x3a, 0xd1, 0x11, 0x32,
-	0x7b, 0x37, 0xa4, 0x05, 0x06, 0x31, 0x31, 0x49, 0xf1, 0x03, 0x58, 0x35, 0x99, 0xbd, 0x01, 0x6d,
-	0x57, 0x70, 0x11, 0x7e, 0x58, 0x95, 0x5c, 0xe4, 0x75, 0x79, 0x58, 0x68, 0x83, 0x4d, 0xd5, 0x06,
-	0xf1, 0x3b, 0xac, 0x93, 0x78, 0x34, 0x18, 0xa7, 0xd9, 0xdf, 0x56, 0x0d, 0x47, 0xe0, 0x2f, 0x58,
-	0x55, 0xf3, 0x6b, 0x87, 0x72, 0x19, 0x3f, 0x8c, 0xf7, 0xe8, 0xb0, 0x96, 0x13, 0x31, 0xb4, 0xa2,
-	0x6c, 0x48, 0x17, 0x8b, 0x56, 0x1c, 0xb1, 0xac, 0x75, 0x25, 0x4f, 0xf5, 0x37, 0xa2, 0x60, 0xfc,
-	0x93, 0x65, 0x0a, 0x3f, 0x88, 0x7c, 0xd6, 0x18, 0xc7, 0xb5, 0x84, 0x5f, 0x85, 0xce, 0x38, 0xa6,
-	0xa7, 0x87, 0x73, 0x15, 0xc8, 0x8f, 0xd1, 0xdb, 0xb0, 0xe2, 0x4d, 0xe2, 0x98, 0x86, 0x69, 0xbe,
-	0x38, 0x14, 0xc9, 0x0d, 0x0a, 0xa6, 0xf6, 0x48, 0x6a, 0x23, 0xeb, 0x30, 0x83, 0xf1, 0x73, 0x96,
-	0xcf, 0x5c, 0x6b, 0xd1, 0x20, 0x0e, 0x22, 0x3f, 0x38, 0xae, 0x97, 0x76, 0x97, 0x00, 0x98, 0x56,
-	0xc6, 0xe6, 0xa5, 0x61, 0xd0, 0x65, 0x58, 0x95, 0x6a, 0x18, 0x3b, 0x80, 0x89, 0xc4, 0xbf, 0x59,
-	0xe0, 0x48, 0x0d, 0xf2, 0xae, 0xa7, 0xb6, 0x95, 0x3a, 0x6a, 0xdc, 0x84, 0x2e, 0x13, 0xba, 0x57,
-	0xdc, 0x55, 0x2a, 0x7a, 0x69, 0x81, 0x10, 0xdd, 0xe0, 0x1a, 0xee, 0x15, 0x57, 0xc3, 0x8a, 0x9b,
-	0x26, 0x1d, 0x5b, 0x5a, 0x78, 0xe0, 0x85, 0xb7, 0xd4, 0xd2, 0xa2, 0xa1, 0xf0, 0xd7, 0xbc, 0xcf,
-	0x72, 0xb3, 0xf2, 0x41, 0x7c, 0x2b, 0x1f, 0x50, 0x83, 0xa9, 0xfa, 0x7f, 0x66, 0x12, 0x37, 0x4b,
-	0x6d, 0x42, 0xc4, 0xb1, 0x48, 0x8e, 0xae, 0xc2, 0x9a, 0xfa, 0x27, 0xcd, 0xa6, 0xb1, 0xcd, 0xa5,
-	0x97, 0xf0, 0x2c, 0x23, 0xb7, 0xa4, 0x0a, 0x7d, 0xcf, 0xcb, 0xb5, 0x7f, 0x3c, 0xf6, 0xff, 0xc5,
-	0xbe, 0xc5, 0x7f, 0x58, 0xac, 0x5f, 0x1b, 0xc5, 0xff, 0x52, 0x5c, 0x87, 0x61, 0x85, 0xa9, 0x78,
-	0x47, 0x8d, 0x1d, 0xe1, 0x36, 0x03, 0xc7, 0xe2, 0xea, 0x4d, 0xe2, 0x3b, 0xe6, 0x8b, 0x83, 0x8e,
-	0x62, 0x3b, 0x46, 0x32, 0x39, 0x62, 0x29, 0x1a, 0xcb, 0xb8, 0xca, 0xe8, 0x17, 0xd1, 0xda, 0x93,
-	0x46, 0xcb, 0x78, 0xd2, 0xc8, 0x9f, 0x2d, 0xda, 0xfa, 0xb3, 0x05, 0xfe, 0x34, 0xeb, 0x1f, 0x03,
-	0x31, 0xd3, 0x5f, 0x20, 0x4e, 0x6c, 0x6d, 0x99, 0xc4, 0xf2, 0x9e, 0x2a, 0xc5, 0x1c, 0x83, 0x9f,
-	0xc3, 0x85, 0x83, 0xb2, 0x3b, 0xea, 0x0d, 0xc0, 0x40, 0x1b, 0x80, 0x81, 0x5f, 0xf1, 0x72, 0x52,
-	0xd5, 0x80, 0x8a, 0x2f, 0x27, 0x17, 0xa1, 0xfd, 0x38, 0x08, 0xd3, 0x77, 0xaf, 0x33, 0x9e, 0xbe,
-	0x9b, 0xba, 0xea, 0xf5, 0x87, 0x7d, 0xe3, 0x18, 0x56, 0xfb, 0xe2, 0x9d, 0x4d, 0x8e, 0x8f, 0x3a,
-	0xca, 0xe5, 0x23, 0xc6, 0xae, 0x37, 0x62, 0x1a, 0xfa, 0xa6, 0x8d, 0x23, 0x58, 0x21, 0xf4, 0x19,
-	0x5b, 0x08, 0x5f, 0xba, 0xc8, 0x0d, 0x68, 0x05, 0x49, 0x7f, 0xa8, 0x66, 0x84, 0x00, 0xf0, 0x2d,
-	0xe8, 0xf2, 0xa9, 0x9b, 0x8b, 0xdc, 0x81, 0x8e, 0xab, 0x00, 0xf9, 0x9f, 0xbb, 0xa6, 0x38, 0x2a,
-	0x3c, 0xc9, 0x49, 0xf0, 0x57, 0xd0, 0xc9, 0x2f, 0xd7, 0x9c, 0xb0, 0x97, 0x00, 0x62, 0xea, 0x9d,
-	0xf6, 0xf5, 0x9f, 0x0d, 0x0d, 0x83, 0x7a, 0xb0, 0x28, 0x9f, 0x38, 0x65, 0x1c, 0xbb, 0xb9, 0x06,
-	0x0c, 0x4b, 0xd4, 0x31, 0x7e, 0x0f, 0xda, 0xfd, 0xcc, 0xa5, 0x72, 0xdf, 0xb0, 0xe6, 0xec, 0x1b,
-	0xb6, 0xb1, 0x6f, 0x5c, 0x01, 0x90, 0x8b, 0x37, 0x4d, 0xce, 0xdb, 0xea, 0x29, 0x74, 0xc4, 0xdc,
-	0x4e, 0xd3, 0x7a, 0xf9, 0x69, 0x3c, 0xc3, 0xd9, 0xf3, 0x9f, 0xe1, 0x1a, 0xc6, 0x33, 0xdc, 0x75,
-	0x80, 0x4c, 0x4c, 0x82, 0xae, 0x40, 0x2b, 0x48, 0xe9, 0xa8, 0x18, 0x80, 0x8c, 0x82, 0x88, 0xe3,
-	0xa3, 0x36, 0x7f, 0x01, 0xbe, 0xf6, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x1b, 0x43, 0x0b,
-	0x29, 0x16, 0x00, 0x00,
+	// 1858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcd, 0x8f, 0x23, 0x47,
+	0x15, 0x9f, 0x6e, 0x7f, 0x8d, 0x9f, 0x67, 0xbc, 0xe3, 0x5a, 0x6b, 0xd2, 0x0c, 0xcb, 0x32, 0x2a,
+	0x85, 0xc8, 0x8a, 0x60, 0x14, 0x4d, 0x16, 0xc2, 0x22, 0x81, 0xe2, 0xec, 0xcc, 0xca, 0x51, 0xd8,
+	0x38, 0xd4, 0x78, 0x15, 0x09, 0x89, 0x43, 0x6f, 0x77, 0xcd, 0xa6, 0x85, 0xdd, 0xed, 0xed, 0x6e,
+	0xef, 0xd8, 0x80, 0x08, 0x47, 0x38, 0x70, 0x84, 0x23, 0xff, 0x02, 0x17, 0x0e, 0x39, 0x71, 0xe6,
+	0xc2, 0x8d, 0xff, 0x81, 0x7f, 0x03, 0xd5, 0x47, 0x77, 0x7d, 0xb8, 0x3d, 0xf4, 0x2a, 0x01, 0xad,
+	0x94, 0x5b, 0xbf, 0xdf, 0x7b, 0xf5, 0xea, 0xd5, 0xab, 0x7a, 0x1f, 0x55, 0x0d, 0xfd, 0xc5, 0x6a,
+	0x9e, 0x47, 0x59, 0xf4, 0xfc, 0x6c, 0x99, 0x26, 0x79, 0x82, 0x5a, 0xf9, 0x66, 0x49, 0xb3, 0x93,
+	0x43, 0x3f, 0x08, 0x92, 0x55, 0x9c, 0x0b, 0x14, 0xff, 0xc1, 0x85, 0xfd, 0x27, 0x4c, 0xf0, 0x2a,
+	0x7a, 0x8e, 0xee, 0x03, 0x04, 0x29, 0xf5, 0x73, 0x3a, 0x0e, 0xc3, 0xd4, 0x73, 0x4e, 0x9d, 0x51,
+	0x97, 0x68, 0x08, 0xc2, 0x70, 0xb0, 0x90, 0xb2, 0x5c, 0xc2, 0xe5, 0x12, 0x06, 0x86, 0xde, 0x84,
+	0x76, 0x72, 0x13, 0xd3, 0x34, 0xf3, 0x1a, 0xa7, 0x8d, 0x51, 0xef, 0xfc, 0xe0, 0x8c, 0xcf, 0x7b,
+	0x36, 0x65, 0x20, 0x91, 0x3c, 0xf4, 0x2e, 0xf4, 0x42, 0x3f, 0x9a, 0x6f, 0x7e, 0x1a, 0x2d, 0xa2,
+	0x3c, 0xf3, 0x9a, 0x5c, 0x74, 0x20, 0x45, 0x2f, 0x4a, 0x0e, 0xd1, 0xa5, 0x90, 0x07, 0x9d, 0x7c,
+	0xfd, 0x88, 0x19, 0xef, 0xb5, 0x4e, 0x9d, 0x51, 0x93, 0x14, 0x24, 0x7a, 0x0b, 0xfa, 0x29, 0x7d,
+	0xb1, 0x8a, 0x52, 0x1a, 0x7e, 0x4a, 0xa3, 0xe7, 0x9f, 0xe5, 0x5e, 0x9b, 0x0b, 0x58, 0x28, 0xba,
+	0x07, 0x5d, 0xba, 0xa6, 0xc1, 0x05, 0x9d, 0xfb, 0x1b, 0xaf, 0xc3, 0x45, 0x14, 0x80, 0x1f, 0x43,
+	0xff, 0x51, 0x12, 0x5f, 0x47, 0xe9, 0x82, 0x86, 0xdc, 0x5c, 0xf4, 0x00, 0xfa, 0x81, 0x81, 0x78,
+	0x4e, 0xc5, 0xa2, 0x2c, 0x19, 0xfc, 0x0f, 0x17, 0xa0, 0xf0, 0xe9, 0x6c, 0x8d, 0x10, 0x34, 0xf3,
+	0x75, 0x14, 0x72, 0x7f, 0x36, 0x09, 0xff, 0x46, 0xc7, 0xd0, 0xce, 0xd7, 0x13, 0x3f, 0xfb, 0x4c,
+	0xfa, 0x50, 0x52, 0xe8, 0x04, 0xf6, 0x99, 0x3d, 0xab, 0x9c, 0x86, 0x5e, 0xe3, 0xd4, 0x19, 0xed,
+	0x93, 0x92, 0x16, 0x63, 0x66, 0x9b, 0x25, 0xf5, 0x9a, 0x5c, 0x93, 0xa4, 0xb6, 0x76, 0xa5, 0x55,
+	0xb1, 0x2b, 0xdb, 0x0b, 0x69, 0xff, 0xf7, 0x85, 0xa0, 0x11, 0xdc, 0x29, 0x91, 0x89, 0xf0, 0x2b,
+	0x73, 0x5a, 0x83, 0xd8, 0x30, 0x73, 0x6c, 0xe0, 0xc7, 0x01, 0x9d, 0xcf, 0x69, 0xe8, 0xed, 0x73,
+	0xc3, 0x15, 0xc0, 0x2c, 0x67, 0xab, 0xa0, 0xa9, 0xd7, 0x15, 0xab, 0x15, 0x14, 0x3a, 0x85, 0xde,
+	0xd2, 0xdf, 0xcc, 0x13, 0x3f, 0xe4, 0xae, 0x80, 0x53, 0x67, 0x74, 0x40, 0x74, 0x08, 0xff, 0x18,
+	0x5a, 0xc2, 0x94, 0x7b, 0xd0, 0xe5, 0x47, 0x47, 0x3b, 0x99, 0x0a, 0x60, 0x13, 0xdc, 0x08, 0xfb,
+	0x5c, 0xe1, 0x1a, 0x41, 0xe1, 0x3f, 0x3b, 0x00, 0xea, 0x34, 0x31, 0xb1, 0x6c, 0xb3, 0x78, 0x96,
+	0xcc, 0xa5, 0x06, 0x49, 0x69, 0xf6, 0xb9, 0x86, 0x7d, 0xf7, 0x01, 0xd4, 0xf9, 0xe3, 0xfb, 0xd1,
+	0x24, 0x1a, 0xc2, 0xf8, 0xd9, 0x92, 0xc6, 0xf9, 0x2c, 0x09, 0xfd, 0x8d, 0xdc, 0x15, 0x0d, 0x61,
+	0x07, 0x76, 0xee, 0x67, 0xf9, 0x85, 0xbf, 0xe1, 0x9b, 0xd2, 0x20, 0x05, 0x89, 0x9f, 0xc1, 0xd1,
+	0x15, 0x9f, 0xfb, 0x7f, 0x67, 0x1d, 0xfe, 0x77, 0x0b, 0xfa, 0xc5, 0x31, 0x1c, 0x07, 0x79, 0x94,
+	0xc4, 0x68, 0x02, 0x83, 0xf2, 0x58, 0x04, 0xc1, 0x23, 0x1e, 0xd9, 0x7c, 0xb6, 0xde, 0xb9, 0x27,
+	0x4f, 0xc2, 0x13, 0x9b, 0x3f, 0xd9, 0x23, 0xdb, 0x83, 0xd0, 0xcf, 0x60, 0x58, 0x80, 0x7c, 0x83,
+	0xa6, 0x4b, 0x9a, 0x32, 0x65, 0x2e, 0x57, 0xf6, 0x4d, 0x4b, 0x99, 0x2e, 0x32, 0xd9, 0x23, 0x95,
+	0x43, 0xd1, 0x47, 0x80, 0xb4, 0x79, 0x0a, 0x85, 0x0d, 0xae, 0xf0, 0x1b, 0xdb, 0xd6, 0x29, 0x75,
+	0x15, 0xc3, 0xf4, 0x95, 0xca, 0x98, 0x9e, 0xad, 0xbd, 0x66, 0xe5, 0x4a, 0x4b, 0xbe, 0xbe, 0xd2,
+	0x12, 0x44, 0x9f, 0xc2, 0x71, 0x01, 0x5e, 0xae, 0x69, 0x30, 0x4b, 0xfd, 0x38, 0xbb, 0xa6, 0xe9,
+	0x2c, 0xe1, 0x7b, 0xda, 0x3b, 0xff, 0x96, 0xa5, 0xce, 0x14, 0x9a, 0xec, 0x91, 0x1d, 0xc3, 0xd1,
+	0x2f, 0xc0, 0xab, 0xe2, 0x3c, 0x4e, 0x93, 0x05, 0x4f, 0x5f, 0xbd, 0xf3, 0x6f, 0xdf, 0xa2, 0x9a,
+	0x89, 0x4d, 0xf6, 0xc8, 0x4e, 0x15, 0xe8, 0x03, 0xb8, 0xa3, 0xf3, 0x56, 0x39, 0xe5, 0x81, 0xd9,
+	0x3b, 0x3f, 0xae, 0xd0, 0xba, 0xe2, 0x8e, 0xb4, 0x07, 0xa0, 0x4b, 0x38, 0x2a, 0x1d, 0xc2, 0xa3,
+	0x79, 0xb6, 0xe6, 0x21, 0xdc, 0x3b, 0x7f, 0xc3, 0x76, 0xa2, 0x64, 0x4f, 0xf6, 0xc8, 0xd6, 0x10,
+	0x5d, 0xcd, 0xd5, 0xea, 0xd9, 0x22, 0xca, 0x67, 0x6b, 0x0f, 0x2a, 0xd5, 0x14, 0x6c, 0x5d, 0x4d,
+	0x81, 0xa1, 0x3e, 0xb8, 0x33, 0x91, 0xb6, 0x5b, 0xc4, 0x9d, 0x6d, 0x3e, 0xe8, 0x40, 0xeb, 0xa5,
+	0x3f, 0x5f, 0x51, 0xfc, 0x85, 0x03, 0x83, 0xad, 0x73, 0xab, 0x55, 0x22, 0xe7, 0x96, 0x4a, 0xb4,
+	0x5d, 0x3a, 0xdc, 0xca, 0xd2, 0xf1, 0xde, 0x56, 0xb4, 0x29, 0xeb, 0xed, 0x50, 0x36, 0x92, 0x84,
+	0x51, 0x73, 0x9a, 0x76, 0xcd, 0xf9, 0xc2, 0x81, 0x61, 0x55, 0x94, 0xb0, 0xdc, 0xab, 0x1d, 0x6b,
+	0x2d, 0xed, 0xd9, 0x30, 0xab, 0x19, 0xc9, 0x5c, 0x66, 0x75, 0x91, 0x21, 0x4a, 0x9a, 0xf1, 0x62,
+	0x7a, 0x23, 0x78, 0x0d, 0xc1, 0x2b, 0x68, 0x66, 0x58, 0x4c, 0x6f, 0xe4, 0xa2, 0xa5, 0x61, 0x25,
+	0xc0, 0x72, 0x73, 0x22, 0x4c, 0x79, 0x3c, 0xf7, 0x9f, 0xcb, 0x82, 0xab, 0x43, 0xf8, 0x8f, 0x2e,
+	0xa0, 0xed, 0x78, 0x7c, 0x05, 0xc3, 0x4d, 0x97, 0xba, 0xf5, 0x5d, 0xfa, 0x5d, 0x18, 0xc4, 0xf4,
+	0x86, 0x98, 0xdb, 0x26, 0x12, 0xe0, 0x36, 0xc3, 0x5e, 0x49, 0x93, 0x57, 0x27, 0x1d, 0x62, 0x15,
+	0x34, 0xa6, 0x37, 0x97, 0xe5, 0x2e, 0x89, 0xc5, 0x1a, 0x18, 0xd3, 0x52, 0xee, 0xda, 0x74, 0xc9,
+	0x03, 0x74, 0x9f, 0xe8, 0x10, 0xfe, 0x8b, 0x03, 0xde, 0xae, 0x48, 0xbd, 0x2d, 0xb9, 0xfb, 0x0b,
+	0xde, 0xd2, 0xb8, 0xbc, 0x42, 0x48, 0x8a, 0x35, 0x0d, 0x71, 0x22, 0xd3, 0x5f, 0x97, 0xf0, 0xef,
+	0xa2, 0x39, 0x88, 0xfd, 0x85, 0x68, 0x01, 0xba, 0xa4, 0xa4, 0x59, 0x6c, 0xe4, 0x89, 0x2c, 0xfd,
+	0x6e, 0x9e, 0xb0, 0xf1, 0xd7, 0x45, 0x22, 0xe9, 0x12, 0xfe, 0x8d, 0x7f, 0xef, 0xc0, 0x71, 0x75,
+	0x96, 0xfa, 0x7f, 0x9b, 0x87, 0x7f, 0xad, 0x02, 0x56, 0x65, 0xda, 0xfa, 0x27, 0x87, 0xb7, 0x54,
+	0x1f, 0x86, 0x32, 0x54, 0xf9, 0xb7, 0xd6, 0xac, 0x4c, 0x53, 0x42, 0x5f, 0x26, 0xbf, 0xa4, 0xb2,
+	0x83, 0xb2, 0x61, 0x3c, 0x85, 0x3b, 0x56, 0xee, 0xfb, 0x72, 0x53, 0xe3, 0x4f, 0xe0, 0xc8, 0xce,
+	0x83, 0x5f, 0x52, 0x63, 0xac, 0x34, 0x96, 0xe9, 0xaf, 0xbe, 0xc6, 0x5d, 0x1d, 0x83, 0x07, 0x1d,
+	0xd9, 0x5c, 0x71, 0xd7, 0x1c, 0x90, 0x82, 0xc4, 0x0f, 0xe1, 0x0e, 0xa1, 0x2f, 0xb4, 0x68, 0xce,
+	0xd0, 0x10, 0x5a, 0x59, 0xee, 0xa7, 0x39, 0x9f, 0xa4, 0x41, 0x04, 0x81, 0x8e, 0xa0, 0x41, 0xe3,
+	0x50, 0x9e, 0x06, 0xf6, 0x89, 0xbf, 0x07, 0x03, 0x42, 0x97, 0xf3, 0x8d, 0x31, 0xd8, 0x83, 0x8e,
+	0x1f, 0x86, 0x29, 0xcd, 0x44, 0xf2, 0xed, 0x92, 0x82, 0xc4, 0x3f, 0x01, 0x64, 0xce, 0xf4, 0x61,
+	0x7c, 0x9d, 0xd4, 0x5f, 0x1b, 0xfe, 0x93, 0x0b, 0x43, 0x7b, 0x3e, 0xae, 0xe2, 0x6b, 0x7e, 0x79,
+	0xf9, 0xbb, 0x03, 0x47, 0x9a, 0x63, 0x67, 0xeb, 0x28, 0xcc, 0xb6, 0xd6, 0xec, 0x54, 0xac, 0xf9,
+	0x04, 0xf6, 0x59, 0x76, 0x98, 0xa9, 0x23, 0x58, 0xd2, 0xfc, 0xca, 0x91, 0x70, 0x4e, 0x43, 0x5e,
+	0x39, 0x38, 0xc5, 0x0f, 0x12, 0x8d, 0xc3, 0x28, 0x2e, 0xd2, 0x69, 0x41, 0x1a, 0x17, 0x98, 0x96,
+	0x75, 0x81, 0x31, 0x2e, 0x09, 0x6d, 0xeb, 0x92, 0x80, 0x3f, 0x06, 0x64, 0xec, 0x6b, 0xfd, 0x15,
+	0x0c, 0xa1, 0xc5, 0x2e, 0x55, 0x99, 0xe7, 0x9e, 0x36, 0x46, 0x4d, 0x22, 0x08, 0xfc, 0x11, 0x0c,
+	0x0c, 0x7f, 0xf0, 0x43, 0x52, 0x47, 0x5d, 0x75, 0x84, 0xdf, 0xb5, 0x8c, 0xe3, 0xea, 0x1e, 0xca,
+	0x5b, 0x76, 0x89, 0xc8, 0x66, 0x7a, 0x60, 0xb5, 0x35, 0xb3, 0x35, 0xb1, 0x04, 0xf1, 0x12, 0x4e,
+	0xcc, 0x38, 0x78, 0x1a, 0x5f, 0xa9, 0x9b, 0x43, 0x1d, 0x3b, 0x77, 0x45, 0xb9, 0xca, 0xe5, 0x0d,
+	0x3d, 0x97, 0xe3, 0x4f, 0xa4, 0x83, 0xe5, 0x44, 0xe3, 0x2c, 0xa3, 0x79, 0x86, 0x7e, 0x04, 0x87,
+	0x2b, 0x1d, 0x90, 0x27, 0x7f, 0x28, 0x57, 0x60, 0x08, 0x13, 0x53, 0x14, 0x7f, 0x0c, 0x87, 0xa6,
+	0xb2, 0xef, 0x40, 0xdb, 0x17, 0x5a, 0x84, 0x1f, 0x0e, 0xa5, 0x16, 0x39, 0x5c, 0x32, 0xad, 0xaa,
+	0xd2, 0x2c, 0xaa, 0x0a, 0xfe, 0x3e, 0xcb, 0x42, 0x01, 0x8d, 0x96, 0x79, 0xf9, 0x24, 0x51, 0xc3,
+	0x11, 0xf8, 0x57, 0x30, 0x94, 0xc3, 0xa6, 0xf2, 0x46, 0x38, 0x4d, 0x2f, 0xe8, 0xbc, 0x96, 0x13,
+	0x31, 0xb4, 0x92, 0xb2, 0x73, 0xb2, 0x03, 0x5e, 0xb0, 0xd8, 0x99, 0xf6, 0xa5, 0xce, 0xe2, 0x52,
+	0x5e, 0xd0, 0xf8, 0x6f, 0x8e, 0x39, 0xf9, 0x93, 0x24, 0x64, 0x75, 0x66, 0x59, 0x6b, 0xf2, 0xb7,
+	0xa1, 0xbb, 0x4c, 0xe9, 0xcb, 0xe9, 0x4e, 0x03, 0x14, 0x1b, 0xbd, 0x03, 0x07, 0xc1, 0x2a, 0x4d,
+	0x69, 0x9c, 0xab, 0x6e, 0xce, 0x16, 0x37, 0x24, 0x98, 0xd9, 0x0b, 0x69, 0x8d, 0x8c, 0xd2, 0x92,
+	0xc6, 0x9f, 0xc3, 0x5d, 0x69, 0xb5, 0x48, 0x2e, 0x4f, 0x92, 0x30, 0xba, 0xae, 0x77, 0xec, 0xee,
+	0x03, 0x30, 0xab, 0x8c, 0x66, 0x59, 0x43, 0xd0, 0x9b, 0x70, 0x28, 0xcd, 0x30, 0x1a, 0x33, 0x13,
+	0xc4, 0xbf, 0x85, 0x63, 0x69, 0x40, 0xd9, 0x62, 0xbd, 0x82, 0x0d, 0xf7, 0x84, 0xe3, 0xf8, 0x30,
+	0x69, 0x82, 0x02, 0x98, 0x06, 0x39, 0x99, 0x10, 0x10, 0x06, 0x18, 0x18, 0xfe, 0x97, 0x03, 0x9e,
+	0x34, 0x40, 0x65, 0xec, 0xa2, 0x85, 0xad, 0x63, 0xc2, 0x43, 0xe8, 0xf3, 0x19, 0xed, 0x06, 0xb6,
+	0xa2, 0x0e, 0x58, 0x82, 0xe8, 0x3d, 0xee, 0xa1, 0x0b, 0xfb, 0x36, 0x51, 0x31, 0xd2, 0x94, 0x63,
+	0x3d, 0x28, 0x3f, 0x78, 0xc2, 0x53, 0x45, 0x27, 0xab, 0x41, 0xf8, 0x77, 0xbc, 0x0a, 0xf0, 0x65,
+	0xa9, 0xbe, 0xea, 0x7d, 0x55, 0x5c, 0x67, 0xeb, 0xe2, 0x19, 0xab, 0xea, 0x26, 0x28, 0xb9, 0xc4,
+	0x16, 0x47, 0x6f, 0xc3, 0x51, 0xf1, 0xe2, 0x53, 0x36, 0x57, 0x2e, 0x9f, 0x7d, 0x0b, 0x67, 0x11,
+	0x71, 0x22, 0x4d, 0x18, 0x07, 0x81, 0xb2, 0xfe, 0xe9, 0x32, 0x7c, 0x8d, 0x7d, 0x8b, 0xff, 0xea,
+	0xc2, 0x40, 0x9a, 0xad, 0xdc, 0xf1, 0x15, 0xb8, 0x0e, 0xc3, 0x01, 0x33, 0xf1, 0xb2, 0x28, 0x8a,
+	0xc2, 0x6d, 0x06, 0xc6, 0xf6, 0x35, 0x58, 0xa5, 0x97, 0xe6, 0xc3, 0x9f, 0x0e, 0xb1, 0xfe, 0x28,
+	0xe3, 0x7d, 0xe0, 0x34, 0x95, 0xfb, 0x2a, 0x77, 0xdf, 0x86, 0xb5, 0x97, 0xc5, 0x96, 0xf1, 0xb2,
+	0xa8, 0x5e, 0x0f, 0xdb, 0xc6, 0xeb, 0xa1, 0xaa, 0x22, 0x9d, 0xdb, 0xde, 0xe6, 0xf6, 0xb7, 0xdf,
+	0xe6, 0xfe, 0xe9, 0xc2, 0x1b, 0x5b, 0x1e, 0xbb, 0xca, 0xfd, 0x7c, 0x95, 0xbd, 0x36, 0x7e, 0x7b,
+	0x07, 0xee, 0xb2, 0x11, 0x8f, 0xac, 0x57, 0xcc, 0x26, 0x6f, 0x5f, 0xab, 0x58, 0xe8, 0x0c, 0x50,
+	0xb0, 0x4a, 0xed, 0x01, 0xe2, 0xf9, 0xae, 0x82, 0xc3, 0xd2, 0x1d, 0x57, 0x63, 0x35, 0x36, 0x26,
+	0x28, 0x53, 0x92, 0x12, 0xea, 0x88, 0xd5, 0xe8, 0x18, 0xfe, 0x79, 0x59, 0x49, 0x66, 0xa2, 0x33,
+	0x7c, 0x85, 0x88, 0x61, 0xcd, 0xef, 0x2a, 0x95, 0xe3, 0x8a, 0xa4, 0xac, 0x10, 0xfc, 0xb9, 0xba,
+	0xf2, 0x68, 0x0e, 0xae, 0xd7, 0x0a, 0x45, 0x5a, 0x2b, 0x14, 0x85, 0x15, 0x4f, 0xc9, 0x55, 0xa5,
+	0xc8, 0x92, 0xc1, 0xf7, 0xa0, 0xfd, 0x34, 0x8a, 0xf3, 0x1f, 0x3c, 0x60, 0x3a, 0x43, 0x3f, 0xf7,
+	0x8b, 0xe7, 0x70, 0xf6, 0x8d, 0x53, 0x38, 0x1c, 0x8b, 0xdf, 0x12, 0xb2, 0x91, 0xa8, 0x63, 0x9c,
+	0x6a, 0x36, 0xdc, 0x7a, 0xcd, 0x46, 0x43, 0xbf, 0xc2, 0xe2, 0x04, 0x0e, 0x08, 0x7d, 0xc1, 0xae,
+	0x15, 0x5f, 0xf9, 0x94, 0x43, 0x68, 0x45, 0xd9, 0x78, 0x5e, 0x74, 0x0b, 0x82, 0xc0, 0xef, 0x43,
+	0x9f, 0xf7, 0x5f, 0x6a, 0xca, 0x33, 0xe8, 0xfa, 0x05, 0x21, 0x1f, 0xa9, 0x8e, 0x0a, 0x8d, 0x05,
+	0x4e, 0x94, 0x08, 0xfe, 0x0d, 0x74, 0xd5, 0xe0, 0x9a, 0xbd, 0xd6, 0x7d, 0x80, 0x94, 0x06, 0x2f,
+	0xc7, 0xfa, 0x2d, 0x5e, 0x43, 0xd0, 0x08, 0x3a, 0xf2, 0x8f, 0x90, 0xdc, 0xc7, 0xbe, 0xb2, 0x80,
+	0xa1, 0xa4, 0x60, 0xe3, 0x1f, 0x42, 0x7b, 0x5c, 0xba, 0x54, 0xe6, 0x0c, 0x67, 0x47, 0xe7, 0xe9,
+	0x1a, 0x9d, 0xe7, 0x5b, 0x00, 0xf2, 0xfa, 0x46, 0xb3, 0xdb, 0xee, 0x86, 0x14, 0xba, 0xa2, 0x83,
+	0xcb, 0xf3, 0xb4, 0x6e, 0x1f, 0xa0, 0xfe, 0x0a, 0xb8, 0xbb, 0xff, 0x0a, 0x34, 0x8c, 0xbf, 0x02,
+	0x0f, 0x00, 0xca, 0x69, 0xd8, 0x03, 0x60, 0x2b, 0xca, 0xe9, 0xc2, 0xde, 0x80, 0x52, 0x82, 0x08,
+	0xf6, 0xb3, 0x36, 0xff, 0x61, 0xf6, 0xee, 0x7f, 0x06, 0x00, 0xf8, 0xc5, 0xde, 0x74, 0x58, 0x1b,
+	0x00, 0x00,
 }
@@ -19,6 +19,8 @@ func init() {
 //InitFork ...
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(MultiSigX, "Enable", 0)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigTimelockX, 10000000)
+	cfg.RegisterDappFork(MultiSigX, ForkMultiSigSubmitTxX, 10000000)
 }
 
 //InitExecutor ...
@@ -58,6 +60,9 @@ func (m *MultiSigType) GetTypeMap() map[string]int32 {
 		"MultiSigConfirmTx":        ActionMultiSigConfirmTx,
 		"MultiSigExecTransferTo":   ActionMultiSigExecTransferTo,
 		"MultiSigExecTransferFrom": ActionMultiSigExecTransferFrom,
+		"MultiSigExecute":          ActionMultiSigExecute,
+		"MultiSigCancelTx":         ActionMultiSigCancelTx,
+		"MultiSigSubmitTx":         ActionMultiSigSubmitTx,
 	}
 }
 
@@ -81,6 +86,9 @@ func (m *MultiSigType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogDailyLimitUpdate: {Ty: reflect.TypeOf(ReceiptAccDailyLimitUpdate{}), Name: "LogAccDailyLimitUpdate"},
 		TyLogMultiSigTx:       {Ty: reflect.TypeOf(ReceiptMultiSigTx{}), Name: "LogMultiSigAccTx"},
 		TyLogTxCountUpdate:    {Ty: reflect.TypeOf(ReceiptTxCountUpdate{}), Name: "LogTxCountUpdate"},
+
+		TyLogMultiSigTxStatus:           {Ty: reflect.TypeOf(ReceiptMultiSigTxStatus{}), Name: "LogMultiSigTxStatus"},
+		TyLogMultiSigAccExecDelayModify: {Ty: reflect.TypeOf(ReceiptExecDelayModify{}), Name: "LogMultiSigAccExecDelayModify"},
 	}
 }
 
@@ -113,6 +121,12 @@ func (m MultiSigType) ActionName(tx *types.Transaction) string {
 		return "MultiSigExecTransfer"
 	} else if g.Ty == ActionMultiSigExecTransferFrom && g.GetMultiSigExecTransferFrom() != nil {
 		return "MultiSigAccExecTransfer"
+	} else if g.Ty == ActionMultiSigExecute && g.GetMultiSigExecute() != nil {
+		return "MultiSigExecute"
+	} else if g.Ty == ActionMultiSigCancelTx && g.GetMultiSigCancelTx() != nil {
+		return "MultiSigCancelTx"
+	} else if g.Ty == ActionMultiSigSubmitTx && g.GetMultiSigSubmitTx() != nil {
+		return "MultiSigSubmitTx"
 	}
 	return "unknown"
 }
@@ -120,6 +120,12 @@ func (e *Paracross) Exec_SelfStageConfig(payload *pt.ParaStageConfig, tx *types.
 	return a.SelfStageConfig(payload)
 }
 
+//Exec_MultiSigExec node config as multisig account process
+func (e *Paracross) Exec_MultiSigExec(payload *pt.ParaMultiSigExec, tx *types.Transaction, index int) (*types.Receipt, error) {
+	a := newAction(e, tx)
+	return a.MultiSigExec(payload)
+}
+
 //Exec_ParaBindMiner node group config process
 func (e *Paracross) Exec_ParaBindMiner(payload *pt.ParaBindMinerCmd, tx *types.Transaction, index int) (*types.Receipt, error) {
 	a := newAction(e, tx)
@@ -202,3 +202,16 @@ func (e *Paracross) ExecDelLocal_TransferToExec(payload *types.AssetsTransferToE
 func (e *Paracross) ExecDelLocal_SelfStageConfig(payload *pt.ParaStageConfig, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return e.execAutoDelLocal(tx, receiptData)
 }
+
+//ExecDelLocal_MultiSigExec node config as multisig account del local db process
+func (e *Paracross) ExecDelLocal_MultiSigExec(payload *pt.ParaMultiSigExec, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	var action pt.ParacrossAction
+	err := types.Decode(payload.GetPayload(), &action)
+	if err != nil {
+		return nil, err
+	}
+	if action.Ty == pt.ParacrossActionNodeConfig {
+		return e.ExecDelLocal_NodeConfig(action.GetNodeConfig(), tx, receiptData, index)
+	}
+	return e.ExecDelLocal_NodeGroupConfig(action.GetNodeGroupConfig(), tx, receiptData, index)
+}
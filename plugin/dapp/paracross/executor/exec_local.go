@@ -322,3 +322,16 @@ func (e *Paracross) ExecLocal_TransferToExec(payload *types.AssetsTransferToExec
 func (e *Paracross) ExecLocal_SelfStageConfig(payload *pt.ParaStageConfig, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	return e.execAutoLocalStage(tx, receiptData, index)
 }
+
+//ExecLocal_MultiSigExec node config as multisig account local db process
+func (e *Paracross) ExecLocal_MultiSigExec(payload *pt.ParaMultiSigExec, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	var action pt.ParacrossAction
+	err := types.Decode(payload.GetPayload(), &action)
+	if err != nil {
+		return nil, err
+	}
+	if action.Ty == pt.ParacrossActionNodeConfig {
+		return e.ExecLocal_NodeConfig(action.GetNodeConfig(), tx, receiptData, index)
+	}
+	return e.ExecLocal_NodeGroupConfig(action.GetNodeGroupConfig(), tx, receiptData, index)
+}
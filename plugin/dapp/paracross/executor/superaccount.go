@@ -14,6 +14,7 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	manager "github.com/33cn/chain33/system/dapp/manage/types"
 	"github.com/33cn/chain33/types"
+	msexec "github.com/33cn/plugin/plugin/dapp/multisig/executor"
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -1089,6 +1090,45 @@ func (a *action) NodeGroupConfig(config *pt.ParaNodeGroupConfig) (*types.Receipt
 
 }
 
+//MultiSigExec 以多重签名账户的身份执行节点和节点组的配置，交易发送者需要是多重签名账户的owner，
+//payload需要先在multisig合约中用MultiSigSubmitTx提交并执行，每个txid只能使用一次
+func (a *action) MultiSigExec(exec *pt.ParaMultiSigExec) (*types.Receipt, error) {
+	cfg := a.api.GetConfig()
+	if !cfg.IsDappFork(a.height, pt.ParaX, pt.ForkParaMultiSig) {
+		return nil, types.ErrActionNotSupport
+	}
+	var inner pt.ParacrossAction
+	err := types.Decode(exec.GetPayload(), &inner)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidParam, "decode payload")
+	}
+	//只在主链检查，多重签名账户在主链上，主链执行成功之后才会同步到平行链
+	var kv *types.KeyValue
+	if !cfg.IsPara() {
+		kv, err = msexec.UseSubmitTx(a.db, pt.ParaX, a.fromaddr, exec.GetMultiSigAddr(), exec.GetTxid(), exec.GetPayload())
+		if err != nil {
+			return nil, errors.Wrapf(err, "multisig addr:%s,txid:%d", exec.GetMultiSigAddr(), exec.GetTxid())
+		}
+	}
+
+	a.fromaddr = exec.GetMultiSigAddr()
+	var receipt *types.Receipt
+	if inner.Ty == pt.ParacrossActionNodeConfig && inner.GetNodeConfig() != nil {
+		receipt, err = a.NodeConfig(inner.GetNodeConfig())
+	} else if inner.Ty == pt.ParacrossActionNodeGroupApply && inner.GetNodeGroupConfig() != nil {
+		receipt, err = a.NodeGroupConfig(inner.GetNodeGroupConfig())
+	} else {
+		return nil, errors.Wrapf(types.ErrActionNotSupport, "multisig exec action:%d", inner.Ty)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if kv != nil {
+		receipt.KV = append(receipt.KV, kv)
+	}
+	return receipt, nil
+}
+
 //NodeConfig support super account node config
 func (a *action) NodeConfig(config *pt.ParaNodeAddrConfig) (*types.Receipt, error) {
 	cfg := a.api.GetConfig()
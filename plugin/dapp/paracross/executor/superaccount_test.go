@@ -8,17 +8,24 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
+	"fmt"
+	"math/rand"
+
 	//"github.com/stretchr/testify/mock"
 	"testing"
 
 	apimock "github.com/33cn/chain33/client/mocks"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	dbm "github.com/33cn/chain33/common/db"
 	dbmock "github.com/33cn/chain33/common/db/mocks"
 	"github.com/33cn/chain33/types"
 
 	"strings"
 
+	msty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 	pt "github.com/33cn/plugin/plugin/dapp/paracross/types"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -404,3 +411,73 @@ func TestGetNodeIdSuffix(t *testing.T) {
 	assert.Equal(t, txID, rtID)
 
 }
+
+func TestNodeGroupApproveByMultiSig(t *testing.T) {
+	title := "user.p.test."
+	// 主链的超级管理员为多重签名地址，由B和C管理
+	multiSigAddr := address.MultiSignAddress([]byte("para-multisig"))
+	cfgstring := strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"test\"", 1)
+	cfgstring = strings.Replace(cfgstring, "[exec.sub.manage]\nsuperManager=[", "[exec.sub.manage]\nsuperManager=[\""+multiSigAddr+"\",", 1)
+	cfg := types.NewChain33Config(cfgstring)
+	assert.True(t, isSuperManager(cfg, multiSigAddr))
+
+	stateDB, _ := dbm.NewGoMemDB("state", "state", 1024)
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	exec := newParacross().(*Paracross)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetEnv(cfg.GetDappFork(pt.ParaX, pt.ForkParaMultiSig), 0, 0)
+	execTx := func(action *pt.ParacrossAction, privKey string) (*types.Receipt, error) {
+		tx := &types.Transaction{Execer: []byte(title + pt.ParaX), Payload: types.Encode(action), Nonce: rand.Int63()}
+		tx, _ = signTx(suite.Suite{}, tx, privKey)
+		receipt, err := exec.Exec(tx, 0)
+		if err != nil {
+			return nil, errors.Cause(err)
+		}
+		for _, kv := range receipt.KV {
+			assert.Nil(t, stateDB.Set(kv.Key, kv.Value))
+		}
+		return receipt, nil
+	}
+
+	multiSig := &msty.MultiSig{MultiSigAddr: multiSigAddr, Owners: []*msty.Owner{{OwnerAddr: string(Nodes[1]), Weight: 1}, {OwnerAddr: string(Nodes[2]), Weight: 1}}, RequiredWeight: 2}
+	stateDB.Set([]byte("mavl-multisig-"+multiSigAddr), types.Encode(multiSig))
+	// 模拟multisig合约中MultiSigSubmitTx的执行结果
+	submit := func(txid uint64, action *pt.ParacrossAction) *pt.ParacrossAction {
+		payload := types.Encode(action)
+		multiSigTx := &msty.MultiSigTx{Txid: txid, TxType: msty.SubmitOperate, MultiSigAddr: multiSigAddr, Executed: true, Execer: pt.ParaX, PayloadHash: common.Sha256(payload)}
+		stateDB.Set([]byte(fmt.Sprintf("mavl-multisig-tx-%s-%018d", multiSigAddr, txid)), types.Encode(multiSigTx))
+		return &pt.ParacrossAction{Ty: pt.ParacrossActionMultiSigExec,
+			Value: &pt.ParacrossAction_MultiSigExec{MultiSigExec: &pt.ParaMultiSigExec{MultiSigAddr: multiSigAddr, Txid: txid, Payload: payload}}}
+	}
+
+	apply := &pt.ParacrossAction{Ty: pt.ParacrossActionNodeGroupApply,
+		Value: &pt.ParacrossAction_NodeGroupConfig{NodeGroupConfig: &pt.ParaNodeGroupConfig{Title: title, Addrs: applyAddrs, Op: pt.ParacrossNodeGroupApply}}}
+	receipt, err := execTx(apply, PrivKeyA)
+	assert.Nil(t, err)
+	var g pt.ReceiptParaNodeGroupConfig
+	assert.Nil(t, types.Decode(receipt.Logs[len(receipt.Logs)-1].Log, &g))
+
+	// 多重签名地址没有私钥，只能由owner发起，以多重签名地址的身份审批
+	approve := &pt.ParacrossAction{Ty: pt.ParacrossActionNodeGroupApply,
+		Value: &pt.ParacrossAction_NodeGroupConfig{NodeGroupConfig: &pt.ParaNodeGroupConfig{Title: title, Id: getParaNodeIDSuffix(g.Current.Id), Op: pt.ParacrossNodeGroupApprove}}}
+	_, err = execTx(approve, PrivKeyB)
+	assert.Equal(t, types.ErrNotAllow, err)
+	multiSigExec := submit(0, approve)
+	_, err = execTx(multiSigExec, PrivKeyA)
+	assert.Equal(t, msty.ErrIsNotOwner, err)
+	_, err = execTx(multiSigExec, PrivKeyB)
+	assert.Nil(t, err)
+	nodes, _, err := getParacrossNodes(stateDB, title)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(nodes))
+	_, err = execTx(multiSigExec, PrivKeyC)
+	assert.Equal(t, msty.ErrSubmitTxUsed, err)
+
+	// 只支持节点和节点组的配置
+	commit := &pt.ParacrossAction{Ty: pt.ParacrossActionCommit,
+		Value: &pt.ParacrossAction_Commit{Commit: &pt.ParacrossCommitAction{Status: &pt.ParacrossNodeStatus{Title: title}}}}
+	_, err = execTx(submit(1, commit), PrivKeyB)
+	assert.Equal(t, types.ErrActionNotSupport, err)
+}
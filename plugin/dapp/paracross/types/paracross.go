@@ -85,6 +85,8 @@ const (
 	ParacrossActionSelfStageConfig
 	// ParacrossActionCrossAssetTransfer crossChain asset transfer key
 	ParacrossActionCrossAssetTransfer
+	// ParacrossActionMultiSigExec exec node config as multisig account
+	ParacrossActionMultiSigExec
 )
 
 //paracross asset porcess
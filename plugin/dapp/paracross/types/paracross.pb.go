@@ -1039,6 +1039,63 @@ func (m *ParaNodeGroupConfig) GetBlsPubKeys() string {
 	return ""
 }
 
+// 以多重签名账户的身份执行节点和节点组的配置, payload为编码后的ParacrossAction
+// 需要先在multisig合约中用MultiSigSubmitTx提交并执行, 由多重签名账户的owner发起, 每个txid只能使用一次
+type ParaMultiSigExec struct {
+	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	Txid                 uint64   `protobuf:"varint,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ParaMultiSigExec) Reset()         { *m = ParaMultiSigExec{} }
+func (m *ParaMultiSigExec) String() string { return proto.CompactTextString(m) }
+func (*ParaMultiSigExec) ProtoMessage()    {}
+func (*ParaMultiSigExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a397e38c9ea6747, []int{14}
+}
+
+func (m *ParaMultiSigExec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ParaMultiSigExec.Unmarshal(m, b)
+}
+func (m *ParaMultiSigExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ParaMultiSigExec.Marshal(b, m, deterministic)
+}
+func (m *ParaMultiSigExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParaMultiSigExec.Merge(m, src)
+}
+func (m *ParaMultiSigExec) XXX_Size() int {
+	return xxx_messageInfo_ParaMultiSigExec.Size(m)
+}
+func (m *ParaMultiSigExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParaMultiSigExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParaMultiSigExec proto.InternalMessageInfo
+
+func (m *ParaMultiSigExec) GetMultiSigAddr() string {
+	if m != nil {
+		return m.MultiSigAddr
+	}
+	return ""
+}
+
+func (m *ParaMultiSigExec) GetTxid() uint64 {
+	if m != nil {
+		return m.Txid
+	}
+	return 0
+}
+
+func (m *ParaMultiSigExec) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 type ParaNodeGroupStatus struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1057,7 +1114,7 @@ func (m *ParaNodeGroupStatus) Reset()         { *m = ParaNodeGroupStatus{} }
 func (m *ParaNodeGroupStatus) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeGroupStatus) ProtoMessage()    {}
 func (*ParaNodeGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{14}
+	return fileDescriptor_6a397e38c9ea6747, []int{15}
 }
 
 func (m *ParaNodeGroupStatus) XXX_Unmarshal(b []byte) error {
@@ -1148,7 +1205,7 @@ func (m *ReceiptParaNodeGroupConfig) Reset()         { *m = ReceiptParaNodeGroup
 func (m *ReceiptParaNodeGroupConfig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParaNodeGroupConfig) ProtoMessage()    {}
 func (*ReceiptParaNodeGroupConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{15}
+	return fileDescriptor_6a397e38c9ea6747, []int{16}
 }
 
 func (m *ReceiptParaNodeGroupConfig) XXX_Unmarshal(b []byte) error {
@@ -1213,7 +1270,7 @@ func (m *ReqParacrossNodeInfo) Reset()         { *m = ReqParacrossNodeInfo{} }
 func (m *ReqParacrossNodeInfo) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossNodeInfo) ProtoMessage()    {}
 func (*ReqParacrossNodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{16}
+	return fileDescriptor_6a397e38c9ea6747, []int{17}
 }
 
 func (m *ReqParacrossNodeInfo) XXX_Unmarshal(b []byte) error {
@@ -1280,7 +1337,7 @@ func (m *RespParacrossNodeAddrs) Reset()         { *m = RespParacrossNodeAddrs{}
 func (m *RespParacrossNodeAddrs) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossNodeAddrs) ProtoMessage()    {}
 func (*RespParacrossNodeAddrs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{17}
+	return fileDescriptor_6a397e38c9ea6747, []int{18}
 }
 
 func (m *RespParacrossNodeAddrs) XXX_Unmarshal(b []byte) error {
@@ -1319,7 +1376,7 @@ func (m *RespParacrossNodeGroups) Reset()         { *m = RespParacrossNodeGroups
 func (m *RespParacrossNodeGroups) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossNodeGroups) ProtoMessage()    {}
 func (*RespParacrossNodeGroups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{18}
+	return fileDescriptor_6a397e38c9ea6747, []int{19}
 }
 
 func (m *RespParacrossNodeGroups) XXX_Unmarshal(b []byte) error {
@@ -1361,7 +1418,7 @@ func (m *ParaBindMinerCmd) Reset()         { *m = ParaBindMinerCmd{} }
 func (m *ParaBindMinerCmd) String() string { return proto.CompactTextString(m) }
 func (*ParaBindMinerCmd) ProtoMessage()    {}
 func (*ParaBindMinerCmd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{19}
+	return fileDescriptor_6a397e38c9ea6747, []int{20}
 }
 
 func (m *ParaBindMinerCmd) XXX_Unmarshal(b []byte) error {
@@ -1419,7 +1476,7 @@ func (m *ParaBindMinerInfo) Reset()         { *m = ParaBindMinerInfo{} }
 func (m *ParaBindMinerInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaBindMinerInfo) ProtoMessage()    {}
 func (*ParaBindMinerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{20}
+	return fileDescriptor_6a397e38c9ea6747, []int{21}
 }
 
 func (m *ParaBindMinerInfo) XXX_Unmarshal(b []byte) error {
@@ -1495,7 +1552,7 @@ func (m *ReceiptParaBindMinerInfo) Reset()         { *m = ReceiptParaBindMinerIn
 func (m *ReceiptParaBindMinerInfo) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParaBindMinerInfo) ProtoMessage()    {}
 func (*ReceiptParaBindMinerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{21}
+	return fileDescriptor_6a397e38c9ea6747, []int{22}
 }
 
 func (m *ReceiptParaBindMinerInfo) XXX_Unmarshal(b []byte) error {
@@ -1549,7 +1606,7 @@ func (m *ParaNodeBindOne) Reset()         { *m = ParaNodeBindOne{} }
 func (m *ParaNodeBindOne) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeBindOne) ProtoMessage()    {}
 func (*ParaNodeBindOne) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{22}
+	return fileDescriptor_6a397e38c9ea6747, []int{23}
 }
 
 func (m *ParaNodeBindOne) XXX_Unmarshal(b []byte) error {
@@ -1596,7 +1653,7 @@ func (m *ParaNodeBindList) Reset()         { *m = ParaNodeBindList{} }
 func (m *ParaNodeBindList) String() string { return proto.CompactTextString(m) }
 func (*ParaNodeBindList) ProtoMessage()    {}
 func (*ParaNodeBindList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{23}
+	return fileDescriptor_6a397e38c9ea6747, []int{24}
 }
 
 func (m *ParaNodeBindList) XXX_Unmarshal(b []byte) error {
@@ -1643,7 +1700,7 @@ func (m *ReceiptParaNodeBindListUpdate) Reset()         { *m = ReceiptParaNodeBi
 func (m *ReceiptParaNodeBindListUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParaNodeBindListUpdate) ProtoMessage()    {}
 func (*ReceiptParaNodeBindListUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{24}
+	return fileDescriptor_6a397e38c9ea6747, []int{25}
 }
 
 func (m *ReceiptParaNodeBindListUpdate) XXX_Unmarshal(b []byte) error {
@@ -1690,7 +1747,7 @@ func (m *RespParaNodeBindList) Reset()         { *m = RespParaNodeBindList{} }
 func (m *RespParaNodeBindList) String() string { return proto.CompactTextString(m) }
 func (*RespParaNodeBindList) ProtoMessage()    {}
 func (*RespParaNodeBindList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{25}
+	return fileDescriptor_6a397e38c9ea6747, []int{26}
 }
 
 func (m *RespParaNodeBindList) XXX_Unmarshal(b []byte) error {
@@ -1739,7 +1796,7 @@ func (m *ParaBlock2MainMap) Reset()         { *m = ParaBlock2MainMap{} }
 func (m *ParaBlock2MainMap) String() string { return proto.CompactTextString(m) }
 func (*ParaBlock2MainMap) ProtoMessage()    {}
 func (*ParaBlock2MainMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{26}
+	return fileDescriptor_6a397e38c9ea6747, []int{27}
 }
 
 func (m *ParaBlock2MainMap) XXX_Unmarshal(b []byte) error {
@@ -1799,7 +1856,7 @@ func (m *ParaBlock2MainInfo) Reset()         { *m = ParaBlock2MainInfo{} }
 func (m *ParaBlock2MainInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaBlock2MainInfo) ProtoMessage()    {}
 func (*ParaBlock2MainInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{27}
+	return fileDescriptor_6a397e38c9ea6747, []int{28}
 }
 
 func (m *ParaBlock2MainInfo) XXX_Unmarshal(b []byte) error {
@@ -1852,7 +1909,7 @@ func (m *ParacrossNodeStatus) Reset()         { *m = ParacrossNodeStatus{} }
 func (m *ParacrossNodeStatus) String() string { return proto.CompactTextString(m) }
 func (*ParacrossNodeStatus) ProtoMessage()    {}
 func (*ParacrossNodeStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{28}
+	return fileDescriptor_6a397e38c9ea6747, []int{29}
 }
 
 func (m *ParacrossNodeStatus) XXX_Unmarshal(b []byte) error {
@@ -1982,7 +2039,7 @@ func (m *SelfConsensStages) Reset()         { *m = SelfConsensStages{} }
 func (m *SelfConsensStages) String() string { return proto.CompactTextString(m) }
 func (*SelfConsensStages) ProtoMessage()    {}
 func (*SelfConsensStages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{29}
+	return fileDescriptor_6a397e38c9ea6747, []int{30}
 }
 
 func (m *SelfConsensStages) XXX_Unmarshal(b []byte) error {
@@ -2022,7 +2079,7 @@ func (m *SelfConsensStage) Reset()         { *m = SelfConsensStage{} }
 func (m *SelfConsensStage) String() string { return proto.CompactTextString(m) }
 func (*SelfConsensStage) ProtoMessage()    {}
 func (*SelfConsensStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{30}
+	return fileDescriptor_6a397e38c9ea6747, []int{31}
 }
 
 func (m *SelfConsensStage) XXX_Unmarshal(b []byte) error {
@@ -2073,7 +2130,7 @@ func (m *SelfConsensStageInfo) Reset()         { *m = SelfConsensStageInfo{} }
 func (m *SelfConsensStageInfo) String() string { return proto.CompactTextString(m) }
 func (*SelfConsensStageInfo) ProtoMessage()    {}
 func (*SelfConsensStageInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{31}
+	return fileDescriptor_6a397e38c9ea6747, []int{32}
 }
 
 func (m *SelfConsensStageInfo) XXX_Unmarshal(b []byte) error {
@@ -2148,7 +2205,7 @@ func (m *LocalSelfConsStageInfo) Reset()         { *m = LocalSelfConsStageInfo{}
 func (m *LocalSelfConsStageInfo) String() string { return proto.CompactTextString(m) }
 func (*LocalSelfConsStageInfo) ProtoMessage()    {}
 func (*LocalSelfConsStageInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{32}
+	return fileDescriptor_6a397e38c9ea6747, []int{33}
 }
 
 func (m *LocalSelfConsStageInfo) XXX_Unmarshal(b []byte) error {
@@ -2196,7 +2253,7 @@ func (m *ConfigVoteInfo) Reset()         { *m = ConfigVoteInfo{} }
 func (m *ConfigVoteInfo) String() string { return proto.CompactTextString(m) }
 func (*ConfigVoteInfo) ProtoMessage()    {}
 func (*ConfigVoteInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{33}
+	return fileDescriptor_6a397e38c9ea6747, []int{34}
 }
 
 func (m *ConfigVoteInfo) XXX_Unmarshal(b []byte) error {
@@ -2242,7 +2299,7 @@ func (m *ConfigCancelInfo) Reset()         { *m = ConfigCancelInfo{} }
 func (m *ConfigCancelInfo) String() string { return proto.CompactTextString(m) }
 func (*ConfigCancelInfo) ProtoMessage()    {}
 func (*ConfigCancelInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{34}
+	return fileDescriptor_6a397e38c9ea6747, []int{35}
 }
 
 func (m *ConfigCancelInfo) XXX_Unmarshal(b []byte) error {
@@ -2289,7 +2346,7 @@ func (m *ParaStageConfig) Reset()         { *m = ParaStageConfig{} }
 func (m *ParaStageConfig) String() string { return proto.CompactTextString(m) }
 func (*ParaStageConfig) ProtoMessage()    {}
 func (*ParaStageConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{35}
+	return fileDescriptor_6a397e38c9ea6747, []int{36}
 }
 
 func (m *ParaStageConfig) XXX_Unmarshal(b []byte) error {
@@ -2395,7 +2452,7 @@ func (m *ReceiptSelfConsStageConfig) Reset()         { *m = ReceiptSelfConsStage
 func (m *ReceiptSelfConsStageConfig) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSelfConsStageConfig) ProtoMessage()    {}
 func (*ReceiptSelfConsStageConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{36}
+	return fileDescriptor_6a397e38c9ea6747, []int{37}
 }
 
 func (m *ReceiptSelfConsStageConfig) XXX_Unmarshal(b []byte) error {
@@ -2446,7 +2503,7 @@ func (m *ReceiptSelfConsStageVoteDone) Reset()         { *m = ReceiptSelfConsSta
 func (m *ReceiptSelfConsStageVoteDone) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSelfConsStageVoteDone) ProtoMessage()    {}
 func (*ReceiptSelfConsStageVoteDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{37}
+	return fileDescriptor_6a397e38c9ea6747, []int{38}
 }
 
 func (m *ReceiptSelfConsStageVoteDone) XXX_Unmarshal(b []byte) error {
@@ -2521,7 +2578,7 @@ func (m *ReceiptSelfConsStagesUpdate) Reset()         { *m = ReceiptSelfConsStag
 func (m *ReceiptSelfConsStagesUpdate) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSelfConsStagesUpdate) ProtoMessage()    {}
 func (*ReceiptSelfConsStagesUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{38}
+	return fileDescriptor_6a397e38c9ea6747, []int{39}
 }
 
 func (m *ReceiptSelfConsStagesUpdate) XXX_Unmarshal(b []byte) error {
@@ -2573,7 +2630,7 @@ func (m *ReqQuerySelfStages) Reset()         { *m = ReqQuerySelfStages{} }
 func (m *ReqQuerySelfStages) String() string { return proto.CompactTextString(m) }
 func (*ReqQuerySelfStages) ProtoMessage()    {}
 func (*ReqQuerySelfStages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{39}
+	return fileDescriptor_6a397e38c9ea6747, []int{40}
 }
 
 func (m *ReqQuerySelfStages) XXX_Unmarshal(b []byte) error {
@@ -2647,7 +2704,7 @@ func (m *ReplyQuerySelfStages) Reset()         { *m = ReplyQuerySelfStages{} }
 func (m *ReplyQuerySelfStages) String() string { return proto.CompactTextString(m) }
 func (*ReplyQuerySelfStages) ProtoMessage()    {}
 func (*ReplyQuerySelfStages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{40}
+	return fileDescriptor_6a397e38c9ea6747, []int{41}
 }
 
 func (m *ReplyQuerySelfStages) XXX_Unmarshal(b []byte) error {
@@ -2688,7 +2745,7 @@ func (m *ParacrossCommitBlsInfo) Reset()         { *m = ParacrossCommitBlsInfo{}
 func (m *ParacrossCommitBlsInfo) String() string { return proto.CompactTextString(m) }
 func (*ParacrossCommitBlsInfo) ProtoMessage()    {}
 func (*ParacrossCommitBlsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{41}
+	return fileDescriptor_6a397e38c9ea6747, []int{42}
 }
 
 func (m *ParacrossCommitBlsInfo) XXX_Unmarshal(b []byte) error {
@@ -2742,7 +2799,7 @@ func (m *ParacrossCommitAction) Reset()         { *m = ParacrossCommitAction{} }
 func (m *ParacrossCommitAction) String() string { return proto.CompactTextString(m) }
 func (*ParacrossCommitAction) ProtoMessage()    {}
 func (*ParacrossCommitAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{42}
+	return fileDescriptor_6a397e38c9ea6747, []int{43}
 }
 
 func (m *ParacrossCommitAction) XXX_Unmarshal(b []byte) error {
@@ -2790,7 +2847,7 @@ func (m *ParacrossMinerAction) Reset()         { *m = ParacrossMinerAction{} }
 func (m *ParacrossMinerAction) String() string { return proto.CompactTextString(m) }
 func (*ParacrossMinerAction) ProtoMessage()    {}
 func (*ParacrossMinerAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{43}
+	return fileDescriptor_6a397e38c9ea6747, []int{44}
 }
 
 func (m *ParacrossMinerAction) XXX_Unmarshal(b []byte) error {
@@ -2844,7 +2901,7 @@ func (m *ParaMinerReward) Reset()         { *m = ParaMinerReward{} }
 func (m *ParaMinerReward) String() string { return proto.CompactTextString(m) }
 func (*ParaMinerReward) ProtoMessage()    {}
 func (*ParaMinerReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{44}
+	return fileDescriptor_6a397e38c9ea6747, []int{45}
 }
 
 func (m *ParaMinerReward) XXX_Unmarshal(b []byte) error {
@@ -2895,7 +2952,7 @@ func (m *CrossAssetTransfer) Reset()         { *m = CrossAssetTransfer{} }
 func (m *CrossAssetTransfer) String() string { return proto.CompactTextString(m) }
 func (*CrossAssetTransfer) ProtoMessage()    {}
 func (*CrossAssetTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{45}
+	return fileDescriptor_6a397e38c9ea6747, []int{46}
 }
 
 func (m *CrossAssetTransfer) XXX_Unmarshal(b []byte) error {
@@ -2965,6 +3022,7 @@ type ParacrossAction struct {
 	//	*ParacrossAction_SelfStageConfig
 	//	*ParacrossAction_CrossAssetTransfer
 	//	*ParacrossAction_ParaBindMiner
+	//	*ParacrossAction_MultiSigExec
 	Value                isParacrossAction_Value `protobuf_oneof:"value"`
 	Ty                   int32                   `protobuf:"varint,2,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
@@ -2976,7 +3034,7 @@ func (m *ParacrossAction) Reset()         { *m = ParacrossAction{} }
 func (m *ParacrossAction) String() string { return proto.CompactTextString(m) }
 func (*ParacrossAction) ProtoMessage()    {}
 func (*ParacrossAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{46}
+	return fileDescriptor_6a397e38c9ea6747, []int{47}
 }
 
 func (m *ParacrossAction) XXX_Unmarshal(b []byte) error {
@@ -3049,6 +3107,10 @@ type ParacrossAction_ParaBindMiner struct {
 	ParaBindMiner *ParaBindMinerCmd `protobuf:"bytes,13,opt,name=paraBindMiner,proto3,oneof"`
 }
 
+type ParacrossAction_MultiSigExec struct {
+	MultiSigExec *ParaMultiSigExec `protobuf:"bytes,14,opt,name=multiSigExec,proto3,oneof"`
+}
+
 func (*ParacrossAction_Commit) isParacrossAction_Value() {}
 
 func (*ParacrossAction_Miner) isParacrossAction_Value() {}
@@ -3073,6 +3135,8 @@ func (*ParacrossAction_CrossAssetTransfer) isParacrossAction_Value() {}
 
 func (*ParacrossAction_ParaBindMiner) isParacrossAction_Value() {}
 
+func (*ParacrossAction_MultiSigExec) isParacrossAction_Value() {}
+
 func (m *ParacrossAction) GetValue() isParacrossAction_Value {
 	if m != nil {
 		return m.Value
@@ -3164,6 +3228,13 @@ func (m *ParacrossAction) GetParaBindMiner() *ParaBindMinerCmd {
 	return nil
 }
 
+func (m *ParacrossAction) GetMultiSigExec() *ParaMultiSigExec {
+	if x, ok := m.GetValue().(*ParacrossAction_MultiSigExec); ok {
+		return x.MultiSigExec
+	}
+	return nil
+}
+
 func (m *ParacrossAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -3186,6 +3257,7 @@ func (*ParacrossAction) XXX_OneofWrappers() []interface{} {
 		(*ParacrossAction_SelfStageConfig)(nil),
 		(*ParacrossAction_CrossAssetTransfer)(nil),
 		(*ParacrossAction_ParaBindMiner)(nil),
+		(*ParacrossAction_MultiSigExec)(nil),
 	}
 }
 
@@ -3204,7 +3276,7 @@ func (m *ReceiptParacrossCommit) Reset()         { *m = ReceiptParacrossCommit{}
 func (m *ReceiptParacrossCommit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossCommit) ProtoMessage()    {}
 func (*ReceiptParacrossCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{47}
+	return fileDescriptor_6a397e38c9ea6747, []int{48}
 }
 
 func (m *ReceiptParacrossCommit) XXX_Unmarshal(b []byte) error {
@@ -3264,7 +3336,7 @@ func (m *ReceiptParacrossMiner) Reset()         { *m = ReceiptParacrossMiner{} }
 func (m *ReceiptParacrossMiner) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossMiner) ProtoMessage()    {}
 func (*ReceiptParacrossMiner) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{48}
+	return fileDescriptor_6a397e38c9ea6747, []int{49}
 }
 
 func (m *ReceiptParacrossMiner) XXX_Unmarshal(b []byte) error {
@@ -3317,7 +3389,7 @@ func (m *ReceiptParacrossDone) Reset()         { *m = ReceiptParacrossDone{} }
 func (m *ReceiptParacrossDone) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossDone) ProtoMessage()    {}
 func (*ReceiptParacrossDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{49}
+	return fileDescriptor_6a397e38c9ea6747, []int{50}
 }
 
 func (m *ReceiptParacrossDone) XXX_Unmarshal(b []byte) error {
@@ -3455,7 +3527,7 @@ func (m *ReceiptParacrossRecord) Reset()         { *m = ReceiptParacrossRecord{}
 func (m *ReceiptParacrossRecord) String() string { return proto.CompactTextString(m) }
 func (*ReceiptParacrossRecord) ProtoMessage()    {}
 func (*ReceiptParacrossRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{50}
+	return fileDescriptor_6a397e38c9ea6747, []int{51}
 }
 
 func (m *ReceiptParacrossRecord) XXX_Unmarshal(b []byte) error {
@@ -3503,7 +3575,7 @@ func (m *ParacrossTx) Reset()         { *m = ParacrossTx{} }
 func (m *ParacrossTx) String() string { return proto.CompactTextString(m) }
 func (*ParacrossTx) ProtoMessage()    {}
 func (*ParacrossTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{51}
+	return fileDescriptor_6a397e38c9ea6747, []int{52}
 }
 
 func (m *ParacrossTx) XXX_Unmarshal(b []byte) error {
@@ -3544,7 +3616,7 @@ func (m *ReqParacrossTitleHeight) Reset()         { *m = ReqParacrossTitleHeight
 func (m *ReqParacrossTitleHeight) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossTitleHeight) ProtoMessage()    {}
 func (*ReqParacrossTitleHeight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{52}
+	return fileDescriptor_6a397e38c9ea6747, []int{53}
 }
 
 func (m *ReqParacrossTitleHeight) XXX_Unmarshal(b []byte) error {
@@ -3599,7 +3671,7 @@ func (m *RespParacrossDone) Reset()         { *m = RespParacrossDone{} }
 func (m *RespParacrossDone) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossDone) ProtoMessage()    {}
 func (*RespParacrossDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{53}
+	return fileDescriptor_6a397e38c9ea6747, []int{54}
 }
 
 func (m *RespParacrossDone) XXX_Unmarshal(b []byte) error {
@@ -3694,7 +3766,7 @@ func (m *RespParacrossTitles) Reset()         { *m = RespParacrossTitles{} }
 func (m *RespParacrossTitles) String() string { return proto.CompactTextString(m) }
 func (*RespParacrossTitles) ProtoMessage()    {}
 func (*RespParacrossTitles) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{54}
+	return fileDescriptor_6a397e38c9ea6747, []int{55}
 }
 
 func (m *RespParacrossTitles) XXX_Unmarshal(b []byte) error {
@@ -3734,7 +3806,7 @@ func (m *ReqParacrossTitleHash) Reset()         { *m = ReqParacrossTitleHash{} }
 func (m *ReqParacrossTitleHash) String() string { return proto.CompactTextString(m) }
 func (*ReqParacrossTitleHash) ProtoMessage()    {}
 func (*ReqParacrossTitleHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{55}
+	return fileDescriptor_6a397e38c9ea6747, []int{56}
 }
 
 func (m *ReqParacrossTitleHash) XXX_Unmarshal(b []byte) error {
@@ -3796,7 +3868,7 @@ func (m *ParacrossAsset) Reset()         { *m = ParacrossAsset{} }
 func (m *ParacrossAsset) String() string { return proto.CompactTextString(m) }
 func (*ParacrossAsset) ProtoMessage()    {}
 func (*ParacrossAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{56}
+	return fileDescriptor_6a397e38c9ea6747, []int{57}
 }
 
 func (m *ParacrossAsset) XXX_Unmarshal(b []byte) error {
@@ -3917,7 +3989,7 @@ func (m *ParaLocalDbBlock) Reset()         { *m = ParaLocalDbBlock{} }
 func (m *ParaLocalDbBlock) String() string { return proto.CompactTextString(m) }
 func (*ParaLocalDbBlock) ProtoMessage()    {}
 func (*ParaLocalDbBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{57}
+	return fileDescriptor_6a397e38c9ea6747, []int{58}
 }
 
 func (m *ParaLocalDbBlock) XXX_Unmarshal(b []byte) error {
@@ -3996,7 +4068,7 @@ func (m *ParaLocalDbBlockInfo) Reset()         { *m = ParaLocalDbBlockInfo{} }
 func (m *ParaLocalDbBlockInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaLocalDbBlockInfo) ProtoMessage()    {}
 func (*ParaLocalDbBlockInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{58}
+	return fileDescriptor_6a397e38c9ea6747, []int{59}
 }
 
 func (m *ParaLocalDbBlockInfo) XXX_Unmarshal(b []byte) error {
@@ -4073,7 +4145,7 @@ func (m *ParaBlsSignSumDetails) Reset()         { *m = ParaBlsSignSumDetails{} }
 func (m *ParaBlsSignSumDetails) String() string { return proto.CompactTextString(m) }
 func (*ParaBlsSignSumDetails) ProtoMessage()    {}
 func (*ParaBlsSignSumDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{59}
+	return fileDescriptor_6a397e38c9ea6747, []int{60}
 }
 
 func (m *ParaBlsSignSumDetails) XXX_Unmarshal(b []byte) error {
@@ -4135,7 +4207,7 @@ func (m *ParaBlsSignSumDetailsShow) Reset()         { *m = ParaBlsSignSumDetails
 func (m *ParaBlsSignSumDetailsShow) String() string { return proto.CompactTextString(m) }
 func (*ParaBlsSignSumDetailsShow) ProtoMessage()    {}
 func (*ParaBlsSignSumDetailsShow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{60}
+	return fileDescriptor_6a397e38c9ea6747, []int{61}
 }
 
 func (m *ParaBlsSignSumDetailsShow) XXX_Unmarshal(b []byte) error {
@@ -4189,7 +4261,7 @@ func (m *ParaBlsSignSumInfo) Reset()         { *m = ParaBlsSignSumInfo{} }
 func (m *ParaBlsSignSumInfo) String() string { return proto.CompactTextString(m) }
 func (*ParaBlsSignSumInfo) ProtoMessage()    {}
 func (*ParaBlsSignSumInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{61}
+	return fileDescriptor_6a397e38c9ea6747, []int{62}
 }
 
 func (m *ParaBlsSignSumInfo) XXX_Unmarshal(b []byte) error {
@@ -4237,7 +4309,7 @@ func (m *LeaderSyncInfo) Reset()         { *m = LeaderSyncInfo{} }
 func (m *LeaderSyncInfo) String() string { return proto.CompactTextString(m) }
 func (*LeaderSyncInfo) ProtoMessage()    {}
 func (*LeaderSyncInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{62}
+	return fileDescriptor_6a397e38c9ea6747, []int{63}
 }
 
 func (m *LeaderSyncInfo) XXX_Unmarshal(b []byte) error {
@@ -4294,7 +4366,7 @@ func (m *ParaP2PSubMsg) Reset()         { *m = ParaP2PSubMsg{} }
 func (m *ParaP2PSubMsg) String() string { return proto.CompactTextString(m) }
 func (*ParaP2PSubMsg) ProtoMessage()    {}
 func (*ParaP2PSubMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{63}
+	return fileDescriptor_6a397e38c9ea6747, []int{64}
 }
 
 func (m *ParaP2PSubMsg) XXX_Unmarshal(b []byte) error {
@@ -4380,7 +4452,7 @@ func (m *ElectionStatus) Reset()         { *m = ElectionStatus{} }
 func (m *ElectionStatus) String() string { return proto.CompactTextString(m) }
 func (*ElectionStatus) ProtoMessage()    {}
 func (*ElectionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{64}
+	return fileDescriptor_6a397e38c9ea6747, []int{65}
 }
 
 func (m *ElectionStatus) XXX_Unmarshal(b []byte) error {
@@ -4426,7 +4498,7 @@ func (m *BlsPubKey) Reset()         { *m = BlsPubKey{} }
 func (m *BlsPubKey) String() string { return proto.CompactTextString(m) }
 func (*BlsPubKey) ProtoMessage()    {}
 func (*BlsPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a397e38c9ea6747, []int{65}
+	return fileDescriptor_6a397e38c9ea6747, []int{66}
 }
 
 func (m *BlsPubKey) XXX_Unmarshal(b []byte) error {
@@ -4469,6 +4541,7 @@ func init() {
 	proto.RegisterType((*ReceiptParaNodeAddrStatUpdate)(nil), "types.ReceiptParaNodeAddrStatUpdate")
 	proto.RegisterType((*ReceiptParaNodeVoteDone)(nil), "types.ReceiptParaNodeVoteDone")
 	proto.RegisterType((*ParaNodeGroupConfig)(nil), "types.ParaNodeGroupConfig")
+	proto.RegisterType((*ParaMultiSigExec)(nil), "types.ParaMultiSigExec")
 	proto.RegisterType((*ParaNodeGroupStatus)(nil), "types.ParaNodeGroupStatus")
 	proto.RegisterType((*ReceiptParaNodeGroupConfig)(nil), "types.ReceiptParaNodeGroupConfig")
 	proto.RegisterType((*ReqParacrossNodeInfo)(nil), "types.ReqParacrossNodeInfo")
@@ -4528,194 +4601,197 @@ func init() {
 }
 
 var fileDescriptor_6a397e38c9ea6747 = []byte{
-	// 2981 bytes of a gzipped FileDescriptorProto
+	// 3030 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3a, 0xcd, 0x6f, 0x24, 0x47,
-	0xf5, 0xee, 0xf9, 0xb2, 0xe7, 0xd9, 0x63, 0xef, 0x76, 0x76, 0x9d, 0x89, 0x93, 0xac, 0xac, 0x52,
-	0x7e, 0x91, 0x7f, 0x64, 0xb3, 0x9b, 0x75, 0x42, 0x50, 0x84, 0x22, 0x88, 0xbd, 0x9b, 0x8c, 0x95,
-	0x75, 0xd8, 0x94, 0x1d, 0x40, 0x8a, 0x40, 0xb4, 0x67, 0xca, 0x76, 0x2b, 0x33, 0xdd, 0xb3, 0x53,
-	0x3d, 0x59, 0x1b, 0x21, 0x85, 0x03, 0x70, 0x43, 0xe2, 0x82, 0x04, 0x91, 0xe0, 0x02, 0x37, 0x24,
-	0x4e, 0x9c, 0x39, 0x20, 0x71, 0x89, 0xb8, 0x84, 0x23, 0x37, 0x6e, 0x48, 0x1c, 0xf9, 0x07, 0xd0,
-	0x7b, 0x55, 0xd5, 0x5d, 0x55, 0xdd, 0x33, 0x76, 0x76, 0x73, 0xe1, 0xd6, 0xf5, 0xfa, 0x55, 0xd5,
-	0xfb, 0xfe, 0xea, 0x86, 0xb5, 0x71, 0x34, 0x89, 0xfa, 0x93, 0x54, 0xca, 0x5b, 0xe3, 0x49, 0x9a,
-	0xa5, 0x61, 0x33, 0x3b, 0x1f, 0x0b, 0xb9, 0x71, 0x35, 0x9b, 0x44, 0x89, 0x8c, 0xfa, 0x59, 0x9c,
-	0x26, 0xea, 0xcd, 0xc6, 0x4a, 0x3f, 0x1d, 0x8d, 0xf2, 0xd5, 0x95, 0xa3, 0x61, 0xda, 0xff, 0xa8,
-	0x7f, 0x1a, 0xc5, 0x1a, 0xc2, 0xee, 0xc3, 0xfa, 0x03, 0x73, 0xd8, 0x41, 0x16, 0x65, 0x53, 0x79,
-	0x57, 0x64, 0x51, 0x3c, 0x94, 0xe1, 0x35, 0x68, 0x46, 0x83, 0xc1, 0x44, 0x76, 0x83, 0xcd, 0xfa,
-	0x56, 0x9b, 0xab, 0x45, 0xf8, 0x1c, 0xb4, 0xe9, 0x8c, 0x5e, 0x24, 0x4f, 0xbb, 0xb5, 0xcd, 0xfa,
-	0xd6, 0x0a, 0x2f, 0x00, 0xec, 0x43, 0x78, 0xd6, 0x3b, 0x6d, 0x07, 0xdf, 0x99, 0x23, 0x6f, 0x00,
-	0xe4, 0xb8, 0xea, 0xdc, 0x15, 0x6e, 0x41, 0xf0, 0xf0, 0xec, 0x8c, 0x0b, 0x39, 0x1d, 0x66, 0xd2,
-	0x1c, 0x9e, 0x03, 0xd8, 0xa7, 0x35, 0xb8, 0x9e, 0x9f, 0xde, 0x13, 0xf1, 0xc9, 0x69, 0xa6, 0xee,
-	0x08, 0xd7, 0xa1, 0x25, 0xe9, 0xa9, 0x1b, 0x6c, 0x06, 0x5b, 0x4d, 0xae, 0x57, 0xc8, 0x42, 0x16,
-	0x67, 0x43, 0xd1, 0xad, 0x6d, 0x06, 0xc8, 0x02, 0x2d, 0x10, 0xfb, 0x94, 0x76, 0x77, 0xeb, 0x9b,
-	0xc1, 0x56, 0x9d, 0xeb, 0x55, 0xf8, 0x35, 0x58, 0x1c, 0x28, 0x42, 0xbb, 0x8d, 0xcd, 0x60, 0x6b,
-	0x79, 0xfb, 0xf9, 0x5b, 0x24, 0xd6, 0x5b, 0xd5, 0x02, 0xe2, 0x06, 0x1b, 0xd9, 0x1a, 0x45, 0x71,
-	0xa2, 0x48, 0xea, 0x36, 0xe9, 0x50, 0x0b, 0x12, 0x6e, 0xc0, 0x12, 0xad, 0x50, 0x64, 0xad, 0xcd,
-	0x60, 0x6b, 0x85, 0xe7, 0xeb, 0xf0, 0x6d, 0x58, 0x39, 0xb2, 0x44, 0xd4, 0x5d, 0xa4, 0x9b, 0x59,
-	0xf5, 0xcd, 0xb6, 0x30, 0xb9, 0xb3, 0x8f, 0xfd, 0x2b, 0x80, 0x6e, 0xa5, 0x70, 0xb8, 0x1c, 0x7f,
-	0x49, 0xf2, 0x71, 0xd9, 0x6c, 0xcc, 0x65, 0xb3, 0x49, 0x07, 0x16, 0x6c, 0x6e, 0xc2, 0x32, 0x1a,
-	0x62, 0x9c, 0xbd, 0x45, 0x26, 0xd5, 0x22, 0x93, 0xb2, 0x41, 0xe1, 0x16, 0xac, 0xa9, 0xe5, 0x4e,
-	0x6e, 0x5e, 0x8b, 0x84, 0xe5, 0x83, 0xd9, 0xaf, 0x03, 0x58, 0xf3, 0x04, 0x53, 0x70, 0x12, 0x54,
-	0x73, 0x52, 0x73, 0x38, 0x71, 0x8c, 0xb8, 0x4e, 0x1a, 0x29, 0x00, 0x5f, 0x98, 0x4f, 0x4b, 0x9d,
-	0xec, 0xf7, 0xb6, 0x1a, 0x76, 0xd3, 0x44, 0x8a, 0x44, 0x4e, 0xe7, 0x13, 0x89, 0xa2, 0x39, 0x2d,
-	0xee, 0x53, 0x94, 0xda, 0xa0, 0xf0, 0x05, 0xe8, 0xf4, 0xd5, 0x51, 0x3d, 0x5b, 0x2f, 0x2e, 0x30,
-	0xfc, 0x0a, 0x5c, 0xd1, 0x80, 0x42, 0x82, 0x0d, 0xba, 0xa8, 0x04, 0x67, 0x7f, 0x0a, 0x20, 0x44,
-	0x32, 0xdf, 0x4b, 0x07, 0x02, 0xc5, 0xbf, 0x9b, 0x26, 0xc7, 0xf1, 0xc9, 0x0c, 0x02, 0x57, 0xa1,
-	0x96, 0x8e, 0x89, 0xae, 0x0e, 0xaf, 0xa5, 0x63, 0x5c, 0xc7, 0x03, 0xa2, 0xa1, 0xcd, 0x6b, 0xf1,
-	0x20, 0x0c, 0xa1, 0x81, 0xb1, 0x41, 0x5f, 0x46, 0xcf, 0x78, 0xd2, 0xc7, 0xd1, 0x70, 0x2a, 0x48,
-	0x40, 0x1d, 0xae, 0x16, 0xca, 0x0a, 0xe2, 0x44, 0xbe, 0x3d, 0x49, 0x7f, 0x28, 0x12, 0xf2, 0x05,
-	0x64, 0xb5, 0x00, 0x29, 0xcd, 0xc8, 0x07, 0xd3, 0xa3, 0x77, 0xc5, 0x39, 0xf9, 0x42, 0x9b, 0x17,
-	0x00, 0xf6, 0xcd, 0x82, 0xea, 0x6f, 0xa7, 0x99, 0x50, 0xb6, 0x3f, 0x23, 0x50, 0x21, 0x05, 0x69,
-	0x26, 0x54, 0x1c, 0x69, 0x73, 0xb5, 0x60, 0x7f, 0x0c, 0xe0, 0x9a, 0xcd, 0xf8, 0xde, 0x40, 0xeb,
-	0xc6, 0x30, 0x11, 0x58, 0x4c, 0xdc, 0x00, 0x18, 0x4f, 0xd2, 0x71, 0x2a, 0xa3, 0xe1, 0xde, 0x40,
-	0xfb, 0x88, 0x05, 0x41, 0xf3, 0x7a, 0x38, 0x8d, 0xb3, 0x3d, 0x23, 0x0c, 0xbd, 0xb2, 0xdc, 0xad,
-	0x51, 0xed, 0x6e, 0x4d, 0x5b, 0xbc, 0x0e, 0xcb, 0x2d, 0x9f, 0xe5, 0x5f, 0xd6, 0xe0, 0x8a, 0x21,
-	0x38, 0x27, 0x56, 0x69, 0x20, 0xc8, 0x35, 0x50, 0x5c, 0x58, 0xab, 0xbe, 0xb0, 0x6e, 0x5f, 0x78,
-	0x03, 0x20, 0x8b, 0x26, 0x27, 0x82, 0x1c, 0x4f, 0x6b, 0xcd, 0x82, 0xf8, 0x5a, 0x6a, 0x96, 0xb5,
-	0x74, 0xdb, 0xc8, 0xb6, 0x45, 0xd1, 0xea, 0x19, 0x2b, 0x5a, 0xb9, 0xba, 0xd1, 0x62, 0x47, 0x97,
-	0x39, 0x9e, 0xa4, 0x23, 0xba, 0x50, 0x69, 0x35, 0x5f, 0x5b, 0x4e, 0xba, 0x54, 0x76, 0x52, 0x23,
-	0x97, 0xb6, 0x2f, 0x97, 0x3f, 0x07, 0x70, 0x9d, 0x8b, 0xbe, 0x88, 0xc7, 0x99, 0xb9, 0x56, 0x1b,
-	0x71, 0x95, 0x26, 0xef, 0x40, 0xab, 0x4f, 0x6f, 0x49, 0x40, 0x65, 0x8a, 0x0b, 0x1f, 0xe0, 0x1a,
-	0x31, 0x7c, 0x09, 0x1a, 0xe3, 0x89, 0xf8, 0x98, 0x44, 0xb7, 0xbc, 0xfd, 0xb4, 0xb7, 0xc1, 0xa8,
-	0x82, 0x13, 0x52, 0x78, 0x07, 0x16, 0xfb, 0xd3, 0xc9, 0x44, 0x24, 0x99, 0x4e, 0x1d, 0x33, 0xf1,
-	0x0d, 0x1e, 0xfb, 0x5d, 0x00, 0xcf, 0x7b, 0x0c, 0x20, 0x15, 0x88, 0xf6, 0xc1, 0x78, 0x10, 0x65,
-	0xc2, 0x11, 0x5a, 0xe0, 0x09, 0xed, 0xb6, 0xa6, 0x4e, 0xb1, 0xf3, 0x6c, 0x05, 0x3b, 0x1e, 0x85,
-	0x5f, 0x2d, 0x28, 0xac, 0x5f, 0xbc, 0x27, 0xa7, 0xf2, 0x3f, 0x01, 0x3c, 0xed, 0x51, 0x49, 0xda,
-	0x4d, 0x13, 0x51, 0xb2, 0xc2, 0xea, 0x6c, 0xe2, 0x5a, 0x5b, 0xbd, 0x64, 0x6d, 0xf8, 0x3e, 0xcd,
-	0xa2, 0x21, 0x1e, 0x6d, 0x1c, 0xc6, 0x82, 0x50, 0x4d, 0x80, 0x2b, 0xbc, 0x96, 0x6c, 0xb1, 0xc9,
-	0x0b, 0x00, 0xc5, 0xe2, 0x54, 0x66, 0xf4, 0xb2, 0x45, 0x2f, 0xf3, 0x75, 0xd8, 0x85, 0x45, 0xb4,
-	0x3e, 0x2e, 0x33, 0x6d, 0x73, 0x66, 0x89, 0x77, 0x0e, 0xd2, 0x44, 0x28, 0x66, 0xc9, 0xec, 0x9a,
-	0xdc, 0x82, 0xa0, 0x6e, 0x9e, 0x32, 0xec, 0xbe, 0x33, 0x49, 0xa7, 0xe3, 0x27, 0x8a, 0x8f, 0x79,
-	0x7c, 0x52, 0xae, 0xa6, 0xe3, 0xd3, 0xc5, 0x5e, 0x46, 0xd5, 0x92, 0xb6, 0x77, 0xa9, 0x23, 0x83,
-	0x05, 0x61, 0xff, 0xf6, 0xa9, 0xfc, 0x52, 0xa2, 0xc3, 0x26, 0x2c, 0x17, 0xda, 0x31, 0x34, 0xdb,
-	0xa0, 0x4b, 0x50, 0x6e, 0x5b, 0x6e, 0x6b, 0xa6, 0xbb, 0x2f, 0xfa, 0xd5, 0x85, 0xc5, 0xed, 0x52,
-	0x89, 0xdb, 0xcf, 0x02, 0xd8, 0xf0, 0x2c, 0xd1, 0x56, 0x4d, 0x95, 0xd7, 0x6f, 0x7b, 0x5e, 0xbf,
-	0xe1, 0x99, 0xbc, 0xb5, 0x3f, 0x77, 0xfb, 0x5b, 0x8e, 0xdb, 0x57, 0xee, 0x70, 0xfc, 0xea, 0x35,
-	0xdf, 0xf3, 0xe7, 0x6d, 0xc9, 0xdd, 0xea, 0x67, 0x01, 0x5c, 0xe3, 0xe2, 0x61, 0x5e, 0x29, 0x50,
-	0x88, 0x48, 0x8e, 0xd3, 0xd9, 0x16, 0x16, 0x9b, 0x04, 0x64, 0x67, 0xdc, 0xba, 0xc5, 0xec, 0xac,
-	0xa4, 0xe3, 0x84, 0xd1, 0xa6, 0x1f, 0x46, 0x77, 0x61, 0x9d, 0x0b, 0x39, 0x76, 0x08, 0x51, 0x5a,
-	0xfe, 0x7f, 0xa8, 0xc7, 0x03, 0x95, 0x53, 0xe7, 0x84, 0x33, 0xc4, 0x61, 0xef, 0x60, 0x8c, 0xf0,
-	0x0e, 0x21, 0xb6, 0x65, 0x78, 0xd3, 0x3e, 0x65, 0x9e, 0x68, 0xe8, 0xa0, 0xb1, 0xca, 0x75, 0x3b,
-	0x71, 0x32, 0xd8, 0x8f, 0x13, 0x31, 0xd9, 0x1d, 0x0d, 0xc8, 0x2e, 0xe2, 0x64, 0xf0, 0x16, 0x35,
-	0x35, 0xba, 0x7e, 0xb5, 0x20, 0xc4, 0x5f, 0x9c, 0x0c, 0x76, 0xd1, 0xfc, 0x74, 0xf1, 0x54, 0x00,
-	0x8a, 0xe8, 0x83, 0xf7, 0xb9, 0xd1, 0x07, 0x21, 0xec, 0xaf, 0x01, 0x5c, 0x75, 0xae, 0x24, 0x2d,
-	0xcc, 0x28, 0x06, 0xf0, 0xd8, 0x03, 0xdb, 0x93, 0x2c, 0x88, 0x4b, 0x47, 0x7d, 0x3e, 0x1d, 0x0d,
-	0x9f, 0x8e, 0xbc, 0x22, 0x3d, 0x8c, 0x47, 0x42, 0x7b, 0x54, 0x01, 0x40, 0x8f, 0x53, 0xe5, 0xa9,
-	0x72, 0x1c, 0x5d, 0x37, 0x59, 0x20, 0xf6, 0x8b, 0x00, 0xba, 0x96, 0x77, 0x5c, 0xcc, 0xce, 0x4d,
-	0x27, 0x81, 0x74, 0x2d, 0xcd, 0x38, 0x7b, 0xb5, 0x95, 0x6f, 0xfb, 0xd9, 0x63, 0xf6, 0x86, 0xdc,
-	0xc6, 0xef, 0xa9, 0x2a, 0x1d, 0xd9, 0x43, 0x8c, 0x6f, 0x25, 0xc4, 0xa5, 0x9c, 0x8e, 0xc5, 0x84,
-	0x84, 0xa0, 0xa8, 0x29, 0x00, 0x68, 0xfb, 0x23, 0x3c, 0xc6, 0xe4, 0x0f, 0x5a, 0xb0, 0xef, 0x16,
-	0xf5, 0x0f, 0x1e, 0x73, 0x3f, 0x96, 0xd9, 0x0c, 0x2f, 0xb9, 0x05, 0x2d, 0xda, 0xa2, 0x4a, 0xbe,
-	0xe5, 0xed, 0x75, 0xcf, 0xdc, 0x34, 0x15, 0x5c, 0x63, 0xb1, 0x4f, 0x4a, 0x09, 0xd8, 0x5c, 0xa0,
-	0x13, 0xb0, 0x29, 0x01, 0x82, 0xca, 0x94, 0x6e, 0x90, 0xcb, 0x25, 0x40, 0x6d, 0x3e, 0x7e, 0x2e,
-	0xa1, 0x47, 0x18, 0x04, 0x94, 0xdf, 0x38, 0xec, 0xbd, 0x04, 0x8d, 0x61, 0x2c, 0xb3, 0x0b, 0xef,
-	0x45, 0x24, 0x54, 0x8d, 0xe9, 0x5a, 0x15, 0xdb, 0x73, 0x54, 0xa3, 0x11, 0xd9, 0x4f, 0x8d, 0xd5,
-	0xa3, 0x05, 0x6d, 0xef, 0x47, 0x71, 0xb2, 0x1f, 0x8d, 0xad, 0xc8, 0x1c, 0xcc, 0xee, 0x96, 0x6a,
-	0x26, 0x82, 0x54, 0x77, 0x4b, 0xf5, 0xb9, 0xdd, 0x52, 0xc3, 0xed, 0x0a, 0xd9, 0x5d, 0x55, 0xcf,
-	0x17, 0x64, 0x90, 0xb9, 0xde, 0x82, 0x66, 0x9c, 0x89, 0x91, 0x89, 0x1a, 0x0e, 0x3f, 0x36, 0xc1,
-	0x5c, 0xa1, 0xb1, 0x7f, 0xd6, 0x55, 0x1e, 0xcc, 0x63, 0x8f, 0xf6, 0xc8, 0x17, 0xa0, 0x83, 0x37,
-	0x15, 0xdd, 0x50, 0x40, 0xcd, 0x9a, 0x0b, 0xc4, 0xbe, 0xb3, 0x00, 0xd8, 0x2d, 0x98, 0x0f, 0x9e,
-	0x91, 0x2f, 0x0b, 0xa9, 0x35, 0x1c, 0xa9, 0x31, 0x58, 0x19, 0x4f, 0x44, 0x71, 0xb9, 0xea, 0x14,
-	0x1d, 0x98, 0x2b, 0xd9, 0x96, 0xdf, 0x87, 0xaa, 0x13, 0x90, 0x19, 0xa1, 0xdb, 0x61, 0x73, 0x42,
-	0x0e, 0x23, 0x8f, 0xca, 0x11, 0x96, 0xd4, 0x09, 0x39, 0x00, 0x65, 0x9f, 0x9d, 0xed, 0xa6, 0xd3,
-	0x24, 0x93, 0x54, 0x41, 0x77, 0x78, 0xbe, 0x56, 0xef, 0xd4, 0x68, 0xa5, 0x0b, 0xaa, 0x8b, 0x35,
-	0x6b, 0xac, 0x9c, 0xb2, 0x33, 0x35, 0xa4, 0x59, 0xa6, 0x29, 0x8c, 0x59, 0x52, 0x2b, 0x8a, 0x62,
-	0x3e, 0x34, 0x5b, 0x57, 0x94, 0x4c, 0x1d, 0x20, 0x52, 0xae, 0x01, 0xea, 0x90, 0x0e, 0x1d, 0xe2,
-	0xc0, 0xc2, 0x9b, 0x70, 0x35, 0x49, 0x93, 0x5d, 0xea, 0xed, 0x0f, 0x0d, 0x91, 0xab, 0x44, 0x64,
-	0xf9, 0x05, 0xdb, 0x81, 0xab, 0x07, 0x62, 0x78, 0xac, 0x3b, 0xea, 0x83, 0x2c, 0x3a, 0x11, 0x32,
-	0x7c, 0xd9, 0x35, 0x14, 0xe3, 0x28, 0x3e, 0xa2, 0xb1, 0x93, 0xfb, 0x70, 0xc5, 0x7f, 0x85, 0x91,
-	0x55, 0x66, 0xd1, 0x24, 0xeb, 0xd9, 0x86, 0x6f, 0x83, 0x50, 0xbf, 0x22, 0x89, 0x8e, 0x74, 0x59,
-	0xdb, 0xe1, 0x7a, 0xc5, 0xfe, 0x11, 0xc0, 0x35, 0xff, 0x38, 0x32, 0xdf, 0xf9, 0xe5, 0x57, 0x27,
-	0x4f, 0xcc, 0x2f, 0x43, 0x53, 0xe2, 0x26, 0xaf, 0xc3, 0x28, 0x53, 0x4f, 0x58, 0x4e, 0x4d, 0xd5,
-	0xf0, 0x6a, 0xaa, 0x1b, 0x00, 0xe2, 0x4c, 0xf4, 0xdd, 0x01, 0x54, 0x01, 0xf9, 0xc2, 0xfd, 0x1a,
-	0x13, 0xb0, 0x7e, 0x3f, 0xed, 0x47, 0x43, 0x43, 0x4c, 0xc1, 0xdd, 0x1d, 0x43, 0x75, 0xe0, 0x74,
-	0x11, 0x55, 0x92, 0x30, 0x94, 0x93, 0x35, 0xed, 0x25, 0x03, 0x71, 0xa6, 0xa3, 0x87, 0x59, 0xb2,
-	0xd7, 0x61, 0x55, 0x95, 0x5f, 0x48, 0x41, 0xa5, 0xf0, 0xf2, 0x39, 0x42, 0xcd, 0x9a, 0x23, 0x30,
-	0x06, 0x57, 0xd4, 0xbe, 0xdd, 0x28, 0xe9, 0x8b, 0x61, 0xd5, 0x4e, 0xf6, 0xb9, 0x9e, 0x12, 0x11,
-	0x39, 0x17, 0xd5, 0xef, 0xd9, 0xb9, 0xa9, 0xdf, 0xb3, 0x73, 0x94, 0x96, 0x62, 0x11, 0xe6, 0x2a,
-	0xa6, 0xb7, 0x60, 0x18, 0x7c, 0x09, 0x1a, 0x28, 0xb6, 0xee, 0x32, 0xe1, 0x5f, 0xd7, 0xf8, 0x2e,
-	0x67, 0xbd, 0x05, 0x4e, 0x48, 0xd4, 0x8a, 0x12, 0xd5, 0xe4, 0x3a, 0xc5, 0xf1, 0x3e, 0x43, 0xbd,
-	0x05, 0xae, 0x11, 0x77, 0x16, 0xb5, 0x10, 0xd8, 0x4f, 0x8a, 0x1a, 0xd8, 0xd1, 0x8c, 0x66, 0xef,
-	0xb6, 0x93, 0xaf, 0xe6, 0xaa, 0xa6, 0xd4, 0x14, 0xd6, 0x2e, 0xde, 0x93, 0xe7, 0xad, 0xcf, 0x03,
-	0x78, 0xae, 0x8a, 0x8c, 0x99, 0x9d, 0x61, 0x6e, 0xea, 0xb5, 0x4b, 0x99, 0xba, 0xdb, 0x12, 0xd6,
-	0xe7, 0xb7, 0x84, 0x8d, 0x79, 0x2d, 0x61, 0x73, 0x76, 0x4b, 0xd8, 0x72, 0x5a, 0x42, 0xf6, 0x09,
-	0x3c, 0x5b, 0xc5, 0x92, 0xd4, 0xa5, 0xc0, 0x4d, 0x47, 0xb4, 0xdd, 0x19, 0x0c, 0xc8, 0x72, 0xb9,
-	0x54, 0xbb, 0x60, 0x43, 0x2e, 0xd4, 0xdf, 0x06, 0x10, 0x72, 0xf1, 0xf0, 0xfd, 0xa9, 0x98, 0x9c,
-	0x23, 0x9a, 0x8e, 0x71, 0xee, 0xe8, 0xb6, 0x88, 0x1e, 0x7e, 0x4b, 0x70, 0x0d, 0x9a, 0x7d, 0x0c,
-	0x95, 0x5a, 0x5c, 0x6a, 0x81, 0x92, 0x1a, 0xc4, 0x13, 0xa1, 0x6a, 0x67, 0x2d, 0xa9, 0x1c, 0x60,
-	0xa5, 0xae, 0xa6, 0x93, 0xba, 0xae, 0x41, 0x33, 0x26, 0x77, 0x55, 0x1d, 0xb5, 0x5a, 0xb0, 0xf7,
-	0xb1, 0x5a, 0x19, 0x0f, 0xcf, 0x7d, 0x0a, 0xdf, 0xa0, 0x14, 0xa4, 0x6c, 0x44, 0x47, 0xe2, 0xb9,
-	0x66, 0x54, 0x60, 0xb3, 0xef, 0x5b, 0x1f, 0x1f, 0x76, 0xf5, 0x94, 0x57, 0x9a, 0x92, 0x55, 0xc6,
-	0x27, 0x89, 0x4e, 0xd9, 0xf4, 0x8c, 0x8a, 0xa5, 0xd6, 0x79, 0x3f, 0x52, 0xdd, 0xf6, 0x0a, 0xcf,
-	0xd7, 0x45, 0x8f, 0x5d, 0xb7, 0x66, 0x80, 0xec, 0x47, 0xd6, 0x07, 0x03, 0x75, 0xbe, 0x6e, 0x1a,
-	0xb6, 0x1d, 0xa9, 0xba, 0x9d, 0x89, 0x57, 0x46, 0xe4, 0x12, 0xbf, 0x0d, 0xf5, 0xa3, 0xa1, 0xd4,
-	0x0a, 0x2d, 0x7d, 0x1a, 0x70, 0xc8, 0xe7, 0x88, 0xc9, 0x3e, 0xd5, 0xb3, 0x46, 0x7a, 0x4f, 0x55,
-	0xd8, 0x13, 0xdc, 0xbe, 0x05, 0x6b, 0xb1, 0xb4, 0xe4, 0xa9, 0xd3, 0xc9, 0x12, 0xf7, 0xc1, 0x98,
-	0xa2, 0xa3, 0xc1, 0x60, 0x4f, 0xca, 0xa9, 0xb0, 0x9b, 0x11, 0x17, 0xc8, 0xde, 0x54, 0xd1, 0x91,
-	0xc8, 0xe2, 0xe2, 0x51, 0x34, 0x19, 0x54, 0xb6, 0x09, 0xeb, 0xd0, 0x8a, 0x46, 0x64, 0x57, 0x7a,
-	0x82, 0xae, 0x56, 0xec, 0x57, 0x01, 0x84, 0xbb, 0x48, 0xea, 0x5b, 0x52, 0x8a, 0xec, 0x70, 0x12,
-	0x25, 0xf2, 0x58, 0x4c, 0xd0, 0xde, 0x22, 0x04, 0xdc, 0x3b, 0x13, 0x7d, 0x53, 0xe0, 0xe7, 0x00,
-	0x4c, 0xb6, 0xb4, 0x38, 0x38, 0x1f, 0x1d, 0xa5, 0x43, 0x6d, 0xbc, 0x36, 0xc8, 0xba, 0xae, 0x6e,
-	0x5f, 0x87, 0xf0, 0x2c, 0xb5, 0x52, 0x9f, 0x5e, 0x21, 0xc9, 0x89, 0xf1, 0xf3, 0x36, 0xa7, 0x67,
-	0xf6, 0x9b, 0x96, 0xf5, 0x79, 0x40, 0x4b, 0xfc, 0x75, 0x68, 0xa9, 0xaf, 0x08, 0x5a, 0xe2, 0xcf,
-	0x55, 0xab, 0x4f, 0x61, 0x53, 0xe4, 0xa5, 0x75, 0xf8, 0xaa, 0x69, 0x49, 0xca, 0x33, 0x33, 0x5f,
-	0xab, 0x98, 0x0e, 0x08, 0x37, 0x7c, 0x13, 0x3a, 0x91, 0x2d, 0x15, 0x3d, 0x18, 0x30, 0x79, 0x81,
-	0x24, 0x26, 0xcd, 0xcb, 0xde, 0x02, 0x77, 0xb1, 0xf3, 0xed, 0xdf, 0x89, 0xb3, 0xd3, 0xc1, 0x24,
-	0x7a, 0x44, 0xcc, 0xf9, 0xdb, 0xcd, 0xcb, 0x7c, 0xbb, 0x01, 0x84, 0xaf, 0xc2, 0x52, 0x66, 0x2e,
-	0x6e, 0xcd, 0xbf, 0x38, 0x47, 0xc4, 0x4d, 0x8f, 0xcc, 0x75, 0x8b, 0xf3, 0xaf, 0xcb, 0x11, 0xc3,
-	0x7b, 0xb0, 0x6a, 0x0e, 0x38, 0x4c, 0x49, 0xe3, 0x4b, 0x8e, 0x94, 0xdc, 0xfb, 0x14, 0x4a, 0x6f,
-	0x81, 0x7b, 0x9b, 0xc2, 0xaf, 0x03, 0x24, 0xf9, 0xf4, 0x96, 0xca, 0xd4, 0x79, 0xf3, 0xd9, 0xde,
-	0x02, 0xb7, 0xd0, 0xc3, 0xb7, 0x61, 0x2d, 0x71, 0x27, 0x39, 0x3a, 0x6b, 0xcf, 0x99, 0xf5, 0xf4,
-	0x16, 0xb8, 0xbf, 0x29, 0xdc, 0x81, 0x35, 0x69, 0x42, 0x9a, 0x3e, 0x47, 0x65, 0x73, 0xbb, 0x89,
-	0xb4, 0xde, 0xe2, 0x19, 0xde, 0x86, 0xf0, 0x5d, 0x08, 0xfb, 0x25, 0x97, 0xd0, 0x59, 0xde, 0x30,
-	0x54, 0xf6, 0x99, 0xde, 0x02, 0xaf, 0xd8, 0x16, 0x7e, 0x03, 0x3a, 0x63, 0xbb, 0x81, 0xeb, 0x76,
-	0x4a, 0xcd, 0xa0, 0x3d, 0x26, 0x41, 0x3b, 0x70, 0xf0, 0xad, 0xaa, 0xa6, 0x89, 0x55, 0x4d, 0x51,
-	0x44, 0x7c, 0x16, 0xc0, 0xba, 0xd5, 0xf7, 0x5a, 0xe6, 0x3f, 0x6b, 0x88, 0x66, 0x95, 0xaf, 0x97,
-	0x0b, 0x56, 0xaf, 0x38, 0x43, 0xb4, 0x92, 0xb3, 0x39, 0x9f, 0x27, 0x55, 0xc6, 0x7c, 0xdd, 0x1f,
-	0xa3, 0xcd, 0xdf, 0x94, 0x67, 0xcd, 0x77, 0x9d, 0xaf, 0x00, 0x85, 0x4f, 0x3e, 0x4e, 0x8c, 0x65,
-	0x3f, 0x6e, 0x60, 0x8a, 0x73, 0x4f, 0xa3, 0x7a, 0xc6, 0x2d, 0x48, 0x82, 0x52, 0x41, 0xb2, 0x09,
-	0xcb, 0xb4, 0x52, 0x62, 0xd4, 0x42, 0xb7, 0x41, 0xe1, 0x8b, 0xb0, 0x8a, 0x45, 0xc8, 0x41, 0x34,
-	0x12, 0x1a, 0x49, 0xe5, 0x69, 0x0f, 0x5a, 0x54, 0xa8, 0x8d, 0xea, 0x1e, 0xb3, 0xe9, 0x77, 0xe6,
-	0x45, 0xf7, 0xd7, 0x9a, 0xd7, 0xfd, 0x2d, 0xce, 0xe9, 0xfe, 0x96, 0xbc, 0xee, 0xcf, 0xe9, 0x4a,
-	0xdb, 0x7e, 0x57, 0x6a, 0xf5, 0x86, 0x70, 0x41, 0x6f, 0xb8, 0x7c, 0x99, 0xde, 0x70, 0xa5, 0xa2,
-	0x37, 0x2c, 0x75, 0xee, 0x9d, 0x4b, 0x76, 0xee, 0xab, 0xd5, 0x9d, 0xfb, 0x16, 0xac, 0xd1, 0xf7,
-	0xd4, 0x7b, 0x45, 0x93, 0xb4, 0xa6, 0x30, 0x3d, 0x30, 0xfb, 0x41, 0xd9, 0x37, 0xb8, 0xe8, 0xa7,
-	0x33, 0xb2, 0xe3, 0x63, 0xf8, 0x06, 0xfb, 0x3f, 0x58, 0xce, 0x5f, 0x1f, 0x9e, 0x51, 0x66, 0x3b,
-	0xcb, 0xa7, 0x13, 0x98, 0xd9, 0x68, 0xa5, 0x66, 0xaa, 0xc5, 0x80, 0xf8, 0x10, 0xed, 0xc0, 0x9f,
-	0x43, 0x5c, 0xe6, 0x5b, 0x37, 0xfb, 0x43, 0x0d, 0xae, 0x3a, 0xd3, 0xd9, 0xff, 0x2d, 0x8b, 0x6e,
-	0x3f, 0xae, 0x45, 0xb7, 0x2d, 0x8b, 0xae, 0xd0, 0x7f, 0xbb, 0x5a, 0xff, 0xef, 0xc0, 0x53, 0x8e,
-	0xb0, 0x48, 0xee, 0x18, 0xd0, 0x5a, 0x44, 0xb7, 0x3f, 0x93, 0x2a, 0x09, 0x96, 0x6b, 0x3c, 0x15,
-	0x98, 0x7c, 0xfd, 0x21, 0x0f, 0xd5, 0xda, 0x2b, 0xcd, 0xd8, 0x9c, 0xdf, 0x6a, 0xfe, 0x56, 0x83,
-	0xd5, 0xa2, 0xa4, 0xc1, 0x3c, 0x81, 0xe6, 0x88, 0xed, 0xbf, 0x31, 0x47, 0x7c, 0xa6, 0x90, 0x9f,
-	0x9a, 0x9e, 0x20, 0x4b, 0x51, 0xc9, 0x71, 0x9e, 0xba, 0x49, 0x3d, 0x4b, 0xdc, 0x82, 0x58, 0xb6,
-	0xd7, 0xb0, 0x6d, 0xcf, 0xaa, 0xc2, 0x9a, 0x4e, 0x15, 0x16, 0x42, 0x43, 0x60, 0x9a, 0x57, 0x7a,
-	0xa1, 0x67, 0xea, 0x4f, 0x54, 0x39, 0xa7, 0xbe, 0xb1, 0xe9, 0x15, 0x32, 0xa4, 0x18, 0x3f, 0x1f,
-	0x0b, 0xd2, 0x47, 0x87, 0x17, 0x00, 0x4b, 0xfd, 0xe0, 0xa8, 0x9f, 0xfe, 0x61, 0x40, 0xb3, 0x41,
-	0x59, 0x6a, 0x4d, 0x5d, 0x27, 0x8c, 0x12, 0x9c, 0xbe, 0xce, 0x47, 0x93, 0x48, 0x63, 0xad, 0xab,
-	0xa1, 0x47, 0x01, 0xc1, 0x40, 0x25, 0xa7, 0xfd, 0xbe, 0x90, 0xb2, 0xfb, 0x34, 0xb1, 0x6e, 0x96,
-	0xec, 0xef, 0x81, 0x9a, 0x29, 0xd3, 0x88, 0xe3, 0xee, 0x11, 0x45, 0x8a, 0x99, 0xd3, 0x4f, 0x7b,
-	0x7e, 0x59, 0xf3, 0x7e, 0xde, 0xb9, 0x68, 0xf6, 0xf9, 0x22, 0xac, 0x8e, 0x23, 0xcc, 0x53, 0xfb,
-	0xf6, 0x04, 0x74, 0x85, 0x7b, 0xd0, 0x0b, 0xa6, 0xff, 0x2f, 0x40, 0x3d, 0x3b, 0x53, 0xff, 0xcc,
-	0x2c, 0x6f, 0x87, 0xda, 0xf2, 0x0e, 0x8b, 0x3f, 0xbd, 0x38, 0xbe, 0x66, 0x7f, 0xd1, 0xdd, 0x86,
-	0xcd, 0x14, 0xb5, 0x52, 0x97, 0x65, 0xac, 0xfd, 0xc4, 0x8c, 0xb5, 0xbf, 0x20, 0x63, 0x57, 0x0a,
-	0xc6, 0xda, 0x8a, 0x89, 0x54, 0x35, 0x6c, 0x3b, 0x43, 0x79, 0x10, 0x9f, 0x24, 0x07, 0xd3, 0x91,
-	0xf9, 0x73, 0x6c, 0x16, 0x13, 0x79, 0xdf, 0x57, 0xb3, 0xff, 0xfd, 0x08, 0xa1, 0x31, 0x92, 0x27,
-	0xaa, 0x19, 0x5c, 0xe1, 0xf4, 0x8c, 0x98, 0xd8, 0x45, 0xca, 0x6e, 0x83, 0x80, 0x6a, 0xc1, 0xbe,
-	0x07, 0xcf, 0x54, 0x5e, 0x78, 0x70, 0x9a, 0x3e, 0x7a, 0x82, 0x4b, 0xdb, 0xea, 0x52, 0x76, 0x64,
-	0x06, 0xdc, 0xe6, 0x78, 0xd2, 0xc8, 0x6b, 0xd0, 0x88, 0x8b, 0x66, 0x79, 0xd3, 0x99, 0x6f, 0x57,
-	0xd0, 0xc1, 0x09, 0x5b, 0xf5, 0x40, 0xe3, 0xb8, 0x6f, 0xae, 0xd5, 0x2b, 0xc6, 0x61, 0xf5, 0xbe,
-	0x88, 0x06, 0x62, 0x72, 0x70, 0x9e, 0xf4, 0xcd, 0x28, 0x6c, 0xef, 0xae, 0x19, 0xbf, 0xec, 0xdd,
-	0x45, 0x4f, 0x38, 0x8a, 0xa4, 0xd8, 0x1b, 0x9c, 0xe9, 0x40, 0x6e, 0x96, 0x78, 0x66, 0x7a, 0x7c,
-	0x2c, 0x85, 0x09, 0xde, 0x7a, 0xc5, 0x7e, 0x1e, 0x40, 0x07, 0xe9, 0x79, 0xb0, 0xfd, 0xe0, 0x60,
-	0x7a, 0xb4, 0x2f, 0x4f, 0x74, 0x39, 0x19, 0x98, 0x72, 0x32, 0x7c, 0x05, 0x96, 0xfa, 0x7a, 0x44,
-	0xab, 0x2b, 0xee, 0x0a, 0xcb, 0xc4, 0x76, 0xc1, 0x60, 0x85, 0x77, 0x60, 0x51, 0x9e, 0x27, 0xfd,
-	0x7d, 0x79, 0xe2, 0x0d, 0xca, 0x5c, 0xea, 0x7b, 0x0b, 0xdc, 0xe0, 0x15, 0x35, 0xeb, 0x87, 0xb0,
-	0x7a, 0x6f, 0xa8, 0xa6, 0x16, 0x7a, 0xb8, 0xbf, 0x01, 0x4b, 0xb1, 0x54, 0x3b, 0x89, 0xaa, 0x25,
-	0x9e, 0xaf, 0xc3, 0x97, 0xa1, 0x35, 0x54, 0x6f, 0x6a, 0x73, 0x2e, 0xe2, 0x1a, 0x89, 0x3d, 0x0f,
-	0xed, 0x1d, 0xf3, 0x41, 0x14, 0x6d, 0xf2, 0x23, 0x71, 0xae, 0x85, 0x87, 0x8f, 0xdb, 0x6f, 0x40,
-	0x3b, 0xff, 0xdd, 0x32, 0xbc, 0x09, 0xad, 0x3d, 0x89, 0x27, 0x84, 0x9d, 0x3c, 0x05, 0x3c, 0x7c,
-	0x2f, 0x1e, 0x6e, 0x5c, 0xd5, 0xcb, 0x3d, 0xb9, 0x1b, 0x4d, 0x4f, 0x4e, 0xb3, 0x0f, 0xc6, 0x6c,
-	0xe1, 0xa8, 0x45, 0xff, 0x58, 0xbe, 0xfa, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa9, 0xdd, 0xb9,
-	0xb4, 0xb0, 0x29, 0x00, 0x00,
+	0xf5, 0xee, 0xf9, 0xb2, 0xe7, 0x79, 0xc6, 0xf6, 0x76, 0xbc, 0xde, 0x8e, 0x93, 0xac, 0xac, 0x56,
+	0x7e, 0x91, 0x7f, 0x64, 0xb3, 0x9b, 0x38, 0x21, 0x28, 0x42, 0x11, 0xc4, 0xde, 0x4d, 0xc6, 0xca,
+	0x3a, 0x6c, 0xca, 0x0e, 0x20, 0x45, 0x20, 0xda, 0x33, 0x65, 0xbb, 0x95, 0x99, 0xee, 0xd9, 0xa9,
+	0x9e, 0xac, 0x8d, 0x90, 0xc2, 0x01, 0xb8, 0x21, 0x71, 0x41, 0x82, 0x1c, 0xb8, 0xc0, 0x0d, 0x89,
+	0x13, 0x67, 0x0e, 0x48, 0x5c, 0x22, 0x2e, 0xe1, 0x88, 0xb8, 0x70, 0x43, 0xe2, 0xc8, 0x3f, 0x80,
+	0xde, 0xab, 0xaa, 0xee, 0xaa, 0xea, 0x9e, 0xb1, 0xb3, 0x9b, 0x0b, 0xb7, 0x7e, 0xaf, 0x5f, 0x55,
+	0xbd, 0xef, 0x7a, 0xef, 0x75, 0xc3, 0xea, 0x38, 0x9a, 0x44, 0xfd, 0x49, 0x2a, 0xc4, 0xed, 0xf1,
+	0x24, 0xcd, 0x52, 0xbf, 0x99, 0x5d, 0x8c, 0xb9, 0xd8, 0xbc, 0x96, 0x4d, 0xa2, 0x44, 0x44, 0xfd,
+	0x2c, 0x4e, 0x13, 0xf9, 0x66, 0xb3, 0xd3, 0x4f, 0x47, 0xa3, 0x1c, 0x5a, 0x3b, 0x1e, 0xa6, 0xfd,
+	0x8f, 0xfa, 0x67, 0x51, 0xac, 0x30, 0xe1, 0x7d, 0xd8, 0x78, 0xa0, 0x37, 0x3b, 0xcc, 0xa2, 0x6c,
+	0x2a, 0xee, 0xf2, 0x2c, 0x8a, 0x87, 0xc2, 0x5f, 0x87, 0x66, 0x34, 0x18, 0x4c, 0x44, 0xe0, 0x6d,
+	0xd5, 0xb7, 0xdb, 0x4c, 0x02, 0xfe, 0xb3, 0xd0, 0xa6, 0x3d, 0x7a, 0x91, 0x38, 0x0b, 0x6a, 0x5b,
+	0xf5, 0xed, 0x0e, 0x2b, 0x10, 0xe1, 0x87, 0xf0, 0x8c, 0xb3, 0xdb, 0x2e, 0xbe, 0xd3, 0x5b, 0xde,
+	0x04, 0xc8, 0x69, 0xe5, 0xbe, 0x1d, 0x66, 0x60, 0x70, 0xf3, 0xec, 0x9c, 0x71, 0x31, 0x1d, 0x66,
+	0x42, 0x6f, 0x9e, 0x23, 0xc2, 0x4f, 0x6b, 0x70, 0x3d, 0xdf, 0xbd, 0xc7, 0xe3, 0xd3, 0xb3, 0x4c,
+	0x9e, 0xe1, 0x6f, 0x40, 0x4b, 0xd0, 0x53, 0xe0, 0x6d, 0x79, 0xdb, 0x4d, 0xa6, 0x20, 0x14, 0x21,
+	0x8b, 0xb3, 0x21, 0x0f, 0x6a, 0x5b, 0x1e, 0x8a, 0x40, 0x00, 0x52, 0x9f, 0xd1, 0xea, 0xa0, 0xbe,
+	0xe5, 0x6d, 0xd7, 0x99, 0x82, 0xfc, 0xaf, 0xc1, 0xe2, 0x40, 0x32, 0x1a, 0x34, 0xb6, 0xbc, 0xed,
+	0xe5, 0x9d, 0xe7, 0x6e, 0x93, 0x5a, 0x6f, 0x57, 0x2b, 0x88, 0x2d, 0x0e, 0x0a, 0xb1, 0x46, 0x51,
+	0x9c, 0x48, 0x96, 0x82, 0x26, 0x6d, 0x6a, 0x60, 0xfc, 0x4d, 0x58, 0x22, 0x08, 0x55, 0xd6, 0xda,
+	0xf2, 0xb6, 0x3b, 0x2c, 0x87, 0xfd, 0xb7, 0xa1, 0x73, 0x6c, 0xa8, 0x28, 0x58, 0xa4, 0x93, 0xc3,
+	0xea, 0x93, 0x4d, 0x65, 0x32, 0x6b, 0x5d, 0xf8, 0x2f, 0x0f, 0x82, 0x4a, 0xe5, 0x30, 0x31, 0xfe,
+	0x92, 0xf4, 0x63, 0x8b, 0xd9, 0x98, 0x2b, 0x66, 0x93, 0x36, 0x2c, 0xc4, 0xdc, 0x82, 0x65, 0x74,
+	0xc4, 0x38, 0x7b, 0x8b, 0x5c, 0xaa, 0x45, 0x2e, 0x65, 0xa2, 0xfc, 0x6d, 0x58, 0x95, 0xe0, 0x6e,
+	0xee, 0x5e, 0x8b, 0x44, 0xe5, 0xa2, 0xc3, 0x5f, 0x7b, 0xb0, 0xea, 0x28, 0xa6, 0x90, 0xc4, 0xab,
+	0x96, 0xa4, 0x66, 0x49, 0x62, 0x39, 0x71, 0x9d, 0x2c, 0x52, 0x20, 0xbe, 0xb0, 0x9c, 0x86, 0x39,
+	0xc3, 0xdf, 0x99, 0x66, 0xd8, 0x4b, 0x13, 0xc1, 0x13, 0x31, 0x9d, 0xcf, 0x24, 0xaa, 0xe6, 0xac,
+	0x38, 0x4f, 0x72, 0x6a, 0xa2, 0xfc, 0xe7, 0xa1, 0xdb, 0x97, 0x5b, 0xf5, 0x4c, 0xbb, 0xd8, 0x48,
+	0xff, 0x2b, 0xb0, 0xa6, 0x10, 0x85, 0x06, 0x1b, 0x74, 0x50, 0x09, 0x1f, 0xfe, 0xd1, 0x03, 0x1f,
+	0xd9, 0x7c, 0x2f, 0x1d, 0x70, 0x54, 0xff, 0x5e, 0x9a, 0x9c, 0xc4, 0xa7, 0x33, 0x18, 0x5c, 0x81,
+	0x5a, 0x3a, 0x26, 0xbe, 0xba, 0xac, 0x96, 0x8e, 0x11, 0x8e, 0x07, 0xc4, 0x43, 0x9b, 0xd5, 0xe2,
+	0x81, 0xef, 0x43, 0x03, 0x73, 0x83, 0x3a, 0x8c, 0x9e, 0x71, 0xa7, 0x8f, 0xa3, 0xe1, 0x94, 0x93,
+	0x82, 0xba, 0x4c, 0x02, 0xd2, 0x0b, 0xe2, 0x44, 0xbc, 0x3d, 0x49, 0x7f, 0xc8, 0x93, 0xa0, 0xa5,
+	0x44, 0x2d, 0x50, 0xd2, 0x32, 0xe2, 0xc1, 0xf4, 0xf8, 0x5d, 0x7e, 0x41, 0xb1, 0xd0, 0x66, 0x05,
+	0x22, 0xfc, 0x66, 0xc1, 0xf5, 0xb7, 0xd3, 0x8c, 0x4b, 0xdf, 0x9f, 0x91, 0xa8, 0x90, 0x83, 0x34,
+	0xe3, 0x32, 0x8f, 0xb4, 0x99, 0x04, 0xc2, 0x3f, 0x78, 0xb0, 0x6e, 0x0a, 0xbe, 0x3f, 0x50, 0xb6,
+	0xd1, 0x42, 0x78, 0x86, 0x10, 0x37, 0x01, 0xc6, 0x93, 0x74, 0x9c, 0x8a, 0x68, 0xb8, 0x3f, 0x50,
+	0x31, 0x62, 0x60, 0xd0, 0xbd, 0x1e, 0x4e, 0xe3, 0x6c, 0x5f, 0x2b, 0x43, 0x41, 0x46, 0xb8, 0x35,
+	0xaa, 0xc3, 0xad, 0x69, 0xaa, 0xd7, 0x12, 0xb9, 0xe5, 0x8a, 0xfc, 0xcb, 0x1a, 0xac, 0x69, 0x86,
+	0x73, 0x66, 0xa5, 0x05, 0xbc, 0xdc, 0x02, 0xc5, 0x81, 0xb5, 0xea, 0x03, 0xeb, 0xe6, 0x81, 0x37,
+	0x01, 0xb2, 0x68, 0x72, 0xca, 0x29, 0xf0, 0x94, 0xd5, 0x0c, 0x8c, 0x6b, 0xa5, 0x66, 0xd9, 0x4a,
+	0x77, 0xb4, 0x6e, 0x5b, 0x94, 0xad, 0x9e, 0x36, 0xb2, 0x95, 0x6d, 0x1b, 0xa5, 0x76, 0x0c, 0x99,
+	0x93, 0x49, 0x3a, 0xa2, 0x03, 0xa5, 0x55, 0x73, 0xd8, 0x08, 0xd2, 0xa5, 0x72, 0x90, 0x6a, 0xbd,
+	0xb4, 0x5d, 0xbd, 0xfc, 0xc9, 0x83, 0xeb, 0x8c, 0xf7, 0x79, 0x3c, 0xce, 0xf4, 0xb1, 0xca, 0x89,
+	0xab, 0x2c, 0xf9, 0x0a, 0xb4, 0xfa, 0xf4, 0x36, 0xa8, 0x55, 0x72, 0x5c, 0xc4, 0x00, 0x53, 0x84,
+	0xfe, 0x8b, 0xd0, 0x18, 0x4f, 0xf8, 0xc7, 0xa4, 0xba, 0xe5, 0x9d, 0x1b, 0xce, 0x02, 0x6d, 0x0a,
+	0x46, 0x44, 0xfe, 0x2b, 0xb0, 0xd8, 0x9f, 0x4e, 0x26, 0x3c, 0xc9, 0x82, 0xc6, 0x7c, 0x7a, 0x4d,
+	0x17, 0xfe, 0xd6, 0x83, 0xe7, 0x1c, 0x01, 0x90, 0x0b, 0x24, 0xfb, 0x60, 0x3c, 0x88, 0x32, 0x6e,
+	0x29, 0xcd, 0x73, 0x94, 0x76, 0x47, 0x71, 0x27, 0xc5, 0x79, 0xa6, 0x42, 0x1c, 0x87, 0xc3, 0xaf,
+	0x16, 0x1c, 0xd6, 0x2f, 0x5f, 0x93, 0x73, 0xf9, 0x1f, 0x0f, 0x6e, 0x38, 0x5c, 0x92, 0x75, 0xd3,
+	0x84, 0x97, 0xbc, 0xb0, 0xfa, 0x36, 0xb1, 0xbd, 0xad, 0x5e, 0xf2, 0x36, 0x7c, 0x9f, 0x66, 0xd1,
+	0x10, 0xb7, 0xd6, 0x01, 0x63, 0x60, 0xa8, 0x26, 0x40, 0x08, 0x8f, 0x25, 0x5f, 0x6c, 0xb2, 0x02,
+	0x41, 0xb9, 0x38, 0x15, 0x19, 0xbd, 0x6c, 0xd1, 0xcb, 0x1c, 0xf6, 0x03, 0x58, 0x44, 0xef, 0x63,
+	0x22, 0x53, 0x3e, 0xa7, 0x41, 0x3c, 0x73, 0x90, 0x26, 0x5c, 0x0a, 0x4b, 0x6e, 0xd7, 0x64, 0x06,
+	0x06, 0x6d, 0xf3, 0x94, 0x16, 0xf7, 0x9d, 0x49, 0x3a, 0x1d, 0x3f, 0x51, 0x7e, 0xcc, 0xf3, 0x93,
+	0x0c, 0x35, 0x09, 0x5c, 0x21, 0xca, 0xa8, 0x5a, 0x52, 0xfe, 0x2e, 0x54, 0x66, 0x30, 0x30, 0xe1,
+	0x40, 0x66, 0x86, 0x83, 0xe9, 0x30, 0x8b, 0x0f, 0xe3, 0xd3, 0x7b, 0xe7, 0xbc, 0xef, 0x87, 0xd0,
+	0x19, 0x29, 0xd8, 0xf0, 0x1b, 0x0b, 0x87, 0x01, 0x92, 0x9d, 0xc7, 0x32, 0xa1, 0x35, 0x18, 0x3d,
+	0xa3, 0xae, 0xc6, 0xd1, 0xc5, 0x30, 0x8d, 0x06, 0xea, 0x3e, 0xd4, 0x60, 0xf8, 0x6f, 0x57, 0x17,
+	0x5f, 0x4a, 0x0e, 0xda, 0x82, 0xe5, 0xc2, 0x07, 0xb4, 0x66, 0x4c, 0xd4, 0x15, 0xf4, 0x63, 0xc6,
+	0x47, 0x6b, 0x66, 0x52, 0x59, 0x74, 0x6b, 0x18, 0x43, 0xa7, 0x4b, 0x25, 0x9d, 0x7e, 0xe6, 0xc1,
+	0xa6, 0xe3, 0xef, 0xa6, 0x03, 0x54, 0xe5, 0x96, 0x1d, 0x27, 0xb7, 0x6c, 0x3a, 0x81, 0x65, 0xac,
+	0xcf, 0x93, 0xcb, 0x6d, 0x2b, 0xb9, 0x54, 0xae, 0xb0, 0xa2, 0xf7, 0x35, 0x37, 0xbf, 0xcc, 0x5b,
+	0x92, 0x07, 0xef, 0xcf, 0x3c, 0x58, 0x67, 0xfc, 0x61, 0x5e, 0x8f, 0x50, 0x22, 0x4a, 0x4e, 0xd2,
+	0xd9, 0x7e, 0x1c, 0xeb, 0x6b, 0xce, 0xbc, 0xd7, 0xeb, 0x86, 0xb0, 0xb3, 0xae, 0x36, 0x2b, 0x59,
+	0x37, 0xdd, 0x64, 0xbd, 0x07, 0x1b, 0x8c, 0x8b, 0xb1, 0xc5, 0x88, 0xb4, 0xf2, 0xff, 0x43, 0x3d,
+	0x1e, 0xc8, 0x9b, 0x7b, 0x4e, 0xd2, 0x44, 0x9a, 0xf0, 0x1d, 0xb8, 0x51, 0xda, 0x84, 0xc4, 0x16,
+	0xfe, 0x2d, 0x73, 0x97, 0x79, 0xaa, 0xa1, 0x8d, 0xc6, 0x32, 0x6e, 0x76, 0xe3, 0x64, 0x70, 0x10,
+	0x27, 0x7c, 0xb2, 0x37, 0x1a, 0x90, 0x5f, 0xc4, 0xc9, 0xe0, 0x2d, 0x6a, 0x9d, 0x54, 0x95, 0x6c,
+	0x60, 0x48, 0xbe, 0x38, 0x19, 0xec, 0xa1, 0xfb, 0xa9, 0x12, 0xad, 0x40, 0x14, 0x39, 0x0e, 0xcf,
+	0xb3, 0x73, 0x1c, 0x62, 0xc2, 0xbf, 0x78, 0x70, 0xcd, 0x3a, 0x92, 0xac, 0x30, 0xa3, 0xe4, 0xc0,
+	0x6d, 0x0f, 0xcd, 0x48, 0x32, 0x30, 0x36, 0x1f, 0xf5, 0xf9, 0x7c, 0x34, 0x5c, 0x3e, 0xf2, 0xba,
+	0xf7, 0x28, 0x1e, 0x71, 0x15, 0x51, 0x05, 0x02, 0x23, 0x8e, 0x00, 0x55, 0x64, 0xaa, 0xea, 0xcc,
+	0x40, 0x85, 0xbf, 0xf0, 0x20, 0x30, 0xa2, 0xe3, 0x72, 0x71, 0x6e, 0x59, 0xd7, 0x54, 0x60, 0x58,
+	0xc6, 0x5a, 0xab, 0xbc, 0x7c, 0xc7, 0xbd, 0xa3, 0x66, 0x2f, 0xc8, 0x7d, 0xfc, 0x9e, 0xec, 0x05,
+	0x50, 0x3c, 0xa4, 0xf8, 0x56, 0x42, 0x52, 0x8a, 0xe9, 0x98, 0x4f, 0x48, 0x09, 0x92, 0x9b, 0x02,
+	0x81, 0xbe, 0x3f, 0xc2, 0x6d, 0xf4, 0x2d, 0x45, 0x40, 0xf8, 0x5d, 0x58, 0x33, 0xb7, 0xb9, 0x1f,
+	0x8b, 0x6c, 0x46, 0x94, 0xdc, 0x86, 0x16, 0x2d, 0x91, 0x85, 0xe5, 0xf2, 0xce, 0x86, 0xe3, 0x6e,
+	0x8a, 0x0b, 0xa6, 0xa8, 0xc2, 0x4f, 0x4a, 0xd7, 0xbc, 0x3e, 0x40, 0x5d, 0xf3, 0xba, 0xd0, 0xf0,
+	0x2a, 0x0b, 0x07, 0x4d, 0x5c, 0x2e, 0x34, 0x6a, 0xf3, 0xe9, 0x73, 0x0d, 0x3d, 0x82, 0x75, 0x1d,
+	0x37, 0x96, 0x78, 0x2f, 0x42, 0x63, 0x18, 0x8b, 0xec, 0xd2, 0x73, 0x91, 0x08, 0x4d, 0xa3, 0x7b,
+	0x63, 0x29, 0xf6, 0x1c, 0xd3, 0x28, 0xc2, 0xf0, 0xa7, 0xda, 0xeb, 0xd1, 0x83, 0x76, 0x0e, 0xa2,
+	0x38, 0x39, 0x88, 0xc6, 0x46, 0x66, 0xf6, 0x66, 0xf7, 0x64, 0x35, 0x9d, 0x41, 0xaa, 0x7b, 0xb2,
+	0xfa, 0xdc, 0x9e, 0xac, 0x61, 0xf7, 0x9e, 0xe1, 0x5d, 0xf0, 0x6d, 0x36, 0xc8, 0x5d, 0x6f, 0x43,
+	0x33, 0xce, 0xf8, 0x48, 0x67, 0x0d, 0x4b, 0x1e, 0x93, 0x61, 0x26, 0xc9, 0xc2, 0x7f, 0xd6, 0xe1,
+	0x29, 0x2b, 0xf7, 0xa8, 0x88, 0x7c, 0x1e, 0xba, 0x78, 0x52, 0xd1, 0x73, 0x79, 0x74, 0x7f, 0xda,
+	0x48, 0xec, 0x6e, 0x0b, 0x84, 0xd9, 0xe8, 0xb9, 0xe8, 0x19, 0xf7, 0x65, 0xa1, 0xb5, 0x86, 0xa5,
+	0xb5, 0x10, 0x3a, 0xe3, 0x09, 0x2f, 0x0e, 0x97, 0xfd, 0xa8, 0x85, 0xb3, 0x35, 0xdb, 0x72, 0xbb,
+	0x5d, 0xb9, 0x03, 0x0a, 0xc3, 0x55, 0xd3, 0xad, 0x77, 0xc8, 0x71, 0x14, 0x51, 0x39, 0xc1, 0x92,
+	0xdc, 0x21, 0x47, 0xa0, 0xee, 0xb3, 0xf3, 0xbd, 0x74, 0x9a, 0x64, 0x82, 0xea, 0xf4, 0x2e, 0xcb,
+	0x61, 0xf9, 0x4e, 0x0e, 0x70, 0x02, 0x90, 0xbd, 0xb2, 0x86, 0xb1, 0xe6, 0xc8, 0xce, 0xe5, 0x28,
+	0x68, 0x99, 0x66, 0x3d, 0x1a, 0xa4, 0x86, 0x17, 0xd5, 0x7c, 0xa4, 0x97, 0x76, 0xa4, 0x4e, 0x2d,
+	0x24, 0x72, 0xae, 0x10, 0x72, 0x93, 0x2e, 0x6d, 0x62, 0xe1, 0xfc, 0x5b, 0x70, 0x2d, 0x49, 0x93,
+	0x3d, 0x9a, 0x20, 0x1c, 0x69, 0x26, 0x57, 0x88, 0xc9, 0xf2, 0x8b, 0x70, 0x17, 0xae, 0x1d, 0xf2,
+	0xe1, 0x89, 0xea, 0xdb, 0x0f, 0xb3, 0xe8, 0x94, 0x0b, 0xff, 0x25, 0xdb, 0x51, 0x74, 0xa0, 0xb8,
+	0x84, 0xda, 0x4f, 0xee, 0xc3, 0x9a, 0xfb, 0x0a, 0x33, 0xab, 0xc8, 0xa2, 0x49, 0xd6, 0x33, 0x1d,
+	0xdf, 0x44, 0xa1, 0x7d, 0x79, 0x12, 0x1d, 0xab, 0xe2, 0xb9, 0xcb, 0x14, 0x14, 0xfe, 0xdd, 0x83,
+	0x75, 0x77, 0x3b, 0x72, 0xdf, 0xf9, 0xe5, 0x57, 0x37, 0xbf, 0x98, 0x5f, 0x82, 0xa6, 0xc0, 0x45,
+	0x4e, 0x1f, 0x53, 0xe6, 0x9e, 0xa8, 0xac, 0x9a, 0xaa, 0xe1, 0xd4, 0x54, 0x37, 0x01, 0xf8, 0x39,
+	0xef, 0xdb, 0x63, 0xae, 0x02, 0xf3, 0x85, 0xbb, 0xc2, 0x90, 0xc3, 0xc6, 0xfd, 0xb4, 0x1f, 0x0d,
+	0x35, 0x33, 0x85, 0x74, 0xaf, 0x68, 0xae, 0x3d, 0xab, 0x57, 0xa9, 0xd2, 0x84, 0xe6, 0x9c, 0xbc,
+	0x69, 0x3f, 0x19, 0xf0, 0x73, 0x95, 0x3d, 0x34, 0x18, 0xbe, 0x0e, 0x2b, 0xb2, 0xfc, 0x42, 0x0e,
+	0x2a, 0x95, 0x97, 0x4f, 0x2b, 0x6a, 0xc6, 0xb4, 0x22, 0x0c, 0x61, 0x4d, 0xae, 0xdb, 0x8b, 0x92,
+	0x3e, 0x1f, 0x56, 0xad, 0x0c, 0x3f, 0x57, 0xb3, 0x28, 0x62, 0xe7, 0xb2, 0x2e, 0x21, 0xbb, 0xd0,
+	0x5d, 0x42, 0x76, 0x81, 0xda, 0x92, 0x22, 0xc2, 0x5c, 0xc3, 0xf4, 0x16, 0xb4, 0x80, 0x2f, 0x42,
+	0x03, 0xd5, 0x16, 0x2c, 0x13, 0xfd, 0x75, 0x45, 0x6f, 0x4b, 0xd6, 0x5b, 0x60, 0x44, 0x44, 0x0d,
+	0x2f, 0x71, 0x1d, 0x74, 0xac, 0xed, 0x5d, 0x81, 0x7a, 0x0b, 0x4c, 0x11, 0xee, 0x2e, 0x2a, 0x25,
+	0x84, 0x3f, 0x29, 0x6a, 0x60, 0xcb, 0x32, 0x4a, 0xbc, 0x3b, 0xd6, 0x7d, 0x35, 0xd7, 0x34, 0xa5,
+	0xd6, 0xb3, 0x76, 0xf9, 0x9a, 0xfc, 0xde, 0xfa, 0xdc, 0x83, 0x67, 0xab, 0xd8, 0x98, 0xd9, 0x7f,
+	0xe6, 0xae, 0x5e, 0xbb, 0x92, 0xab, 0xdb, 0x8d, 0x67, 0x7d, 0x7e, 0xe3, 0xd9, 0x98, 0xd7, 0x78,
+	0x36, 0x67, 0x37, 0x9e, 0x2d, 0xab, 0xf1, 0x0c, 0x3f, 0x81, 0x67, 0xaa, 0x44, 0x12, 0xaa, 0x14,
+	0xb8, 0x65, 0xa9, 0x36, 0x98, 0x21, 0x80, 0x28, 0x97, 0x4b, 0xb5, 0x4b, 0x16, 0xe4, 0x4a, 0xfd,
+	0x8d, 0x07, 0x3e, 0xe3, 0x0f, 0xdf, 0x9f, 0xf2, 0xc9, 0x05, 0x92, 0xc9, 0xf7, 0xce, 0x80, 0xb8,
+	0xc8, 0x1e, 0x6e, 0x4b, 0xb0, 0x0e, 0xcd, 0x3e, 0xa6, 0x4a, 0xa5, 0x2e, 0x09, 0xa0, 0xa6, 0x06,
+	0xf1, 0x84, 0xcb, 0xda, 0x59, 0x69, 0x2a, 0x47, 0x18, 0x57, 0x57, 0xd3, 0xba, 0xba, 0xd6, 0xa1,
+	0x19, 0x53, 0xb8, 0xca, 0xbe, 0x5d, 0x02, 0xe1, 0xfb, 0x58, 0xad, 0x8c, 0x87, 0x17, 0x2e, 0x87,
+	0x6f, 0xd0, 0x15, 0x24, 0x7d, 0x44, 0x65, 0xe2, 0xb9, 0x6e, 0x54, 0x50, 0x87, 0xdf, 0x37, 0x3e,
+	0x71, 0xec, 0xa9, 0x59, 0xb2, 0xd0, 0x25, 0xab, 0x88, 0x4f, 0x13, 0x75, 0x65, 0xd3, 0x33, 0x1a,
+	0x96, 0x1a, 0xf4, 0x83, 0x48, 0xf6, 0xf4, 0x1d, 0x96, 0xc3, 0x45, 0x27, 0x5f, 0x37, 0x26, 0x8d,
+	0xe1, 0x8f, 0xe0, 0xba, 0xb3, 0xbf, 0x6a, 0x1a, 0x76, 0x2c, 0xad, 0xda, 0x9d, 0x89, 0x53, 0x46,
+	0xe4, 0x1a, 0xbf, 0x03, 0xf5, 0xe3, 0xa1, 0x08, 0x6a, 0xd5, 0x1f, 0x20, 0x2c, 0xf6, 0x19, 0x52,
+	0x86, 0x9f, 0xaa, 0x89, 0x26, 0xbd, 0xa7, 0x2a, 0xec, 0x09, 0x4e, 0xdf, 0x86, 0xd5, 0x58, 0x18,
+	0xfa, 0x54, 0xd7, 0xc9, 0x12, 0x73, 0xd1, 0x78, 0x45, 0x47, 0x83, 0xc1, 0xbe, 0x10, 0x53, 0x6e,
+	0x36, 0x23, 0x36, 0x32, 0x7c, 0x53, 0x66, 0x47, 0x62, 0x8b, 0xf1, 0x47, 0xd1, 0x64, 0x50, 0xd9,
+	0x26, 0x6c, 0x40, 0x2b, 0x1a, 0x91, 0x5f, 0xa9, 0x39, 0xbd, 0x84, 0xc2, 0x5f, 0x79, 0xe0, 0xef,
+	0x21, 0xab, 0x6f, 0x09, 0xc1, 0xb3, 0xa3, 0x49, 0x94, 0x88, 0x13, 0x3e, 0x41, 0x7f, 0x8b, 0x10,
+	0x81, 0x13, 0x0f, 0x5d, 0xe0, 0xe7, 0x08, 0xbc, 0x6c, 0x09, 0x38, 0xbc, 0x18, 0x1d, 0xa7, 0x43,
+	0xe5, 0xbc, 0x26, 0xca, 0x38, 0xae, 0x6e, 0x1e, 0x87, 0xf8, 0x2c, 0x35, 0xae, 0x3e, 0x05, 0x21,
+	0xcb, 0x89, 0x8e, 0xf3, 0x36, 0xa3, 0xe7, 0xf0, 0x1f, 0x2d, 0xe3, 0x23, 0x84, 0xd2, 0xf8, 0xeb,
+	0x38, 0x09, 0x40, 0x03, 0x29, 0x8d, 0x3f, 0x5b, 0x6d, 0x3e, 0x49, 0x4d, 0x99, 0x97, 0x60, 0xff,
+	0x55, 0xdd, 0x92, 0x94, 0x27, 0x73, 0xae, 0x55, 0xf1, 0x3a, 0x20, 0x5a, 0xff, 0x4d, 0xe8, 0x46,
+	0xa6, 0x56, 0x82, 0x86, 0x75, 0x2f, 0x90, 0xc6, 0x84, 0x7e, 0xd9, 0x5b, 0x60, 0x36, 0x75, 0xbe,
+	0xfc, 0x3b, 0x71, 0x76, 0x36, 0x98, 0x44, 0x8f, 0x82, 0x66, 0xc5, 0x72, 0xfd, 0x32, 0x5f, 0xae,
+	0x11, 0xfe, 0xab, 0xb0, 0x94, 0xe9, 0x83, 0x5b, 0xf3, 0x0f, 0xce, 0x09, 0x71, 0xd1, 0x23, 0x7d,
+	0xdc, 0xe2, 0xfc, 0xe3, 0x72, 0x42, 0xff, 0x1e, 0xac, 0xe8, 0x0d, 0x8e, 0x52, 0xb2, 0xf8, 0x92,
+	0xa5, 0x25, 0xfb, 0x3c, 0x49, 0xd2, 0x5b, 0x60, 0xce, 0x22, 0xff, 0xeb, 0x00, 0x49, 0x3e, 0x23,
+	0x0e, 0xda, 0x95, 0x15, 0x4a, 0x31, 0x05, 0xee, 0x2d, 0x30, 0x83, 0xdc, 0x7f, 0x1b, 0x56, 0x13,
+	0x7b, 0x92, 0x13, 0x40, 0x29, 0xa6, 0x9c, 0x59, 0x4f, 0x6f, 0x81, 0xb9, 0x8b, 0xfc, 0x5d, 0x58,
+	0x15, 0x3a, 0xa5, 0xa9, 0x7d, 0xe4, 0x6d, 0x6e, 0x36, 0x91, 0xc6, 0x5b, 0xdc, 0xc3, 0x59, 0xe0,
+	0xbf, 0x0b, 0x7e, 0xbf, 0x14, 0x12, 0x41, 0xc7, 0x12, 0xa8, 0x1c, 0x33, 0xbd, 0x05, 0x56, 0xb1,
+	0xcc, 0xff, 0x06, 0x74, 0xc7, 0x66, 0x03, 0x17, 0x74, 0x4b, 0xcd, 0xa0, 0x39, 0x26, 0x41, 0x3f,
+	0xb0, 0xe8, 0xfd, 0x37, 0x8b, 0x79, 0x23, 0xd9, 0x66, 0xa5, 0xb4, 0xde, 0x1c, 0x4f, 0xf6, 0x16,
+	0x98, 0x45, 0x6e, 0x14, 0x45, 0x4d, 0x2c, 0x8a, 0x8a, 0x1a, 0xe4, 0x33, 0x0f, 0x36, 0xd4, 0x4d,
+	0xe9, 0x44, 0xcf, 0xac, 0x19, 0x9c, 0x51, 0xfd, 0x5e, 0x2d, 0xd7, 0xbd, 0x6c, 0xcd, 0xe0, 0x4a,
+	0xb1, 0x6a, 0x7d, 0x43, 0x25, 0x4a, 0xff, 0x75, 0x77, 0x0a, 0x37, 0x7f, 0x51, 0x7e, 0xe9, 0xbe,
+	0x6b, 0x7d, 0xaa, 0x28, 0x42, 0xfa, 0x71, 0x52, 0x74, 0xf8, 0xe3, 0x06, 0xac, 0xbb, 0xbb, 0x51,
+	0x39, 0x64, 0xd7, 0x33, 0x5e, 0xa9, 0x9e, 0xc1, 0x91, 0x2b, 0x42, 0x52, 0x8d, 0x4a, 0xe9, 0x26,
+	0xca, 0x7f, 0x01, 0x56, 0xb0, 0x86, 0x39, 0x8c, 0x46, 0x5c, 0x11, 0xc9, 0x6b, 0xde, 0xc1, 0x16,
+	0x05, 0x6e, 0xa3, 0xba, 0x45, 0x6d, 0xba, 0x8d, 0x7d, 0xd1, 0x3c, 0xb6, 0xe6, 0x35, 0x8f, 0x8b,
+	0x73, 0x9a, 0xc7, 0x25, 0xa7, 0x79, 0xb4, 0x9a, 0xda, 0xb6, 0xdb, 0xd4, 0x1a, 0xad, 0x25, 0x5c,
+	0xd2, 0x5a, 0x2e, 0x5f, 0xa5, 0xb5, 0xec, 0x54, 0xb4, 0x96, 0xa5, 0xc6, 0xbf, 0x7b, 0xc5, 0xc6,
+	0x7f, 0xa5, 0xba, 0xf1, 0xc7, 0x0f, 0xe0, 0xf8, 0xd1, 0xf7, 0x5e, 0xd1, 0x63, 0xad, 0x4a, 0x4a,
+	0x07, 0x1d, 0xfe, 0xa0, 0x1c, 0x1b, 0x8c, 0xf7, 0xd3, 0x19, 0x97, 0xeb, 0x63, 0xc4, 0x46, 0xf8,
+	0x7f, 0xb0, 0x9c, 0xbf, 0x3e, 0x3a, 0xa7, 0x8b, 0xf1, 0x3c, 0x1f, 0x6e, 0xb4, 0x99, 0x82, 0xe4,
+	0x48, 0xb6, 0x98, 0x2f, 0x1f, 0xa1, 0x1f, 0xb8, 0x63, 0x8c, 0xab, 0x7c, 0x90, 0x0f, 0x7f, 0x5f,
+	0x83, 0x6b, 0xd6, 0x70, 0xf7, 0x7f, 0xcb, 0xa3, 0xdb, 0x8f, 0xeb, 0xd1, 0x6d, 0xc3, 0xa3, 0x2b,
+	0xec, 0xdf, 0xae, 0xb6, 0xff, 0x3b, 0xf0, 0x94, 0xa5, 0x2c, 0xd2, 0x3b, 0x26, 0xb4, 0x16, 0xf1,
+	0xed, 0x8e, 0xb4, 0x4a, 0x8a, 0x65, 0x8a, 0x4e, 0x26, 0x26, 0xd7, 0x7e, 0x28, 0x43, 0xb5, 0xf5,
+	0x4a, 0x23, 0x3a, 0xeb, 0xdf, 0x9f, 0xbf, 0xd6, 0x60, 0xa5, 0xa8, 0x88, 0x84, 0xe0, 0x94, 0xaa,
+	0x71, 0x7a, 0xa0, 0xdd, 0x11, 0x9f, 0x29, 0xe5, 0xa7, 0xba, 0xa5, 0xc8, 0x52, 0x34, 0x72, 0x9c,
+	0xdf, 0xfc, 0x64, 0x9e, 0x25, 0x66, 0x60, 0x0c, 0xdf, 0x6b, 0x98, 0xbe, 0x67, 0x14, 0x71, 0x4d,
+	0xab, 0x88, 0xf3, 0xa1, 0x81, 0x33, 0x09, 0x65, 0x17, 0x7a, 0x46, 0x5a, 0x21, 0xab, 0x41, 0xf9,
+	0x21, 0x50, 0x41, 0x28, 0x90, 0x14, 0xfc, 0x62, 0xcc, 0xc9, 0x1e, 0x5d, 0x56, 0x20, 0x0c, 0xf3,
+	0x83, 0x65, 0x7e, 0xfa, 0xd1, 0x02, 0xdd, 0x06, 0x75, 0xa9, 0x2c, 0x75, 0x9d, 0x28, 0x4a, 0x78,
+	0x94, 0x0e, 0x2f, 0x4c, 0x45, 0xb5, 0x41, 0x54, 0x06, 0x06, 0x13, 0x95, 0x98, 0xf6, 0xfb, 0x5c,
+	0x88, 0xe0, 0x06, 0x89, 0xae, 0xc1, 0xf0, 0x6f, 0x9e, 0x1c, 0x49, 0xd3, 0x84, 0xe4, 0xee, 0x31,
+	0x65, 0x8a, 0x99, 0xc3, 0x53, 0x73, 0xfc, 0x59, 0x73, 0xfe, 0x30, 0xba, 0x6c, 0x74, 0xfa, 0x02,
+	0xac, 0x8c, 0x23, 0xbc, 0xa7, 0x0e, 0xcc, 0x01, 0x6a, 0x87, 0x39, 0xd8, 0x4b, 0x3e, 0x1e, 0x3c,
+	0x0f, 0xf5, 0xec, 0x5c, 0xfe, 0xd8, 0xb3, 0xbc, 0xe3, 0x2b, 0xcf, 0x3b, 0x2a, 0x7e, 0x47, 0x63,
+	0xf8, 0x3a, 0xfc, 0xb3, 0x6a, 0x56, 0x4c, 0xa1, 0xa8, 0x13, 0xbb, 0xaa, 0x60, 0xed, 0x27, 0x16,
+	0xac, 0xfd, 0x05, 0x05, 0x5b, 0x2b, 0x04, 0x6b, 0x4b, 0x21, 0x52, 0xd9, 0xef, 0xed, 0x0e, 0xc5,
+	0x61, 0x7c, 0x9a, 0x1c, 0x4e, 0x47, 0xfa, 0xf7, 0xb6, 0x59, 0x42, 0xe4, 0x6d, 0x63, 0xcd, 0xfc,
+	0x41, 0xc5, 0x87, 0xc6, 0x48, 0x9c, 0xca, 0x5e, 0xb2, 0xc3, 0xe8, 0x19, 0x29, 0xb1, 0x09, 0xc5,
+	0xaf, 0x6b, 0x88, 0x94, 0x40, 0xf8, 0x3d, 0x78, 0xba, 0xf2, 0xc0, 0xc3, 0xb3, 0xf4, 0xd1, 0x13,
+	0x1c, 0xda, 0x96, 0x87, 0x86, 0xc7, 0xe0, 0xdb, 0xdb, 0x93, 0x45, 0x5e, 0x83, 0x46, 0x5c, 0xf4,
+	0xda, 0x5b, 0xd6, 0x78, 0xbc, 0x82, 0x0f, 0x46, 0xd4, 0xb2, 0x85, 0x1a, 0xc7, 0x7d, 0x7d, 0xac,
+	0x82, 0x42, 0x06, 0x2b, 0xf7, 0x79, 0x34, 0xe0, 0x93, 0xc3, 0x8b, 0xa4, 0xaf, 0x27, 0x69, 0xfb,
+	0x77, 0xf5, 0xf4, 0x66, 0xff, 0x2e, 0x46, 0xc2, 0x71, 0x24, 0xf8, 0xfe, 0xe0, 0x5c, 0x25, 0x72,
+	0x0d, 0xe2, 0x9e, 0xe9, 0xc9, 0x89, 0xe0, 0x3a, 0x79, 0x2b, 0x28, 0xfc, 0xb9, 0x07, 0x5d, 0xe4,
+	0xe7, 0xc1, 0xce, 0x83, 0xc3, 0xe9, 0xf1, 0x81, 0x38, 0x55, 0xe5, 0xa4, 0xa7, 0xcb, 0x49, 0xff,
+	0x65, 0x58, 0xea, 0xab, 0x09, 0xaf, 0x2a, 0xd8, 0x2b, 0x3c, 0x13, 0xbb, 0x0d, 0x4d, 0x85, 0xdf,
+	0x57, 0xc4, 0x45, 0xd2, 0x3f, 0x10, 0xa7, 0xce, 0x9c, 0xcd, 0xe6, 0xbe, 0xb7, 0xc0, 0x34, 0x5d,
+	0x51, 0xb3, 0x7e, 0x08, 0x2b, 0xf7, 0x86, 0x72, 0xe8, 0xa1, 0xbe, 0x0d, 0x6c, 0xc2, 0x52, 0x2c,
+	0xe4, 0x4a, 0xe2, 0x6a, 0x89, 0xe5, 0xb0, 0xff, 0x12, 0xb4, 0x86, 0xf2, 0x4d, 0x6d, 0xce, 0x41,
+	0x4c, 0x11, 0x85, 0xcf, 0x41, 0x7b, 0x57, 0x7f, 0x4f, 0x45, 0x9f, 0xfc, 0x88, 0x5f, 0x28, 0xe5,
+	0xe1, 0xe3, 0xce, 0x1b, 0xd0, 0xce, 0xff, 0x09, 0xf5, 0x6f, 0x41, 0x6b, 0x5f, 0xe0, 0x0e, 0x7e,
+	0x37, 0xbf, 0x02, 0x1e, 0xbe, 0x17, 0x0f, 0x37, 0xaf, 0x29, 0x70, 0x5f, 0xec, 0x45, 0xd3, 0xd3,
+	0xb3, 0xec, 0x83, 0x71, 0xb8, 0x70, 0xdc, 0xa2, 0x1f, 0x41, 0x5f, 0xfd, 0xef, 0x00, 0x56, 0xc1,
+	0xac, 0x1f, 0x55, 0x2a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -30,6 +30,8 @@ var (
 	ForkParaAssetTransferRbk = "ForkParaAssetTransferRbk"
 	// ForkParaFullMinerHeight 平行链全挖矿开启高度
 	ForkParaFullMinerHeight = "ForkParaFullMinerHeight"
+	// ForkParaMultiSig 以多重签名账户的身份配置节点和节点组
+	ForkParaMultiSig = "ForkParaMultiSig"
 
 	// ParaConsSubConf sub
 	ParaConsSubConf = "consensus.sub.para"
@@ -58,6 +60,7 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(ParaX, ForkCommitTx, 1850000)
 	cfg.RegisterDappFork(ParaX, ForkLoopCheckCommitTxDone, 3230000)
 	cfg.RegisterDappFork(ParaX, ForkParaAssetTransferRbk, 4500000)
+	cfg.RegisterDappFork(ParaX, ForkParaMultiSig, 10000000)
 
 	//只在平行链启用
 	cfg.RegisterDappFork(ParaX, ForkParaSelfConsStages, types.MaxHeight)
@@ -132,6 +135,7 @@ func (p *ParacrossType) GetTypeMap() map[string]int32 {
 		"NodeGroupConfig":    ParacrossActionNodeGroupApply,
 		"SelfStageConfig":    ParacrossActionSelfStageConfig,
 		"ParaBindMiner":      ParacrossActionParaBindMiner,
+		"MultiSigExec":       ParacrossActionMultiSigExec,
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/system/dapp/commands"
@@ -44,6 +45,15 @@ func TokenCmd() *cobra.Command {
 		CreateTokenTransferExecCmd(),
 		CreateRawTokenMintTxCmd(),
 		CreateRawTokenBurnTxCmd(),
+		CreateRawTokenUpdateMetadataTxCmd(),
+		CreateRawTokenSetRestrictionTxCmd(),
+		CreateRawTokenPauseTxCmd(),
+		CreateRawTokenFreezeTxCmd(),
+		CreateRawTokenSeizeTxCmd(),
+		CreateRawTokenMultiSigExecTxCmd(),
+		GetTokenFrozenHoldersCmd(),
+		GetTokenHoldersCmd(),
+		GetTokenMetadataCmd(),
 		GetTokenLogsCmd(),
 		GetTokenCmd(),
 		QueryTxCmd(),
@@ -541,6 +551,361 @@ func tokenBurn(cmd *cobra.Command, args []string) {
 	ctx.RunWithoutMarshal()
 }
 
+// CreateRawTokenUpdateMetadataTxCmd create raw token update metadata transaction
+func CreateRawTokenUpdateMetadataTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update_metadata",
+		Short: "Create a update token metadata transaction",
+		Run:   tokenUpdateMetadata,
+	}
+	addTokenUpdateMetadataFlags(cmd)
+	return cmd
+}
+
+func addTokenUpdateMetadataFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().Int32P("decimals", "d", 0, "token decimals for display")
+	cmd.Flags().StringP("logo", "l", "", "token logo uri")
+	cmd.Flags().StringP("website", "w", "", "token website")
+	cmd.Flags().StringP("hash", "c", "", "hash of token content, such as white paper")
+}
+
+func tokenUpdateMetadata(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	decimals, _ := cmd.Flags().GetInt32("decimals")
+	logo, _ := cmd.Flags().GetString("logo")
+	website, _ := cmd.Flags().GetString("website")
+	hash, _ := cmd.Flags().GetString("hash")
+
+	params := &tokenty.TokenUpdateMetadata{
+		Symbol:      symbol,
+		Decimals:    decimals,
+		LogoURI:     logo,
+		Website:     website,
+		ContentHash: hash,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenUpdateMetadataTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenSetRestrictionTxCmd create raw token set transfer restriction transaction
+func CreateRawTokenSetRestrictionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set_restriction",
+		Short: "Create a set token transfer restriction transaction",
+		Run:   tokenSetRestriction,
+	}
+	addTokenSetRestrictionFlags(cmd)
+	return cmd
+}
+
+func addTokenSetRestrictionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().BoolP("whitelist_only", "o", false, "only addresses in whitelist can transfer")
+	cmd.Flags().StringP("add_whitelist", "a", "", "addresses add to whitelist, separated by ','")
+	cmd.Flags().StringP("remove_whitelist", "r", "", "addresses remove from whitelist, separated by ','")
+	cmd.Flags().StringP("add_frozen", "z", "", "addresses to freeze, separated by ','")
+	cmd.Flags().StringP("remove_frozen", "u", "", "addresses to unfreeze, separated by ','")
+}
+
+func splitAddrs(s string) []string {
+	var addrs []string
+	for _, addr := range strings.Split(s, ",") {
+		if addr = strings.TrimSpace(addr); addr != "" {
+			addrs = append(addrs, addr)
+		}
+	}
+	return addrs
+}
+
+func tokenSetRestriction(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	whitelistOnly, _ := cmd.Flags().GetBool("whitelist_only")
+	addWhitelist, _ := cmd.Flags().GetString("add_whitelist")
+	removeWhitelist, _ := cmd.Flags().GetString("remove_whitelist")
+	addFrozen, _ := cmd.Flags().GetString("add_frozen")
+	removeFrozen, _ := cmd.Flags().GetString("remove_frozen")
+
+	params := &tokenty.TokenSetRestriction{
+		Symbol:          symbol,
+		WhitelistOnly:   whitelistOnly,
+		AddWhitelist:    splitAddrs(addWhitelist),
+		RemoveWhitelist: splitAddrs(removeWhitelist),
+		AddFrozen:       splitAddrs(addFrozen),
+		RemoveFrozen:    splitAddrs(removeFrozen),
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenSetRestrictionTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenPauseTxCmd create raw token pause transaction
+func CreateRawTokenPauseTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Create a pause or resume token transfer transaction",
+		Run:   tokenPause,
+	}
+	addTokenPauseFlags(cmd)
+	return cmd
+}
+
+func addTokenPauseFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().BoolP("resume", "r", false, "resume token transfer")
+}
+
+func tokenPause(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	resume, _ := cmd.Flags().GetBool("resume")
+
+	params := &tokenty.TokenPause{
+		Symbol: symbol,
+		Paused: !resume,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenPauseTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenFreezeTxCmd create raw token freeze holder transaction
+func CreateRawTokenFreezeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze",
+		Short: "Create a freeze or unfreeze token holder transaction",
+		Run:   tokenFreeze,
+	}
+	addTokenFreezeFlags(cmd)
+	return cmd
+}
+
+func addTokenFreezeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("addr", "a", "", "holder address")
+	cmd.MarkFlagRequired("addr")
+
+	cmd.Flags().BoolP("unfreeze", "u", false, "unfreeze the holder")
+}
+
+func tokenFreeze(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	addr, _ := cmd.Flags().GetString("addr")
+	unfreeze, _ := cmd.Flags().GetBool("unfreeze")
+
+	params := &tokenty.TokenFreeze{
+		Symbol: symbol,
+		Addr:   addr,
+		Frozen: !unfreeze,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenFreezeTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenSeizeTxCmd create raw token seize transaction
+func CreateRawTokenSeizeTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seize",
+		Short: "Create a transfer token from frozen holder to recovery address transaction",
+		Run:   tokenSeize,
+	}
+	addTokenSeizeFlags(cmd)
+	return cmd
+}
+
+func addTokenSeizeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("from", "f", "", "frozen holder address")
+	cmd.MarkFlagRequired("from")
+
+	cmd.Flags().StringP("to", "t", "", "recovery address")
+	cmd.MarkFlagRequired("to")
+
+	cmd.Flags().Float64P("amount", "a", 0, "amount of seize")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().StringP("exec", "e", "", "seize the balance in this executor, default seize the main balance")
+}
+
+func tokenSeize(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	from, _ := cmd.Flags().GetString("from")
+	to, _ := cmd.Flags().GetString("to")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	execName, _ := cmd.Flags().GetString("exec")
+
+	params := &tokenty.TokenSeize{
+		Symbol:   symbol,
+		From:     from,
+		To:       to,
+		Amount:   int64((amount+0.000001)*1e4) * 1e4,
+		ExecName: execName,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenSeizeTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// CreateRawTokenMultiSigExecTxCmd create raw token multisig exec transaction
+func CreateRawTokenMultiSigExecTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig_exec",
+		Short: "Create a transaction to exec the payload submitted by multisig account",
+		Run:   tokenMultiSigExec,
+	}
+	addTokenMultiSigExecFlags(cmd)
+	return cmd
+}
+
+func addTokenMultiSigExecFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("multisig_addr", "a", "", "address of multisig account, the owner of token")
+	cmd.MarkFlagRequired("multisig_addr")
+
+	cmd.Flags().Uint64P("txid", "i", 0, "txid of the executed MultiSigSubmitTx")
+
+	cmd.Flags().StringP("payload", "p", "", "hex encoded token action submitted by MultiSigSubmitTx")
+	cmd.MarkFlagRequired("payload")
+}
+
+func tokenMultiSigExec(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	multiSigAddr, _ := cmd.Flags().GetString("multisig_addr")
+	txid, _ := cmd.Flags().GetUint64("txid")
+	payload, _ := cmd.Flags().GetString("payload")
+
+	payloadByte, err := common.FromHex(payload)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	params := &tokenty.TokenMultiSigExec{
+		MultiSigAddr: multiSigAddr,
+		Txid:         txid,
+		Payload:      payloadByte,
+	}
+
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "token.CreateRawTokenMultiSigExecTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+// GetTokenFrozenHoldersCmd get frozen holders of token
+func GetTokenFrozenHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen_holders",
+		Short: "Get frozen holders of token",
+		Run:   getTokenFrozenHolders,
+	}
+	addGetTokenFrozenHoldersFlags(cmd)
+	return cmd
+}
+
+func addGetTokenFrozenHoldersFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("primary", "p", "", "primary key of last holder for paging")
+	cmd.Flags().Int32P("count", "c", 20, "count")
+	cmd.Flags().Int32P("direction", "d", 0, "query direction, 0: desc, 1: asc")
+}
+
+func getTokenFrozenHolders(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenFrozenHolders"
+	params.Payload = types.MustPBToJSON(&tokenty.ReqTokenFrozenHolders{Symbol: symbol, PrimaryKey: primary, Count: count, Direction: direction})
+
+	var res tokenty.ReplyTokenFrozenHolders
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// GetTokenHoldersCmd get holders and balances of token at height
+func GetTokenHoldersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "holders",
+		Short: "Get holders and balances of token at height",
+		Run:   getTokenHolders,
+	}
+	addGetTokenHoldersFlags(cmd)
+	return cmd
+}
+
+func addGetTokenHoldersFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().Int64P("height", "t", -1, "snapshot height, -1: latest")
+	cmd.Flags().StringP("primary", "p", "", "primary key returned by the last page")
+	cmd.Flags().Int32P("count", "c", 20, "count")
+}
+
+func getTokenHolders(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	height, _ := cmd.Flags().GetInt64("height")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenHolders"
+	params.Payload = types.MustPBToJSON(&tokenty.ReqTokenHolders{Symbol: symbol, Height: height, PrimaryKey: primary, Count: count})
+
+	var res tokenty.ReplyTokenHolders
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
+// GetTokenMetadataCmd get token metadata
+func GetTokenMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metadata",
+		Short: "Get token metadata",
+		Run:   getTokenMetadata,
+	}
+	addGetTokenFlags(cmd)
+	return cmd
+}
+
+func getTokenMetadata(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	paraName, _ := cmd.Flags().GetString("paraName")
+	symbol, _ := cmd.Flags().GetString("symbol")
+
+	var params rpctypes.Query4Jrpc
+	params.Execer = getRealExecName(paraName, "token")
+	params.FuncName = "GetTokenMetadata"
+	params.Payload = types.MustPBToJSON(&types.ReqString{Data: symbol})
+
+	var res tokenty.TokenMetadata
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &res)
+	ctx.Run()
+}
+
 // GetTokenLogsCmd get logs of token
 func GetTokenLogsCmd() *cobra.Command {
 	cmd := &cobra.Command{
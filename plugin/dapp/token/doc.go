@@ -7,6 +7,7 @@
 // 1. token 的创建
 // 1. token 的转账
 // 1. token 燃烧和增发
+// 1. token 元数据和转账限制
 //
 //token的创建
 // 1. prepare create
@@ -17,5 +18,20 @@
 // 1. transfer
 // 1. withdraw
 // 1. transfer to exec
+//
+//token的元数据和转账限制, 只能由owner设置
+// 1. update metadata: decimals, logo, website, content hash
+// 1. set restriction: 白名单模式和冻结地址列表, 对 transfer 和 transfer to exec 生效
+//
+//token的管理操作, 需要创建时设置 CategoryAdminSupport, 只能由owner发起
+// 1. pause:  暂停或恢复token的所有转账
+// 1. freeze: 冻结或解冻持有人
+// 1. seize:  从被冻结的持有人强制转账到恢复地址
+//
+//暂停, 冻结和白名单限制同样作用于 trade 和 exchange 合约内部的成交, 受限制的地址不能下单, 已有的挂单在撮合时跳过
+//evm 合约中通过ERC-20资产合约转移token时同样检查这些限制
+//
+//token的owner可以是多重签名地址, 多重签名账户的owner先在multisig合约中用MultiSigSubmitTx提交编码后的TokenAction,
+//执行之后由任意owner发送 TokenMultiSigExec 交易, 以多重签名地址的身份执行其中的 mint, burn, 元数据, 转账限制和管理操作
 
 package token
@@ -83,3 +83,33 @@ func (t *token) Exec_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction
 	action := newTokenAction(t, "", tx)
 	return action.burn(payload)
 }
+
+func (t *token) Exec_TokenUpdateMetadata(payload *tokenty.TokenUpdateMetadata, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.updateMetadata(payload)
+}
+
+func (t *token) Exec_TokenSetRestriction(payload *tokenty.TokenSetRestriction, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.setRestriction(payload)
+}
+
+func (t *token) Exec_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.pause(payload)
+}
+
+func (t *token) Exec_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.freeze(payload)
+}
+
+func (t *token) Exec_TokenSeize(payload *tokenty.TokenSeize, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.seize(payload)
+}
+
+func (t *token) Exec_TokenMultiSigExec(payload *tokenty.TokenMultiSigExec, tx *types.Transaction, index int) (*types.Receipt, error) {
+	action := newTokenAction(t, "", tx)
+	return action.multiSigExec(payload)
+}
@@ -139,12 +139,16 @@ func (t *token) ExecDelLocal_TokenRevokeCreate(payload *tokenty.TokenRevokeCreat
 }
 
 func (t *token) ExecDelLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	localToken, err := loadLocalToken(payload.Symbol, tx.From(), tokenty.TokenStatusCreated, t.GetLocalDB())
+	return t.execDelLocalMint(payload, tx.From(), tx, index)
+}
+
+func (t *token) execDelLocalMint(payload *tokenty.TokenMint, owner string, tx *types.Transaction, index int) (*types.LocalDBSet, error) {
+	localToken, err := loadLocalToken(payload.Symbol, owner, tokenty.TokenStatusCreated, t.GetLocalDB())
 	if err != nil {
 		return nil, err
 	}
 	localToken = resetMint(localToken, t.GetHeight(), t.GetBlockTime(), payload.Amount)
-	key := calcTokenStatusKeyLocal(payload.Symbol, tx.From(), tokenty.TokenStatusCreated)
+	key := calcTokenStatusKeyLocal(payload.Symbol, owner, tokenty.TokenStatusCreated)
 	var set []*types.KeyValue
 	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
 
@@ -164,12 +168,16 @@ func (t *token) ExecDelLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Tra
 }
 
 func (t *token) ExecDelLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	localToken, err := loadLocalToken(payload.Symbol, tx.From(), tokenty.TokenStatusCreated, t.GetLocalDB())
+	return t.execDelLocalBurn(payload, tx.From(), tx, index)
+}
+
+func (t *token) execDelLocalBurn(payload *tokenty.TokenBurn, owner string, tx *types.Transaction, index int) (*types.LocalDBSet, error) {
+	localToken, err := loadLocalToken(payload.Symbol, owner, tokenty.TokenStatusCreated, t.GetLocalDB())
 	if err != nil {
 		return nil, err
 	}
 	localToken = resetBurn(localToken, t.GetHeight(), t.GetBlockTime(), payload.Amount)
-	key := calcTokenStatusKeyLocal(payload.Symbol, tx.From(), tokenty.TokenStatusCreated)
+	key := calcTokenStatusKeyLocal(payload.Symbol, owner, tokenty.TokenStatusCreated)
 	var set []*types.KeyValue
 	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
 
@@ -187,3 +195,27 @@ func (t *token) ExecDelLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Tra
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecDelLocal_TokenUpdateMetadata(payload *tokenty.TokenUpdateMetadata, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionUpdateMetadata, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenSetRestriction(payload *tokenty.TokenSetRestriction, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionSetRestriction, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionPause, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionFreeze, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenSeize(payload *tokenty.TokenSeize, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionSeize, tx, receiptData, index, true)
+}
+
+func (t *token) ExecDelLocal_TokenMultiSigExec(payload *tokenty.TokenMultiSigExec, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalMultiSig(payload, tx, receiptData, index, true)
+}
@@ -233,13 +233,17 @@ func resetBurn(t *tokenty.LocalToken, height, time, amount int64) *tokenty.Local
 }
 
 func (t *token) ExecLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	localToken, err := loadLocalToken(payload.Symbol, tx.From(), tokenty.TokenStatusCreated, t.GetLocalDB())
+	return t.execLocalMint(payload, tx.From(), tx, index)
+}
+
+func (t *token) execLocalMint(payload *tokenty.TokenMint, owner string, tx *types.Transaction, index int) (*types.LocalDBSet, error) {
+	localToken, err := loadLocalToken(payload.Symbol, owner, tokenty.TokenStatusCreated, t.GetLocalDB())
 	if err != nil {
 		return nil, err
 	}
 	localToken = setMint(localToken, t.GetHeight(), t.GetBlockTime(), payload.Amount)
 	var set []*types.KeyValue
-	key := calcTokenStatusKeyLocal(payload.Symbol, tx.From(), tokenty.TokenStatusCreated)
+	key := calcTokenStatusKeyLocal(payload.Symbol, owner, tokenty.TokenStatusCreated)
 	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
 
 	table := NewLogsTable(t.GetLocalDB())
@@ -258,13 +262,17 @@ func (t *token) ExecLocal_TokenMint(payload *tokenty.TokenMint, tx *types.Transa
 }
 
 func (t *token) ExecLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	localToken, err := loadLocalToken(payload.Symbol, tx.From(), tokenty.TokenStatusCreated, t.GetLocalDB())
+	return t.execLocalBurn(payload, tx.From(), tx, index)
+}
+
+func (t *token) execLocalBurn(payload *tokenty.TokenBurn, owner string, tx *types.Transaction, index int) (*types.LocalDBSet, error) {
+	localToken, err := loadLocalToken(payload.Symbol, owner, tokenty.TokenStatusCreated, t.GetLocalDB())
 	if err != nil {
 		return nil, err
 	}
 	localToken = setBurn(localToken, t.GetHeight(), t.GetBlockTime(), payload.Amount)
 	var set []*types.KeyValue
-	key := calcTokenStatusKeyLocal(payload.Symbol, tx.From(), tokenty.TokenStatusCreated)
+	key := calcTokenStatusKeyLocal(payload.Symbol, owner, tokenty.TokenStatusCreated)
 	set = append(set, &types.KeyValue{Key: key, Value: types.Encode(localToken)})
 
 	table := NewLogsTable(t.GetLocalDB())
@@ -281,3 +289,119 @@ func (t *token) ExecLocal_TokenBurn(payload *tokenty.TokenBurn, tx *types.Transa
 
 	return &types.LocalDBSet{KV: set}, nil
 }
+
+func (t *token) ExecLocal_TokenUpdateMetadata(payload *tokenty.TokenUpdateMetadata, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionUpdateMetadata, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenSetRestriction(payload *tokenty.TokenSetRestriction, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionSetRestriction, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenPause(payload *tokenty.TokenPause, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionPause, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenFreeze(payload *tokenty.TokenFreeze, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionFreeze, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenSeize(payload *tokenty.TokenSeize, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalAdmin(payload.Symbol, tokenty.TokenActionSeize, tx, receiptData, index, false)
+}
+
+func (t *token) ExecLocal_TokenMultiSigExec(payload *tokenty.TokenMultiSigExec, tx *types.Transaction, receiptData *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.execLocalMultiSig(payload, tx, receiptData, index, false)
+}
+
+// execLocalMultiSig 按payload中的操作处理, owner为多重签名地址
+func (t *token) execLocalMultiSig(payload *tokenty.TokenMultiSigExec, tx *types.Transaction, receiptData *types.ReceiptData, index int, isDel bool) (*types.LocalDBSet, error) {
+	var action tokenty.TokenAction
+	if err := types.Decode(payload.GetPayload(), &action); err != nil {
+		return nil, err
+	}
+	switch action.Ty {
+	case tokenty.TokenActionMint:
+		if isDel {
+			return t.execDelLocalMint(action.GetTokenMint(), payload.MultiSigAddr, tx, index)
+		}
+		return t.execLocalMint(action.GetTokenMint(), payload.MultiSigAddr, tx, index)
+	case tokenty.TokenActionBurn:
+		if isDel {
+			return t.execDelLocalBurn(action.GetTokenBurn(), payload.MultiSigAddr, tx, index)
+		}
+		return t.execLocalBurn(action.GetTokenBurn(), payload.MultiSigAddr, tx, index)
+	case tokenty.TokenActionUpdateMetadata:
+		return t.execLocalAdmin(action.GetTokenUpdateMetadata().GetSymbol(), action.Ty, tx, receiptData, index, isDel)
+	case tokenty.TokenActionSetRestriction:
+		return t.execLocalAdmin(action.GetTokenSetRestriction().GetSymbol(), action.Ty, tx, receiptData, index, isDel)
+	case tokenty.TokenActionPause:
+		return t.execLocalAdmin(action.GetTokenPause().GetSymbol(), action.Ty, tx, receiptData, index, isDel)
+	case tokenty.TokenActionFreeze:
+		return t.execLocalAdmin(action.GetTokenFreeze().GetSymbol(), action.Ty, tx, receiptData, index, isDel)
+	case tokenty.TokenActionSeize:
+		return t.execLocalAdmin(action.GetTokenSeize().GetSymbol(), action.Ty, tx, receiptData, index, isDel)
+	}
+	return nil, types.ErrActionNotSupport
+}
+
+// execLocalAdmin 管理操作记录到token的变更历史中, 冻结状态发生变化时同时更新冻结持有人列表
+func (t *token) execLocalAdmin(symbol string, actionType int32, tx *types.Transaction, receiptData *types.ReceiptData, index int, isDel bool) (*types.LocalDBSet, error) {
+	logsTable := NewLogsTable(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	var err error
+	if isDel {
+		err = logsTable.Del([]byte(txIndex))
+	} else {
+		err = logsTable.Add(&tokenty.LocalLogs{Symbol: symbol, TxIndex: txIndex, ActionType: actionType, TxHash: "0x" + hex.EncodeToString(tx.Hash())})
+	}
+	if err != nil {
+		return nil, err
+	}
+	set, err := logsTable.Save()
+	if err != nil {
+		return nil, err
+	}
+
+	frozenTable := NewFrozenTable(t.GetLocalDB())
+	for _, item := range receiptData.Logs {
+		switch item.Ty {
+		case tokenty.TyLogTokenRestriction:
+			var receipt tokenty.ReceiptTokenRestriction
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			for _, addr := range receipt.AddFrozen {
+				if err := updateFrozen(frozenTable, symbol, addr, !isDel); err != nil {
+					return nil, err
+				}
+			}
+			for _, addr := range receipt.RemoveFrozen {
+				if err := updateFrozen(frozenTable, symbol, addr, isDel); err != nil {
+					return nil, err
+				}
+			}
+		case tokenty.TyLogTokenFreeze:
+			var receipt tokenty.ReceiptTokenFreeze
+			if err := types.Decode(item.Log, &receipt); err != nil {
+				return nil, err
+			}
+			if receipt.Prev == receipt.Current {
+				continue
+			}
+			frozen := receipt.Current
+			if isDel {
+				frozen = receipt.Prev
+			}
+			if err := updateFrozen(frozenTable, symbol, receipt.Addr, frozen); err != nil {
+				return nil, err
+			}
+		}
+	}
+	kvs, err := frozenTable.Save()
+	if err != nil {
+		return nil, err
+	}
+	set = append(set, kvs...)
+	return &types.LocalDBSet{KV: set}, nil
+}
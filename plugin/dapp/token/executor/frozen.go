@@ -0,0 +1,111 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 记录token 被冻结的持有人，
+// 冻结和解冻可以由 TokenFreeze 或 TokenSetRestriction 发起
+
+import (
+	"fmt"
+
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/common/db/table"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+var opt_frozen_table = &table.Option{
+	Prefix:  "LODB-token",
+	Name:    "frozen",
+	Primary: "holder",
+
+	Index: []string{
+		"symbol",
+	},
+}
+
+// FrozenRow row
+type FrozenRow struct {
+	*pty.LocalTokenFrozen
+}
+
+// NewFrozenRow create row
+func NewFrozenRow() *FrozenRow {
+	return &FrozenRow{LocalTokenFrozen: nil}
+}
+
+// CreateRow create row
+func (r *FrozenRow) CreateRow() *table.Row {
+	return &table.Row{Data: &pty.LocalTokenFrozen{}}
+}
+
+// SetPayload set payload
+func (r *FrozenRow) SetPayload(data types.Message) error {
+	if d, ok := data.(*pty.LocalTokenFrozen); ok {
+		r.LocalTokenFrozen = d
+		return nil
+	}
+	return types.ErrTypeAsset
+}
+
+// Get get index key
+func (r *FrozenRow) Get(key string) ([]byte, error) {
+	switch key {
+	case "holder":
+		return calcFrozenHolderPrimary(r.Symbol, r.Addr), nil
+	case "symbol":
+		return []byte(r.Symbol), nil
+	default:
+		return nil, types.ErrNotFound
+	}
+}
+
+func calcFrozenHolderPrimary(symbol, addr string) []byte {
+	return []byte(fmt.Sprintf("%s-%s", symbol, addr))
+}
+
+// NewFrozenTable create table
+func NewFrozenTable(kvdb dbm.KV) *table.Table {
+	rowMeta := NewFrozenRow()
+	err := rowMeta.SetPayload(&pty.LocalTokenFrozen{})
+	if err != nil {
+		panic(err)
+	}
+	t, err := table.NewTable(rowMeta, kvdb, opt_frozen_table)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// updateFrozen 冻结时添加记录, 解冻时删除记录
+func updateFrozen(table *table.Table, symbol, addr string, frozen bool) error {
+	if frozen {
+		return table.Replace(&pty.LocalTokenFrozen{Symbol: symbol, Addr: addr})
+	}
+	return table.DelRow(&pty.LocalTokenFrozen{Symbol: symbol, Addr: addr})
+}
+
+func listFrozen(db dbm.KVDB, req *pty.ReqTokenFrozenHolders) ([]*pty.LocalTokenFrozen, error) {
+	query := NewFrozenTable(db).GetQuery(db)
+	var primary []byte
+	if len(req.PrimaryKey) > 0 {
+		primary = []byte(req.PrimaryKey)
+	}
+	rows, err := query.ListIndex("symbol", []byte(req.Symbol), primary, req.Count, req.Direction)
+	if err != nil {
+		tokenlog.Error("listFrozen failed", "symbol", req.Symbol, "primary", req.PrimaryKey, "err", err)
+		return nil, err
+	}
+	var holders []*pty.LocalTokenFrozen
+	for _, row := range rows {
+		holder := row.Data.(*pty.LocalTokenFrozen)
+		// 索引按前缀匹配, 过滤掉以该symbol为前缀的其他token
+		if holder.Symbol == req.Symbol {
+			holders = append(holders, holder)
+		}
+	}
+	return holders, nil
+}
@@ -0,0 +1,110 @@
+// Copyright Fuzamei Corp. 2018 All Rights Reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package executor
+
+// 按高度查询token持有人和余额的快照
+// 持有人和余额都从该高度区块的状态哈希对应的状态数据库中读取, 包括主账户和所有执行器下的合约账户,
+// 其他执行器(如 trade, exchange, evm)中发生的合约账户转账也都包含在内, 不依赖本地数据库的索引
+
+import (
+	"strings"
+
+	"github.com/33cn/chain33/account"
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/types"
+	pty "github.com/33cn/plugin/plugin/dapp/token/types"
+)
+
+// 一次查询最多返回的账户数量
+const holderPageSize = 100
+
+// 取指定高度的状态哈希, 高度小于0时取最新的区块
+func (t *token) stateHashAtHeight(height int64) (int64, []byte, error) {
+	api := t.GetAPI()
+	if height < 0 {
+		header, err := api.GetLastHeader()
+		if err != nil {
+			return 0, nil, err
+		}
+		return header.Height, header.StateHash, nil
+	}
+	headers, err := api.GetHeaders(&types.ReqBlocks{Start: height, End: height})
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(headers.Items) != 1 {
+		return 0, nil, types.ErrBlockNotFound
+	}
+	return height, headers.Items[0].StateHash, nil
+}
+
+// listHolders 按账户在状态数据库中的key升序分页遍历, 余额为0的账户不返回, 所以一页返回的持有人可能少于count
+func (t *token) listHolders(req *pty.ReqTokenHolders) (*pty.ReplyTokenHolders, error) {
+	height, stateHash, err := t.stateHashAtHeight(req.Height)
+	if err != nil {
+		return nil, err
+	}
+	count := req.Count
+	if count <= 0 || count > holderPageSize {
+		count = holderPageSize
+	}
+	accDB, err := account.NewAccountDB(t.GetAPI().GetConfig(), t.GetName(), req.Symbol, nil)
+	if err != nil {
+		return nil, err
+	}
+	prefix := accDB.AccountKey("")
+	start := prefix
+	if len(req.PrimaryKey) > 0 {
+		if !strings.HasPrefix(req.PrimaryKey, string(prefix)) {
+			return nil, types.ErrInvalidParam
+		}
+		start = []byte(req.PrimaryKey)
+	}
+
+	list, err := t.GetAPI().StoreList(&types.StoreList{StateHash: stateHash, Start: start, End: prefixEdge(prefix), Count: int64(count), Mode: 1})
+	if err != nil {
+		tokenlog.Error("listHolders failed", "symbol", req.Symbol, "height", height, "primary", req.PrimaryKey, "err", err)
+		return nil, err
+	}
+	reply := &pty.ReplyTokenHolders{Symbol: req.Symbol, Height: height, StateHash: common.ToHex(stateHash), PrimaryKey: string(list.NextKey)}
+	for i, key := range list.Keys {
+		if holder := decodeHolder(prefix, key, list.Values[i]); holder != nil {
+			reply.Holders = append(reply.Holders, holder)
+		}
+	}
+	return reply, nil
+}
+
+// 主账户的key为 prefix+addr, 合约账户的key为 prefix+exec-execAddr:addr
+func decodeHolder(prefix, key, value []byte) *pty.TokenHolder {
+	var acc types.Account
+	if err := types.Decode(value, &acc); err != nil || (acc.Balance == 0 && acc.Frozen == 0) {
+		return nil
+	}
+	addr, execAddr := string(key[len(prefix):]), ""
+	if strings.HasPrefix(addr, "exec-") {
+		pair := strings.SplitN(addr[len("exec-"):], ":", 2)
+		if len(pair) != 2 {
+			return nil
+		}
+		execAddr, addr = pair[0], pair[1]
+	}
+	// 以该symbol为前缀的其他token的账户
+	if addr != acc.Addr {
+		return nil
+	}
+	return &pty.TokenHolder{Addr: addr, ExecAddr: execAddr, Balance: acc.Balance, Frozen: acc.Frozen}
+}
+
+func prefixEdge(prefix []byte) []byte {
+	edge := append([]byte{}, prefix...)
+	for i := len(edge) - 1; i >= 0; i-- {
+		if edge[i] < 0xff {
+			edge[i]++
+			return edge[:i+1]
+		}
+	}
+	return nil
+}
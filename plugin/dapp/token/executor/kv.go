@@ -16,6 +16,12 @@ var (
 	tokenPreCreatedSTONew = "mavl-token-create-sto-"
 
 	tokenPreCreatedSTONewLocal = "LODB-token-create-sto-"
+
+	tokenMetadata    = "mavl-token-meta-"
+	tokenRestriction = "mavl-token-restrict-"
+	tokenWhitelist   = "mavl-token-whitelist-"
+	tokenFrozen      = "mavl-token-frozen-"
+	tokenPaused      = "mavl-token-paused-"
 )
 
 func calcTokenKey(token string) (key []byte) {
@@ -50,6 +56,26 @@ func calcTokenStatusTokenKeyPrefixLocal(status int32, token string) []byte {
 	return []byte(fmt.Sprintf(tokenPreCreatedSTONewLocal+"%d-%s-", status, token))
 }
 
+func calcTokenMetadataKey(token string) []byte {
+	return []byte(fmt.Sprintf(tokenMetadata+"%s", token))
+}
+
+func calcTokenRestrictionKey(token string) []byte {
+	return []byte(fmt.Sprintf(tokenRestriction+"%s", token))
+}
+
+func calcTokenWhitelistKey(token string, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenWhitelist+"%s-%s", token, addr))
+}
+
+func calcTokenFrozenKey(token string, addr string) []byte {
+	return []byte(fmt.Sprintf(tokenFrozen+"%s-%s", token, addr))
+}
+
+func calcTokenPausedKey(token string) []byte {
+	return []byte(fmt.Sprintf(tokenPaused+"%s", token))
+}
+
 //存储地址上收币的信息
 func calcAddrKey(token string, addr string) []byte {
 	return []byte(fmt.Sprintf("LODB-token-%s-Addr:%s", token, addr))
@@ -91,3 +91,62 @@ func (t *token) Query_GetTokenHistory(in *types.ReqString) (types.Message, error
 	}
 	return &replys, nil
 }
+
+// Query_GetTokenMetadata 获取token元数据
+func (t *token) Query_GetTokenMetadata(in *types.ReqString) (types.Message, error) {
+	if in == nil || in.Data == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if _, err := loadTokenDB(t.GetStateDB(), in.Data); err != nil {
+		return nil, err
+	}
+	return loadTokenMetadata(t.GetStateDB(), in.Data)
+}
+
+// Query_GetTokenRestriction 获取token转账限制, 以及指定地址是否在白名单和冻结列表中
+func (t *token) Query_GetTokenRestriction(in *tokenty.ReqTokenRestriction) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	restriction, err := loadTokenRestriction(t.GetStateDB(), in.Symbol)
+	if err != nil {
+		return nil, err
+	}
+	paused, err := getTokenFlag(t.GetStateDB(), calcTokenPausedKey(in.Symbol))
+	if err != nil {
+		return nil, err
+	}
+	reply := &tokenty.ReplyTokenRestriction{Symbol: in.Symbol, WhitelistOnly: restriction.WhitelistOnly, Paused: paused}
+	for _, addr := range in.Addresses {
+		whitelisted, err := getTokenFlag(t.GetStateDB(), calcTokenWhitelistKey(in.Symbol, addr))
+		if err != nil {
+			return nil, err
+		}
+		frozen, err := getTokenFlag(t.GetStateDB(), calcTokenFrozenKey(in.Symbol, addr))
+		if err != nil {
+			return nil, err
+		}
+		reply.Addrs = append(reply.Addrs, &tokenty.TokenAddrRestriction{Addr: addr, Whitelisted: whitelisted, Frozen: frozen})
+	}
+	return reply, nil
+}
+
+// Query_GetTokenFrozenHolders 分页获取token被冻结的持有人
+func (t *token) Query_GetTokenFrozenHolders(in *tokenty.ReqTokenFrozenHolders) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	holders, err := listFrozen(t.GetLocalDB(), in)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenty.ReplyTokenFrozenHolders{Holders: holders}, nil
+}
+
+// Query_GetTokenHolders 按高度查询token的持有人和余额快照, 高度小于0时查询最新状态
+func (t *token) Query_GetTokenHolders(in *tokenty.ReqTokenHolders) (types.Message, error) {
+	if in == nil || in.Symbol == "" {
+		return nil, types.ErrInvalidParam
+	}
+	return t.listHolders(in)
+}
@@ -1,6 +1,8 @@
 package executor
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
 
 	"github.com/33cn/chain33/account"
@@ -11,6 +13,7 @@ import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
+	mty "github.com/33cn/plugin/plugin/dapp/multisig/types"
 	pty "github.com/33cn/plugin/plugin/dapp/token/types"
 	"github.com/stretchr/testify/assert"
 
@@ -284,6 +287,238 @@ func TestToken(t *testing.T) {
 	t.Log(reply.TokenAssets)
 }
 
+// tokenTestEnv 依次执行交易, 并把执行结果写入statedb和localdb
+type tokenTestEnv struct {
+	t           *testing.T
+	cfg         *types.Chain33Config
+	exec        *token
+	stateDB     dbm.KV
+	localDB     dbm.DB
+	kvdb        dbm.KVDB
+	lastTx      *types.Transaction
+	lastReceipt *types.ReceiptData
+}
+
+func newTokenTestEnv(t *testing.T, fork string) *tokenTestEnv {
+	cfg := types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	InitExecType()
+	stateDB, _ := dbm.NewGoMemDB("1", "2", 100)
+	_, localDB, kvdb := util.CreateTestDB()
+	item := &types.ConfigItem{
+		Key: "mavl-manage-token-blacklist",
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: []string{"bty"}},
+		},
+	}
+	stateDB.Set([]byte(item.Key), types.Encode(item))
+	item2 := &types.ConfigItem{
+		Key: "mavl-manage-token-finisher",
+		Value: &types.ConfigItem_Arr{
+			Arr: &types.ArrayConfig{Value: []string{string(Nodes[0])}},
+		},
+	}
+	stateDB.Set([]byte(item2.Key), types.Encode(item2))
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(cfg, nil)
+	exec := newToken().(*token)
+	exec.SetAPI(api)
+	exec.SetStateDB(stateDB)
+	exec.SetLocalDB(kvdb)
+	exec.SetEnv(cfg.GetDappFork(pty.TokenX, fork), 1539918074, 10)
+	return &tokenTestEnv{t: t, cfg: cfg, exec: exec, stateDB: stateDB, localDB: localDB, kvdb: kvdb}
+}
+
+// setLocal 写入localdb, 值为空时删除
+func (env *tokenTestEnv) setLocal(set *types.LocalDBSet) {
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			assert.Nil(env.t, env.localDB.Delete(kv.Key))
+			continue
+		}
+		env.kvdb.Set(kv.Key, kv.Value)
+	}
+}
+
+func (env *tokenTestEnv) execTxTo(action string, param types.Message, to, priv string) error {
+	tx, err := types.CallCreateTransaction(pty.TokenX, action, param)
+	assert.Nil(env.t, err)
+	if to != "" {
+		tx.To = to
+	}
+	tx, err = signTx(tx, priv)
+	assert.Nil(env.t, err)
+	env.nextBlock()
+	receipt, err := env.exec.Exec(tx, 1)
+	if err != nil {
+		return err
+	}
+	for _, kv := range receipt.KV {
+		env.stateDB.Set(kv.Key, kv.Value)
+	}
+	receiptData := &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}
+	set, err := env.exec.ExecLocal(tx, receiptData, 1)
+	assert.Nil(env.t, err)
+	env.setLocal(set)
+	env.lastTx, env.lastReceipt = tx, receiptData
+	return nil
+}
+
+// nextBlock 每个交易放在单独的区块中, 避免localdb中的txIndex重复
+func (env *tokenTestEnv) nextBlock() {
+	env.exec.SetEnv(env.exec.GetHeight()+1, env.exec.GetBlockTime()+1, env.exec.GetDifficulty())
+}
+
+func (env *tokenTestEnv) execTx(action string, param types.Message, priv string) error {
+	return env.execTxTo(action, param, "", priv)
+}
+
+func (env *tokenTestEnv) transfer(to string, amount int64, priv string) error {
+	return env.execTxTo("Transfer", &types.AssetsTransfer{Cointoken: Symbol, Amount: amount, To: to}, to, priv)
+}
+
+// createToken 由A创建token, 并转给B一部分
+func (env *tokenTestEnv) createToken(category int32) {
+	err := env.execTx("TokenPreCreate", &pty.TokenPreCreate{Name: Symbol, Symbol: Symbol, Introduction: Symbol, Total: 10000 * 1e8, Owner: string(Nodes[0]), Category: category}, PrivKeyA)
+	assert.Nil(env.t, err)
+	err = env.execTx("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: string(Nodes[0])}, PrivKeyA)
+	assert.Nil(env.t, err)
+	assert.Nil(env.t, env.transfer(string(Nodes[1]), 1e8, PrivKeyA))
+}
+
+func TestTokenMetadataAndRestriction(t *testing.T) {
+	env := newTokenTestEnv(t, pty.ForkTokenMetadataX)
+	execTx, execTxTo, transfer := env.execTx, env.execTxTo, env.transfer
+	env.createToken(pty.CategoryAdminSupport)
+
+	// 元数据只能由owner更新
+	meta := &pty.TokenUpdateMetadata{Symbol: Symbol, Decimals: 6, LogoURI: "https://example.com/logo.png", Website: "https://example.com", ContentHash: "0x1234"}
+	assert.Equal(t, types.ErrNotAllow, execTx("TokenUpdateMetadata", meta, PrivKeyB))
+	assert.Equal(t, types.ErrInvalidParam, execTx("TokenUpdateMetadata", &pty.TokenUpdateMetadata{Symbol: Symbol, Decimals: pty.TokenDecimalsLimit + 1}, PrivKeyA))
+	assert.Nil(t, execTx("TokenUpdateMetadata", meta, PrivKeyA))
+	tokenExec := env.exec
+	out, err := tokenExec.Query_GetTokenMetadata(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	assert.Equal(t, meta.LogoURI, out.(*pty.TokenMetadata).LogoURI)
+	assert.Equal(t, int32(6), out.(*pty.TokenMetadata).Decimals)
+
+	// 冻结B之后, B不能转出也不能转入
+	assert.Equal(t, types.ErrNotAllow, execTx("TokenSetRestriction", &pty.TokenSetRestriction{Symbol: Symbol, AddFrozen: []string{string(Nodes[1])}}, PrivKeyB))
+	assert.Nil(t, execTx("TokenSetRestriction", &pty.TokenSetRestriction{Symbol: Symbol, AddFrozen: []string{string(Nodes[1])}}, PrivKeyA))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, transfer(string(Nodes[0]), 1e7, PrivKeyB))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, transfer(string(Nodes[1]), 1e7, PrivKeyA))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, execTxTo("TransferToExec", &types.AssetsTransferToExec{Cointoken: Symbol, Amount: 1e7, ExecName: "trade", To: address.ExecAddress("trade")}, address.ExecAddress("trade"), PrivKeyB))
+
+	// 白名单模式: 只有白名单中的地址和owner可以转账
+	assert.Nil(t, execTx("TokenSetRestriction", &pty.TokenSetRestriction{Symbol: Symbol, WhitelistOnly: true, AddWhitelist: []string{string(Nodes[1])}, RemoveFrozen: []string{string(Nodes[1])}}, PrivKeyA))
+	assert.Nil(t, transfer(string(Nodes[1]), 1e7, PrivKeyA))
+	assert.Equal(t, pty.ErrTokenNotInWhitelist, transfer(string(Nodes[2]), 1e7, PrivKeyB))
+	assert.Nil(t, transfer(string(Nodes[0]), 1e7, PrivKeyB))
+
+	// 白名单中的B转入trade合约后, 在合约内部转给不在白名单中的C, C不能从合约取回, B可以
+	accDB, _ := account.NewAccountDB(env.cfg, pty.TokenX, Symbol, env.stateDB)
+	tradeAddr := address.ExecAddress("trade")
+	assert.Nil(t, execTxTo("TransferToExec", &types.AssetsTransferToExec{Cointoken: Symbol, Amount: 2e7, ExecName: "trade", To: tradeAddr}, tradeAddr, PrivKeyB))
+	receipt, err := accDB.ExecTransfer(string(Nodes[1]), string(Nodes[2]), tradeAddr, 1e7)
+	assert.Nil(t, err)
+	for _, kv := range receipt.KV {
+		env.stateDB.Set(kv.Key, kv.Value)
+	}
+	withdraw := &types.AssetsWithdraw{Cointoken: Symbol, Amount: 1e7, ExecName: "trade", To: tradeAddr}
+	assert.Equal(t, pty.ErrTokenNotInWhitelist, execTxTo("Withdraw", withdraw, tradeAddr, PrivKeyC))
+	assert.Nil(t, execTxTo("Withdraw", withdraw, tradeAddr, PrivKeyB))
+	assert.Equal(t, int64(1e7), accDB.LoadExecAccount(string(Nodes[2]), tradeAddr).Balance)
+
+	out, err = tokenExec.Query_GetTokenRestriction(&pty.ReqTokenRestriction{Symbol: Symbol, Addresses: []string{string(Nodes[1]), string(Nodes[2])}})
+	assert.Nil(t, err)
+	reply := out.(*pty.ReplyTokenRestriction)
+	assert.True(t, reply.WhitelistOnly)
+	assert.Equal(t, 2, len(reply.Addrs))
+	assert.True(t, reply.Addrs[0].Whitelisted)
+	assert.False(t, reply.Addrs[0].Frozen)
+	assert.False(t, reply.Addrs[1].Whitelisted)
+
+	assert.Equal(t, int64(1e8-1e7), accDB.LoadAccount(string(Nodes[1])).Balance)
+
+	// 分叉之前不支持
+	env.exec.SetEnv(env.cfg.GetDappFork(pty.TokenX, pty.ForkTokenMetadataX)-2, env.exec.GetBlockTime(), env.exec.GetDifficulty())
+	assert.Equal(t, types.ErrActionNotSupport, execTx("TokenUpdateMetadata", meta, PrivKeyA))
+}
+
+func TestTokenAdmin(t *testing.T) {
+	// 创建时没有设置CategoryAdminSupport的token不支持管理操作
+	env := newTokenTestEnv(t, pty.ForkTokenAdminX)
+	env.createToken(0)
+	assert.Equal(t, types.ErrNotSupport, env.execTx("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyA))
+
+	// 冻结列表和TokenFreeze共用同一个标记, 同样需要CategoryAdminSupport
+	assert.Equal(t, types.ErrNotSupport, env.execTx("TokenSetRestriction", &pty.TokenSetRestriction{Symbol: Symbol, AddFrozen: []string{string(Nodes[1])}}, PrivKeyA))
+	assert.Nil(t, env.execTx("TokenSetRestriction", &pty.TokenSetRestriction{Symbol: Symbol, WhitelistOnly: true}, PrivKeyA))
+
+	env = newTokenTestEnv(t, pty.ForkTokenAdminX)
+	env.createToken(pty.CategoryAdminSupport)
+	accDB, _ := account.NewAccountDB(env.cfg, pty.TokenX, Symbol, env.stateDB)
+	tradeAddr := address.ExecAddress("trade")
+
+	// 暂停之后所有转账都不能执行
+	assert.Equal(t, types.ErrNotAllow, env.execTx("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyB))
+	assert.Nil(t, env.execTx("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyA))
+	assert.Equal(t, pty.ErrTokenPaused, env.transfer(string(Nodes[2]), 1e7, PrivKeyA))
+	assert.Equal(t, pty.ErrTokenPaused, env.transfer(string(Nodes[2]), 1e7, PrivKeyB))
+	out, err := env.exec.Query_GetTokenRestriction(&pty.ReqTokenRestriction{Symbol: Symbol})
+	assert.Nil(t, err)
+	assert.True(t, out.(*pty.ReplyTokenRestriction).Paused)
+	assert.Nil(t, env.execTx("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: false}, PrivKeyA))
+	assert.Nil(t, env.transfer(string(Nodes[2]), 1e7, PrivKeyB))
+	assert.Nil(t, env.execTxTo("TransferToExec", &types.AssetsTransferToExec{Cointoken: Symbol, Amount: 1e7, ExecName: "trade", To: tradeAddr}, tradeAddr, PrivKeyB))
+
+	// 没有冻结的持有人不能没收
+	seize := &pty.TokenSeize{Symbol: Symbol, From: string(Nodes[1]), To: string(Nodes[3]), Amount: 5e7}
+	assert.Equal(t, pty.ErrTokenAddrNotFrozen, env.execTx("TokenSeize", seize, PrivKeyA))
+
+	// 冻结B和C
+	assert.Nil(t, env.execTx("TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: string(Nodes[1]), Frozen: true}, PrivKeyA))
+	assert.Nil(t, env.execTx("TokenSetRestriction", &pty.TokenSetRestriction{Symbol: Symbol, AddFrozen: []string{string(Nodes[1]), string(Nodes[2])}}, PrivKeyA))
+	assert.Equal(t, pty.ErrTokenAddrFrozen, env.transfer(string(Nodes[0]), 1e7, PrivKeyB))
+	holders, err := env.exec.Query_GetTokenFrozenHolders(&pty.ReqTokenFrozenHolders{Symbol: Symbol, Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(holders.(*pty.ReplyTokenFrozenHolders).Holders))
+
+	// 没收B的token到恢复地址D, 暂停状态下也可以执行
+	assert.Nil(t, env.execTx("TokenPause", &pty.TokenPause{Symbol: Symbol, Paused: true}, PrivKeyA))
+	assert.Equal(t, types.ErrNotAllow, env.execTx("TokenSeize", seize, PrivKeyB))
+	assert.Nil(t, env.execTx("TokenSeize", seize, PrivKeyA))
+	assert.Equal(t, int64(8e7-5e7), accDB.LoadAccount(string(Nodes[1])).Balance)
+	assert.Equal(t, int64(5e7), accDB.LoadAccount(string(Nodes[3])).Balance)
+	seize.Amount = 1e8
+	assert.Equal(t, types.ErrNoBalance, env.execTx("TokenSeize", seize, PrivKeyA))
+
+	// 没收B在trade合约中的余额, 转到D在trade合约中的账户
+	seize = &pty.TokenSeize{Symbol: Symbol, From: string(Nodes[1]), To: string(Nodes[3]), Amount: 1e7, ExecName: "trade"}
+	assert.Nil(t, env.execTx("TokenSeize", seize, PrivKeyA))
+	assert.Equal(t, int64(0), accDB.LoadExecAccount(string(Nodes[1]), tradeAddr).Balance)
+	assert.Equal(t, int64(1e7), accDB.LoadExecAccount(string(Nodes[3]), tradeAddr).Balance)
+	assert.Equal(t, int64(3e7), accDB.LoadAccount(string(Nodes[1])).Balance)
+
+	// 解冻C, 回滚之后C重新出现在冻结列表中
+	assert.Nil(t, env.execTx("TokenFreeze", &pty.TokenFreeze{Symbol: Symbol, Addr: string(Nodes[2]), Frozen: false}, PrivKeyA))
+	holders, err = env.exec.Query_GetTokenFrozenHolders(&pty.ReqTokenFrozenHolders{Symbol: Symbol, Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(holders.(*pty.ReplyTokenFrozenHolders).Holders))
+	assert.Equal(t, string(Nodes[1]), holders.(*pty.ReplyTokenFrozenHolders).Holders[0].Addr)
+	set, err := env.exec.ExecDelLocal(env.lastTx, env.lastReceipt, 1)
+	assert.Nil(t, err)
+	env.setLocal(set)
+	holders, err = env.exec.Query_GetTokenFrozenHolders(&pty.ReqTokenFrozenHolders{Symbol: Symbol, Count: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(holders.(*pty.ReplyTokenFrozenHolders).Holders))
+
+	history, err := env.exec.Query_GetTokenHistory(&types.ReqString{Data: Symbol})
+	assert.Nil(t, err)
+	// finish, pause*2, freeze, restriction, pause, seize*2
+	assert.Equal(t, 8, len(history.(*pty.ReplyTokenLogs).Logs))
+}
+
 func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error) {
 	signType := types.SECP256K1
 	c, err := crypto.New(types.GetSignName(pty.TokenX, signType))
@@ -304,3 +539,168 @@ func signTx(tx *types.Transaction, hexPrivKey string) (*types.Transaction, error
 	tx.Sign(int32(signType), privKey)
 	return tx, nil
 }
+
+func TestTokenMultiSigExec(t *testing.T) {
+	env := newTokenTestEnv(t, pty.ForkTokenMultiSigX)
+	accDB, _ := account.NewAccountDB(env.cfg, pty.TokenX, Symbol, env.stateDB)
+	// 多重签名账户由B和C管理, 作为token的owner
+	multiSigAddr := address.MultiSignAddress([]byte("token-multisig"))
+	multiSig := &mty.MultiSig{MultiSigAddr: multiSigAddr, Owners: []*mty.Owner{{OwnerAddr: string(Nodes[1]), Weight: 1}, {OwnerAddr: string(Nodes[2]), Weight: 1}}, RequiredWeight: 2}
+	env.stateDB.Set([]byte("mavl-multisig-"+multiSigAddr), types.Encode(multiSig))
+	// 模拟multisig合约中MultiSigSubmitTx的执行结果
+	submit := func(txid uint64, action *pty.TokenAction, executed bool) *pty.TokenMultiSigExec {
+		payload := types.Encode(action)
+		multiSigTx := &mty.MultiSigTx{Txid: txid, TxType: mty.SubmitOperate, MultiSigAddr: multiSigAddr, Executed: executed, Execer: pty.TokenX, PayloadHash: common.Sha256(payload)}
+		env.stateDB.Set([]byte(fmt.Sprintf("mavl-multisig-tx-%s-%018d", multiSigAddr, txid)), types.Encode(multiSigTx))
+		return &pty.TokenMultiSigExec{MultiSigAddr: multiSigAddr, Txid: txid, Payload: payload}
+	}
+
+	category := int32(pty.CategoryMintBurnSupport | pty.CategoryAdminSupport)
+	assert.Nil(t, env.execTx("TokenPreCreate", &pty.TokenPreCreate{Name: Symbol, Symbol: Symbol, Introduction: Symbol, Total: 10000 * 1e8, Owner: multiSigAddr, Category: category}, PrivKeyA))
+	assert.Nil(t, env.execTx("TokenFinishCreate", &pty.TokenFinishCreate{Symbol: Symbol, Owner: multiSigAddr}, PrivKeyA))
+	assert.Equal(t, int64(10000*1e8), accDB.LoadAccount(multiSigAddr).Balance)
+	// 多重签名地址没有私钥, 不能直接增发
+	mint := &pty.TokenAction{Ty: pty.TokenActionMint, Value: &pty.TokenAction_TokenMint{TokenMint: &pty.TokenMint{Symbol: Symbol, Amount: 1e8}}}
+	assert.Equal(t, types.ErrNotAllow, env.execTx("TokenMint", mint.GetTokenMint(), PrivKeyB))
+
+	// 交易没有提交或者权重不够时不能执行
+	exec := &pty.TokenMultiSigExec{MultiSigAddr: multiSigAddr, Txid: 0, Payload: types.Encode(mint)}
+	assert.Equal(t, mty.ErrTxidNotExist, env.execTx("TokenMultiSigExec", exec, PrivKeyB))
+	exec = submit(0, mint, false)
+	assert.Equal(t, mty.ErrTxNotConfirmed, env.execTx("TokenMultiSigExec", exec, PrivKeyB))
+
+	// 执行之后由owner发起, 以多重签名地址的身份增发, 每个txid只能使用一次
+	exec = submit(0, mint, true)
+	assert.Equal(t, mty.ErrIsNotOwner, env.execTx("TokenMultiSigExec", exec, PrivKeyA))
+	assert.Nil(t, env.execTx("TokenMultiSigExec", exec, PrivKeyB))
+	assert.Equal(t, int64(10001*1e8), accDB.LoadAccount(multiSigAddr).Balance)
+	localToken, err := loadLocalToken(Symbol, multiSigAddr, pty.TokenStatusCreated, env.kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(10001*1e8), localToken.Total)
+	assert.Equal(t, mty.ErrSubmitTxUsed, env.execTx("TokenMultiSigExec", exec, PrivKeyC))
+
+	// payload需要和提交时一致
+	pause := &pty.TokenAction{Ty: pty.TokenActionPause, Value: &pty.TokenAction_TokenPause{TokenPause: &pty.TokenPause{Symbol: Symbol, Paused: true}}}
+	exec = submit(1, pause, true)
+	assert.Equal(t, mty.ErrSubmitTxNoMatch, env.execTx("TokenMultiSigExec", &pty.TokenMultiSigExec{MultiSigAddr: multiSigAddr, Txid: 1, Payload: types.Encode(mint)}, PrivKeyC))
+	assert.Nil(t, env.execTx("TokenMultiSigExec", exec, PrivKeyC))
+	out, err := env.exec.Query_GetTokenRestriction(&pty.ReqTokenRestriction{Symbol: Symbol})
+	assert.Nil(t, err)
+	assert.True(t, out.(*pty.ReplyTokenRestriction).Paused)
+
+	// 只支持owner的管理操作, 不能转出多重签名地址的余额
+	transfer := &pty.TokenAction{Ty: pty.ActionTransfer, Value: &pty.TokenAction_Transfer{Transfer: &types.AssetsTransfer{Cointoken: Symbol, Amount: 1e8, To: string(Nodes[1])}}}
+	assert.Equal(t, types.ErrActionNotSupport, env.execTx("TokenMultiSigExec", submit(2, transfer, true), PrivKeyB))
+
+	// 分叉之前不支持
+	env.exec.SetEnv(env.cfg.GetDappFork(pty.TokenX, pty.ForkTokenMultiSigX)-2, env.exec.GetBlockTime(), env.exec.GetDifficulty())
+	assert.Equal(t, types.ErrActionNotSupport, env.execTx("TokenMultiSigExec", submit(3, pause, true), PrivKeyB))
+}
+
+func TestTokenHolders(t *testing.T) {
+	env := newTokenTestEnv(t, pty.ForkTokenAdminX)
+	accDB, _ := account.NewAccountDB(env.cfg, pty.TokenX, Symbol, env.stateDB)
+	prefix := accDB.AccountKey("")
+	// 用区块高度作为状态哈希, 每个高度保存一份该token账户的快照
+	snapshots := make(map[string][][2][]byte)
+	snapshot := func() {
+		var kvs [][2][]byte
+		it := env.stateDB.(dbm.DB).Iterator(prefix, prefixEdge(prefix), false)
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			kvs = append(kvs, [2][]byte{append([]byte{}, it.Key()...), it.ValueCopy()})
+		}
+		snapshots[fmt.Sprint(env.exec.GetHeight())] = kvs
+	}
+	api := env.exec.GetAPI().(*apimock.QueueProtocolAPI)
+	api.On("GetHeaders", mock.Anything).Return(func(req *types.ReqBlocks) *types.Headers {
+		return &types.Headers{Items: []*types.Header{{Height: req.Start, StateHash: []byte(fmt.Sprint(req.Start))}}}
+	}, nil)
+	api.On("GetLastHeader").Return(func() *types.Header {
+		return &types.Header{Height: env.exec.GetHeight(), StateHash: []byte(fmt.Sprint(env.exec.GetHeight()))}
+	}, nil)
+	// 和状态数据库的 [start, end) 模式一致
+	api.On("StoreList", mock.Anything).Return(func(req *types.StoreList) *types.StoreListReply {
+		reply := &types.StoreListReply{Start: req.Start, End: req.End, Count: req.Count, Mode: req.Mode}
+		for _, kv := range snapshots[string(req.StateHash)] {
+			if bytes.Compare(kv[0], req.Start) < 0 || bytes.Compare(kv[0], req.End) >= 0 {
+				continue
+			}
+			if reply.Num >= req.Count {
+				reply.NextKey = kv[0]
+				break
+			}
+			reply.Num++
+			reply.Keys = append(reply.Keys, kv[0])
+			reply.Values = append(reply.Values, kv[1])
+		}
+		return reply
+	}, nil)
+
+	// A创建token并转给B, 然后转给C
+	env.createToken(0)
+	snapshot()
+	h1 := env.exec.GetHeight()
+	assert.Nil(t, env.transfer(string(Nodes[2]), 2e8, PrivKeyA))
+	snapshot()
+	h2 := env.exec.GetHeight()
+
+	query := func(height int64, primary string, count int32) *pty.ReplyTokenHolders {
+		out, err := env.exec.Query_GetTokenHolders(&pty.ReqTokenHolders{Symbol: Symbol, Height: height, PrimaryKey: primary, Count: count})
+		assert.Nil(t, err)
+		return out.(*pty.ReplyTokenHolders)
+	}
+	balances := func(reply *pty.ReplyTokenHolders) map[string]int64 {
+		m := make(map[string]int64)
+		for _, holder := range reply.Holders {
+			m[holder.Addr] = holder.Balance
+		}
+		return m
+	}
+
+	// h1时C还没有持有token
+	reply := query(h1, "", 10)
+	assert.Equal(t, map[string]int64{string(Nodes[0]): 9999e8, string(Nodes[1]): 1e8}, balances(reply))
+	reply = query(h2, "", 10)
+	assert.Equal(t, map[string]int64{string(Nodes[0]): 9997e8, string(Nodes[1]): 1e8, string(Nodes[2]): 2e8}, balances(reply))
+	reply = query(-1, "", 10)
+	assert.Equal(t, h2, reply.Height)
+	assert.Equal(t, 3, len(reply.Holders))
+
+	// 分页查询
+	reply = query(h2, "", 2)
+	assert.Equal(t, 2, len(reply.Holders))
+	assert.NotEqual(t, "", reply.PrimaryKey)
+	reply = query(h2, reply.PrimaryKey, 2)
+	assert.Equal(t, 1, len(reply.Holders))
+	assert.Equal(t, "", reply.PrimaryKey)
+	_, err := env.exec.Query_GetTokenHolders(&pty.ReqTokenHolders{Height: h2})
+	assert.Equal(t, types.ErrInvalidParam, err)
+	_, err = env.exec.Query_GetTokenHolders(&pty.ReqTokenHolders{Symbol: Symbol, Height: h2, PrimaryKey: "mavl-coins-bty-"})
+	assert.Equal(t, types.ErrInvalidParam, err)
+
+	// 其他执行器中合约账户之间的转账不经过token执行器, 快照中同样可以查到
+	tradeAddr := address.ExecAddress("trade")
+	accDB.SaveExecAccount(tradeAddr, &types.Account{Addr: string(Nodes[1]), Balance: 4e7})
+	accDB.SaveExecAccount(tradeAddr, &types.Account{Addr: string(Nodes[3]), Frozen: 1e7})
+	env.exec.SetEnv(env.exec.GetHeight()+1, env.exec.GetBlockTime(), env.exec.GetDifficulty())
+	snapshot()
+	h3 := env.exec.GetHeight()
+	reply = query(h3, "", 10)
+	assert.Equal(t, 5, len(reply.Holders))
+	var execHolders []*pty.TokenHolder
+	for _, holder := range reply.Holders {
+		if holder.ExecAddr != "" {
+			execHolders = append(execHolders, holder)
+		}
+	}
+	assert.Contains(t, execHolders, &pty.TokenHolder{Addr: string(Nodes[1]), ExecAddr: tradeAddr, Balance: 4e7})
+	assert.Contains(t, execHolders, &pty.TokenHolder{Addr: string(Nodes[3]), ExecAddr: tradeAddr, Frozen: 1e7})
+
+	// 卖出全部余额的地址不在之后的快照中, 但仍然在之前的快照中
+	accDB.SaveExecAccount(tradeAddr, &types.Account{Addr: string(Nodes[3])})
+	env.exec.SetEnv(env.exec.GetHeight()+1, env.exec.GetBlockTime(), env.exec.GetDifficulty())
+	snapshot()
+	assert.Equal(t, 4, len(query(-1, "", 10).Holders))
+	assert.Equal(t, 5, len(query(h3, "", 10).Holders))
+}
@@ -15,6 +15,7 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
+	msexec "github.com/33cn/plugin/plugin/dapp/multisig/executor"
 	pty "github.com/33cn/plugin/plugin/dapp/token/types"
 )
 
@@ -161,7 +162,12 @@ func (action *tokenAction) preCreate(token *pty.TokenPreCreate) (*types.Receipt,
 		return nil, pty.ErrTokenTotalOverflow
 	}
 	if cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenCheckX) {
-		if err := address.CheckAddress(token.Owner); err != nil {
+		err := address.CheckAddress(token.Owner)
+		// owner可以是多重签名地址, 通过TokenMultiSigExec管理token
+		if err != nil && cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenMultiSigX) && address.CheckMultiSignAddress(token.Owner) == nil {
+			err = nil
+		}
+		if err != nil {
 			return nil, err
 		}
 	}
@@ -634,3 +640,425 @@ func (action *tokenAction) burn(burn *pty.TokenBurn) (*types.Receipt, error) {
 
 	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
 }
+
+func loadTokenMetadata(db dbm.KV, symbol string) (*pty.TokenMetadata, error) {
+	value, err := db.Get(calcTokenMetadataKey(symbol))
+	if err == types.ErrNotFound {
+		return &pty.TokenMetadata{Symbol: symbol}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var meta pty.TokenMetadata
+	err = types.Decode(value, &meta)
+	if err != nil {
+		tokenlog.Error("loadTokenMetadata", "Can't decode token metadata", symbol)
+		return nil, err
+	}
+	return &meta, nil
+}
+
+func loadTokenRestriction(db dbm.KV, symbol string) (*pty.TokenRestriction, error) {
+	value, err := db.Get(calcTokenRestrictionKey(symbol))
+	if err == types.ErrNotFound {
+		return &pty.TokenRestriction{Symbol: symbol}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var restriction pty.TokenRestriction
+	err = types.Decode(value, &restriction)
+	if err != nil {
+		tokenlog.Error("loadTokenRestriction", "Can't decode token restriction", symbol)
+		return nil, err
+	}
+	return &restriction, nil
+}
+
+// 暂停标记, 白名单和冻结列表按地址单独存储, 值为1表示开启
+func getTokenFlag(db dbm.KV, key []byte) (bool, error) {
+	value, err := db.Get(key)
+	if err == types.ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var flag types.Int32
+	err = types.Decode(value, &flag)
+	if err != nil {
+		return false, err
+	}
+	return flag.Data == 1, nil
+}
+
+func tokenFlagKV(key []byte, on bool) *types.KeyValue {
+	flag := &types.Int32{}
+	if on {
+		flag.Data = 1
+	}
+	return &types.KeyValue{Key: key, Value: types.Encode(flag)}
+}
+
+// checkTokenPaused 检查token是否暂停转账
+func checkTokenPaused(db dbm.KV, symbol string) error {
+	paused, err := getTokenFlag(db, calcTokenPausedKey(symbol))
+	if err != nil {
+		return err
+	}
+	if paused {
+		tokenlog.Error("checkTokenPaused", "symbol", symbol)
+		return pty.ErrTokenPaused
+	}
+	return nil
+}
+
+// CheckTransferRestriction 其他合约在合约账户之间转移token时检查暂停, 冻结和白名单限制, exec 不是token时不检查
+// 暂停和冻结列表分别在 ForkTokenAdminX 和 ForkTokenMetadataX 之后才能设置, 任意一个分叉之后就检查全部的限制
+func CheckTransferRestriction(cfg *types.Chain33Config, db dbm.KV, height int64, exec, symbol, from, to string) error {
+	if exec != pty.TokenX {
+		return nil
+	}
+	if !cfg.IsDappFork(height, pty.TokenX, pty.ForkTokenMetadataX) && !cfg.IsDappFork(height, pty.TokenX, pty.ForkTokenAdminX) {
+		return nil
+	}
+	return checkTransferRestriction(db, symbol, from, to)
+}
+
+// checkTransferRestriction 检查token的转账限制, to 为空表示转入或者从合约取回, 只检查from
+// 转入合约后在合约内部的转账token合约无法检查, 所以从合约取回时也要检查取回地址
+// 开启白名单模式后owner不受白名单限制
+func checkTransferRestriction(db dbm.KV, symbol, from, to string) error {
+	if err := checkTokenPaused(db, symbol); err != nil {
+		return err
+	}
+	addrs := []string{from}
+	if to != "" {
+		addrs = append(addrs, to)
+	}
+	for _, addr := range addrs {
+		frozen, err := getTokenFlag(db, calcTokenFrozenKey(symbol, addr))
+		if err != nil {
+			return err
+		}
+		if frozen {
+			tokenlog.Error("checkTransferRestriction", "symbol", symbol, "frozen addr", addr)
+			return pty.ErrTokenAddrFrozen
+		}
+	}
+
+	restriction, err := loadTokenRestriction(db, symbol)
+	if err != nil {
+		return err
+	}
+	if !restriction.WhitelistOnly {
+		return nil
+	}
+	tokendb, err := loadTokenDB(db, symbol)
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if addr == tokendb.token.Owner {
+			continue
+		}
+		whitelisted, err := getTokenFlag(db, calcTokenWhitelistKey(symbol, addr))
+		if err != nil {
+			return err
+		}
+		if !whitelisted {
+			tokenlog.Error("checkTransferRestriction", "symbol", symbol, "not in whitelist", addr)
+			return pty.ErrTokenNotInWhitelist
+		}
+	}
+	return nil
+}
+
+// diffTokenFlags 计算地址标记实际的变化, 同一地址同时出现在添加和删除列表中时, 以删除为准
+func diffTokenFlags(db dbm.KV, add, remove []string, calcKey func(addr string) []byte) (added, removed []string, err error) {
+	var addrs []string
+	flags := make(map[string]bool)
+	for _, addr := range add {
+		if _, ok := flags[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		flags[addr] = true
+	}
+	for _, addr := range remove {
+		if _, ok := flags[addr]; !ok {
+			addrs = append(addrs, addr)
+		}
+		flags[addr] = false
+	}
+	for _, addr := range addrs {
+		prev, err := getTokenFlag(db, calcKey(addr))
+		if err != nil {
+			return nil, nil, err
+		}
+		if prev == flags[addr] {
+			continue
+		}
+		if flags[addr] {
+			added = append(added, addr)
+		} else {
+			removed = append(removed, addr)
+		}
+	}
+	return added, removed, nil
+}
+
+func (action *tokenAction) updateMetadata(meta *pty.TokenUpdateMetadata) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenMetadataX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if meta == nil || meta.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if meta.GetDecimals() < 0 || meta.GetDecimals() > pty.TokenDecimalsLimit ||
+		len(meta.GetLogoURI()) > pty.TokenURILenLimit || len(meta.GetWebsite()) > pty.TokenURILenLimit ||
+		len(meta.GetContentHash()) > pty.TokenContentHashLenLimit {
+		return nil, types.ErrInvalidParam
+	}
+
+	tokendb, err := loadTokenDB(action.db, meta.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Owner != action.fromaddr {
+		tokenlog.Error("token updateMetadata", "symbol", meta.GetSymbol(), "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, types.ErrNotAllow
+	}
+
+	prev, err := loadTokenMetadata(action.db, meta.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	current := &pty.TokenMetadata{
+		Symbol:      meta.GetSymbol(),
+		Decimals:    meta.GetDecimals(),
+		LogoURI:     meta.GetLogoURI(),
+		Website:     meta.GetWebsite(),
+		ContentHash: meta.GetContentHash(),
+	}
+	kvs := []*types.KeyValue{{Key: calcTokenMetadataKey(meta.GetSymbol()), Value: types.Encode(current)}}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenMetadata, Log: types.Encode(&pty.ReceiptTokenMetadata{Prev: prev, Current: current})}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) setRestriction(restrict *pty.TokenSetRestriction) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenMetadataX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if restrict == nil || restrict.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	for _, list := range [][]string{restrict.AddWhitelist, restrict.RemoveWhitelist, restrict.AddFrozen, restrict.RemoveFrozen} {
+		for _, addr := range list {
+			if err := address.CheckAddress(addr); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	tokendb, err := loadTokenDB(action.db, restrict.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Owner != action.fromaddr {
+		tokenlog.Error("token setRestriction", "symbol", restrict.GetSymbol(), "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, types.ErrNotAllow
+	}
+	// 冻结列表和TokenFreeze共用同一个标记, 同样只对支持管理操作的token有效
+	if len(restrict.AddFrozen) > 0 || len(restrict.RemoveFrozen) > 0 {
+		if _, err := action.loadAdminTokenDB(restrict.GetSymbol()); err != nil {
+			return nil, err
+		}
+	}
+
+	prev, err := loadTokenRestriction(action.db, restrict.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	current := &pty.TokenRestriction{Symbol: restrict.GetSymbol(), WhitelistOnly: restrict.GetWhitelistOnly()}
+	kvs := []*types.KeyValue{{Key: calcTokenRestrictionKey(restrict.GetSymbol()), Value: types.Encode(current)}}
+	receiptLog := &pty.ReceiptTokenRestriction{Prev: prev, Current: current}
+	// 收据中只记录实际发生变化的地址, 便于localdb回滚
+	receiptLog.AddWhitelist, receiptLog.RemoveWhitelist, err = diffTokenFlags(action.db, restrict.AddWhitelist, restrict.RemoveWhitelist,
+		func(addr string) []byte { return calcTokenWhitelistKey(restrict.GetSymbol(), addr) })
+	if err != nil {
+		return nil, err
+	}
+	receiptLog.AddFrozen, receiptLog.RemoveFrozen, err = diffTokenFlags(action.db, restrict.AddFrozen, restrict.RemoveFrozen,
+		func(addr string) []byte { return calcTokenFrozenKey(restrict.GetSymbol(), addr) })
+	if err != nil {
+		return nil, err
+	}
+	for _, addr := range receiptLog.AddWhitelist {
+		kvs = append(kvs, tokenFlagKV(calcTokenWhitelistKey(restrict.GetSymbol(), addr), true))
+	}
+	for _, addr := range receiptLog.RemoveWhitelist {
+		kvs = append(kvs, tokenFlagKV(calcTokenWhitelistKey(restrict.GetSymbol(), addr), false))
+	}
+	for _, addr := range receiptLog.AddFrozen {
+		kvs = append(kvs, tokenFlagKV(calcTokenFrozenKey(restrict.GetSymbol(), addr), true))
+	}
+	for _, addr := range receiptLog.RemoveFrozen {
+		kvs = append(kvs, tokenFlagKV(calcTokenFrozenKey(restrict.GetSymbol(), addr), false))
+	}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenRestriction, Log: types.Encode(receiptLog)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// loadAdminTokenDB 加载支持管理操作的token, 只有owner可以操作
+func (action *tokenAction) loadAdminTokenDB(symbol string) (*tokenDB, error) {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenAdminX) {
+		return nil, types.ErrActionNotSupport
+	}
+	tokendb, err := loadTokenDB(action.db, symbol)
+	if err != nil {
+		return nil, err
+	}
+	if tokendb.token.Category&pty.CategoryAdminSupport == 0 {
+		tokenlog.Error("Can't admin category", "category", tokendb.token.Category, "support", pty.CategoryAdminSupport)
+		return nil, types.ErrNotSupport
+	}
+	if tokendb.token.Owner != action.fromaddr {
+		tokenlog.Error("token admin", "symbol", symbol, "from", action.fromaddr, "owner", tokendb.token.Owner)
+		return nil, types.ErrNotAllow
+	}
+	return tokendb, nil
+}
+
+func (action *tokenAction) pause(pause *pty.TokenPause) (*types.Receipt, error) {
+	if pause == nil || pause.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	_, err := action.loadAdminTokenDB(pause.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	prev, err := getTokenFlag(action.db, calcTokenPausedKey(pause.GetSymbol()))
+	if err != nil {
+		return nil, err
+	}
+	kvs := []*types.KeyValue{tokenFlagKV(calcTokenPausedKey(pause.GetSymbol()), pause.GetPaused())}
+	receiptLog := &pty.ReceiptTokenPause{Symbol: pause.GetSymbol(), Prev: prev, Current: pause.GetPaused()}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenPause, Log: types.Encode(receiptLog)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+func (action *tokenAction) freeze(freeze *pty.TokenFreeze) (*types.Receipt, error) {
+	if freeze == nil || freeze.GetSymbol() == "" {
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(freeze.GetAddr()); err != nil {
+		return nil, err
+	}
+	_, err := action.loadAdminTokenDB(freeze.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	key := calcTokenFrozenKey(freeze.GetSymbol(), freeze.GetAddr())
+	prev, err := getTokenFlag(action.db, key)
+	if err != nil {
+		return nil, err
+	}
+	kvs := []*types.KeyValue{tokenFlagKV(key, freeze.GetFrozen())}
+	receiptLog := &pty.ReceiptTokenFreeze{Symbol: freeze.GetSymbol(), Addr: freeze.GetAddr(), Prev: prev, Current: freeze.GetFrozen()}
+	logs := []*types.ReceiptLog{{Ty: pty.TyLogTokenFreeze, Log: types.Encode(receiptLog)}}
+	return &types.Receipt{Ty: types.ExecOk, KV: kvs, Logs: logs}, nil
+}
+
+// seize 只能从已经冻结的持有人转出, 暂停状态下也可以执行
+// 指定execName时转移持有人在该合约下账户的可用余额, 在合约中被冻结的部分(如未成交的挂单)需要先在合约中解冻
+func (action *tokenAction) seize(seize *pty.TokenSeize) (*types.Receipt, error) {
+	if seize == nil || seize.GetSymbol() == "" || seize.GetAmount() <= 0 || seize.GetFrom() == seize.GetTo() {
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(seize.GetFrom()); err != nil {
+		return nil, err
+	}
+	if err := address.CheckAddress(seize.GetTo()); err != nil {
+		return nil, err
+	}
+	_, err := action.loadAdminTokenDB(seize.GetSymbol())
+	if err != nil {
+		return nil, err
+	}
+	frozen, err := getTokenFlag(action.db, calcTokenFrozenKey(seize.GetSymbol(), seize.GetFrom()))
+	if err != nil {
+		return nil, err
+	}
+	if !frozen {
+		return nil, pty.ErrTokenAddrNotFrozen
+	}
+
+	cfg := action.api.GetConfig()
+	tokenAccount, err := account.NewAccountDB(cfg, "token", seize.GetSymbol(), action.db)
+	if err != nil {
+		return nil, err
+	}
+	var receipt *types.Receipt
+	if seize.GetExecName() == "" {
+		receipt, err = tokenAccount.Transfer(seize.GetFrom(), seize.GetTo(), seize.GetAmount())
+	} else {
+		receipt, err = tokenAccount.ExecTransfer(seize.GetFrom(), seize.GetTo(), address.ExecAddress(seize.GetExecName()), seize.GetAmount())
+	}
+	if err != nil {
+		tokenlog.Error("token seize", "symbol", seize.GetSymbol(), "from", seize.GetFrom(), "exec", seize.GetExecName(), "amount", seize.GetAmount(), "err", err)
+		return nil, err
+	}
+	receiptLog := &pty.ReceiptTokenSeize{Symbol: seize.GetSymbol(), From: seize.GetFrom(), To: seize.GetTo(), Amount: seize.GetAmount(), ExecName: seize.GetExecName()}
+	logs := append(receipt.Logs, &types.ReceiptLog{Ty: pty.TyLogTokenSeize, Log: types.Encode(receiptLog)})
+	return &types.Receipt{Ty: types.ExecOk, KV: receipt.KV, Logs: logs}, nil
+}
+
+// multiSigExec 以多重签名账户的身份执行token的管理操作, 交易发送者需要是多重签名账户的owner,
+// payload需要先在multisig合约中用MultiSigSubmitTx提交并执行, 每个txid只能使用一次
+func (action *tokenAction) multiSigExec(exec *pty.TokenMultiSigExec) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.TokenX, pty.ForkTokenMultiSigX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if exec == nil {
+		return nil, types.ErrInvalidParam
+	}
+	var inner pty.TokenAction
+	if err := types.Decode(exec.GetPayload(), &inner); err != nil {
+		return nil, types.ErrInvalidParam
+	}
+	kv, err := msexec.UseSubmitTx(action.db, pty.TokenX, action.fromaddr, exec.GetMultiSigAddr(), exec.GetTxid(), exec.GetPayload())
+	if err != nil {
+		tokenlog.Error("token multiSigExec", "from", action.fromaddr, "multiSigAddr", exec.GetMultiSigAddr(), "txid", exec.GetTxid(), "err", err)
+		return nil, err
+	}
+
+	action.fromaddr = exec.GetMultiSigAddr()
+	var receipt *types.Receipt
+	switch inner.Ty {
+	case pty.TokenActionMint:
+		receipt, err = action.mint(inner.GetTokenMint())
+	case pty.TokenActionBurn:
+		receipt, err = action.burn(inner.GetTokenBurn())
+	case pty.TokenActionUpdateMetadata:
+		receipt, err = action.updateMetadata(inner.GetTokenUpdateMetadata())
+	case pty.TokenActionSetRestriction:
+		receipt, err = action.setRestriction(inner.GetTokenSetRestriction())
+	case pty.TokenActionPause:
+		receipt, err = action.pause(inner.GetTokenPause())
+	case pty.TokenActionFreeze:
+		receipt, err = action.freeze(inner.GetTokenFreeze())
+	case pty.TokenActionSeize:
+		receipt, err = action.seize(inner.GetTokenSeize())
+	default:
+		return nil, types.ErrActionNotSupport
+	}
+	if err != nil {
+		return nil, err
+	}
+	receipt.KV = append(receipt.KV, kv)
+	return receipt, nil
+}
@@ -20,8 +20,14 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 		from := tx.From()
 		//to 是 execs 合约地址
 		if drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) {
+			if err := t.checkTransferRestriction(transfer.Cointoken, from, ""); err != nil {
+				return nil, err
+			}
 			return accountDB.TransferToExec(from, tx.GetRealToAddr(), transfer.Amount)
 		}
+		if err := t.checkTransferRestriction(transfer.Cointoken, from, tx.GetRealToAddr()); err != nil {
+			return nil, err
+		}
 		return accountDB.Transfer(from, tx.GetRealToAddr(), transfer.Amount)
 	} else if (action.Ty == tokenty.ActionWithdraw) && action.GetWithdraw() != nil {
 		withdraw := action.GetWithdraw()
@@ -31,6 +37,10 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 		from := tx.From()
 		//to 是 execs 合约地址
 		if drivers.IsDriverAddress(tx.GetRealToAddr(), t.GetHeight()) || isExecAddrMatch(withdraw.ExecName, tx.GetRealToAddr()) {
+			// 合约内部的转账不经过token合约, 取回时检查最终的接收地址
+			if err := t.checkTransferRestriction(withdraw.Cointoken, from, ""); err != nil {
+				return nil, err
+			}
 			return accountDB.TransferWithdraw(from, tx.GetRealToAddr(), withdraw.Amount)
 		}
 		return nil, types.ErrActionNotSupport
@@ -53,12 +63,19 @@ func (t *token) ExecTransWithdraw(accountDB *account.DB, tx *types.Transaction,
 		if !isExecAddrMatch(transfer.ExecName, tx.GetRealToAddr()) {
 			return nil, types.ErrToAddrNotSameToExecAddr
 		}
+		if err := t.checkTransferRestriction(transfer.Cointoken, from, ""); err != nil {
+			return nil, err
+		}
 		return accountDB.TransferToExec(from, tx.GetRealToAddr(), transfer.Amount)
 	} else {
 		return nil, types.ErrActionNotSupport
 	}
 }
 
+func (t *token) checkTransferRestriction(symbol, from, to string) error {
+	return CheckTransferRestriction(t.GetAPI().GetConfig(), t.GetStateDB(), t.GetHeight(), tokenty.TokenX, symbol, from, to)
+}
+
 func isExecAddrMatch(name string, to string) bool {
 	toaddr := address.ExecAddress(name)
 	return toaddr == to
@@ -149,3 +149,87 @@ func (c *Jrpc) CreateRawTokenBurnTx(param *tokenty.TokenBurn, result *interface{
 	*result = hex.EncodeToString(data)
 	return nil
 }
+
+// CreateRawTokenUpdateMetadataTx 创建未签名的更新token元数据交易
+func (c *Jrpc) CreateRawTokenUpdateMetadataTx(param *tokenty.TokenUpdateMetadata, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenUpdateMetadata", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenSetRestrictionTx 创建未签名的设置token转账限制交易
+func (c *Jrpc) CreateRawTokenSetRestrictionTx(param *tokenty.TokenSetRestriction, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenSetRestriction", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenPauseTx 创建未签名的暂停token转账交易
+func (c *Jrpc) CreateRawTokenPauseTx(param *tokenty.TokenPause, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenPause", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenFreezeTx 创建未签名的冻结token持有人交易
+func (c *Jrpc) CreateRawTokenFreezeTx(param *tokenty.TokenFreeze, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenFreeze", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenSeizeTx 创建未签名的没收冻结持有人token交易
+func (c *Jrpc) CreateRawTokenSeizeTx(param *tokenty.TokenSeize, result *interface{}) error {
+	if param == nil || param.Symbol == "" {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenSeize", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
+
+// CreateRawTokenMultiSigExecTx 创建未签名的以多重签名账户身份执行token管理操作的交易
+func (c *Jrpc) CreateRawTokenMultiSigExecTx(param *tokenty.TokenMultiSigExec, result *interface{}) error {
+	if param == nil || param.MultiSigAddr == "" || len(param.Payload) == 0 {
+		return types.ErrInvalidParam
+	}
+	cfg := c.cli.GetConfig()
+	data, err := types.CallCreateTx(cfg, cfg.ExecName(tokenty.TokenX), "TokenMultiSigExec", param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(data)
+	return nil
+}
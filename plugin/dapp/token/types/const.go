@@ -23,6 +23,18 @@ const (
 	TokenActionMint = 12
 	// TokenActionBurn for token burn
 	TokenActionBurn = 13
+	// TokenActionUpdateMetadata for token update metadata
+	TokenActionUpdateMetadata = 14
+	// TokenActionSetRestriction for token set transfer restriction
+	TokenActionSetRestriction = 15
+	// TokenActionPause for token pause transfer
+	TokenActionPause = 16
+	// TokenActionFreeze for token freeze holder
+	TokenActionFreeze = 17
+	// TokenActionSeize for token seize from frozen holder
+	TokenActionSeize = 18
+	// TokenActionMultiSigExec for token exec action as multisig account
+	TokenActionMultiSigExec = 19
 )
 
 // token status
@@ -49,6 +61,12 @@ var (
 	ForkTokenSymbolWithNumberX = "ForkTokenSymbolWithNumber"
 	// ForkTokenCheckX  fork check impl bug
 	ForkTokenCheckX = "ForkTokenCheck"
+	// ForkTokenMetadataX fork token metadata and transfer restriction
+	ForkTokenMetadataX = "ForkTokenMetadata"
+	// ForkTokenAdminX fork token pause, freeze and seize
+	ForkTokenAdminX = "ForkTokenAdmin"
+	// ForkTokenMultiSigX fork token exec action as multisig account
+	ForkTokenMultiSigX = "ForkTokenMultiSig"
 )
 
 const (
@@ -82,6 +100,16 @@ const (
 	TyLogTokenMint = 323
 	// TyLogTokenBurn log for token burn
 	TyLogTokenBurn = 324
+	// TyLogTokenMetadata log for token update metadata
+	TyLogTokenMetadata = 325
+	// TyLogTokenRestriction log for token set transfer restriction
+	TyLogTokenRestriction = 326
+	// TyLogTokenPause log for token pause
+	TyLogTokenPause = 327
+	// TyLogTokenFreeze log for token freeze holder
+	TyLogTokenFreeze = 328
+	// TyLogTokenSeize log for token seize
+	TyLogTokenSeize = 329
 )
 
 const (
@@ -91,9 +119,17 @@ const (
 	TokenSymbolLenLimit = 16
 	// TokenIntroLenLimit token introduction length limit
 	TokenIntroLenLimit = 1024
+	// TokenURILenLimit token logo uri and website length limit
+	TokenURILenLimit = 256
+	// TokenContentHashLenLimit token content hash length limit
+	TokenContentHashLenLimit = 128
+	// TokenDecimalsLimit token decimals limit
+	TokenDecimalsLimit = 18
 )
 
 const (
 	// CategoryMintBurnSupport support mint & burn
 	CategoryMintBurnSupport = 1 << iota
+	// CategoryAdminSupport support pause, freeze & seize
+	CategoryAdminSupport
 )
@@ -37,4 +37,12 @@ var (
 	ErrTokenNotExist = errors.New("ErrTokenSymbolNotExist")
 	// ErrTokenBlacklistNotInit error token hasn't init blacklist
 	ErrTokenBlacklistNotInit = errors.New("ErrTokenBlacklistNotInit")
+	// ErrTokenAddrFrozen error token address is frozen
+	ErrTokenAddrFrozen = errors.New("ErrTokenAddrFrozen")
+	// ErrTokenNotInWhitelist error token address not in whitelist
+	ErrTokenNotInWhitelist = errors.New("ErrTokenNotInWhitelist")
+	// ErrTokenPaused error token transfer is paused
+	ErrTokenPaused = errors.New("ErrTokenPaused")
+	// ErrTokenAddrNotFrozen error token address is not frozen
+	ErrTokenAddrNotFrozen = errors.New("ErrTokenAddrNotFrozen")
 )
@@ -38,6 +38,12 @@ type TokenAction struct {
 	//	*TokenAction_TransferToExec
 	//	*TokenAction_TokenMint
 	//	*TokenAction_TokenBurn
+	//	*TokenAction_TokenUpdateMetadata
+	//	*TokenAction_TokenSetRestriction
+	//	*TokenAction_TokenPause
+	//	*TokenAction_TokenFreeze
+	//	*TokenAction_TokenSeize
+	//	*TokenAction_TokenMultiSigExec
 	Value                isTokenAction_Value `protobuf_oneof:"value"`
 	Ty                   int32               `protobuf:"varint,7,opt,name=Ty,proto3" json:"Ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -110,6 +116,30 @@ type TokenAction_TokenBurn struct {
 	TokenBurn *TokenBurn `protobuf:"bytes,10,opt,name=tokenBurn,proto3,oneof"`
 }
 
+type TokenAction_TokenUpdateMetadata struct {
+	TokenUpdateMetadata *TokenUpdateMetadata `protobuf:"bytes,11,opt,name=tokenUpdateMetadata,proto3,oneof"`
+}
+
+type TokenAction_TokenSetRestriction struct {
+	TokenSetRestriction *TokenSetRestriction `protobuf:"bytes,12,opt,name=tokenSetRestriction,proto3,oneof"`
+}
+
+type TokenAction_TokenPause struct {
+	TokenPause *TokenPause `protobuf:"bytes,13,opt,name=tokenPause,proto3,oneof"`
+}
+
+type TokenAction_TokenFreeze struct {
+	TokenFreeze *TokenFreeze `protobuf:"bytes,14,opt,name=tokenFreeze,proto3,oneof"`
+}
+
+type TokenAction_TokenSeize struct {
+	TokenSeize *TokenSeize `protobuf:"bytes,15,opt,name=tokenSeize,proto3,oneof"`
+}
+
+type TokenAction_TokenMultiSigExec struct {
+	TokenMultiSigExec *TokenMultiSigExec `protobuf:"bytes,16,opt,name=tokenMultiSigExec,proto3,oneof"`
+}
+
 func (*TokenAction_TokenPreCreate) isTokenAction_Value() {}
 
 func (*TokenAction_TokenFinishCreate) isTokenAction_Value() {}
@@ -128,6 +158,18 @@ func (*TokenAction_TokenMint) isTokenAction_Value() {}
 
 func (*TokenAction_TokenBurn) isTokenAction_Value() {}
 
+func (*TokenAction_TokenUpdateMetadata) isTokenAction_Value() {}
+
+func (*TokenAction_TokenSetRestriction) isTokenAction_Value() {}
+
+func (*TokenAction_TokenPause) isTokenAction_Value() {}
+
+func (*TokenAction_TokenFreeze) isTokenAction_Value() {}
+
+func (*TokenAction_TokenSeize) isTokenAction_Value() {}
+
+func (*TokenAction_TokenMultiSigExec) isTokenAction_Value() {}
+
 func (m *TokenAction) GetValue() isTokenAction_Value {
 	if m != nil {
 		return m.Value
@@ -198,6 +240,48 @@ func (m *TokenAction) GetTokenBurn() *TokenBurn {
 	return nil
 }
 
+func (m *TokenAction) GetTokenUpdateMetadata() *TokenUpdateMetadata {
+	if x, ok := m.GetValue().(*TokenAction_TokenUpdateMetadata); ok {
+		return x.TokenUpdateMetadata
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenSetRestriction() *TokenSetRestriction {
+	if x, ok := m.GetValue().(*TokenAction_TokenSetRestriction); ok {
+		return x.TokenSetRestriction
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenPause() *TokenPause {
+	if x, ok := m.GetValue().(*TokenAction_TokenPause); ok {
+		return x.TokenPause
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenFreeze() *TokenFreeze {
+	if x, ok := m.GetValue().(*TokenAction_TokenFreeze); ok {
+		return x.TokenFreeze
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenSeize() *TokenSeize {
+	if x, ok := m.GetValue().(*TokenAction_TokenSeize); ok {
+		return x.TokenSeize
+	}
+	return nil
+}
+
+func (m *TokenAction) GetTokenMultiSigExec() *TokenMultiSigExec {
+	if x, ok := m.GetValue().(*TokenAction_TokenMultiSigExec); ok {
+		return x.TokenMultiSigExec
+	}
+	return nil
+}
+
 func (m *TokenAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -217,6 +301,12 @@ func (*TokenAction) XXX_OneofWrappers() []interface{} {
 		(*TokenAction_TransferToExec)(nil),
 		(*TokenAction_TokenMint)(nil),
 		(*TokenAction_TokenBurn)(nil),
+		(*TokenAction_TokenUpdateMetadata)(nil),
+		(*TokenAction_TokenSetRestriction)(nil),
+		(*TokenAction_TokenPause)(nil),
+		(*TokenAction_TokenFreeze)(nil),
+		(*TokenAction_TokenSeize)(nil),
+		(*TokenAction_TokenMultiSigExec)(nil),
 	}
 }
 
@@ -496,1028 +586,2365 @@ func (m *TokenBurn) GetAmount() int64 {
 	return 0
 }
 
-// state db
-type Token struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	Total                int64    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Price                int64    `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Owner                string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
-	Creator              string   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
-	Status               int32    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	Category             int32    `protobuf:"varint,9,opt,name=category,proto3" json:"category,omitempty"`
+// token 元数据, 只能由owner更新
+type TokenUpdateMetadata struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals             int32    `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	LogoURI              string   `protobuf:"bytes,3,opt,name=logoURI,proto3" json:"logoURI,omitempty"`
+	Website              string   `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	ContentHash          string   `protobuf:"bytes,5,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Token) Reset()         { *m = Token{} }
-func (m *Token) String() string { return proto.CompactTextString(m) }
-func (*Token) ProtoMessage()    {}
-func (*Token) Descriptor() ([]byte, []int) {
+func (m *TokenUpdateMetadata) Reset()         { *m = TokenUpdateMetadata{} }
+func (m *TokenUpdateMetadata) String() string { return proto.CompactTextString(m) }
+func (*TokenUpdateMetadata) ProtoMessage()    {}
+func (*TokenUpdateMetadata) Descriptor() ([]byte, []int) {
 	return fileDescriptor_3aff0bcd502840ab, []int{6}
 }
 
-func (m *Token) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Token.Unmarshal(m, b)
+func (m *TokenUpdateMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenUpdateMetadata.Unmarshal(m, b)
 }
-func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+func (m *TokenUpdateMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenUpdateMetadata.Marshal(b, m, deterministic)
 }
-func (m *Token) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Token.Merge(m, src)
+func (m *TokenUpdateMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenUpdateMetadata.Merge(m, src)
 }
-func (m *Token) XXX_Size() int {
-	return xxx_messageInfo_Token.Size(m)
+func (m *TokenUpdateMetadata) XXX_Size() int {
+	return xxx_messageInfo_TokenUpdateMetadata.Size(m)
 }
-func (m *Token) XXX_DiscardUnknown() {
-	xxx_messageInfo_Token.DiscardUnknown(m)
+func (m *TokenUpdateMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenUpdateMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Token proto.InternalMessageInfo
+var xxx_messageInfo_TokenUpdateMetadata proto.InternalMessageInfo
 
-func (m *Token) GetName() string {
+func (m *TokenUpdateMetadata) GetSymbol() string {
 	if m != nil {
-		return m.Name
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *Token) GetSymbol() string {
+func (m *TokenUpdateMetadata) GetDecimals() int32 {
 	if m != nil {
-		return m.Symbol
+		return m.Decimals
 	}
-	return ""
+	return 0
 }
 
-func (m *Token) GetIntroduction() string {
+func (m *TokenUpdateMetadata) GetLogoURI() string {
 	if m != nil {
-		return m.Introduction
+		return m.LogoURI
 	}
 	return ""
 }
 
-func (m *Token) GetTotal() int64 {
+func (m *TokenUpdateMetadata) GetWebsite() string {
 	if m != nil {
-		return m.Total
+		return m.Website
 	}
-	return 0
+	return ""
 }
 
-func (m *Token) GetPrice() int64 {
+func (m *TokenUpdateMetadata) GetContentHash() string {
 	if m != nil {
-		return m.Price
+		return m.ContentHash
 	}
-	return 0
+	return ""
 }
 
-func (m *Token) GetOwner() string {
+// token 转账限制, 只能由owner设置
+type TokenSetRestriction struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// 开启后只有白名单地址之间可以转账
+	WhitelistOnly        bool     `protobuf:"varint,2,opt,name=whitelistOnly,proto3" json:"whitelistOnly,omitempty"`
+	AddWhitelist         []string `protobuf:"bytes,3,rep,name=addWhitelist,proto3" json:"addWhitelist,omitempty"`
+	RemoveWhitelist      []string `protobuf:"bytes,4,rep,name=removeWhitelist,proto3" json:"removeWhitelist,omitempty"`
+	AddFrozen            []string `protobuf:"bytes,5,rep,name=addFrozen,proto3" json:"addFrozen,omitempty"`
+	RemoveFrozen         []string `protobuf:"bytes,6,rep,name=removeFrozen,proto3" json:"removeFrozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenSetRestriction) Reset()         { *m = TokenSetRestriction{} }
+func (m *TokenSetRestriction) String() string { return proto.CompactTextString(m) }
+func (*TokenSetRestriction) ProtoMessage()    {}
+func (*TokenSetRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{7}
+}
+
+func (m *TokenSetRestriction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenSetRestriction.Unmarshal(m, b)
+}
+func (m *TokenSetRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenSetRestriction.Marshal(b, m, deterministic)
+}
+func (m *TokenSetRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSetRestriction.Merge(m, src)
+}
+func (m *TokenSetRestriction) XXX_Size() int {
+	return xxx_messageInfo_TokenSetRestriction.Size(m)
+}
+func (m *TokenSetRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSetRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenSetRestriction proto.InternalMessageInfo
+
+func (m *TokenSetRestriction) GetSymbol() string {
 	if m != nil {
-		return m.Owner
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *Token) GetCreator() string {
+func (m *TokenSetRestriction) GetWhitelistOnly() bool {
 	if m != nil {
-		return m.Creator
+		return m.WhitelistOnly
 	}
-	return ""
+	return false
 }
 
-func (m *Token) GetStatus() int32 {
+func (m *TokenSetRestriction) GetAddWhitelist() []string {
 	if m != nil {
-		return m.Status
+		return m.AddWhitelist
 	}
-	return 0
+	return nil
 }
 
-func (m *Token) GetCategory() int32 {
+func (m *TokenSetRestriction) GetRemoveWhitelist() []string {
 	if m != nil {
-		return m.Category
+		return m.RemoveWhitelist
 	}
-	return 0
+	return nil
 }
 
-// log
-type ReceiptToken struct {
+func (m *TokenSetRestriction) GetAddFrozen() []string {
+	if m != nil {
+		return m.AddFrozen
+	}
+	return nil
+}
+
+func (m *TokenSetRestriction) GetRemoveFrozen() []string {
+	if m != nil {
+		return m.RemoveFrozen
+	}
+	return nil
+}
+
+// 以下管理操作只对创建时设置了CategoryAdminSupport的token有效, 只能由owner发起
+// 暂停或恢复token的所有转账
+type TokenPause struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Status               int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Paused               bool     `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReceiptToken) Reset()         { *m = ReceiptToken{} }
-func (m *ReceiptToken) String() string { return proto.CompactTextString(m) }
-func (*ReceiptToken) ProtoMessage()    {}
-func (*ReceiptToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{7}
+func (m *TokenPause) Reset()         { *m = TokenPause{} }
+func (m *TokenPause) String() string { return proto.CompactTextString(m) }
+func (*TokenPause) ProtoMessage()    {}
+func (*TokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{8}
 }
 
-func (m *ReceiptToken) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReceiptToken.Unmarshal(m, b)
+func (m *TokenPause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenPause.Unmarshal(m, b)
 }
-func (m *ReceiptToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReceiptToken.Marshal(b, m, deterministic)
+func (m *TokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenPause.Marshal(b, m, deterministic)
 }
-func (m *ReceiptToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptToken.Merge(m, src)
+func (m *TokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenPause.Merge(m, src)
 }
-func (m *ReceiptToken) XXX_Size() int {
-	return xxx_messageInfo_ReceiptToken.Size(m)
+func (m *TokenPause) XXX_Size() int {
+	return xxx_messageInfo_TokenPause.Size(m)
 }
-func (m *ReceiptToken) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiptToken.DiscardUnknown(m)
+func (m *TokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenPause.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiptToken proto.InternalMessageInfo
+var xxx_messageInfo_TokenPause proto.InternalMessageInfo
 
-func (m *ReceiptToken) GetSymbol() string {
+func (m *TokenPause) GetSymbol() string {
 	if m != nil {
 		return m.Symbol
 	}
 	return ""
 }
 
-func (m *ReceiptToken) GetOwner() string {
-	if m != nil {
-		return m.Owner
-	}
-	return ""
-}
-
-func (m *ReceiptToken) GetStatus() int32 {
+func (m *TokenPause) GetPaused() bool {
 	if m != nil {
-		return m.Status
+		return m.Paused
 	}
-	return 0
+	return false
 }
 
-type ReceiptTokenAmount struct {
-	Prev                 *Token   `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
-	Current              *Token   `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+// 冻结或解冻持有人, 冻结后不能转入转出
+type TokenFreeze struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Frozen               bool     `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReceiptTokenAmount) Reset()         { *m = ReceiptTokenAmount{} }
-func (m *ReceiptTokenAmount) String() string { return proto.CompactTextString(m) }
-func (*ReceiptTokenAmount) ProtoMessage()    {}
-func (*ReceiptTokenAmount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{8}
+func (m *TokenFreeze) Reset()         { *m = TokenFreeze{} }
+func (m *TokenFreeze) String() string { return proto.CompactTextString(m) }
+func (*TokenFreeze) ProtoMessage()    {}
+func (*TokenFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{9}
 }
 
-func (m *ReceiptTokenAmount) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReceiptTokenAmount.Unmarshal(m, b)
+func (m *TokenFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenFreeze.Unmarshal(m, b)
 }
-func (m *ReceiptTokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReceiptTokenAmount.Marshal(b, m, deterministic)
+func (m *TokenFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenFreeze.Marshal(b, m, deterministic)
 }
-func (m *ReceiptTokenAmount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReceiptTokenAmount.Merge(m, src)
+func (m *TokenFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenFreeze.Merge(m, src)
 }
-func (m *ReceiptTokenAmount) XXX_Size() int {
-	return xxx_messageInfo_ReceiptTokenAmount.Size(m)
+func (m *TokenFreeze) XXX_Size() int {
+	return xxx_messageInfo_TokenFreeze.Size(m)
 }
-func (m *ReceiptTokenAmount) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReceiptTokenAmount.DiscardUnknown(m)
+func (m *TokenFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenFreeze.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReceiptTokenAmount proto.InternalMessageInfo
+var xxx_messageInfo_TokenFreeze proto.InternalMessageInfo
 
-func (m *ReceiptTokenAmount) GetPrev() *Token {
+func (m *TokenFreeze) GetSymbol() string {
 	if m != nil {
-		return m.Prev
+		return m.Symbol
 	}
-	return nil
+	return ""
 }
 
-func (m *ReceiptTokenAmount) GetCurrent() *Token {
+func (m *TokenFreeze) GetAddr() string {
 	if m != nil {
-		return m.Current
+		return m.Addr
 	}
-	return nil
+	return ""
 }
 
-// local
-type LocalToken struct {
-	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Symbol              string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Introduction        string `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
-	Total               int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
-	Price               int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
-	Owner               string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
-	Creator             string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
-	Status              int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	CreatedHeight       int64  `protobuf:"varint,9,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
-	CreatedTime         int64  `protobuf:"varint,10,opt,name=createdTime,proto3" json:"createdTime,omitempty"`
-	PrepareCreateHeight int64  `protobuf:"varint,11,opt,name=prepareCreateHeight,proto3" json:"prepareCreateHeight,omitempty"`
-	PrepareCreateTime   int64  `protobuf:"varint,12,opt,name=prepareCreateTime,proto3" json:"prepareCreateTime,omitempty"`
-	Precision           int32  `protobuf:"varint,13,opt,name=precision,proto3" json:"precision,omitempty"`
-	// 如果需要这个项可以单独做一个域存储
-	TotalTransferTimes   int64    `protobuf:"varint,14,opt,name=totalTransferTimes,proto3" json:"totalTransferTimes,omitempty"`
-	RevokedHeight        int64    `protobuf:"varint,15,opt,name=revokedHeight,proto3" json:"revokedHeight,omitempty"`
-	RevokedTime          int64    `protobuf:"varint,16,opt,name=revokedTime,proto3" json:"revokedTime,omitempty"`
-	Category             int32    `protobuf:"varint,17,opt,name=category,proto3" json:"category,omitempty"`
+func (m *TokenFreeze) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+// 从被冻结的持有人强制转账到恢复地址
+// execName为空时转移主账户余额, 否则转移持有人在该合约下账户中的可用余额
+type TokenSeize struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExecName             string   `protobuf:"bytes,5,opt,name=execName,proto3" json:"execName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LocalToken) Reset()         { *m = LocalToken{} }
-func (m *LocalToken) String() string { return proto.CompactTextString(m) }
-func (*LocalToken) ProtoMessage()    {}
-func (*LocalToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{9}
+func (m *TokenSeize) Reset()         { *m = TokenSeize{} }
+func (m *TokenSeize) String() string { return proto.CompactTextString(m) }
+func (*TokenSeize) ProtoMessage()    {}
+func (*TokenSeize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{10}
 }
 
-func (m *LocalToken) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LocalToken.Unmarshal(m, b)
+func (m *TokenSeize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenSeize.Unmarshal(m, b)
 }
-func (m *LocalToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LocalToken.Marshal(b, m, deterministic)
+func (m *TokenSeize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenSeize.Marshal(b, m, deterministic)
 }
-func (m *LocalToken) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LocalToken.Merge(m, src)
+func (m *TokenSeize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenSeize.Merge(m, src)
 }
-func (m *LocalToken) XXX_Size() int {
-	return xxx_messageInfo_LocalToken.Size(m)
+func (m *TokenSeize) XXX_Size() int {
+	return xxx_messageInfo_TokenSeize.Size(m)
 }
-func (m *LocalToken) XXX_DiscardUnknown() {
-	xxx_messageInfo_LocalToken.DiscardUnknown(m)
+func (m *TokenSeize) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenSeize.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LocalToken proto.InternalMessageInfo
+var xxx_messageInfo_TokenSeize proto.InternalMessageInfo
 
-func (m *LocalToken) GetName() string {
+func (m *TokenSeize) GetSymbol() string {
 	if m != nil {
-		return m.Name
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *LocalToken) GetSymbol() string {
+func (m *TokenSeize) GetFrom() string {
 	if m != nil {
-		return m.Symbol
+		return m.From
 	}
 	return ""
 }
 
-func (m *LocalToken) GetIntroduction() string {
+func (m *TokenSeize) GetTo() string {
 	if m != nil {
-		return m.Introduction
+		return m.To
 	}
 	return ""
 }
 
-func (m *LocalToken) GetTotal() int64 {
+func (m *TokenSeize) GetAmount() int64 {
 	if m != nil {
-		return m.Total
+		return m.Amount
 	}
 	return 0
 }
 
-func (m *LocalToken) GetPrice() int64 {
+func (m *TokenSeize) GetExecName() string {
 	if m != nil {
-		return m.Price
+		return m.ExecName
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalToken) GetOwner() string {
-	if m != nil {
-		return m.Owner
-	}
-	return ""
+// 以多重签名账户的身份执行token的管理操作, payload为编码后的TokenAction
+// 需要先在multisig合约中用MultiSigSubmitTx提交并执行, 由多重签名账户的owner发起, 每个txid只能使用一次
+type TokenMultiSigExec struct {
+	MultiSigAddr         string   `protobuf:"bytes,1,opt,name=multiSigAddr,proto3" json:"multiSigAddr,omitempty"`
+	Txid                 uint64   `protobuf:"varint,2,opt,name=txid,proto3" json:"txid,omitempty"`
+	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LocalToken) GetCreator() string {
+func (m *TokenMultiSigExec) Reset()         { *m = TokenMultiSigExec{} }
+func (m *TokenMultiSigExec) String() string { return proto.CompactTextString(m) }
+func (*TokenMultiSigExec) ProtoMessage()    {}
+func (*TokenMultiSigExec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{11}
+}
+
+func (m *TokenMultiSigExec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenMultiSigExec.Unmarshal(m, b)
+}
+func (m *TokenMultiSigExec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenMultiSigExec.Marshal(b, m, deterministic)
+}
+func (m *TokenMultiSigExec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMultiSigExec.Merge(m, src)
+}
+func (m *TokenMultiSigExec) XXX_Size() int {
+	return xxx_messageInfo_TokenMultiSigExec.Size(m)
+}
+func (m *TokenMultiSigExec) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMultiSigExec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMultiSigExec proto.InternalMessageInfo
+
+func (m *TokenMultiSigExec) GetMultiSigAddr() string {
 	if m != nil {
-		return m.Creator
+		return m.MultiSigAddr
 	}
 	return ""
 }
 
-func (m *LocalToken) GetStatus() int32 {
+func (m *TokenMultiSigExec) GetTxid() uint64 {
 	if m != nil {
-		return m.Status
+		return m.Txid
 	}
 	return 0
 }
 
-func (m *LocalToken) GetCreatedHeight() int64 {
+func (m *TokenMultiSigExec) GetPayload() []byte {
 	if m != nil {
-		return m.CreatedHeight
+		return m.Payload
 	}
-	return 0
+	return nil
 }
 
-func (m *LocalToken) GetCreatedTime() int64 {
+// state db
+type Token struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol               string   `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Introduction         string   `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Total                int64    `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Price                int64    `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Owner                string   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Creator              string   `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status               int32    `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Category             int32    `protobuf:"varint,9,opt,name=category,proto3" json:"category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Token) Reset()         { *m = Token{} }
+func (m *Token) String() string { return proto.CompactTextString(m) }
+func (*Token) ProtoMessage()    {}
+func (*Token) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{12}
+}
+
+func (m *Token) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Token.Unmarshal(m, b)
+}
+func (m *Token) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Token.Marshal(b, m, deterministic)
+}
+func (m *Token) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Token.Merge(m, src)
+}
+func (m *Token) XXX_Size() int {
+	return xxx_messageInfo_Token.Size(m)
+}
+func (m *Token) XXX_DiscardUnknown() {
+	xxx_messageInfo_Token.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Token proto.InternalMessageInfo
+
+func (m *Token) GetName() string {
 	if m != nil {
-		return m.CreatedTime
+		return m.Name
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalToken) GetPrepareCreateHeight() int64 {
+func (m *Token) GetSymbol() string {
 	if m != nil {
-		return m.PrepareCreateHeight
+		return m.Symbol
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalToken) GetPrepareCreateTime() int64 {
+func (m *Token) GetIntroduction() string {
 	if m != nil {
-		return m.PrepareCreateTime
+		return m.Introduction
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalToken) GetPrecision() int32 {
+func (m *Token) GetTotal() int64 {
 	if m != nil {
-		return m.Precision
+		return m.Total
 	}
 	return 0
 }
 
-func (m *LocalToken) GetTotalTransferTimes() int64 {
+func (m *Token) GetPrice() int64 {
 	if m != nil {
-		return m.TotalTransferTimes
+		return m.Price
 	}
 	return 0
 }
 
-func (m *LocalToken) GetRevokedHeight() int64 {
+func (m *Token) GetOwner() string {
 	if m != nil {
-		return m.RevokedHeight
+		return m.Owner
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalToken) GetRevokedTime() int64 {
+func (m *Token) GetCreator() string {
 	if m != nil {
-		return m.RevokedTime
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Token) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
 	return 0
 }
 
-func (m *LocalToken) GetCategory() int32 {
+func (m *Token) GetCategory() int32 {
 	if m != nil {
 		return m.Category
 	}
 	return 0
 }
 
-type LocalLogs struct {
+type TokenMetadata struct {
 	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	TxIndex              string   `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
-	ActionType           int32    `protobuf:"varint,3,opt,name=actionType,proto3" json:"actionType,omitempty"`
-	TxHash               string   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Decimals             int32    `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	LogoURI              string   `protobuf:"bytes,3,opt,name=logoURI,proto3" json:"logoURI,omitempty"`
+	Website              string   `protobuf:"bytes,4,opt,name=website,proto3" json:"website,omitempty"`
+	ContentHash          string   `protobuf:"bytes,5,opt,name=contentHash,proto3" json:"contentHash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LocalLogs) Reset()         { *m = LocalLogs{} }
-func (m *LocalLogs) String() string { return proto.CompactTextString(m) }
-func (*LocalLogs) ProtoMessage()    {}
-func (*LocalLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{10}
+func (m *TokenMetadata) Reset()         { *m = TokenMetadata{} }
+func (m *TokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*TokenMetadata) ProtoMessage()    {}
+func (*TokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{13}
 }
 
-func (m *LocalLogs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LocalLogs.Unmarshal(m, b)
+func (m *TokenMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenMetadata.Unmarshal(m, b)
 }
-func (m *LocalLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LocalLogs.Marshal(b, m, deterministic)
+func (m *TokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenMetadata.Marshal(b, m, deterministic)
 }
-func (m *LocalLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LocalLogs.Merge(m, src)
+func (m *TokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMetadata.Merge(m, src)
 }
-func (m *LocalLogs) XXX_Size() int {
-	return xxx_messageInfo_LocalLogs.Size(m)
+func (m *TokenMetadata) XXX_Size() int {
+	return xxx_messageInfo_TokenMetadata.Size(m)
 }
-func (m *LocalLogs) XXX_DiscardUnknown() {
-	xxx_messageInfo_LocalLogs.DiscardUnknown(m)
+func (m *TokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LocalLogs proto.InternalMessageInfo
+var xxx_messageInfo_TokenMetadata proto.InternalMessageInfo
 
-func (m *LocalLogs) GetSymbol() string {
+func (m *TokenMetadata) GetSymbol() string {
 	if m != nil {
 		return m.Symbol
 	}
 	return ""
 }
 
-func (m *LocalLogs) GetTxIndex() string {
+func (m *TokenMetadata) GetDecimals() int32 {
 	if m != nil {
-		return m.TxIndex
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *TokenMetadata) GetLogoURI() string {
+	if m != nil {
+		return m.LogoURI
 	}
 	return ""
 }
 
-func (m *LocalLogs) GetActionType() int32 {
+func (m *TokenMetadata) GetWebsite() string {
 	if m != nil {
-		return m.ActionType
+		return m.Website
 	}
-	return 0
+	return ""
 }
 
-func (m *LocalLogs) GetTxHash() string {
+func (m *TokenMetadata) GetContentHash() string {
 	if m != nil {
-		return m.TxHash
+		return m.ContentHash
 	}
 	return ""
 }
 
-// query
-type ReqTokens struct {
-	QueryAll             bool     `protobuf:"varint,1,opt,name=queryAll,proto3" json:"queryAll,omitempty"`
-	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Tokens               []string `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	SymbolOnly           bool     `protobuf:"varint,4,opt,name=symbolOnly,proto3" json:"symbolOnly,omitempty"`
+type TokenRestriction struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	WhitelistOnly        bool     `protobuf:"varint,2,opt,name=whitelistOnly,proto3" json:"whitelistOnly,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqTokens) Reset()         { *m = ReqTokens{} }
-func (m *ReqTokens) String() string { return proto.CompactTextString(m) }
-func (*ReqTokens) ProtoMessage()    {}
-func (*ReqTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{11}
+func (m *TokenRestriction) Reset()         { *m = TokenRestriction{} }
+func (m *TokenRestriction) String() string { return proto.CompactTextString(m) }
+func (*TokenRestriction) ProtoMessage()    {}
+func (*TokenRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{14}
 }
 
-func (m *ReqTokens) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqTokens.Unmarshal(m, b)
-}
-func (m *ReqTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqTokens.Marshal(b, m, deterministic)
+func (m *TokenRestriction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenRestriction.Unmarshal(m, b)
 }
-func (m *ReqTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokens.Merge(m, src)
+func (m *TokenRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenRestriction.Marshal(b, m, deterministic)
 }
-func (m *ReqTokens) XXX_Size() int {
-	return xxx_messageInfo_ReqTokens.Size(m)
+func (m *TokenRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRestriction.Merge(m, src)
 }
-func (m *ReqTokens) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqTokens.DiscardUnknown(m)
+func (m *TokenRestriction) XXX_Size() int {
+	return xxx_messageInfo_TokenRestriction.Size(m)
 }
-
-var xxx_messageInfo_ReqTokens proto.InternalMessageInfo
-
-func (m *ReqTokens) GetQueryAll() bool {
-	if m != nil {
-		return m.QueryAll
-	}
-	return false
+func (m *TokenRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenRestriction.DiscardUnknown(m)
 }
 
-func (m *ReqTokens) GetStatus() int32 {
-	if m != nil {
-		return m.Status
-	}
-	return 0
-}
+var xxx_messageInfo_TokenRestriction proto.InternalMessageInfo
 
-func (m *ReqTokens) GetTokens() []string {
+func (m *TokenRestriction) GetSymbol() string {
 	if m != nil {
-		return m.Tokens
+		return m.Symbol
 	}
-	return nil
+	return ""
 }
 
-func (m *ReqTokens) GetSymbolOnly() bool {
+func (m *TokenRestriction) GetWhitelistOnly() bool {
 	if m != nil {
-		return m.SymbolOnly
+		return m.WhitelistOnly
 	}
 	return false
 }
 
-type ReplyTokens struct {
-	Tokens               []*LocalToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// log
+type ReceiptToken struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Owner                string   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Status               int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplyTokens) Reset()         { *m = ReplyTokens{} }
-func (m *ReplyTokens) String() string { return proto.CompactTextString(m) }
-func (*ReplyTokens) ProtoMessage()    {}
-func (*ReplyTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{12}
+func (m *ReceiptToken) Reset()         { *m = ReceiptToken{} }
+func (m *ReceiptToken) String() string { return proto.CompactTextString(m) }
+func (*ReceiptToken) ProtoMessage()    {}
+func (*ReceiptToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{15}
 }
 
-func (m *ReplyTokens) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyTokens.Unmarshal(m, b)
+func (m *ReceiptToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptToken.Unmarshal(m, b)
 }
-func (m *ReplyTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyTokens.Marshal(b, m, deterministic)
+func (m *ReceiptToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptToken.Marshal(b, m, deterministic)
 }
-func (m *ReplyTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTokens.Merge(m, src)
+func (m *ReceiptToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptToken.Merge(m, src)
 }
-func (m *ReplyTokens) XXX_Size() int {
-	return xxx_messageInfo_ReplyTokens.Size(m)
+func (m *ReceiptToken) XXX_Size() int {
+	return xxx_messageInfo_ReceiptToken.Size(m)
 }
-func (m *ReplyTokens) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyTokens.DiscardUnknown(m)
+func (m *ReceiptToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptToken.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyTokens proto.InternalMessageInfo
+var xxx_messageInfo_ReceiptToken proto.InternalMessageInfo
 
-func (m *ReplyTokens) GetTokens() []*LocalToken {
+func (m *ReceiptToken) GetSymbol() string {
 	if m != nil {
-		return m.Tokens
+		return m.Symbol
 	}
-	return nil
+	return ""
 }
 
-type TokenRecv struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Recv                 int64    `protobuf:"varint,2,opt,name=recv,proto3" json:"recv,omitempty"`
+func (m *ReceiptToken) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReceiptToken) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type ReceiptTokenAmount struct {
+	Prev                 *Token   `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *Token   `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TokenRecv) Reset()         { *m = TokenRecv{} }
-func (m *TokenRecv) String() string { return proto.CompactTextString(m) }
-func (*TokenRecv) ProtoMessage()    {}
-func (*TokenRecv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{13}
+func (m *ReceiptTokenAmount) Reset()         { *m = ReceiptTokenAmount{} }
+func (m *ReceiptTokenAmount) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenAmount) ProtoMessage()    {}
+func (*ReceiptTokenAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{16}
 }
 
-func (m *TokenRecv) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TokenRecv.Unmarshal(m, b)
+func (m *ReceiptTokenAmount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenAmount.Unmarshal(m, b)
 }
-func (m *TokenRecv) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TokenRecv.Marshal(b, m, deterministic)
+func (m *ReceiptTokenAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenAmount.Marshal(b, m, deterministic)
 }
-func (m *TokenRecv) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenRecv.Merge(m, src)
+func (m *ReceiptTokenAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenAmount.Merge(m, src)
 }
-func (m *TokenRecv) XXX_Size() int {
-	return xxx_messageInfo_TokenRecv.Size(m)
+func (m *ReceiptTokenAmount) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenAmount.Size(m)
 }
-func (m *TokenRecv) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenRecv.DiscardUnknown(m)
+func (m *ReceiptTokenAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenAmount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenRecv proto.InternalMessageInfo
+var xxx_messageInfo_ReceiptTokenAmount proto.InternalMessageInfo
 
-func (m *TokenRecv) GetToken() string {
+func (m *ReceiptTokenAmount) GetPrev() *Token {
 	if m != nil {
-		return m.Token
+		return m.Prev
 	}
-	return ""
+	return nil
 }
 
-func (m *TokenRecv) GetRecv() int64 {
+func (m *ReceiptTokenAmount) GetCurrent() *Token {
 	if m != nil {
-		return m.Recv
+		return m.Current
 	}
-	return 0
+	return nil
 }
 
-type ReplyAddrRecvForTokens struct {
-	TokenRecvs           []*TokenRecv `protobuf:"bytes,1,rep,name=tokenRecvs,proto3" json:"tokenRecvs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type ReceiptTokenMetadata struct {
+	Prev                 *TokenMetadata `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TokenMetadata `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *ReplyAddrRecvForTokens) Reset()         { *m = ReplyAddrRecvForTokens{} }
-func (m *ReplyAddrRecvForTokens) String() string { return proto.CompactTextString(m) }
-func (*ReplyAddrRecvForTokens) ProtoMessage()    {}
-func (*ReplyAddrRecvForTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{14}
+func (m *ReceiptTokenMetadata) Reset()         { *m = ReceiptTokenMetadata{} }
+func (m *ReceiptTokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenMetadata) ProtoMessage()    {}
+func (*ReceiptTokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{17}
 }
 
-func (m *ReplyAddrRecvForTokens) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyAddrRecvForTokens.Unmarshal(m, b)
+func (m *ReceiptTokenMetadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenMetadata.Unmarshal(m, b)
 }
-func (m *ReplyAddrRecvForTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyAddrRecvForTokens.Marshal(b, m, deterministic)
+func (m *ReceiptTokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenMetadata.Marshal(b, m, deterministic)
 }
-func (m *ReplyAddrRecvForTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyAddrRecvForTokens.Merge(m, src)
+func (m *ReceiptTokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenMetadata.Merge(m, src)
 }
-func (m *ReplyAddrRecvForTokens) XXX_Size() int {
-	return xxx_messageInfo_ReplyAddrRecvForTokens.Size(m)
+func (m *ReceiptTokenMetadata) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenMetadata.Size(m)
 }
-func (m *ReplyAddrRecvForTokens) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyAddrRecvForTokens.DiscardUnknown(m)
+func (m *ReceiptTokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenMetadata.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyAddrRecvForTokens proto.InternalMessageInfo
+var xxx_messageInfo_ReceiptTokenMetadata proto.InternalMessageInfo
 
-func (m *ReplyAddrRecvForTokens) GetTokenRecvs() []*TokenRecv {
+func (m *ReceiptTokenMetadata) GetPrev() *TokenMetadata {
 	if m != nil {
-		return m.TokenRecvs
+		return m.Prev
 	}
 	return nil
 }
 
-type ReqTokenBalance struct {
-	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	TokenSymbol          string   `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	Execer               string   `protobuf:"bytes,3,opt,name=execer,proto3" json:"execer,omitempty"`
+func (m *ReceiptTokenMetadata) GetCurrent() *TokenMetadata {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+type ReceiptTokenRestriction struct {
+	Prev                 *TokenRestriction `protobuf:"bytes,1,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              *TokenRestriction `protobuf:"bytes,2,opt,name=current,proto3" json:"current,omitempty"`
+	AddWhitelist         []string          `protobuf:"bytes,3,rep,name=addWhitelist,proto3" json:"addWhitelist,omitempty"`
+	RemoveWhitelist      []string          `protobuf:"bytes,4,rep,name=removeWhitelist,proto3" json:"removeWhitelist,omitempty"`
+	AddFrozen            []string          `protobuf:"bytes,5,rep,name=addFrozen,proto3" json:"addFrozen,omitempty"`
+	RemoveFrozen         []string          `protobuf:"bytes,6,rep,name=removeFrozen,proto3" json:"removeFrozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptTokenRestriction) Reset()         { *m = ReceiptTokenRestriction{} }
+func (m *ReceiptTokenRestriction) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenRestriction) ProtoMessage()    {}
+func (*ReceiptTokenRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{18}
+}
+
+func (m *ReceiptTokenRestriction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenRestriction.Unmarshal(m, b)
+}
+func (m *ReceiptTokenRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenRestriction.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenRestriction.Merge(m, src)
+}
+func (m *ReceiptTokenRestriction) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenRestriction.Size(m)
+}
+func (m *ReceiptTokenRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenRestriction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenRestriction proto.InternalMessageInfo
+
+func (m *ReceiptTokenRestriction) GetPrev() *TokenRestriction {
+	if m != nil {
+		return m.Prev
+	}
+	return nil
+}
+
+func (m *ReceiptTokenRestriction) GetCurrent() *TokenRestriction {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *ReceiptTokenRestriction) GetAddWhitelist() []string {
+	if m != nil {
+		return m.AddWhitelist
+	}
+	return nil
+}
+
+func (m *ReceiptTokenRestriction) GetRemoveWhitelist() []string {
+	if m != nil {
+		return m.RemoveWhitelist
+	}
+	return nil
+}
+
+func (m *ReceiptTokenRestriction) GetAddFrozen() []string {
+	if m != nil {
+		return m.AddFrozen
+	}
+	return nil
+}
+
+func (m *ReceiptTokenRestriction) GetRemoveFrozen() []string {
+	if m != nil {
+		return m.RemoveFrozen
+	}
+	return nil
+}
+
+type ReceiptTokenPause struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Prev                 bool     `protobuf:"varint,2,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              bool     `protobuf:"varint,3,opt,name=current,proto3" json:"current,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqTokenBalance) Reset()         { *m = ReqTokenBalance{} }
-func (m *ReqTokenBalance) String() string { return proto.CompactTextString(m) }
-func (*ReqTokenBalance) ProtoMessage()    {}
-func (*ReqTokenBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{15}
+func (m *ReceiptTokenPause) Reset()         { *m = ReceiptTokenPause{} }
+func (m *ReceiptTokenPause) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenPause) ProtoMessage()    {}
+func (*ReceiptTokenPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{19}
 }
 
-func (m *ReqTokenBalance) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqTokenBalance.Unmarshal(m, b)
+func (m *ReceiptTokenPause) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenPause.Unmarshal(m, b)
 }
-func (m *ReqTokenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqTokenBalance.Marshal(b, m, deterministic)
+func (m *ReceiptTokenPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenPause.Marshal(b, m, deterministic)
 }
-func (m *ReqTokenBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokenBalance.Merge(m, src)
+func (m *ReceiptTokenPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenPause.Merge(m, src)
 }
-func (m *ReqTokenBalance) XXX_Size() int {
-	return xxx_messageInfo_ReqTokenBalance.Size(m)
+func (m *ReceiptTokenPause) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenPause.Size(m)
 }
-func (m *ReqTokenBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqTokenBalance.DiscardUnknown(m)
+func (m *ReceiptTokenPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenPause.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqTokenBalance proto.InternalMessageInfo
+var xxx_messageInfo_ReceiptTokenPause proto.InternalMessageInfo
 
-func (m *ReqTokenBalance) GetAddresses() []string {
+func (m *ReceiptTokenPause) GetSymbol() string {
 	if m != nil {
-		return m.Addresses
+		return m.Symbol
 	}
-	return nil
+	return ""
 }
 
-func (m *ReqTokenBalance) GetTokenSymbol() string {
+func (m *ReceiptTokenPause) GetPrev() bool {
 	if m != nil {
-		return m.TokenSymbol
+		return m.Prev
+	}
+	return false
+}
+
+func (m *ReceiptTokenPause) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ReceiptTokenFreeze struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	Prev                 bool     `protobuf:"varint,3,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              bool     `protobuf:"varint,4,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReceiptTokenFreeze) Reset()         { *m = ReceiptTokenFreeze{} }
+func (m *ReceiptTokenFreeze) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenFreeze) ProtoMessage()    {}
+func (*ReceiptTokenFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{20}
+}
+
+func (m *ReceiptTokenFreeze) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenFreeze.Unmarshal(m, b)
+}
+func (m *ReceiptTokenFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenFreeze.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTokenFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenFreeze.Merge(m, src)
+}
+func (m *ReceiptTokenFreeze) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenFreeze.Size(m)
+}
+func (m *ReceiptTokenFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenFreeze proto.InternalMessageInfo
+
+func (m *ReceiptTokenFreeze) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *ReqTokenBalance) GetExecer() string {
+func (m *ReceiptTokenFreeze) GetAddr() string {
 	if m != nil {
-		return m.Execer
+		return m.Addr
 	}
 	return ""
 }
 
-type ReqAccountTokenAssets struct {
-	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+func (m *ReceiptTokenFreeze) GetPrev() bool {
+	if m != nil {
+		return m.Prev
+	}
+	return false
+}
+
+func (m *ReceiptTokenFreeze) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+type ReceiptTokenSeize struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	From                 string   `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Amount               int64    `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExecName             string   `protobuf:"bytes,5,opt,name=execName,proto3" json:"execName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqAccountTokenAssets) Reset()         { *m = ReqAccountTokenAssets{} }
-func (m *ReqAccountTokenAssets) String() string { return proto.CompactTextString(m) }
-func (*ReqAccountTokenAssets) ProtoMessage()    {}
-func (*ReqAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{16}
+func (m *ReceiptTokenSeize) Reset()         { *m = ReceiptTokenSeize{} }
+func (m *ReceiptTokenSeize) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTokenSeize) ProtoMessage()    {}
+func (*ReceiptTokenSeize) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{21}
 }
 
-func (m *ReqAccountTokenAssets) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqAccountTokenAssets.Unmarshal(m, b)
+func (m *ReceiptTokenSeize) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTokenSeize.Unmarshal(m, b)
 }
-func (m *ReqAccountTokenAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqAccountTokenAssets.Marshal(b, m, deterministic)
+func (m *ReceiptTokenSeize) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTokenSeize.Marshal(b, m, deterministic)
 }
-func (m *ReqAccountTokenAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqAccountTokenAssets.Merge(m, src)
+func (m *ReceiptTokenSeize) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTokenSeize.Merge(m, src)
+}
+func (m *ReceiptTokenSeize) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTokenSeize.Size(m)
+}
+func (m *ReceiptTokenSeize) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTokenSeize.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTokenSeize proto.InternalMessageInfo
+
+func (m *ReceiptTokenSeize) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReceiptTokenSeize) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ReceiptTokenSeize) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ReceiptTokenSeize) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ReceiptTokenSeize) GetExecName() string {
+	if m != nil {
+		return m.ExecName
+	}
+	return ""
+}
+
+// local
+type LocalToken struct {
+	Name                string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol              string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Introduction        string `protobuf:"bytes,3,opt,name=introduction,proto3" json:"introduction,omitempty"`
+	Total               int64  `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	Price               int64  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`
+	Owner               string `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty"`
+	Creator             string `protobuf:"bytes,7,opt,name=creator,proto3" json:"creator,omitempty"`
+	Status              int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedHeight       int64  `protobuf:"varint,9,opt,name=createdHeight,proto3" json:"createdHeight,omitempty"`
+	CreatedTime         int64  `protobuf:"varint,10,opt,name=createdTime,proto3" json:"createdTime,omitempty"`
+	PrepareCreateHeight int64  `protobuf:"varint,11,opt,name=prepareCreateHeight,proto3" json:"prepareCreateHeight,omitempty"`
+	PrepareCreateTime   int64  `protobuf:"varint,12,opt,name=prepareCreateTime,proto3" json:"prepareCreateTime,omitempty"`
+	Precision           int32  `protobuf:"varint,13,opt,name=precision,proto3" json:"precision,omitempty"`
+	// 如果需要这个项可以单独做一个域存储
+	TotalTransferTimes   int64    `protobuf:"varint,14,opt,name=totalTransferTimes,proto3" json:"totalTransferTimes,omitempty"`
+	RevokedHeight        int64    `protobuf:"varint,15,opt,name=revokedHeight,proto3" json:"revokedHeight,omitempty"`
+	RevokedTime          int64    `protobuf:"varint,16,opt,name=revokedTime,proto3" json:"revokedTime,omitempty"`
+	Category             int32    `protobuf:"varint,17,opt,name=category,proto3" json:"category,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocalToken) Reset()         { *m = LocalToken{} }
+func (m *LocalToken) String() string { return proto.CompactTextString(m) }
+func (*LocalToken) ProtoMessage()    {}
+func (*LocalToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{22}
+}
+
+func (m *LocalToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalToken.Unmarshal(m, b)
+}
+func (m *LocalToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalToken.Marshal(b, m, deterministic)
+}
+func (m *LocalToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalToken.Merge(m, src)
+}
+func (m *LocalToken) XXX_Size() int {
+	return xxx_messageInfo_LocalToken.Size(m)
+}
+func (m *LocalToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalToken proto.InternalMessageInfo
+
+func (m *LocalToken) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LocalToken) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *LocalToken) GetIntroduction() string {
+	if m != nil {
+		return m.Introduction
+	}
+	return ""
+}
+
+func (m *LocalToken) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *LocalToken) GetPrice() int64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *LocalToken) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LocalToken) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *LocalToken) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *LocalToken) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *LocalToken) GetCreatedTime() int64 {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return 0
+}
+
+func (m *LocalToken) GetPrepareCreateHeight() int64 {
+	if m != nil {
+		return m.PrepareCreateHeight
+	}
+	return 0
+}
+
+func (m *LocalToken) GetPrepareCreateTime() int64 {
+	if m != nil {
+		return m.PrepareCreateTime
+	}
+	return 0
+}
+
+func (m *LocalToken) GetPrecision() int32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *LocalToken) GetTotalTransferTimes() int64 {
+	if m != nil {
+		return m.TotalTransferTimes
+	}
+	return 0
+}
+
+func (m *LocalToken) GetRevokedHeight() int64 {
+	if m != nil {
+		return m.RevokedHeight
+	}
+	return 0
+}
+
+func (m *LocalToken) GetRevokedTime() int64 {
+	if m != nil {
+		return m.RevokedTime
+	}
+	return 0
+}
+
+func (m *LocalToken) GetCategory() int32 {
+	if m != nil {
+		return m.Category
+	}
+	return 0
+}
+
+type LocalTokenFrozen struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocalTokenFrozen) Reset()         { *m = LocalTokenFrozen{} }
+func (m *LocalTokenFrozen) String() string { return proto.CompactTextString(m) }
+func (*LocalTokenFrozen) ProtoMessage()    {}
+func (*LocalTokenFrozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{23}
+}
+
+func (m *LocalTokenFrozen) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalTokenFrozen.Unmarshal(m, b)
+}
+func (m *LocalTokenFrozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalTokenFrozen.Marshal(b, m, deterministic)
+}
+func (m *LocalTokenFrozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalTokenFrozen.Merge(m, src)
+}
+func (m *LocalTokenFrozen) XXX_Size() int {
+	return xxx_messageInfo_LocalTokenFrozen.Size(m)
+}
+func (m *LocalTokenFrozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalTokenFrozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalTokenFrozen proto.InternalMessageInfo
+
+func (m *LocalTokenFrozen) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *LocalTokenFrozen) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type LocalLogs struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	TxIndex              string   `protobuf:"bytes,2,opt,name=txIndex,proto3" json:"txIndex,omitempty"`
+	ActionType           int32    `protobuf:"varint,3,opt,name=actionType,proto3" json:"actionType,omitempty"`
+	TxHash               string   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LocalLogs) Reset()         { *m = LocalLogs{} }
+func (m *LocalLogs) String() string { return proto.CompactTextString(m) }
+func (*LocalLogs) ProtoMessage()    {}
+func (*LocalLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{24}
+}
+
+func (m *LocalLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LocalLogs.Unmarshal(m, b)
+}
+func (m *LocalLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LocalLogs.Marshal(b, m, deterministic)
+}
+func (m *LocalLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LocalLogs.Merge(m, src)
+}
+func (m *LocalLogs) XXX_Size() int {
+	return xxx_messageInfo_LocalLogs.Size(m)
+}
+func (m *LocalLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_LocalLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LocalLogs proto.InternalMessageInfo
+
+func (m *LocalLogs) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *LocalLogs) GetTxIndex() string {
+	if m != nil {
+		return m.TxIndex
+	}
+	return ""
+}
+
+func (m *LocalLogs) GetActionType() int32 {
+	if m != nil {
+		return m.ActionType
+	}
+	return 0
+}
+
+func (m *LocalLogs) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// query
+type ReqTokens struct {
+	QueryAll             bool     `protobuf:"varint,1,opt,name=queryAll,proto3" json:"queryAll,omitempty"`
+	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Tokens               []string `protobuf:"bytes,3,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	SymbolOnly           bool     `protobuf:"varint,4,opt,name=symbolOnly,proto3" json:"symbolOnly,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokens) Reset()         { *m = ReqTokens{} }
+func (m *ReqTokens) String() string { return proto.CompactTextString(m) }
+func (*ReqTokens) ProtoMessage()    {}
+func (*ReqTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{25}
+}
+
+func (m *ReqTokens) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokens.Unmarshal(m, b)
+}
+func (m *ReqTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokens.Marshal(b, m, deterministic)
+}
+func (m *ReqTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokens.Merge(m, src)
+}
+func (m *ReqTokens) XXX_Size() int {
+	return xxx_messageInfo_ReqTokens.Size(m)
+}
+func (m *ReqTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokens proto.InternalMessageInfo
+
+func (m *ReqTokens) GetQueryAll() bool {
+	if m != nil {
+		return m.QueryAll
+	}
+	return false
+}
+
+func (m *ReqTokens) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReqTokens) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+func (m *ReqTokens) GetSymbolOnly() bool {
+	if m != nil {
+		return m.SymbolOnly
+	}
+	return false
+}
+
+type ReplyTokens struct {
+	Tokens               []*LocalToken `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyTokens) Reset()         { *m = ReplyTokens{} }
+func (m *ReplyTokens) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokens) ProtoMessage()    {}
+func (*ReplyTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{26}
+}
+
+func (m *ReplyTokens) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokens.Unmarshal(m, b)
+}
+func (m *ReplyTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokens.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokens.Merge(m, src)
+}
+func (m *ReplyTokens) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokens.Size(m)
+}
+func (m *ReplyTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokens proto.InternalMessageInfo
+
+func (m *ReplyTokens) GetTokens() []*LocalToken {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type TokenRecv struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Recv                 int64    `protobuf:"varint,2,opt,name=recv,proto3" json:"recv,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenRecv) Reset()         { *m = TokenRecv{} }
+func (m *TokenRecv) String() string { return proto.CompactTextString(m) }
+func (*TokenRecv) ProtoMessage()    {}
+func (*TokenRecv) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{27}
+}
+
+func (m *TokenRecv) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenRecv.Unmarshal(m, b)
+}
+func (m *TokenRecv) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenRecv.Marshal(b, m, deterministic)
+}
+func (m *TokenRecv) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenRecv.Merge(m, src)
+}
+func (m *TokenRecv) XXX_Size() int {
+	return xxx_messageInfo_TokenRecv.Size(m)
+}
+func (m *TokenRecv) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenRecv.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenRecv proto.InternalMessageInfo
+
+func (m *TokenRecv) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *TokenRecv) GetRecv() int64 {
+	if m != nil {
+		return m.Recv
+	}
+	return 0
+}
+
+type ReplyAddrRecvForTokens struct {
+	TokenRecvs           []*TokenRecv `protobuf:"bytes,1,rep,name=tokenRecvs,proto3" json:"tokenRecvs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReplyAddrRecvForTokens) Reset()         { *m = ReplyAddrRecvForTokens{} }
+func (m *ReplyAddrRecvForTokens) String() string { return proto.CompactTextString(m) }
+func (*ReplyAddrRecvForTokens) ProtoMessage()    {}
+func (*ReplyAddrRecvForTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{28}
+}
+
+func (m *ReplyAddrRecvForTokens) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyAddrRecvForTokens.Unmarshal(m, b)
+}
+func (m *ReplyAddrRecvForTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyAddrRecvForTokens.Marshal(b, m, deterministic)
+}
+func (m *ReplyAddrRecvForTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyAddrRecvForTokens.Merge(m, src)
+}
+func (m *ReplyAddrRecvForTokens) XXX_Size() int {
+	return xxx_messageInfo_ReplyAddrRecvForTokens.Size(m)
+}
+func (m *ReplyAddrRecvForTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyAddrRecvForTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyAddrRecvForTokens proto.InternalMessageInfo
+
+func (m *ReplyAddrRecvForTokens) GetTokenRecvs() []*TokenRecv {
+	if m != nil {
+		return m.TokenRecvs
+	}
+	return nil
+}
+
+type ReqTokenBalance struct {
+	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	TokenSymbol          string   `protobuf:"bytes,2,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Execer               string   `protobuf:"bytes,3,opt,name=execer,proto3" json:"execer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenBalance) Reset()         { *m = ReqTokenBalance{} }
+func (m *ReqTokenBalance) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenBalance) ProtoMessage()    {}
+func (*ReqTokenBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{29}
+}
+
+func (m *ReqTokenBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenBalance.Unmarshal(m, b)
+}
+func (m *ReqTokenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenBalance.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenBalance.Merge(m, src)
+}
+func (m *ReqTokenBalance) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenBalance.Size(m)
+}
+func (m *ReqTokenBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenBalance proto.InternalMessageInfo
+
+func (m *ReqTokenBalance) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *ReqTokenBalance) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReqTokenBalance) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+type ReqAccountTokenAssets struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Execer               string   `protobuf:"bytes,2,opt,name=execer,proto3" json:"execer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqAccountTokenAssets) Reset()         { *m = ReqAccountTokenAssets{} }
+func (m *ReqAccountTokenAssets) String() string { return proto.CompactTextString(m) }
+func (*ReqAccountTokenAssets) ProtoMessage()    {}
+func (*ReqAccountTokenAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{30}
+}
+
+func (m *ReqAccountTokenAssets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqAccountTokenAssets.Unmarshal(m, b)
+}
+func (m *ReqAccountTokenAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqAccountTokenAssets.Marshal(b, m, deterministic)
+}
+func (m *ReqAccountTokenAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAccountTokenAssets.Merge(m, src)
+}
+func (m *ReqAccountTokenAssets) XXX_Size() int {
+	return xxx_messageInfo_ReqAccountTokenAssets.Size(m)
+}
+func (m *ReqAccountTokenAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqAccountTokenAssets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqAccountTokenAssets proto.InternalMessageInfo
+
+func (m *ReqAccountTokenAssets) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReqAccountTokenAssets) GetExecer() string {
+	if m != nil {
+		return m.Execer
+	}
+	return ""
+}
+
+type TokenAsset struct {
+	Symbol               string         `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Account              *types.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *TokenAsset) Reset()         { *m = TokenAsset{} }
+func (m *TokenAsset) String() string { return proto.CompactTextString(m) }
+func (*TokenAsset) ProtoMessage()    {}
+func (*TokenAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{31}
+}
+
+func (m *TokenAsset) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAsset.Unmarshal(m, b)
+}
+func (m *TokenAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAsset.Marshal(b, m, deterministic)
+}
+func (m *TokenAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAsset.Merge(m, src)
+}
+func (m *TokenAsset) XXX_Size() int {
+	return xxx_messageInfo_TokenAsset.Size(m)
+}
+func (m *TokenAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenAsset proto.InternalMessageInfo
+
+func (m *TokenAsset) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenAsset) GetAccount() *types.Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type ReplyAccountTokenAssets struct {
+	TokenAssets          []*TokenAsset `protobuf:"bytes,1,rep,name=tokenAssets,proto3" json:"tokenAssets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ReplyAccountTokenAssets) Reset()         { *m = ReplyAccountTokenAssets{} }
+func (m *ReplyAccountTokenAssets) String() string { return proto.CompactTextString(m) }
+func (*ReplyAccountTokenAssets) ProtoMessage()    {}
+func (*ReplyAccountTokenAssets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{32}
+}
+
+func (m *ReplyAccountTokenAssets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyAccountTokenAssets.Unmarshal(m, b)
+}
+func (m *ReplyAccountTokenAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyAccountTokenAssets.Marshal(b, m, deterministic)
+}
+func (m *ReplyAccountTokenAssets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyAccountTokenAssets.Merge(m, src)
+}
+func (m *ReplyAccountTokenAssets) XXX_Size() int {
+	return xxx_messageInfo_ReplyAccountTokenAssets.Size(m)
+}
+func (m *ReplyAccountTokenAssets) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyAccountTokenAssets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyAccountTokenAssets proto.InternalMessageInfo
+
+func (m *ReplyAccountTokenAssets) GetTokenAssets() []*TokenAsset {
+	if m != nil {
+		return m.TokenAssets
+	}
+	return nil
+}
+
+type ReqAddrTokens struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Token                []string `protobuf:"bytes,3,rep,name=token,proto3" json:"token,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Count                int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	FromKey              string   `protobuf:"bytes,6,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqAddrTokens) Reset()         { *m = ReqAddrTokens{} }
+func (m *ReqAddrTokens) String() string { return proto.CompactTextString(m) }
+func (*ReqAddrTokens) ProtoMessage()    {}
+func (*ReqAddrTokens) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{33}
+}
+
+func (m *ReqAddrTokens) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqAddrTokens.Unmarshal(m, b)
+}
+func (m *ReqAddrTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqAddrTokens.Marshal(b, m, deterministic)
+}
+func (m *ReqAddrTokens) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqAddrTokens.Merge(m, src)
+}
+func (m *ReqAddrTokens) XXX_Size() int {
+	return xxx_messageInfo_ReqAddrTokens.Size(m)
+}
+func (m *ReqAddrTokens) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqAddrTokens.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqAddrTokens proto.InternalMessageInfo
+
+func (m *ReqAddrTokens) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *ReqAddrTokens) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ReqAddrTokens) GetToken() []string {
+	if m != nil {
+		return m.Token
+	}
+	return nil
+}
+
+func (m *ReqAddrTokens) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqAddrTokens) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqAddrTokens) GetFromKey() string {
+	if m != nil {
+		return m.FromKey
+	}
+	return ""
+}
+
+type ReqTokenTx struct {
+	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	//表示取所有/from/to/其他的hash列表
+	Flag                 int32    `protobuf:"varint,2,opt,name=flag,proto3" json:"flag,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
+	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
+	Addr                 string   `protobuf:"bytes,7,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenTx) Reset()         { *m = ReqTokenTx{} }
+func (m *ReqTokenTx) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenTx) ProtoMessage()    {}
+func (*ReqTokenTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{34}
+}
+
+func (m *ReqTokenTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenTx.Unmarshal(m, b)
+}
+func (m *ReqTokenTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenTx.Marshal(b, m, deterministic)
+}
+func (m *ReqTokenTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenTx.Merge(m, src)
+}
+func (m *ReqTokenTx) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenTx.Size(m)
+}
+func (m *ReqTokenTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqTokenTx proto.InternalMessageInfo
+
+func (m *ReqTokenTx) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReqTokenTx) GetFlag() int32 {
+	if m != nil {
+		return m.Flag
+	}
+	return 0
+}
+
+func (m *ReqTokenTx) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ReqTokenTx) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+func (m *ReqTokenTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReqTokenTx) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReqTokenTx) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type ReplyTokenLogs struct {
+	Logs                 []*LocalLogs `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReplyTokenLogs) Reset()         { *m = ReplyTokenLogs{} }
+func (m *ReplyTokenLogs) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenLogs) ProtoMessage()    {}
+func (*ReplyTokenLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{35}
+}
+
+func (m *ReplyTokenLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenLogs.Unmarshal(m, b)
+}
+func (m *ReplyTokenLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenLogs.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenLogs.Merge(m, src)
+}
+func (m *ReplyTokenLogs) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenLogs.Size(m)
+}
+func (m *ReplyTokenLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenLogs proto.InternalMessageInfo
+
+func (m *ReplyTokenLogs) GetLogs() []*LocalLogs {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+type ReqTokenRestriction struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Addresses            []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqTokenRestriction) Reset()         { *m = ReqTokenRestriction{} }
+func (m *ReqTokenRestriction) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenRestriction) ProtoMessage()    {}
+func (*ReqTokenRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{36}
+}
+
+func (m *ReqTokenRestriction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenRestriction.Unmarshal(m, b)
+}
+func (m *ReqTokenRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenRestriction.Marshal(b, m, deterministic)
 }
-func (m *ReqAccountTokenAssets) XXX_Size() int {
-	return xxx_messageInfo_ReqAccountTokenAssets.Size(m)
+func (m *ReqTokenRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenRestriction.Merge(m, src)
 }
-func (m *ReqAccountTokenAssets) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqAccountTokenAssets.DiscardUnknown(m)
+func (m *ReqTokenRestriction) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenRestriction.Size(m)
+}
+func (m *ReqTokenRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenRestriction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqAccountTokenAssets proto.InternalMessageInfo
+var xxx_messageInfo_ReqTokenRestriction proto.InternalMessageInfo
 
-func (m *ReqAccountTokenAssets) GetAddress() string {
+func (m *ReqTokenRestriction) GetSymbol() string {
 	if m != nil {
-		return m.Address
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *ReqAccountTokenAssets) GetExecer() string {
+func (m *ReqTokenRestriction) GetAddresses() []string {
 	if m != nil {
-		return m.Execer
+		return m.Addresses
 	}
-	return ""
+	return nil
 }
 
-type TokenAsset struct {
-	Symbol               string         `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	Account              *types.Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type TokenAddrRestriction struct {
+	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Whitelisted          bool     `protobuf:"varint,2,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+	Frozen               bool     `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TokenAsset) Reset()         { *m = TokenAsset{} }
-func (m *TokenAsset) String() string { return proto.CompactTextString(m) }
-func (*TokenAsset) ProtoMessage()    {}
-func (*TokenAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{17}
+func (m *TokenAddrRestriction) Reset()         { *m = TokenAddrRestriction{} }
+func (m *TokenAddrRestriction) String() string { return proto.CompactTextString(m) }
+func (*TokenAddrRestriction) ProtoMessage()    {}
+func (*TokenAddrRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{37}
 }
 
-func (m *TokenAsset) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_TokenAsset.Unmarshal(m, b)
+func (m *TokenAddrRestriction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenAddrRestriction.Unmarshal(m, b)
 }
-func (m *TokenAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_TokenAsset.Marshal(b, m, deterministic)
+func (m *TokenAddrRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenAddrRestriction.Marshal(b, m, deterministic)
 }
-func (m *TokenAsset) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TokenAsset.Merge(m, src)
+func (m *TokenAddrRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenAddrRestriction.Merge(m, src)
 }
-func (m *TokenAsset) XXX_Size() int {
-	return xxx_messageInfo_TokenAsset.Size(m)
+func (m *TokenAddrRestriction) XXX_Size() int {
+	return xxx_messageInfo_TokenAddrRestriction.Size(m)
 }
-func (m *TokenAsset) XXX_DiscardUnknown() {
-	xxx_messageInfo_TokenAsset.DiscardUnknown(m)
+func (m *TokenAddrRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenAddrRestriction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TokenAsset proto.InternalMessageInfo
+var xxx_messageInfo_TokenAddrRestriction proto.InternalMessageInfo
 
-func (m *TokenAsset) GetSymbol() string {
+func (m *TokenAddrRestriction) GetAddr() string {
 	if m != nil {
-		return m.Symbol
+		return m.Addr
 	}
 	return ""
 }
 
-func (m *TokenAsset) GetAccount() *types.Account {
+func (m *TokenAddrRestriction) GetWhitelisted() bool {
 	if m != nil {
-		return m.Account
+		return m.Whitelisted
 	}
-	return nil
+	return false
 }
 
-type ReplyAccountTokenAssets struct {
-	TokenAssets          []*TokenAsset `protobuf:"bytes,1,rep,name=tokenAssets,proto3" json:"tokenAssets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *TokenAddrRestriction) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
 }
 
-func (m *ReplyAccountTokenAssets) Reset()         { *m = ReplyAccountTokenAssets{} }
-func (m *ReplyAccountTokenAssets) String() string { return proto.CompactTextString(m) }
-func (*ReplyAccountTokenAssets) ProtoMessage()    {}
-func (*ReplyAccountTokenAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{18}
+type ReplyTokenRestriction struct {
+	Symbol               string                  `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	WhitelistOnly        bool                    `protobuf:"varint,2,opt,name=whitelistOnly,proto3" json:"whitelistOnly,omitempty"`
+	Addrs                []*TokenAddrRestriction `protobuf:"bytes,3,rep,name=addrs,proto3" json:"addrs,omitempty"`
+	Paused               bool                    `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
-func (m *ReplyAccountTokenAssets) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyAccountTokenAssets.Unmarshal(m, b)
+func (m *ReplyTokenRestriction) Reset()         { *m = ReplyTokenRestriction{} }
+func (m *ReplyTokenRestriction) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenRestriction) ProtoMessage()    {}
+func (*ReplyTokenRestriction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{38}
 }
-func (m *ReplyAccountTokenAssets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyAccountTokenAssets.Marshal(b, m, deterministic)
+
+func (m *ReplyTokenRestriction) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenRestriction.Unmarshal(m, b)
 }
-func (m *ReplyAccountTokenAssets) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyAccountTokenAssets.Merge(m, src)
+func (m *ReplyTokenRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenRestriction.Marshal(b, m, deterministic)
 }
-func (m *ReplyAccountTokenAssets) XXX_Size() int {
-	return xxx_messageInfo_ReplyAccountTokenAssets.Size(m)
+func (m *ReplyTokenRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenRestriction.Merge(m, src)
 }
-func (m *ReplyAccountTokenAssets) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyAccountTokenAssets.DiscardUnknown(m)
+func (m *ReplyTokenRestriction) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenRestriction.Size(m)
+}
+func (m *ReplyTokenRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenRestriction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyAccountTokenAssets proto.InternalMessageInfo
+var xxx_messageInfo_ReplyTokenRestriction proto.InternalMessageInfo
 
-func (m *ReplyAccountTokenAssets) GetTokenAssets() []*TokenAsset {
+func (m *ReplyTokenRestriction) GetSymbol() string {
 	if m != nil {
-		return m.TokenAssets
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReplyTokenRestriction) GetWhitelistOnly() bool {
+	if m != nil {
+		return m.WhitelistOnly
+	}
+	return false
+}
+
+func (m *ReplyTokenRestriction) GetAddrs() []*TokenAddrRestriction {
+	if m != nil {
+		return m.Addrs
 	}
 	return nil
 }
 
-type ReqAddrTokens struct {
-	Addr                 string   `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
-	Status               int32    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	Token                []string `protobuf:"bytes,3,rep,name=token,proto3" json:"token,omitempty"`
+func (m *ReplyTokenRestriction) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+type ReqTokenFrozenHolders struct {
+	Symbol               string   `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
-	Count                int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	FromKey              string   `protobuf:"bytes,6,opt,name=fromKey,proto3" json:"fromKey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqAddrTokens) Reset()         { *m = ReqAddrTokens{} }
-func (m *ReqAddrTokens) String() string { return proto.CompactTextString(m) }
-func (*ReqAddrTokens) ProtoMessage()    {}
-func (*ReqAddrTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{19}
+func (m *ReqTokenFrozenHolders) Reset()         { *m = ReqTokenFrozenHolders{} }
+func (m *ReqTokenFrozenHolders) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenFrozenHolders) ProtoMessage()    {}
+func (*ReqTokenFrozenHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{39}
 }
 
-func (m *ReqAddrTokens) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqAddrTokens.Unmarshal(m, b)
+func (m *ReqTokenFrozenHolders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenFrozenHolders.Unmarshal(m, b)
 }
-func (m *ReqAddrTokens) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqAddrTokens.Marshal(b, m, deterministic)
+func (m *ReqTokenFrozenHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenFrozenHolders.Marshal(b, m, deterministic)
 }
-func (m *ReqAddrTokens) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqAddrTokens.Merge(m, src)
+func (m *ReqTokenFrozenHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenFrozenHolders.Merge(m, src)
 }
-func (m *ReqAddrTokens) XXX_Size() int {
-	return xxx_messageInfo_ReqAddrTokens.Size(m)
+func (m *ReqTokenFrozenHolders) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenFrozenHolders.Size(m)
 }
-func (m *ReqAddrTokens) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqAddrTokens.DiscardUnknown(m)
+func (m *ReqTokenFrozenHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenFrozenHolders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqAddrTokens proto.InternalMessageInfo
+var xxx_messageInfo_ReqTokenFrozenHolders proto.InternalMessageInfo
 
-func (m *ReqAddrTokens) GetAddr() string {
+func (m *ReqTokenFrozenHolders) GetSymbol() string {
 	if m != nil {
-		return m.Addr
+		return m.Symbol
 	}
 	return ""
 }
 
-func (m *ReqAddrTokens) GetStatus() int32 {
+func (m *ReqTokenFrozenHolders) GetPrimaryKey() string {
 	if m != nil {
-		return m.Status
+		return m.PrimaryKey
 	}
-	return 0
+	return ""
 }
 
-func (m *ReqAddrTokens) GetToken() []string {
+func (m *ReqTokenFrozenHolders) GetCount() int32 {
 	if m != nil {
-		return m.Token
+		return m.Count
 	}
-	return nil
+	return 0
 }
 
-func (m *ReqAddrTokens) GetDirection() int32 {
+func (m *ReqTokenFrozenHolders) GetDirection() int32 {
 	if m != nil {
 		return m.Direction
 	}
 	return 0
 }
 
-func (m *ReqAddrTokens) GetCount() int32 {
-	if m != nil {
-		return m.Count
-	}
-	return 0
+type ReplyTokenFrozenHolders struct {
+	Holders              []*LocalTokenFrozen `protobuf:"bytes,1,rep,name=holders,proto3" json:"holders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *ReqAddrTokens) GetFromKey() string {
+func (m *ReplyTokenFrozenHolders) Reset()         { *m = ReplyTokenFrozenHolders{} }
+func (m *ReplyTokenFrozenHolders) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenFrozenHolders) ProtoMessage()    {}
+func (*ReplyTokenFrozenHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{40}
+}
+
+func (m *ReplyTokenFrozenHolders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenFrozenHolders.Unmarshal(m, b)
+}
+func (m *ReplyTokenFrozenHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenFrozenHolders.Marshal(b, m, deterministic)
+}
+func (m *ReplyTokenFrozenHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenFrozenHolders.Merge(m, src)
+}
+func (m *ReplyTokenFrozenHolders) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenFrozenHolders.Size(m)
+}
+func (m *ReplyTokenFrozenHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenFrozenHolders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplyTokenFrozenHolders proto.InternalMessageInfo
+
+func (m *ReplyTokenFrozenHolders) GetHolders() []*LocalTokenFrozen {
 	if m != nil {
-		return m.FromKey
+		return m.Holders
 	}
-	return ""
+	return nil
 }
 
-type ReqTokenTx struct {
+// 从快照高度的状态数据库中按账户key升序查询持有人
+type ReqTokenHolders struct {
 	Symbol string `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	//表示取所有/from/to/其他的hash列表
-	Flag                 int32    `protobuf:"varint,2,opt,name=flag,proto3" json:"flag,omitempty"`
-	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	Direction            int32    `protobuf:"varint,4,opt,name=direction,proto3" json:"direction,omitempty"`
-	Height               int64    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	Index                int64    `protobuf:"varint,6,opt,name=index,proto3" json:"index,omitempty"`
-	Addr                 string   `protobuf:"bytes,7,opt,name=addr,proto3" json:"addr,omitempty"`
+	// 快照的高度, 小于0时使用最新的高度
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// 上一页返回的primaryKey, 为空时从头开始
+	PrimaryKey           string   `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReqTokenTx) Reset()         { *m = ReqTokenTx{} }
-func (m *ReqTokenTx) String() string { return proto.CompactTextString(m) }
-func (*ReqTokenTx) ProtoMessage()    {}
-func (*ReqTokenTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{20}
+func (m *ReqTokenHolders) Reset()         { *m = ReqTokenHolders{} }
+func (m *ReqTokenHolders) String() string { return proto.CompactTextString(m) }
+func (*ReqTokenHolders) ProtoMessage()    {}
+func (*ReqTokenHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{41}
 }
 
-func (m *ReqTokenTx) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReqTokenTx.Unmarshal(m, b)
+func (m *ReqTokenHolders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqTokenHolders.Unmarshal(m, b)
 }
-func (m *ReqTokenTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReqTokenTx.Marshal(b, m, deterministic)
+func (m *ReqTokenHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqTokenHolders.Marshal(b, m, deterministic)
 }
-func (m *ReqTokenTx) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReqTokenTx.Merge(m, src)
+func (m *ReqTokenHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqTokenHolders.Merge(m, src)
 }
-func (m *ReqTokenTx) XXX_Size() int {
-	return xxx_messageInfo_ReqTokenTx.Size(m)
+func (m *ReqTokenHolders) XXX_Size() int {
+	return xxx_messageInfo_ReqTokenHolders.Size(m)
 }
-func (m *ReqTokenTx) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReqTokenTx.DiscardUnknown(m)
+func (m *ReqTokenHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqTokenHolders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReqTokenTx proto.InternalMessageInfo
+var xxx_messageInfo_ReqTokenHolders proto.InternalMessageInfo
 
-func (m *ReqTokenTx) GetSymbol() string {
+func (m *ReqTokenHolders) GetSymbol() string {
 	if m != nil {
 		return m.Symbol
 	}
 	return ""
 }
 
-func (m *ReqTokenTx) GetFlag() int32 {
+func (m *ReqTokenHolders) GetHeight() int64 {
 	if m != nil {
-		return m.Flag
+		return m.Height
 	}
 	return 0
 }
 
-func (m *ReqTokenTx) GetCount() int32 {
+func (m *ReqTokenHolders) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *ReqTokenHolders) GetCount() int32 {
 	if m != nil {
 		return m.Count
 	}
 	return 0
 }
 
-func (m *ReqTokenTx) GetDirection() int32 {
+type TokenHolder struct {
+	Addr    string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	Balance int64  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Frozen  int64  `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// 合约账户所在的执行器地址, 为空表示主账户
+	ExecAddr             string   `protobuf:"bytes,4,opt,name=execAddr,proto3" json:"execAddr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TokenHolder) Reset()         { *m = TokenHolder{} }
+func (m *TokenHolder) String() string { return proto.CompactTextString(m) }
+func (*TokenHolder) ProtoMessage()    {}
+func (*TokenHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{42}
+}
+
+func (m *TokenHolder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TokenHolder.Unmarshal(m, b)
+}
+func (m *TokenHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TokenHolder.Marshal(b, m, deterministic)
+}
+func (m *TokenHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenHolder.Merge(m, src)
+}
+func (m *TokenHolder) XXX_Size() int {
+	return xxx_messageInfo_TokenHolder.Size(m)
+}
+func (m *TokenHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenHolder proto.InternalMessageInfo
+
+func (m *TokenHolder) GetAddr() string {
 	if m != nil {
-		return m.Direction
+		return m.Addr
 	}
-	return 0
+	return ""
 }
 
-func (m *ReqTokenTx) GetHeight() int64 {
+func (m *TokenHolder) GetBalance() int64 {
 	if m != nil {
-		return m.Height
+		return m.Balance
 	}
 	return 0
 }
 
-func (m *ReqTokenTx) GetIndex() int64 {
+func (m *TokenHolder) GetFrozen() int64 {
 	if m != nil {
-		return m.Index
+		return m.Frozen
 	}
 	return 0
 }
 
-func (m *ReqTokenTx) GetAddr() string {
+func (m *TokenHolder) GetExecAddr() string {
 	if m != nil {
-		return m.Addr
+		return m.ExecAddr
 	}
 	return ""
 }
 
-type ReplyTokenLogs struct {
-	Logs                 []*LocalLogs `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type ReplyTokenHolders struct {
+	Symbol    string         `protobuf:"bytes,1,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Height    int64          `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	StateHash string         `protobuf:"bytes,3,opt,name=stateHash,proto3" json:"stateHash,omitempty"`
+	Holders   []*TokenHolder `protobuf:"bytes,4,rep,name=holders,proto3" json:"holders,omitempty"`
+	// 下一页的起始位置, 为空表示没有更多数据
+	PrimaryKey           string   `protobuf:"bytes,5,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplyTokenLogs) Reset()         { *m = ReplyTokenLogs{} }
-func (m *ReplyTokenLogs) String() string { return proto.CompactTextString(m) }
-func (*ReplyTokenLogs) ProtoMessage()    {}
-func (*ReplyTokenLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3aff0bcd502840ab, []int{21}
+func (m *ReplyTokenHolders) Reset()         { *m = ReplyTokenHolders{} }
+func (m *ReplyTokenHolders) String() string { return proto.CompactTextString(m) }
+func (*ReplyTokenHolders) ProtoMessage()    {}
+func (*ReplyTokenHolders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3aff0bcd502840ab, []int{43}
 }
 
-func (m *ReplyTokenLogs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplyTokenLogs.Unmarshal(m, b)
+func (m *ReplyTokenHolders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplyTokenHolders.Unmarshal(m, b)
 }
-func (m *ReplyTokenLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplyTokenLogs.Marshal(b, m, deterministic)
+func (m *ReplyTokenHolders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplyTokenHolders.Marshal(b, m, deterministic)
 }
-func (m *ReplyTokenLogs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplyTokenLogs.Merge(m, src)
+func (m *ReplyTokenHolders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplyTokenHolders.Merge(m, src)
 }
-func (m *ReplyTokenLogs) XXX_Size() int {
-	return xxx_messageInfo_ReplyTokenLogs.Size(m)
+func (m *ReplyTokenHolders) XXX_Size() int {
+	return xxx_messageInfo_ReplyTokenHolders.Size(m)
 }
-func (m *ReplyTokenLogs) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplyTokenLogs.DiscardUnknown(m)
+func (m *ReplyTokenHolders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplyTokenHolders.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplyTokenLogs proto.InternalMessageInfo
+var xxx_messageInfo_ReplyTokenHolders proto.InternalMessageInfo
 
-func (m *ReplyTokenLogs) GetLogs() []*LocalLogs {
+func (m *ReplyTokenHolders) GetSymbol() string {
 	if m != nil {
-		return m.Logs
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *ReplyTokenHolders) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ReplyTokenHolders) GetStateHash() string {
+	if m != nil {
+		return m.StateHash
+	}
+	return ""
+}
+
+func (m *ReplyTokenHolders) GetHolders() []*TokenHolder {
+	if m != nil {
+		return m.Holders
 	}
 	return nil
 }
 
+func (m *ReplyTokenHolders) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TokenAction)(nil), "types.TokenAction")
 	proto.RegisterType((*TokenPreCreate)(nil), "types.TokenPreCreate")
@@ -1525,10 +2952,24 @@ func init() {
 	proto.RegisterType((*TokenRevokeCreate)(nil), "types.TokenRevokeCreate")
 	proto.RegisterType((*TokenMint)(nil), "types.TokenMint")
 	proto.RegisterType((*TokenBurn)(nil), "types.TokenBurn")
+	proto.RegisterType((*TokenUpdateMetadata)(nil), "types.TokenUpdateMetadata")
+	proto.RegisterType((*TokenSetRestriction)(nil), "types.TokenSetRestriction")
+	proto.RegisterType((*TokenPause)(nil), "types.TokenPause")
+	proto.RegisterType((*TokenFreeze)(nil), "types.TokenFreeze")
+	proto.RegisterType((*TokenSeize)(nil), "types.TokenSeize")
+	proto.RegisterType((*TokenMultiSigExec)(nil), "types.TokenMultiSigExec")
 	proto.RegisterType((*Token)(nil), "types.Token")
+	proto.RegisterType((*TokenMetadata)(nil), "types.TokenMetadata")
+	proto.RegisterType((*TokenRestriction)(nil), "types.TokenRestriction")
 	proto.RegisterType((*ReceiptToken)(nil), "types.ReceiptToken")
 	proto.RegisterType((*ReceiptTokenAmount)(nil), "types.ReceiptTokenAmount")
+	proto.RegisterType((*ReceiptTokenMetadata)(nil), "types.ReceiptTokenMetadata")
+	proto.RegisterType((*ReceiptTokenRestriction)(nil), "types.ReceiptTokenRestriction")
+	proto.RegisterType((*ReceiptTokenPause)(nil), "types.ReceiptTokenPause")
+	proto.RegisterType((*ReceiptTokenFreeze)(nil), "types.ReceiptTokenFreeze")
+	proto.RegisterType((*ReceiptTokenSeize)(nil), "types.ReceiptTokenSeize")
 	proto.RegisterType((*LocalToken)(nil), "types.LocalToken")
+	proto.RegisterType((*LocalTokenFrozen)(nil), "types.LocalTokenFrozen")
 	proto.RegisterType((*LocalLogs)(nil), "types.LocalLogs")
 	proto.RegisterType((*ReqTokens)(nil), "types.ReqTokens")
 	proto.RegisterType((*ReplyTokens)(nil), "types.ReplyTokens")
@@ -1541,6 +2982,14 @@ func init() {
 	proto.RegisterType((*ReqAddrTokens)(nil), "types.ReqAddrTokens")
 	proto.RegisterType((*ReqTokenTx)(nil), "types.ReqTokenTx")
 	proto.RegisterType((*ReplyTokenLogs)(nil), "types.ReplyTokenLogs")
+	proto.RegisterType((*ReqTokenRestriction)(nil), "types.ReqTokenRestriction")
+	proto.RegisterType((*TokenAddrRestriction)(nil), "types.TokenAddrRestriction")
+	proto.RegisterType((*ReplyTokenRestriction)(nil), "types.ReplyTokenRestriction")
+	proto.RegisterType((*ReqTokenFrozenHolders)(nil), "types.ReqTokenFrozenHolders")
+	proto.RegisterType((*ReplyTokenFrozenHolders)(nil), "types.ReplyTokenFrozenHolders")
+	proto.RegisterType((*ReqTokenHolders)(nil), "types.ReqTokenHolders")
+	proto.RegisterType((*TokenHolder)(nil), "types.TokenHolder")
+	proto.RegisterType((*ReplyTokenHolders)(nil), "types.ReplyTokenHolders")
 }
 
 func init() {
@@ -1548,77 +2997,122 @@ func init() {
 }
 
 var fileDescriptor_3aff0bcd502840ab = []byte{
-	// 1115 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xdd, 0x6a, 0xe3, 0xc6,
-	0x17, 0xb7, 0x2d, 0x7f, 0x44, 0xc7, 0x89, 0x13, 0xcf, 0x7f, 0x37, 0x7f, 0x91, 0x2e, 0x8b, 0x11,
-	0x4b, 0x49, 0xa1, 0x84, 0x90, 0xd0, 0x52, 0x68, 0xa1, 0x38, 0x25, 0xbb, 0xde, 0x76, 0xbb, 0x2d,
-	0x53, 0x43, 0xef, 0x0a, 0x5a, 0xe9, 0xc4, 0x11, 0xb1, 0x25, 0x65, 0x34, 0x76, 0xec, 0xa7, 0xe9,
-	0x7d, 0x2f, 0xfa, 0x08, 0xbd, 0xe8, 0xcb, 0xf4, 0x35, 0xca, 0x9c, 0x19, 0xc9, 0x23, 0x3b, 0x2e,
-	0xe4, 0xae, 0xf4, 0x4e, 0xe7, 0xeb, 0x37, 0x73, 0xce, 0xf9, 0x9d, 0xe3, 0x31, 0x74, 0x65, 0x7a,
-	0x87, 0xc9, 0x59, 0x26, 0x52, 0x99, 0xb2, 0x96, 0x5c, 0x65, 0x98, 0x9f, 0xf4, 0xa5, 0x08, 0x92,
-	0x3c, 0x08, 0x65, 0x9c, 0x1a, 0xcb, 0xc9, 0x41, 0x10, 0x86, 0xe9, 0x3c, 0x91, 0x5a, 0xf4, 0xff,
-	0x6c, 0x42, 0x77, 0xac, 0x02, 0x87, 0xe4, 0xc4, 0xbe, 0x86, 0x1e, 0xe1, 0xfc, 0x28, 0xf0, 0x1b,
-	0x81, 0x81, 0x44, 0xaf, 0x3e, 0xa8, 0x9f, 0x76, 0x2f, 0x9e, 0x9f, 0x11, 0xe2, 0xd9, 0xb8, 0x62,
-	0x1c, 0xd5, 0xf8, 0x86, 0x3b, 0x1b, 0x41, 0x9f, 0x34, 0xaf, 0xe3, 0x24, 0xce, 0x6f, 0x0d, 0x46,
-	0x83, 0x30, 0x3c, 0x1b, 0xc3, 0xb6, 0x8f, 0x6a, 0x7c, 0x3b, 0xa8, 0x44, 0xe2, 0xb8, 0x48, 0xef,
-	0x8a, 0xdb, 0x38, 0xdb, 0x48, 0xb6, 0xbd, 0x44, 0xb2, 0x95, 0xec, 0x12, 0xf6, 0xa8, 0x10, 0x37,
-	0x28, 0xbc, 0x66, 0x25, 0x9d, 0x61, 0x9e, 0xa3, 0xcc, 0xc7, 0xc6, 0x38, 0xaa, 0xf1, 0xd2, 0x51,
-	0x05, 0x3d, 0xc4, 0xf2, 0x36, 0x12, 0xc1, 0x83, 0xd7, 0x7a, 0x24, 0xe8, 0x67, 0x63, 0x54, 0x41,
-	0x85, 0x23, 0x3b, 0x87, 0xce, 0x04, 0x13, 0xcc, 0xe3, 0xdc, 0x6b, 0x53, 0xcc, 0xb3, 0x4a, 0xcc,
-	0x1b, 0x6d, 0x1b, 0xd5, 0x78, 0xe1, 0xc6, 0xae, 0xa1, 0x57, 0x1c, 0x39, 0x4e, 0xaf, 0x97, 0x18,
-	0x7a, 0x7b, 0x14, 0xf8, 0xd1, 0xa3, 0x37, 0xd4, 0x2e, 0x54, 0xf6, 0x8a, 0x86, 0x9d, 0x83, 0x4b,
-	0x79, 0x7f, 0x1f, 0x27, 0xd2, 0x73, 0x09, 0xe1, 0xc8, 0x2e, 0x92, 0xd2, 0x8f, 0x6a, 0x7c, 0xed,
-	0x54, 0x46, 0x5c, 0xcd, 0x45, 0xe2, 0xc1, 0x76, 0x84, 0xd2, 0x97, 0x11, 0x4a, 0x60, 0x3d, 0x68,
-	0x8c, 0x57, 0x5e, 0x67, 0x50, 0x3f, 0x6d, 0xf1, 0xc6, 0x78, 0x75, 0xd5, 0x81, 0xd6, 0x22, 0x98,
-	0xce, 0xd1, 0xff, 0xa3, 0x0e, 0xbd, 0x2a, 0x31, 0x18, 0x83, 0x66, 0x12, 0xcc, 0x34, 0x7b, 0x5c,
-	0x4e, 0xdf, 0xec, 0x18, 0xda, 0xf9, 0x6a, 0xf6, 0x21, 0x9d, 0x12, 0x1f, 0x5c, 0x6e, 0x24, 0xe6,
-	0xc3, 0x7e, 0x9c, 0x48, 0x91, 0x46, 0x73, 0xe2, 0x20, 0xf5, 0xd8, 0xe5, 0x15, 0x1d, 0x7b, 0x06,
-	0x2d, 0x99, 0xca, 0x60, 0x4a, 0xfd, 0x73, 0xb8, 0x16, 0x94, 0x36, 0x13, 0x71, 0x88, 0xd4, 0x20,
-	0x87, 0x6b, 0x41, 0x69, 0xd3, 0x87, 0x04, 0x05, 0xb5, 0xc0, 0xe5, 0x5a, 0x60, 0x27, 0xb0, 0x17,
-	0x06, 0x12, 0x27, 0xa9, 0x28, 0x72, 0x28, 0x65, 0x7f, 0x08, 0xfd, 0x2d, 0x52, 0x5a, 0xd7, 0xad,
-	0x57, 0xae, 0x5b, 0xc2, 0x37, 0x2c, 0xf8, 0x12, 0xa2, 0x42, 0xbc, 0xa7, 0x41, 0x7c, 0x09, 0x6e,
-	0xd9, 0xab, 0x9d, 0xa1, 0xc7, 0xd0, 0x0e, 0x66, 0x6a, 0x80, 0x29, 0xd6, 0xe1, 0x46, 0x2a, 0x83,
-	0xa9, 0x53, 0x4f, 0x0d, 0xfe, 0xab, 0x0e, 0x2d, 0x8a, 0xfe, 0x17, 0xf6, 0xcd, 0x83, 0x4e, 0xa8,
-	0xaa, 0x99, 0x0a, 0x6a, 0x9b, 0xcb, 0x0b, 0x91, 0xee, 0x25, 0x03, 0x39, 0xcf, 0x69, 0x64, 0x5a,
-	0xdc, 0x48, 0x95, 0x4e, 0xbb, 0x1b, 0x9d, 0x1e, 0xc3, 0x3e, 0xc7, 0x10, 0xe3, 0x4c, 0xea, 0x7c,
-	0x9f, 0xd4, 0x21, 0xeb, 0x44, 0xc7, 0x3e, 0xd1, 0xff, 0x05, 0x98, 0x8d, 0x3a, 0xa4, 0xaa, 0xb2,
-	0x01, 0x34, 0x33, 0x81, 0x0b, 0xb3, 0x41, 0xf7, 0x2b, 0x3b, 0x8b, 0x2c, 0xec, 0x63, 0xe8, 0x84,
-	0x73, 0x21, 0xd0, 0x34, 0x64, 0xd3, 0xa9, 0x30, 0xfa, 0xbf, 0x35, 0x01, 0xde, 0xa5, 0x61, 0x30,
-	0xfd, 0xef, 0x34, 0xe9, 0x15, 0x1c, 0x90, 0x0b, 0x46, 0x23, 0x8c, 0x27, 0xb7, 0x7a, 0x69, 0x39,
-	0xbc, 0xaa, 0x64, 0x03, 0xe8, 0x1a, 0xc5, 0x38, 0x9e, 0x21, 0xad, 0x29, 0x87, 0xdb, 0x2a, 0x76,
-	0x0e, 0xff, 0xcb, 0x04, 0x66, 0x41, 0xf9, 0x93, 0xa4, 0xd1, 0xba, 0xe4, 0xf9, 0x98, 0x89, 0x7d,
-	0x0a, 0xfd, 0x8a, 0x9a, 0x90, 0xf7, 0xc9, 0x7f, 0xdb, 0xc0, 0x5e, 0x80, 0x9b, 0x09, 0x0c, 0xe3,
-	0x5c, 0x15, 0xef, 0x80, 0x52, 0x58, 0x2b, 0xd8, 0x19, 0x30, 0x2a, 0x56, 0xb9, 0x9f, 0xe3, 0x19,
-	0xe6, 0x5e, 0x8f, 0xc0, 0x1e, 0xb1, 0xa8, 0xac, 0x05, 0x2d, 0x88, 0x22, 0xeb, 0x43, 0x9d, 0x75,
-	0x45, 0xa9, 0xb2, 0x36, 0x0a, 0xba, 0xdb, 0x91, 0xce, 0xda, 0x52, 0x55, 0x28, 0xde, 0xdf, 0xa0,
-	0xf8, 0x1c, 0x5c, 0xe2, 0xca, 0xbb, 0x74, 0x92, 0xef, 0xe4, 0xb7, 0x07, 0x1d, 0xb9, 0x7c, 0x9b,
-	0x44, 0xb8, 0x34, 0x7c, 0x29, 0x44, 0xf6, 0x12, 0x40, 0x3f, 0x18, 0xc6, 0xab, 0x0c, 0x0d, 0xcf,
-	0x2d, 0x8d, 0x42, 0x94, 0xcb, 0x51, 0x90, 0xdf, 0x12, 0x5b, 0x5c, 0x6e, 0x24, 0xff, 0x01, 0x5c,
-	0x8e, 0xf7, 0x44, 0x50, 0x1a, 0xc1, 0xfb, 0x39, 0x8a, 0xd5, 0x70, 0xaa, 0x0f, 0xde, 0xe3, 0xa5,
-	0x6c, 0x31, 0xa2, 0x51, 0x61, 0x84, 0x02, 0xa6, 0x68, 0xcf, 0x19, 0x38, 0x04, 0xac, 0xb1, 0x5e,
-	0x02, 0xe8, 0x4b, 0xff, 0x90, 0x4c, 0x57, 0x74, 0xe8, 0x1e, 0xb7, 0x34, 0xfe, 0x17, 0xd0, 0xe5,
-	0x98, 0x4d, 0x57, 0xe6, 0xe8, 0x4f, 0x4a, 0x98, 0xfa, 0xc0, 0x39, 0xed, 0x5e, 0xf4, 0xcd, 0x48,
-	0xad, 0xe7, 0xa7, 0x40, 0xf6, 0x3f, 0x33, 0x3b, 0x93, 0x63, 0xb8, 0xd0, 0x43, 0x70, 0x87, 0x89,
-	0x29, 0x94, 0x16, 0xd4, 0xa8, 0x09, 0x0c, 0x17, 0x66, 0x5f, 0xd2, 0xb7, 0xff, 0x2d, 0x1c, 0xd3,
-	0x81, 0xc3, 0x28, 0x12, 0x2a, 0xf4, 0x75, 0x2a, 0xcc, 0xd9, 0xe7, 0x00, 0xb2, 0x00, 0x2c, 0xce,
-	0x3f, 0xaa, 0xbe, 0x55, 0xc2, 0x05, 0xb7, 0x7c, 0xfc, 0x18, 0x0e, 0x8b, 0xaa, 0x5d, 0x05, 0xd3,
-	0x20, 0x09, 0x89, 0x71, 0x41, 0x14, 0x09, 0xcc, 0x73, 0xd4, 0x18, 0x2e, 0x5f, 0x2b, 0x14, 0x37,
-	0x28, 0xfc, 0x27, 0x7b, 0xd8, 0x6d, 0x95, 0xaa, 0x23, 0x2e, 0x31, 0x44, 0x61, 0x66, 0xdd, 0x48,
-	0xfe, 0x5b, 0x78, 0xce, 0xf1, 0x7e, 0xa8, 0x9f, 0x7f, 0x7a, 0x4f, 0xd1, 0xdb, 0x42, 0x71, 0xc1,
-	0xe0, 0x9b, 0xdc, 0x0b, 0xd1, 0x82, 0x6a, 0x54, 0xa0, 0xde, 0x03, 0xac, 0x01, 0x76, 0x72, 0xec,
-	0x14, 0x3a, 0xe6, 0xb1, 0x69, 0xb6, 0x5b, 0xaf, 0x78, 0xd3, 0x68, 0x2d, 0x2f, 0xcc, 0xfe, 0x7b,
-	0xf8, 0xbf, 0xae, 0xe8, 0xf6, 0xe5, 0x2e, 0x4d, 0xbe, 0x5a, 0xdc, 0xe8, 0xe9, 0xda, 0x91, 0xdb,
-	0x5e, 0xfe, 0xaf, 0x75, 0x38, 0x50, 0xb9, 0x46, 0x51, 0xd1, 0x19, 0x06, 0x4d, 0x95, 0x54, 0xb1,
-	0x32, 0xd5, 0xf7, 0x4e, 0x22, 0x96, 0x4c, 0xd0, 0x3c, 0x34, 0x4c, 0x78, 0x01, 0x6e, 0x14, 0x0b,
-	0xd4, 0x5b, 0xb4, 0xa9, 0x17, 0x41, 0xa9, 0x50, 0x31, 0x3a, 0xd3, 0x16, 0x59, 0xb4, 0xa0, 0x2a,
-	0x7b, 0x23, 0xd2, 0xd9, 0x77, 0xb8, 0x32, 0xeb, 0xb2, 0x10, 0xfd, 0xdf, 0xeb, 0x00, 0x45, 0xe3,
-	0xc7, 0xcb, 0x9d, 0x25, 0x64, 0xd0, 0xbc, 0x99, 0x06, 0x13, 0x73, 0x41, 0xfa, 0x5e, 0x1f, 0xe5,
-	0xd8, 0x47, 0xfd, 0xf3, 0xf5, 0x8e, 0xa1, 0x7d, 0xab, 0x17, 0x8e, 0x5e, 0xe6, 0x46, 0x52, 0x58,
-	0x31, 0x2d, 0x81, 0xb6, 0xde, 0xf1, 0x24, 0x94, 0xc5, 0xea, 0xac, 0x8b, 0xe5, 0x7f, 0x0e, 0xbd,
-	0xf5, 0x94, 0xd1, 0x6a, 0x79, 0x05, 0xcd, 0x69, 0x3a, 0xd9, 0xa4, 0x79, 0xb9, 0x7a, 0x38, 0x59,
-	0x2f, 0xae, 0x4d, 0x31, 0xd9, 0x57, 0x70, 0xf8, 0x06, 0x65, 0x85, 0xe9, 0xc7, 0x26, 0x66, 0x63,
-	0x02, 0x4e, 0x0e, 0xab, 0x3c, 0xc9, 0xfd, 0xda, 0x87, 0x36, 0xfd, 0x5d, 0xb9, 0xfc, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xe2, 0xae, 0x58, 0x34, 0xe6, 0x0c, 0x00, 0x00,
+	// 1832 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x8e, 0x1b, 0x49,
+	0x15, 0xb6, 0xdd, 0xf6, 0x78, 0x7c, 0x3c, 0xbf, 0x95, 0xc9, 0xa4, 0x35, 0xac, 0x56, 0xa3, 0x56,
+	0x84, 0x06, 0xb1, 0x1a, 0x25, 0x1b, 0xb1, 0x42, 0x62, 0x05, 0x9a, 0xa0, 0x64, 0x1d, 0xf2, 0xc3,
+	0x52, 0xf1, 0x6a, 0xc5, 0x0d, 0x52, 0xa7, 0xfb, 0x64, 0xa6, 0x15, 0xbb, 0xdb, 0xe9, 0x2e, 0xcf,
+	0x8c, 0x23, 0xee, 0xf6, 0x8a, 0x47, 0xe0, 0x0a, 0xae, 0xb9, 0x80, 0x37, 0xe0, 0x11, 0x78, 0x09,
+	0x2e, 0x78, 0x0d, 0x54, 0xa7, 0x7e, 0xba, 0xaa, 0x6d, 0x0f, 0x24, 0x5a, 0x89, 0x88, 0xbb, 0x3e,
+	0xa7, 0xce, 0x7f, 0x9d, 0xf3, 0x55, 0x95, 0x0d, 0x43, 0x51, 0xbc, 0xc1, 0xfc, 0x74, 0x56, 0x16,
+	0xa2, 0x60, 0x3d, 0xb1, 0x98, 0x61, 0x75, 0xb4, 0x2f, 0xca, 0x38, 0xaf, 0xe2, 0x44, 0x64, 0x85,
+	0x5e, 0x39, 0xda, 0x8e, 0x93, 0xa4, 0x98, 0xe7, 0x42, 0x91, 0xd1, 0x3f, 0xfa, 0x30, 0x1c, 0x4b,
+	0xc5, 0x33, 0x12, 0x62, 0xbf, 0x80, 0x1d, 0xb2, 0xf3, 0x75, 0x89, 0xbf, 0x2c, 0x31, 0x16, 0x18,
+	0xb6, 0x8f, 0xdb, 0x27, 0xc3, 0xcf, 0x6f, 0x9f, 0x92, 0xc5, 0xd3, 0xb1, 0xb7, 0x38, 0x6a, 0xf1,
+	0x86, 0x38, 0x1b, 0xc1, 0x3e, 0x71, 0x1e, 0x67, 0x79, 0x56, 0x5d, 0x68, 0x1b, 0x1d, 0xb2, 0x11,
+	0xba, 0x36, 0xdc, 0xf5, 0x51, 0x8b, 0x2f, 0x2b, 0x59, 0x4b, 0x1c, 0x2f, 0x8b, 0x37, 0x26, 0x9a,
+	0x60, 0xd9, 0x92, 0xbb, 0x6e, 0x2d, 0xb9, 0x4c, 0xf6, 0x00, 0x36, 0xa9, 0x10, 0xaf, 0xb1, 0x0c,
+	0xbb, 0x5e, 0x3a, 0x67, 0x55, 0x85, 0xa2, 0x1a, 0xeb, 0xc5, 0x51, 0x8b, 0x5b, 0x41, 0xa9, 0x74,
+	0x95, 0x89, 0x8b, 0xb4, 0x8c, 0xaf, 0xc2, 0xde, 0x0a, 0xa5, 0x6f, 0xf5, 0xa2, 0x54, 0x32, 0x82,
+	0xec, 0x1e, 0xf4, 0xcf, 0x31, 0xc7, 0x2a, 0xab, 0xc2, 0x0d, 0xd2, 0x39, 0xf0, 0x74, 0xbe, 0x52,
+	0x6b, 0xa3, 0x16, 0x37, 0x62, 0xec, 0x11, 0xec, 0x18, 0x97, 0xe3, 0xe2, 0xd1, 0x35, 0x26, 0xe1,
+	0x26, 0x29, 0xfe, 0x60, 0x65, 0x84, 0x4a, 0x84, 0xca, 0xee, 0x71, 0xd8, 0x3d, 0x18, 0x50, 0xde,
+	0xcf, 0xb3, 0x5c, 0x84, 0x03, 0xb2, 0xb0, 0xe7, 0x16, 0x49, 0xf2, 0x47, 0x2d, 0x5e, 0x0b, 0x59,
+	0x8d, 0x87, 0xf3, 0x32, 0x0f, 0x61, 0x59, 0x43, 0xf2, 0xad, 0x86, 0x24, 0xd8, 0x0b, 0xb8, 0x45,
+	0xc4, 0x37, 0xb3, 0x34, 0x16, 0xf8, 0x1c, 0x45, 0x9c, 0xc6, 0x22, 0x0e, 0x87, 0xa4, 0x7b, 0xe4,
+	0xea, 0xfa, 0x12, 0xa3, 0x16, 0x5f, 0xa5, 0x68, 0xed, 0xbd, 0x44, 0xc1, 0xb1, 0x12, 0x65, 0x46,
+	0x2d, 0x18, 0x6e, 0x2d, 0xdb, 0xf3, 0x25, 0xac, 0x3d, 0x9f, 0xcd, 0x1e, 0x00, 0xa8, 0x66, 0x8c,
+	0xe7, 0x15, 0x86, 0xdb, 0x64, 0x66, 0xdf, 0xeb, 0x5b, 0xb9, 0x30, 0x6a, 0x71, 0x47, 0x8c, 0x7d,
+	0xa1, 0x07, 0xe7, 0x71, 0x89, 0xf8, 0x0e, 0xc3, 0x1d, 0xd2, 0x62, 0x5e, 0xa7, 0xd2, 0xca, 0xa8,
+	0xc5, 0x5d, 0x41, 0xeb, 0xec, 0x25, 0x66, 0xef, 0x30, 0xdc, 0x5d, 0x76, 0x46, 0x0b, 0xd6, 0x19,
+	0x51, 0xb6, 0xa5, 0x9f, 0xcf, 0x27, 0x22, 0x7b, 0x99, 0x9d, 0xd3, 0x7e, 0xef, 0x2d, 0xb7, 0xb4,
+	0xbb, 0x6e, 0x5b, 0xda, 0x65, 0xb2, 0x1d, 0xe8, 0x8c, 0x17, 0x61, 0xff, 0xb8, 0x7d, 0xd2, 0xe3,
+	0x9d, 0xf1, 0xe2, 0x61, 0x1f, 0x7a, 0x97, 0xf1, 0x64, 0x8e, 0xd1, 0xdf, 0xdb, 0xb0, 0xe3, 0x0f,
+	0x29, 0x63, 0xd0, 0xcd, 0xe3, 0xa9, 0x9a, 0xe4, 0x01, 0xa7, 0x6f, 0x76, 0x08, 0x1b, 0xd5, 0x62,
+	0xfa, 0xaa, 0x98, 0xd0, 0x6c, 0x0e, 0xb8, 0xa6, 0x58, 0x04, 0x5b, 0x59, 0x2e, 0xca, 0x22, 0x9d,
+	0xab, 0xcd, 0x08, 0x68, 0xd5, 0xe3, 0xb1, 0x03, 0xe8, 0x89, 0x42, 0xc4, 0x13, 0x9a, 0xa5, 0x80,
+	0x2b, 0x42, 0x72, 0x67, 0x65, 0x96, 0x20, 0x0d, 0x4b, 0xc0, 0x15, 0x21, 0xb9, 0xc5, 0x55, 0x8e,
+	0x25, 0x8d, 0xc3, 0x80, 0x2b, 0x82, 0x1d, 0xc1, 0x66, 0x12, 0x0b, 0x3c, 0x2f, 0x4a, 0x93, 0x83,
+	0xa5, 0xa3, 0x33, 0xd8, 0x5f, 0x02, 0x08, 0x27, 0xdc, 0xb6, 0x17, 0xae, 0x35, 0xdf, 0x71, 0xcc,
+	0x5b, 0x13, 0x1e, 0x08, 0xbc, 0x9f, 0x89, 0x9f, 0xc1, 0xc0, 0xce, 0xcd, 0x5a, 0xd5, 0x43, 0xd8,
+	0x88, 0xa7, 0x12, 0x4c, 0x49, 0x37, 0xe0, 0x9a, 0xb2, 0xca, 0x34, 0x35, 0xef, 0xab, 0xfc, 0xa7,
+	0x36, 0xdc, 0x5a, 0x31, 0x44, 0x6b, 0xed, 0x1c, 0xc1, 0x66, 0x8a, 0x49, 0x36, 0x8d, 0x27, 0x15,
+	0x59, 0xea, 0x71, 0x4b, 0xb3, 0x10, 0xfa, 0x93, 0xe2, 0xbc, 0xf8, 0x86, 0x3f, 0xd1, 0x1b, 0x69,
+	0x48, 0xb9, 0x72, 0x85, 0xaf, 0xaa, 0x4c, 0x20, 0xed, 0xe2, 0x80, 0x1b, 0x92, 0x1d, 0xc3, 0x30,
+	0x29, 0x72, 0x81, 0xb9, 0x18, 0xc5, 0xd5, 0x05, 0xed, 0xe6, 0x80, 0xbb, 0xac, 0xe8, 0x9f, 0x26,
+	0xc2, 0xc6, 0xfc, 0xad, 0x8b, 0xf0, 0x2e, 0x6c, 0x5f, 0x5d, 0x64, 0x02, 0x27, 0x59, 0x25, 0x7e,
+	0x9d, 0x4f, 0x16, 0x14, 0xe6, 0x26, 0xf7, 0x99, 0xb2, 0xf3, 0xe2, 0x34, 0xfd, 0xd6, 0xf0, 0xc2,
+	0xe0, 0x38, 0x90, 0x9d, 0xe7, 0xf2, 0xd8, 0x09, 0xec, 0x96, 0x38, 0x2d, 0x2e, 0xb1, 0x16, 0xeb,
+	0x92, 0x58, 0x93, 0xcd, 0x3e, 0x81, 0x41, 0x9c, 0xa6, 0x8f, 0xcb, 0xe2, 0x1d, 0xe6, 0x61, 0x8f,
+	0x64, 0x6a, 0x86, 0xf4, 0xa5, 0x14, 0xb4, 0xc0, 0x86, 0xf2, 0xe5, 0xf2, 0xa2, 0x2f, 0x01, 0x6a,
+	0xd0, 0xb8, 0x69, 0x17, 0x67, 0x52, 0x20, 0xd5, 0x49, 0x69, 0x2a, 0xfa, 0x8d, 0x3e, 0x56, 0x35,
+	0x5a, 0xac, 0x53, 0x67, 0xd0, 0x8d, 0xd3, 0xd4, 0xf4, 0x1e, 0x7d, 0x4b, 0xd9, 0xd7, 0x2a, 0xac,
+	0x40, 0x99, 0x54, 0x54, 0xf4, 0x7b, 0x1d, 0x90, 0x82, 0x92, 0x1b, 0x2c, 0xbe, 0x2e, 0x8b, 0xa9,
+	0xb1, 0x28, 0xbf, 0x25, 0x58, 0x88, 0x42, 0x77, 0x40, 0x47, 0x14, 0x4e, 0xeb, 0x75, 0xdd, 0xd6,
+	0x93, 0xad, 0x84, 0xd7, 0x98, 0xbc, 0x90, 0x60, 0xa1, 0xf6, 0xdd, 0xd2, 0x11, 0xea, 0x99, 0xf2,
+	0x50, 0x28, 0x82, 0xad, 0xa9, 0xa6, 0xcf, 0x64, 0x1a, 0x2a, 0x14, 0x8f, 0x27, 0x03, 0x12, 0xd7,
+	0x99, 0xaa, 0x4f, 0x97, 0xd3, 0xb7, 0xec, 0xbe, 0x59, 0xbc, 0x98, 0x14, 0x71, 0x4a, 0x51, 0x6d,
+	0x71, 0x43, 0x46, 0xff, 0x6a, 0x43, 0x8f, 0xfc, 0x7c, 0x84, 0xa8, 0x15, 0x42, 0x3f, 0x91, 0x58,
+	0x52, 0x94, 0x04, 0x5a, 0x03, 0x6e, 0x48, 0x8a, 0x4b, 0xc4, 0x62, 0x5e, 0xd1, 0xe1, 0xdd, 0xe3,
+	0x9a, 0xf2, 0x70, 0x6e, 0xd0, 0xc0, 0xb9, 0x3f, 0xb6, 0x61, 0x5b, 0x55, 0xf4, 0xe3, 0x9b, 0xf0,
+	0xaf, 0x61, 0x4f, 0x03, 0xe8, 0xf7, 0x34, 0xdd, 0xd1, 0x18, 0xb6, 0x38, 0x26, 0x98, 0xcd, 0x84,
+	0xda, 0xdd, 0xf7, 0x42, 0x63, 0xa7, 0xbe, 0x81, 0x5b, 0xdf, 0xe8, 0x77, 0xc0, 0x5c, 0xab, 0x67,
+	0xaa, 0x8d, 0x8f, 0xa1, 0x3b, 0x2b, 0xf1, 0x52, 0xdf, 0x5c, 0xb7, 0xbc, 0xbb, 0x22, 0xad, 0xb0,
+	0x1f, 0x42, 0x3f, 0x99, 0x97, 0x25, 0x6a, 0xf0, 0x6d, 0x0a, 0x99, 0xc5, 0x68, 0x06, 0x07, 0xae,
+	0x7d, 0xbb, 0x53, 0x27, 0x9e, 0x87, 0x03, 0xef, 0xe8, 0xd6, 0x32, 0xda, 0xd3, 0x69, 0xd3, 0xd3,
+	0x6a, 0x61, 0xeb, 0xf1, 0x0f, 0x1d, 0xb8, 0xe3, 0xba, 0x74, 0x77, 0xe0, 0xc7, 0x9e, 0xd7, 0x3b,
+	0x5e, 0xc8, 0xb5, 0x98, 0x76, 0x7c, 0xbf, 0xe9, 0x78, 0xad, 0xbc, 0x91, 0xfb, 0xe8, 0x10, 0xf8,
+	0xb7, 0xb0, 0xef, 0x96, 0xe2, 0x66, 0x20, 0x66, 0xba, 0x38, 0xaa, 0xfb, 0xe8, 0x9b, 0x06, 0x56,
+	0xd7, 0x40, 0x41, 0xa9, 0x2d, 0x73, 0xee, 0x37, 0xce, 0x07, 0xa0, 0xb4, 0xf1, 0x17, 0xac, 0xf6,
+	0xd7, 0xf5, 0xfd, 0x7d, 0xd7, 0xf6, 0x73, 0xf9, 0xdf, 0x60, 0xf8, 0x5f, 0xba, 0x00, 0xcf, 0x8a,
+	0x24, 0x9e, 0xfc, 0xff, 0x20, 0xec, 0x5d, 0xd8, 0x26, 0x11, 0x4c, 0x47, 0x98, 0x9d, 0x5f, 0xa8,
+	0xb7, 0x4f, 0xc0, 0x7d, 0x26, 0x21, 0x9e, 0x62, 0x8c, 0xb3, 0x29, 0xd2, 0x6b, 0x27, 0xe0, 0x2e,
+	0x8b, 0xdd, 0x83, 0x5b, 0xb3, 0x12, 0x67, 0xb1, 0x7d, 0xd9, 0x2a, 0x6b, 0x43, 0x92, 0x5c, 0xb5,
+	0xc4, 0x3e, 0x83, 0x7d, 0x8f, 0x4d, 0x96, 0xb7, 0x48, 0x7e, 0x79, 0x41, 0x4e, 0xc3, 0xac, 0xc4,
+	0x24, 0xab, 0x64, 0xf1, 0xb6, 0x29, 0x85, 0x9a, 0xc1, 0x4e, 0x81, 0x51, 0xb1, 0xec, 0x33, 0x2f,
+	0x9b, 0x62, 0x45, 0x6f, 0x91, 0x80, 0xaf, 0x58, 0x91, 0x59, 0x97, 0x74, 0xb7, 0x35, 0x59, 0xef,
+	0xaa, 0xac, 0x3d, 0xa6, 0xcc, 0x5a, 0x33, 0x28, 0xb6, 0x3d, 0x95, 0xb5, 0xc3, 0xf2, 0xce, 0xa7,
+	0xfd, 0xc6, 0xf9, 0xf4, 0x73, 0xd8, 0xab, 0x7b, 0x45, 0x4f, 0xed, 0x7b, 0x0c, 0x48, 0x34, 0x87,
+	0x01, 0xe9, 0x3f, 0x2b, 0xce, 0xab, 0xb5, 0x8a, 0x21, 0xf4, 0xc5, 0xf5, 0x93, 0x3c, 0xc5, 0x6b,
+	0xad, 0x6b, 0x48, 0xf6, 0x29, 0x80, 0xfa, 0xdd, 0x62, 0xbc, 0x98, 0xa1, 0x86, 0x7d, 0x87, 0x23,
+	0x2d, 0x8a, 0x6b, 0x3a, 0xbf, 0xd4, 0xe9, 0xa6, 0xa9, 0xe8, 0x0a, 0x06, 0x1c, 0xdf, 0x52, 0xd0,
+	0x74, 0xfe, 0xbe, 0x9d, 0x63, 0xb9, 0x38, 0x9b, 0x28, 0xc7, 0x9b, 0xdc, 0xd2, 0x4e, 0x47, 0x75,
+	0xbc, 0x8e, 0x92, 0x86, 0x49, 0x5b, 0xe3, 0x9f, 0xa6, 0x64, 0x40, 0x2a, 0x68, 0x3a, 0xe4, 0xd4,
+	0x7c, 0x3b, 0x9c, 0xe8, 0xa7, 0x30, 0xe4, 0x38, 0x9b, 0x2c, 0xb4, 0xeb, 0x1f, 0x59, 0x33, 0xed,
+	0xe3, 0xc0, 0x79, 0x1b, 0xd6, 0x35, 0x35, 0x96, 0xa3, 0x9f, 0xe8, 0xe7, 0x02, 0xc7, 0xe4, 0x52,
+	0x0d, 0xd1, 0x1b, 0xcc, 0x75, 0xa1, 0x14, 0x21, 0x0b, 0x5c, 0x62, 0x72, 0xa9, 0x9f, 0x0a, 0xf4,
+	0x1d, 0xfd, 0x0a, 0x0e, 0xc9, 0xa1, 0xbc, 0x65, 0x49, 0xd5, 0xc7, 0x45, 0xa9, 0x7d, 0xdf, 0xd3,
+	0x6f, 0x53, 0xc9, 0x35, 0xfe, 0xf7, 0x7c, 0xf8, 0x4f, 0x2e, 0xb9, 0x23, 0x13, 0x65, 0xb0, 0x6b,
+	0xaa, 0xf6, 0x30, 0x9e, 0xc4, 0x79, 0x82, 0x1a, 0xbf, 0x4b, 0xac, 0x2a, 0x54, 0x36, 0x06, 0xbc,
+	0x66, 0xc8, 0xde, 0x52, 0xef, 0x5a, 0x17, 0x2c, 0x5c, 0x96, 0xac, 0xa3, 0x04, 0x1e, 0x2c, 0x35,
+	0x56, 0x68, 0x2a, 0x7a, 0x02, 0xb7, 0x39, 0xbe, 0x3d, 0x53, 0xbf, 0x42, 0xa9, 0x63, 0x9b, 0x7e,
+	0xe2, 0x90, 0xbd, 0xa0, 0xed, 0xeb, 0xdc, 0x0d, 0xe9, 0x98, 0xea, 0x78, 0xa6, 0x5e, 0x00, 0xd4,
+	0x06, 0xd6, 0xf6, 0xd8, 0x09, 0xf4, 0xf5, 0x6f, 0x5e, 0xfa, 0x24, 0xdc, 0x31, 0x3f, 0xad, 0x28,
+	0x2e, 0x37, 0xcb, 0xd1, 0x0b, 0xb8, 0xa3, 0x2a, 0xba, 0x1c, 0xdc, 0x03, 0x9d, 0xaf, 0x22, 0x1b,
+	0x7b, 0x5a, 0x0b, 0x72, 0x57, 0x4a, 0x3e, 0xe5, 0xb6, 0x65, 0xae, 0x69, 0x6a, 0x76, 0xc6, 0x0c,
+	0x4a, 0xdb, 0xbf, 0xef, 0xaf, 0x6c, 0x44, 0xdb, 0x09, 0xaa, 0x0f, 0x15, 0x21, 0xb7, 0x25, 0xcd,
+	0x4a, 0x54, 0x28, 0xdc, 0x55, 0x40, 0x62, 0x19, 0x52, 0x47, 0x65, 0xda, 0xa3, 0x15, 0x45, 0xc8,
+	0xca, 0xca, 0x33, 0xe4, 0x29, 0x2e, 0x34, 0xdc, 0x1a, 0x32, 0xfa, 0x6b, 0x1b, 0xc0, 0x6c, 0xfc,
+	0xf8, 0xfa, 0xc6, 0x03, 0x69, 0x12, 0x9f, 0xeb, 0x00, 0xe9, 0xbb, 0x76, 0x15, 0xb8, 0xae, 0x6e,
+	0x0e, 0xef, 0x10, 0x36, 0x2e, 0x14, 0x60, 0xa9, 0xc3, 0x40, 0x53, 0xd2, 0x56, 0x46, 0x20, 0xb0,
+	0x41, 0x6c, 0x45, 0xd8, 0x62, 0xf5, 0x1d, 0x54, 0xf9, 0x02, 0x76, 0xea, 0x29, 0x23, 0x68, 0xb9,
+	0x0b, 0xdd, 0x49, 0x71, 0xde, 0x6c, 0x73, 0x0b, 0x3d, 0x9c, 0x56, 0xa3, 0xa7, 0x70, 0xcb, 0xe4,
+	0xf9, 0xdf, 0x5c, 0x6a, 0xbd, 0xe6, 0xef, 0x34, 0x9a, 0x3f, 0x4a, 0xe1, 0x40, 0x6d, 0x39, 0x4d,
+	0x5e, 0x6d, 0x6d, 0xd5, 0xee, 0x1e, 0xc3, 0xd0, 0xde, 0x84, 0xed, 0x2b, 0xd1, 0x65, 0xad, 0x7d,
+	0xef, 0xfd, 0xb9, 0x0d, 0xb7, 0xeb, 0x5c, 0xbf, 0xbf, 0x87, 0xf6, 0x7d, 0xe8, 0xc9, 0xc8, 0x14,
+	0xbe, 0xd5, 0x3f, 0x34, 0xae, 0xca, 0x88, 0x2b, 0x49, 0xe7, 0x95, 0xdb, 0xf5, 0x5e, 0xb9, 0xdf,
+	0x51, 0x88, 0x6f, 0x9d, 0x23, 0x62, 0x54, 0x4c, 0x52, 0x2c, 0xd7, 0x03, 0xfe, 0xa7, 0x00, 0xb3,
+	0x32, 0x9b, 0xc6, 0xe5, 0xe2, 0x29, 0xaa, 0xf8, 0x06, 0xdc, 0xe1, 0x7c, 0x48, 0x57, 0x45, 0xcf,
+	0xf4, 0xd8, 0xae, 0x08, 0xe3, 0x3e, 0xf4, 0x2f, 0xd4, 0xa7, 0xee, 0x8f, 0x3b, 0x4b, 0x30, 0xac,
+	0x14, 0xb8, 0x91, 0x8b, 0xae, 0x6a, 0x28, 0xfc, 0x4f, 0xc9, 0xd4, 0xed, 0xdc, 0xf1, 0xda, 0xd9,
+	0x4f, 0x32, 0x58, 0x9f, 0x64, 0xd7, 0x49, 0x32, 0x2a, 0x60, 0xe8, 0x78, 0x5d, 0xd9, 0x4c, 0x21,
+	0xf4, 0x5f, 0x29, 0x78, 0xd6, 0x1e, 0x0d, 0xd9, 0x68, 0xa2, 0xc0, 0x34, 0x91, 0xb9, 0x0e, 0xd2,
+	0xeb, 0xbc, 0x5b, 0x5f, 0x07, 0x25, 0x1d, 0xfd, 0x8d, 0x2e, 0xa5, 0xa6, 0x70, 0x1f, 0x9a, 0xec,
+	0x27, 0x30, 0x90, 0x80, 0x85, 0x74, 0x16, 0xab, 0x5c, 0x6b, 0x06, 0xfb, 0xac, 0xde, 0x80, 0xee,
+	0x71, 0xd0, 0xfc, 0x69, 0x55, 0xf9, 0xb4, 0xb5, 0x6f, 0x14, 0xae, 0xd7, 0x2c, 0xdc, 0xe7, 0x8f,
+	0x34, 0x24, 0xb2, 0x2f, 0x61, 0xf7, 0x2b, 0x14, 0xde, 0x79, 0x75, 0xa8, 0x0d, 0x37, 0xce, 0xb1,
+	0xa3, 0x5d, 0x1f, 0xed, 0xab, 0xa8, 0xf5, 0x6a, 0x83, 0xfe, 0xfb, 0x78, 0xf0, 0xef, 0x01, 0x00,
+	0x7f, 0x1e, 0x31, 0xdf, 0x33, 0x19, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
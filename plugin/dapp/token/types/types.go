@@ -29,6 +29,9 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TokenX, ForkTokenPriceX, 560000)
 	cfg.RegisterDappFork(TokenX, ForkTokenSymbolWithNumberX, 1298600)
 	cfg.RegisterDappFork(TokenX, ForkTokenCheckX, 1600000)
+	cfg.RegisterDappFork(TokenX, ForkTokenMetadataX, 10000000)
+	cfg.RegisterDappFork(TokenX, ForkTokenAdminX, 10000000)
+	cfg.RegisterDappFork(TokenX, ForkTokenMultiSigX, 10000000)
 }
 
 //InitExecutor ...
@@ -62,15 +65,21 @@ func (t *TokenType) GetPayload() types.Message {
 // GetTypeMap 根据action的name获取type
 func (t *TokenType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Transfer":          ActionTransfer,
-		"Genesis":           ActionGenesis,
-		"Withdraw":          ActionWithdraw,
-		"TokenPreCreate":    TokenActionPreCreate,
-		"TokenFinishCreate": TokenActionFinishCreate,
-		"TokenRevokeCreate": TokenActionRevokeCreate,
-		"TransferToExec":    TokenActionTransferToExec,
-		"TokenMint":         TokenActionMint,
-		"TokenBurn":         TokenActionBurn,
+		"Transfer":            ActionTransfer,
+		"Genesis":             ActionGenesis,
+		"Withdraw":            ActionWithdraw,
+		"TokenPreCreate":      TokenActionPreCreate,
+		"TokenFinishCreate":   TokenActionFinishCreate,
+		"TokenRevokeCreate":   TokenActionRevokeCreate,
+		"TransferToExec":      TokenActionTransferToExec,
+		"TokenMint":           TokenActionMint,
+		"TokenBurn":           TokenActionBurn,
+		"TokenUpdateMetadata": TokenActionUpdateMetadata,
+		"TokenSetRestriction": TokenActionSetRestriction,
+		"TokenPause":          TokenActionPause,
+		"TokenFreeze":         TokenActionFreeze,
+		"TokenSeize":          TokenActionSeize,
+		"TokenMultiSigExec":   TokenActionMultiSigExec,
 	}
 }
 
@@ -91,6 +100,11 @@ func (t *TokenType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogRevokeCreateToken:    {Ty: reflect.TypeOf(ReceiptToken{}), Name: "LogRevokeCreateToken"},
 		TyLogTokenMint:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogMintToken"},
 		TyLogTokenBurn:            {Ty: reflect.TypeOf(ReceiptTokenAmount{}), Name: "LogBurnToken"},
+		TyLogTokenMetadata:        {Ty: reflect.TypeOf(ReceiptTokenMetadata{}), Name: "LogTokenMetadata"},
+		TyLogTokenRestriction:     {Ty: reflect.TypeOf(ReceiptTokenRestriction{}), Name: "LogTokenRestriction"},
+		TyLogTokenPause:           {Ty: reflect.TypeOf(ReceiptTokenPause{}), Name: "LogTokenPause"},
+		TyLogTokenFreeze:          {Ty: reflect.TypeOf(ReceiptTokenFreeze{}), Name: "LogTokenFreeze"},
+		TyLogTokenSeize:           {Ty: reflect.TypeOf(ReceiptTokenSeize{}), Name: "LogTokenSeize"},
 	}
 }
 
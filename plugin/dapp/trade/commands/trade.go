@@ -33,6 +33,7 @@ func TradeCmd() *cobra.Command {
 		CreateRawBuyLimitTxCmd(),
 		CreateRawSellMarketTxCmd(),
 		CreateRawBuyRevokeTxCmd(),
+		CreateRawMatchTxCmd(),
 
 		ShowOnesSellOrdersCmd(),
 		ShowOnesSellOrdersStatusCmd(),
@@ -706,3 +707,68 @@ func buyRevoke(cmd *cobra.Command, args []string) {
 	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeRevokeBuyTx", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+// CreateRawMatchTxCmd : create raw match transaction
+func CreateRawMatchTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "match",
+		Short: "Create a transaction to match best-priced open orders",
+		Run:   tokenMatch,
+	}
+	addTokenMatchFlags(cmd)
+	return cmd
+}
+
+func addTokenMatchFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("symbol", "s", "", "token symbol")
+	cmd.MarkFlagRequired("symbol")
+
+	cmd.Flags().StringP("side", "d", "buy", "buy: take sell orders, sell: take buy orders")
+
+	cmd.Flags().Float64P("price", "p", 0, "price limit per token")
+	cmd.MarkFlagRequired("price")
+
+	cmd.Flags().Float64P("amount", "a", 0, "amount of tokens to trade")
+	cmd.MarkFlagRequired("amount")
+
+	cmd.Flags().Float64P("fee", "f", 0, "transaction fee")
+
+	cmd.Flags().StringP("asset_exec", "e", "", "asset exec, default: token")
+	cmd.Flags().StringP("price_exec", "", "", "price exec")
+	cmd.Flags().StringP("price_symbol", "", "", "price symbol")
+}
+
+func tokenMatch(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	symbol, _ := cmd.Flags().GetString("symbol")
+	side, _ := cmd.Flags().GetString("side")
+	price, _ := cmd.Flags().GetFloat64("price")
+	amount, _ := cmd.Flags().GetFloat64("amount")
+	fee, _ := cmd.Flags().GetFloat64("fee")
+	priceExec, _ := cmd.Flags().GetString("price_exec")
+	priceSymbol, _ := cmd.Flags().GetString("price_symbol")
+	exec, _ := cmd.Flags().GetString("asset_exec")
+	if exec == "" {
+		exec = "token"
+	}
+	if side != "buy" && side != "sell" {
+		fmt.Fprintln(os.Stderr, "side should be buy or sell")
+		return
+	}
+
+	priceInt64 := int64(price * 1e4)
+	amountInt64 := int64(amount * 1e4)
+	feeInt64 := int64(fee * 1e4)
+	params := &pty.TradeMatchTx{
+		TokenSymbol: symbol,
+		AssetExec:   exec,
+		PriceExec:   priceExec,
+		PriceSymbol: priceSymbol,
+		IsSell:      side == "sell",
+		PriceLimit:  priceInt64 * 1e4,
+		Amount:      amountInt64 * 1e4,
+		Fee:         feeInt64 * 1e4,
+	}
+	ctx := jsonrpc.NewRPCCtx(rpcLaddr, "trade.CreateRawTradeMatchTx", params, nil)
+	ctx.RunWithoutMarshal()
+}
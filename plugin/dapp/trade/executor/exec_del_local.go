@@ -34,6 +34,10 @@ func (t *trade) ExecDelLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.
 	return t.localDelLog(tx, receipt, index, 0)
 }
 
+func (t *trade) ExecDelLocal_Match(match *pty.TradeForMatch, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localMatchLog(tx, receipt, index, true)
+}
+
 func (t *trade) localDelLog(tx *types.Transaction, receipt *types.ReceiptData, index int, tradedBoardlot int64) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
 	table := NewOrderTableV2(t.GetLocalDB())
@@ -34,6 +34,10 @@ func (t *trade) ExecLocal_RevokeBuy(revoke *pty.TradeForRevokeBuy, tx *types.Tra
 	return t.localAddLog(tx, receipt, index)
 }
 
+func (t *trade) ExecLocal_Match(match *pty.TradeForMatch, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return t.localMatchLog(tx, receipt, index, false)
+}
+
 func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	var set types.LocalDBSet
 	table := NewOrderTableV2(t.GetLocalDB())
@@ -100,6 +104,40 @@ func (t *trade) localAddLog(tx *types.Transaction, receipt *types.ReceiptData, i
 	return &set, nil
 }
 
+// 撮合交易只处理汇总的日志, 一笔交易内成交的多个挂单在汇总日志中
+func (t *trade) localMatchLog(tx *types.Transaction, receipt *types.ReceiptData, index int, isDel bool) (*types.LocalDBSet, error) {
+	var set types.LocalDBSet
+	table := NewOrderTableV2(t.GetLocalDB())
+	txIndex := dapp.HeightIndexStr(t.GetHeight(), int64(index))
+	for i := 0; i < len(receipt.Logs); i++ {
+		item := receipt.Logs[i]
+		if item.Ty != pty.TyLogTradeMatch {
+			continue
+		}
+		var receipt pty.ReceiptTradeMatch
+		err := types.Decode(item.Log, &receipt)
+		if err != nil {
+			panic(err) //数据错误了，已经被修改了
+		}
+		if isDel {
+			t.deleteMatch(&receipt, tx, txIndex, table)
+		} else {
+			t.saveMatch(&receipt, tx, txIndex, table)
+		}
+	}
+	newKvs, err := table.Save()
+	debugTableKV(newKvs, "match orderV2 kvs")
+	if err != nil {
+		tradelog.Error("trade table.Save failed", "error", err)
+		return nil, err
+	}
+	set.KV = append(set.KV, newKvs...)
+	for _, kv := range set.KV {
+		t.GetLocalDB().Set(kv.Key, kv.Value)
+	}
+	return &set, nil
+}
+
 func debugTableKV(kvs []*types.KeyValue, msg string) {
 	tradelog.Debug("table save debug:"+msg, "count", len(kvs))
 	for i, kv := range kvs {
@@ -15,8 +15,10 @@ import (
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/common/crypto"
 	dbm "github.com/33cn/chain33/common/db"
+	drivers "github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	tokenty "github.com/33cn/plugin/plugin/dapp/token/types"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -533,3 +535,270 @@ func TestTradeSellFixAssetDB(t *testing.T) {
 	assert.Equal(t, 1, len(orders.Orders))
 	ldb.Close()
 }
+
+func TestTradeMatch(t *testing.T) {
+	total := int64(100000)
+	accountA := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[0]),
+	}
+	accountB := types.Account{
+		Balance: total,
+		Frozen:  0,
+		Addr:    string(Nodes[1]),
+	}
+
+	env := execEnv{
+		1539918074,
+		chain33TestCfg.GetDappFork(pty.TradeX, pty.ForkTradeMatchX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	accB := account.NewCoinsAccount(chain33TestCfg)
+	accB.SetDB(kvdb)
+	accB.SaveExecAccount(address.ExecAddress("trade"), &accountB)
+
+	accA, _ := account.NewAccountDB(chain33TestCfg, AssetExecToken, Symbol, kvdb)
+	accA.SaveExecAccount(address.ExecAddress("trade"), &accountA)
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	driver := newTrade()
+	driver.SetAPI(api)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+
+	// 每个交易放在单独的区块中, 删除的kv直接从数据库中删除
+	height := env.blockHeight
+	execTx := func(tx *types.Transaction, priv string) (*types.Receipt, *types.Transaction, error) {
+		height++
+		driver.SetEnv(height, env.blockTime, env.difficulty)
+		tx, _ = signTx(tx, priv)
+		// 分叉之后执行时需要读取本地的挂单索引
+		assert.Equal(t, drivers.ExecLocalSameTime, driver.ExecutorOrder())
+		receipt, err := driver.Exec(tx, env.index)
+		if err != nil {
+			return nil, tx, err
+		}
+		set, err := driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+		assert.Nil(t, err)
+		for _, kv := range set.KV {
+			if kv.Value == nil {
+				ldb.Delete(kv.Key)
+			}
+		}
+		return receipt, tx, nil
+	}
+	sell := func(price, cnt int64) string {
+		tx, _ := pty.CreateRawTradeSellTx(chain33TestCfg, &pty.TradeSellTx{
+			TokenSymbol:       Symbol,
+			AmountPerBoardlot: 100,
+			MinBoardlot:       1,
+			PricePerBoardlot:  price,
+			TotalBoardlot:     cnt,
+			AssetExec:         AssetExecToken,
+			PriceExec:         "coins",
+			PriceSymbol:       "bty",
+		})
+		_, tx, err := execTx(tx, PrivKeyA)
+		assert.Nil(t, err)
+		return calcTokenSellID(common.ToHex(tx.Hash())[2:])
+	}
+	sell1 := sell(2, 10)
+	sell2 := sell(1, 10)
+	sell3 := sell(1, 5)
+	sell4 := sell(5, 10)
+
+	match := &pty.TradeMatchTx{
+		TokenSymbol: Symbol,
+		AssetExec:   AssetExecToken,
+		PriceExec:   "coins",
+		PriceSymbol: "bty",
+		PriceLimit:  calcPriceOfToken(2, 100),
+		Amount:      2250,
+	}
+	// 没有指定数量
+	match.Amount = 0
+	tx, _ := pty.CreateRawTradeMatchTx(chain33TestCfg, match)
+	_, _, err := execTx(tx, PrivKeyB)
+	assert.Equal(t, types.ErrInvalidParam, err)
+	match.Amount = 2250
+
+	// 价格优先, 同价格时间优先, 超过限价的不返回
+	req := &pty.ReqMatchOrders{Match: &pty.TradeForMatch{
+		TokenSymbol: match.TokenSymbol,
+		AssetExec:   match.AssetExec,
+		PriceExec:   match.PriceExec,
+		PriceSymbol: match.PriceSymbol,
+		PriceLimit:  match.PriceLimit,
+		Amount:      match.Amount,
+	}}
+	reply, err := driver.(*trade).Query_GetMatchOrders(req)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{sell2, sell3, sell1}, reply.(*types.ReplyStrings).Datas)
+	req.Owner = string(Nodes[0])
+	reply, err = driver.(*trade).Query_GetMatchOrders(req)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(reply.(*types.ReplyStrings).Datas))
+
+	// 挂单者不能吃自己的单
+	tx, _ = pty.CreateRawTradeMatchTx(chain33TestCfg, match)
+	_, _, err = execTx(tx, PrivKeyA)
+	assert.Equal(t, pty.ErrTMatchNotFilled, err)
+
+	// 执行时在链上按价格选择挂单, 超过限价的挂单不成交
+	tx, _ = pty.CreateRawTradeMatchTx(chain33TestCfg, match)
+	receipt, tx, err := execTx(tx, PrivKeyB)
+	assert.Nil(t, err)
+	matchLog := receipt.Logs[len(receipt.Logs)-1]
+	assert.Equal(t, int32(pty.TyLogTradeMatch), matchLog.Ty)
+	var result pty.ReceiptTradeMatch
+	assert.Nil(t, types.Decode(matchLog.Log, &result))
+	// 价格优先, 同价格时间优先, 超过限价的不成交
+	assert.Equal(t, 3, len(result.Fills))
+	assert.Equal(t, sell2, result.Fills[0].OrderID)
+	assert.Equal(t, int64(10), result.Fills[0].BoardlotCnt)
+	assert.Equal(t, sell3, result.Fills[1].OrderID)
+	assert.Equal(t, int64(5), result.Fills[1].BoardlotCnt)
+	assert.Equal(t, sell1, result.Fills[2].OrderID)
+	assert.Equal(t, int64(7), result.Fills[2].BoardlotCnt)
+	assert.Equal(t, int64(pty.TradeOrderStatusOnSale), int64(result.Fills[2].Status))
+	assert.Equal(t, int64(2200), result.FilledAmount)
+	assert.Equal(t, int64(50), result.RemainAmount)
+	assert.Equal(t, int64(29), result.TotalPrice)
+
+	acc := accB.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, total-29, acc.Balance)
+	tokenB := accA.LoadExecAccount(string(Nodes[1]), address.ExecAddress("trade"))
+	assert.Equal(t, int64(2200), tokenB.Balance)
+	order4, err := getSellOrderFromID([]byte(sell4), kvdb)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), order4.SoldBoardlot)
+
+	// 本地数据: 挂单状态更新, 记录吃单方的成交
+	localOrder := func(key string) *pty.LocalOrder {
+		rows, err := NewOrderTableV2(kvdb).ListIndex("key", []byte(key), nil, 1, 0)
+		if err != nil {
+			return nil
+		}
+		return rows[0].Data.(*pty.LocalOrder)
+	}
+	assert.True(t, localOrder(sell2).IsFinished)
+	assert.Equal(t, int64(7), localOrder(sell1).TradedBoardlot)
+	assert.False(t, localOrder(sell1).IsFinished)
+	buyID := calcTokenBuyID(common.ToHex(tx.Hash())[2:])
+	taker := localOrder(buyID)
+	assert.NotNil(t, taker)
+	assert.Equal(t, string(Nodes[1]), taker.Owner)
+	assert.Equal(t, int64(2200), taker.AmountPerBoardlot)
+
+	// 回滚本地数据
+	set, err := driver.ExecDelLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+	assert.Nil(t, err)
+	for _, kv := range set.KV {
+		if kv.Value == nil {
+			ldb.Delete(kv.Key)
+		}
+	}
+	assert.False(t, localOrder(sell2).IsFinished)
+	assert.Equal(t, int64(0), localOrder(sell2).TradedBoardlot)
+	assert.Equal(t, int64(0), localOrder(sell1).TradedBoardlot)
+	assert.Nil(t, localOrder(buyID))
+}
+
+func TestTradeTokenRestriction(t *testing.T) {
+	total := int64(100000)
+	env := execEnv{
+		1539918074,
+		chain33TestCfg.GetDappFork(pty.TradeX, pty.ForkTradeMatchX),
+		2,
+		1539918074,
+		"hash",
+	}
+
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	accB := account.NewCoinsAccount(chain33TestCfg)
+	accB.SetDB(kvdb)
+	accB.SaveExecAccount(address.ExecAddress("trade"), &types.Account{Balance: total, Addr: string(Nodes[1])})
+	accA, _ := account.NewAccountDB(chain33TestCfg, AssetExecToken, Symbol, kvdb)
+	accA.SaveExecAccount(address.ExecAddress("trade"), &types.Account{Balance: total, Addr: string(Nodes[0])})
+
+	api := new(apimock.QueueProtocolAPI)
+	api.On("GetConfig", mock.Anything).Return(chain33TestCfg, nil)
+	driver := newTrade()
+	driver.SetAPI(api)
+	driver.SetStateDB(kvdb)
+	driver.SetLocalDB(kvdb)
+
+	height := env.blockHeight
+	execTx := func(tx *types.Transaction, priv string) error {
+		height++
+		driver.SetEnv(height, env.blockTime, env.difficulty)
+		tx, _ = signTx(tx, priv)
+		receipt, err := driver.Exec(tx, env.index)
+		if err != nil {
+			return err
+		}
+		_, err = driver.ExecLocal(tx, &types.ReceiptData{Ty: receipt.Ty, Logs: receipt.Logs}, env.index)
+		assert.Nil(t, err)
+		return nil
+	}
+	setFlag := func(key string, on bool) {
+		flag := &types.Int32{}
+		if on {
+			flag.Data = 1
+		}
+		kvdb.Set([]byte(key), types.Encode(flag))
+	}
+	frozenKey := func(addr []byte) string {
+		return "mavl-token-frozen-" + Symbol + "-" + string(addr)
+	}
+
+	tx, _ := pty.CreateRawTradeSellTx(chain33TestCfg, &pty.TradeSellTx{
+		TokenSymbol:       Symbol,
+		AmountPerBoardlot: 100,
+		MinBoardlot:       1,
+		PricePerBoardlot:  1,
+		TotalBoardlot:     10,
+		AssetExec:         AssetExecToken,
+		PriceExec:         "coins",
+		PriceSymbol:       "bty",
+	})
+	sellTx := tx
+	assert.Nil(t, execTx(tx, PrivKeyA))
+	sellID := common.ToHex(tx.Hash())[2:]
+
+	// 卖单的挂单者被冻结后不能成交, 撮合时跳过
+	setFlag(frozenKey(Nodes[0]), true)
+	tx, _ = pty.CreateRawTradeBuyTx(chain33TestCfg, &pty.TradeBuyTx{SellID: sellID, BoardlotCnt: 1})
+	assert.Equal(t, tokenty.ErrTokenAddrFrozen, execTx(tx, PrivKeyB))
+	match := &pty.TradeMatchTx{
+		TokenSymbol: Symbol,
+		AssetExec:   AssetExecToken,
+		PriceExec:   "coins",
+		PriceSymbol: "bty",
+		PriceLimit:  calcPriceOfToken(1, 100),
+		Amount:      100,
+	}
+	tx, _ = pty.CreateRawTradeMatchTx(chain33TestCfg, match)
+	assert.Equal(t, pty.ErrTMatchNotFilled, execTx(tx, PrivKeyB))
+
+	// 吃单方被冻结
+	setFlag(frozenKey(Nodes[0]), false)
+	setFlag(frozenKey(Nodes[1]), true)
+	tx, _ = pty.CreateRawTradeMatchTx(chain33TestCfg, match)
+	assert.Equal(t, tokenty.ErrTokenAddrFrozen, execTx(tx, PrivKeyB))
+	setFlag(frozenKey(Nodes[1]), false)
+	tx, _ = pty.CreateRawTradeMatchTx(chain33TestCfg, match)
+	assert.Nil(t, execTx(tx, PrivKeyB))
+
+	// 暂停转账后不能挂单
+	setFlag("mavl-token-paused-"+Symbol, true)
+	assert.Equal(t, tokenty.ErrTokenPaused, execTx(sellTx, PrivKeyA))
+}
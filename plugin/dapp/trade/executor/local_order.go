@@ -398,3 +398,35 @@ func (t *trade) genBuyMarket(tx *types.Transaction, buy *pty.ReceiptBuyBase, txI
 	}
 	return order
 }
+
+// 吃单方成交的多个挂单每手数量不同, 记录为一手, 每手数量为成交数量, 价格为成交总价
+func (t *trade) genMatch(tx *types.Transaction, match *pty.ReceiptTradeMatch, txIndex string) *pty.LocalOrder {
+	order := &pty.LocalOrder{
+		AssetSymbol:       match.TokenSymbol,
+		TxIndex:           txIndex,
+		Owner:             match.Owner,
+		AmountPerBoardlot: match.FilledAmount,
+		MinBoardlot:       1,
+		PricePerBoardlot:  match.TotalPrice,
+		TotalBoardlot:     1,
+		TradedBoardlot:    1,
+		TxHash:            []string{common.ToHex(tx.Hash())},
+		Height:            match.Height,
+		BlockTime:         t.GetBlockTime(),
+		IsSellOrder:       match.IsSell,
+		AssetExec:         match.AssetExec,
+		IsFinished:        true,
+		PriceExec:         match.PriceExec,
+		PriceSymbol:       match.PriceSymbol,
+	}
+	if match.IsSell {
+		order.Status = pty.TradeOrderStatusSoldOut
+		order.SellID = calcTokenSellID(hex.EncodeToString(tx.Hash()))
+		order.Key = order.SellID
+	} else {
+		order.Status = pty.TradeOrderStatusBoughtOut
+		order.BuyID = calcTokenBuyID(hex.EncodeToString(tx.Hash()))
+		order.Key = order.BuyID
+	}
+	return order
+}
@@ -30,6 +30,11 @@ func (t *trade) Query_GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error)
 	return t.GetOneOrder(req)
 }
 
+// GetMatchOrders 查询撮合时会按顺序成交的挂单
+func (t *trade) Query_GetMatchOrders(req *pty.ReqMatchOrders) (types.Message, error) {
+	return t.GetMatchOrders(req)
+}
+
 // query reply utils
 
 const (
@@ -138,3 +143,28 @@ func (t *trade) GetOneOrder(req *pty.ReqAddrAssets) (types.Message, error) {
 
 	return reply, nil
 }
+
+// GetMatchOrders 按价格优先, 时间优先的顺序返回限价以内的对手方挂单
+// 吃卖单时价格从低到高, 吃买单时价格从高到低, 同一价格按挂单的先后顺序
+func (t *trade) GetMatchOrders(req *pty.ReqMatchOrders) (types.Message, error) {
+	match := req.GetMatch()
+	if match == nil || match.PriceLimit <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	reply := &types.ReplyStrings{}
+	err := listMatchOrders(t.GetLocalDB(), match, func(local *pty.LocalOrder) (bool, error) {
+		if local.AmountPerBoardlot <= 0 || local.Owner == req.Owner {
+			return true, nil
+		}
+		price := calcPriceOfToken(local.PricePerBoardlot, local.AmountPerBoardlot)
+		if (match.IsSell && price < match.PriceLimit) || (!match.IsSell && price > match.PriceLimit) {
+			return false, nil
+		}
+		reply.Datas = append(reply.Datas, local.Key)
+		return len(reply.Datas) < pty.TradeMatchMaxOrder, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
@@ -14,6 +14,7 @@ trade执行器支持trade的创建和交易，
 4）挂单购买；
 5）出售指定的买单；
 6）撤销买单；
+7）按价格优先撮合吃单；
 */
 
 import (
@@ -64,6 +65,15 @@ func (t *trade) GetDriverName() string {
 	return driverName
 }
 
+//ExecutorOrder 撮合吃单需要在执行时按价格读取本地的挂单索引, 分叉之后 Exec 的时候同时执行 ExecLocal
+func (t *trade) ExecutorOrder() int64 {
+	cfg := t.GetAPI().GetConfig()
+	if cfg.IsDappFork(t.GetHeight(), pty.TradeX, pty.ForkTradeMatchX) {
+		return drivers.ExecLocalSameTime
+	}
+	return t.DriverBase.ExecutorOrder()
+}
+
 func (t *trade) getSellOrderFromDb(sellID []byte) *pty.SellOrder {
 	value, err := t.GetStateDB().Get(sellID)
 	if err != nil {
@@ -148,6 +158,33 @@ func (t *trade) deleteSellMarket(receiptTradeBuy *pty.ReceiptSellBase, txIndex s
 	ldb.Del([]byte(txIndex))
 }
 
+// match: 更新被吃掉的挂单, 并记录吃单方的成交
+func (t *trade) saveMatch(match *pty.ReceiptTradeMatch, tx *types.Transaction, txIndex string, ldb *table.Table) {
+	for _, fill := range match.Fills {
+		if match.IsSell {
+			buyOrder := &pty.BuyLimitOrder{Status: fill.Status, BoughtBoardlot: fill.TradedBoardlot}
+			t.updateBuyLimit(tx, &pty.ReceiptBuyBase{BuyID: fill.OrderID}, buyOrder, txIndex, ldb)
+		} else {
+			sellOrder := &pty.SellOrder{Status: fill.Status, SoldBoardlot: fill.TradedBoardlot}
+			t.updateSellLimit(tx, &pty.ReceiptSellBase{SellID: fill.OrderID}, sellOrder, txIndex, ldb)
+		}
+	}
+	order := t.genMatch(tx, match, txIndex)
+	tradelog.Debug("trade Match save local", "order", order)
+	ldb.Add(order)
+}
+
+func (t *trade) deleteMatch(match *pty.ReceiptTradeMatch, tx *types.Transaction, txIndex string, ldb *table.Table) {
+	for _, fill := range match.Fills {
+		if match.IsSell {
+			t.rollbackBuyLimit(tx, &pty.ReceiptBuyBase{BuyID: fill.OrderID}, nil, txIndex, ldb, fill.BoardlotCnt)
+		} else {
+			t.rollBackSellLimit(tx, &pty.ReceiptSellBase{SellID: fill.OrderID}, nil, txIndex, ldb, fill.BoardlotCnt)
+		}
+	}
+	ldb.Del([]byte(txIndex))
+}
+
 // CheckReceiptExecOk return true to check if receipt ty is ok
 func (t *trade) CheckReceiptExecOk() bool {
 	return true
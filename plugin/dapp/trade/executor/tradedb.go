@@ -15,6 +15,7 @@ import (
 	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
+	tokenexec "github.com/33cn/plugin/plugin/dapp/token/executor"
 	pty "github.com/33cn/plugin/plugin/dapp/trade/types"
 )
 
@@ -231,13 +232,14 @@ type tradeAction struct {
 	height    int64
 	execaddr  string
 	api       client.QueueProtocolAPI
+	localdb   dbm.KVDB
 }
 
 func newTradeAction(t *trade, tx *types.Transaction) *tradeAction {
 	hash := hex.EncodeToString(tx.Hash())
 	fromaddr := tx.From()
 	return &tradeAction{t.GetStateDB(), hash, fromaddr,
-		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetAPI()}
+		t.GetBlockTime(), t.GetHeight(), dapp.ExecAddress(string(tx.Execer)), t.GetAPI(), t.GetLocalDB()}
 }
 
 func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, error) {
@@ -256,6 +258,9 @@ func (action *tradeAction) tradeSell(sell *pty.TradeForSell) (*types.Receipt, er
 		return nil, pty.ErrAssetAndPriceSame
 	}
 
+	if err := action.checkRestriction(sell.AssetExec, sell.TokenSymbol, sell.PriceExec, sell.PriceSymbol, action.fromaddr, ""); err != nil {
+		return nil, err
+	}
 	accDB, err := createAccountDB(cfg, action.height, action.db, sell.AssetExec, sell.TokenSymbol)
 	if err != nil {
 		return nil, err
@@ -329,6 +334,15 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		return nil, pty.ErrTCntLessThanMinBoardlot
 	}
 
+	return action.buyFromSellOrder(sellOrder, buyOrder.BoardlotCnt)
+}
+
+// 从指定卖单购买 boardlotCnt 手, 调用者需要先检查卖单的状态和数量
+func (action *tradeAction) buyFromSellOrder(sellOrder *pty.SellOrder, boardlotCnt int64) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	if err := action.checkRestriction(sellOrder.AssetExec, sellOrder.TokenSymbol, sellOrder.PriceExec, sellOrder.PriceSymbol, action.fromaddr, sellOrder.Address); err != nil {
+		return nil, err
+	}
 	priceAcc, err := createPriceDB(cfg, action.height, action.db, sellOrder.PriceExec, sellOrder.PriceSymbol)
 	if err != nil {
 		tradelog.Error("createPriceDB", "addrFrom", action.fromaddr, "height", action.height,
@@ -336,10 +350,10 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 		return nil, err
 	}
 	//首先购买费用的划转
-	receiptFromAcc, err := priceAcc.ExecTransfer(action.fromaddr, sellOrder.Address, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
+	receiptFromAcc, err := priceAcc.ExecTransfer(action.fromaddr, sellOrder.Address, action.execaddr, boardlotCnt*sellOrder.PricePerBoardlot)
 	if err != nil {
 		tradelog.Error("account.Transfer ", "addrFrom", action.fromaddr, "addrTo", sellOrder.Address,
-			"amount", buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
+			"amount", boardlotCnt*sellOrder.PricePerBoardlot)
 		return nil, err
 	}
 	//然后实现购买token的转移,因为这部分token在之前的卖单生成时已经进行冻结
@@ -349,13 +363,13 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 			"price", sellOrder.AssetExec+"-"+sellOrder.TokenSymbol, "err", err)
 		return nil, err
 	}
-	receiptFromExecAcc, err := accDB.ExecTransferFrozen(sellOrder.Address, action.fromaddr, action.execaddr, buyOrder.BoardlotCnt*sellOrder.AmountPerBoardlot)
+	receiptFromExecAcc, err := accDB.ExecTransferFrozen(sellOrder.Address, action.fromaddr, action.execaddr, boardlotCnt*sellOrder.AmountPerBoardlot)
 	if err != nil {
 		tradelog.Error("account.ExecTransfer token ", "error info", err, "addrFrom", sellOrder.Address,
 			"addrTo", action.fromaddr, "execaddr", action.execaddr,
-			"amount", buyOrder.BoardlotCnt*sellOrder.AmountPerBoardlot)
+			"amount", boardlotCnt*sellOrder.AmountPerBoardlot)
 		//因为未能成功将对应的token进行转账，所以需要将购买方的账户资金进行回退
-		priceAcc.ExecTransfer(sellOrder.Address, action.fromaddr, action.execaddr, buyOrder.BoardlotCnt*sellOrder.PricePerBoardlot)
+		priceAcc.ExecTransfer(sellOrder.Address, action.fromaddr, action.execaddr, boardlotCnt*sellOrder.PricePerBoardlot)
 		return nil, err
 	}
 
@@ -363,7 +377,7 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 	var kv []*types.KeyValue
 
 	tradelog.Debug("tradeBuy", "Soldboardlot before this buy", sellOrder.SoldBoardlot)
-	sellOrder.SoldBoardlot += buyOrder.BoardlotCnt
+	sellOrder.SoldBoardlot += boardlotCnt
 	tradelog.Debug("tradeBuy", "Soldboardlot after this buy", sellOrder.SoldBoardlot)
 	if sellOrder.SoldBoardlot == sellOrder.TotalBoardlot {
 		sellOrder.Status = pty.TradeOrderStatusSoldOut
@@ -374,7 +388,7 @@ func (action *tradeAction) tradeBuy(buyOrder *pty.TradeForBuy) (*types.Receipt,
 	logs = append(logs, receiptFromAcc.Logs...)
 	logs = append(logs, receiptFromExecAcc.Logs...)
 	logs = append(logs, sellTokendb.getSellLogs(pty.TyLogTradeSellLimit, action.txhash))
-	logs = append(logs, sellTokendb.getBuyLogs(action.fromaddr, buyOrder.BoardlotCnt, action.txhash))
+	logs = append(logs, sellTokendb.getBuyLogs(action.fromaddr, boardlotCnt, action.txhash))
 	kv = append(kv, receiptFromAcc.KV...)
 	kv = append(kv, receiptFromExecAcc.KV...)
 	kv = append(kv, sellOrderKV...)
@@ -472,6 +486,9 @@ func (action *tradeAction) tradeBuyLimit(buy *pty.TradeForBuyLimit) (*types.Rece
 		return nil, pty.ErrAssetAndPriceSame
 	}
 
+	if err := action.checkRestriction(buy.AssetExec, buy.TokenSymbol, buy.PriceExec, buy.PriceSymbol, action.fromaddr, ""); err != nil {
+		return nil, err
+	}
 	priceAcc, err := createPriceDB(cfg, action.height, action.db, buy.PriceExec, buy.PriceSymbol)
 	if err != nil {
 		return nil, err
@@ -539,14 +556,23 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 		return nil, pty.ErrTCntLessThanMinBoardlot
 	}
 
+	return action.sellToBuyOrder(buyOrder, sellOrder.BoardlotCnt)
+}
+
+// 向指定买单出售 boardlotCnt 手, 调用者需要先检查买单的状态和数量
+func (action *tradeAction) sellToBuyOrder(buyOrder *pty.BuyLimitOrder, boardlotCnt int64) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	if err := action.checkRestriction(buyOrder.AssetExec, buyOrder.TokenSymbol, buyOrder.PriceExec, buyOrder.PriceSymbol, action.fromaddr, buyOrder.Address); err != nil {
+		return nil, err
+	}
 	// 打token
 	accDB, err := createAccountDB(cfg, action.height, action.db, buyOrder.AssetExec, buyOrder.TokenSymbol)
 	if err != nil {
 		tradelog.Error("createAccountDB failed", "err", err, "order", buyOrder)
 		return nil, err
 	}
-	amountToken := sellOrder.BoardlotCnt * buyOrder.AmountPerBoardlot
-	tradelog.Debug("tradeSellMarket", "step1 cnt", sellOrder.BoardlotCnt, "amountToken", amountToken)
+	amountToken := boardlotCnt * buyOrder.AmountPerBoardlot
+	tradelog.Debug("tradeSellMarket", "step1 cnt", boardlotCnt, "amountToken", amountToken)
 	receiptFromExecAcc, err := accDB.ExecTransfer(action.fromaddr, buyOrder.Address, action.execaddr, amountToken)
 	if err != nil {
 		tradelog.Error("account.ExecTransfer token ", "error info", err, "addrFrom", buyOrder.Address,
@@ -560,8 +586,8 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 	if err != nil {
 		return nil, err
 	}
-	amount := sellOrder.BoardlotCnt * buyOrder.PricePerBoardlot
-	tradelog.Debug("tradeSellMarket", "step2 cnt", sellOrder.BoardlotCnt, "price", buyOrder.PricePerBoardlot, "amount", amount)
+	amount := boardlotCnt * buyOrder.PricePerBoardlot
+	tradelog.Debug("tradeSellMarket", "step2 cnt", boardlotCnt, "price", buyOrder.PricePerBoardlot, "amount", amount)
 	receiptFromAcc, err := priceAcc.ExecTransferFrozen(buyOrder.Address, action.fromaddr, action.execaddr, amount)
 	if err != nil {
 		tradelog.Error("account.Transfer ", "addrFrom", buyOrder.Address, "addrTo", action.fromaddr,
@@ -575,7 +601,7 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 	var kv []*types.KeyValue
 
 	tradelog.Debug("tradeBuy", "BoughtBoardlot before this buy", buyOrder.BoughtBoardlot)
-	buyOrder.BoughtBoardlot += sellOrder.BoardlotCnt
+	buyOrder.BoughtBoardlot += boardlotCnt
 	tradelog.Debug("tradeBuy", "BoughtBoardlot after this buy", buyOrder.BoughtBoardlot)
 	if buyOrder.BoughtBoardlot == buyOrder.TotalBoardlot {
 		buyOrder.Status = pty.TradeOrderStatusBoughtOut
@@ -586,7 +612,7 @@ func (action *tradeAction) tradeSellMarket(sellOrder *pty.TradeForSellMarket) (*
 	logs = append(logs, receiptFromAcc.Logs...)
 	logs = append(logs, receiptFromExecAcc.Logs...)
 	logs = append(logs, buyTokendb.getBuyLogs(pty.TyLogTradeBuyLimit, action.txhash))
-	logs = append(logs, buyTokendb.getSellLogs(action.fromaddr, action.txhash, sellOrder.BoardlotCnt, action.txhash))
+	logs = append(logs, buyTokendb.getSellLogs(action.fromaddr, action.txhash, boardlotCnt, action.txhash))
 	kv = append(kv, receiptFromAcc.KV...)
 	kv = append(kv, receiptFromExecAcc.KV...)
 	kv = append(kv, sellOrderKV...)
@@ -642,3 +668,274 @@ func (action *tradeAction) tradeRevokeBuyLimit(revoke *pty.TradeForRevokeBuy) (*
 	kv = append(kv, sellOrderKV...)
 	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
 }
+
+// 撮合时按本地挂单索引的价格优先, 时间优先顺序成交, 分叉之后 Exec 和 ExecLocal 同时执行
+// 每个挂单都用状态数据库中的订单检查交易对, 状态和限价, 不满足条件的直接跳过
+func (action *tradeAction) tradeMatch(match *pty.TradeForMatch) (*types.Receipt, error) {
+	cfg := action.api.GetConfig()
+	if !cfg.IsDappFork(action.height, pty.TradeX, pty.ForkTradeMatchX) {
+		return nil, types.ErrActionNotSupport
+	}
+	if match.Amount <= 0 || match.PriceLimit <= 0 {
+		return nil, types.ErrInvalidParam
+	}
+	if !checkAsset(cfg, action.height, match.AssetExec, match.TokenSymbol) {
+		return nil, types.ErrInvalidParam
+	}
+	if !checkPrice(cfg, action.height, match.PriceExec, match.PriceSymbol) {
+		return nil, types.ErrInvalidParam
+	}
+	if !notSameAsset(cfg, action.height, match.AssetExec, match.TokenSymbol, match.PriceExec, match.PriceSymbol) {
+		return nil, pty.ErrAssetAndPriceSame
+	}
+	if err := action.checkRestriction(match.AssetExec, match.TokenSymbol, match.PriceExec, match.PriceSymbol, action.fromaddr, ""); err != nil {
+		return nil, err
+	}
+
+	var logs []*types.ReceiptLog
+	var kv []*types.KeyValue
+	result := &pty.ReceiptTradeMatch{
+		TokenSymbol:  match.TokenSymbol,
+		Owner:        action.fromaddr,
+		AssetExec:    match.AssetExec,
+		PriceExec:    match.PriceExec,
+		PriceSymbol:  match.PriceSymbol,
+		IsSell:       match.IsSell,
+		PriceLimit:   match.PriceLimit,
+		Amount:       match.Amount,
+		RemainAmount: match.Amount,
+		TxHash:       action.txhash,
+		Height:       action.height,
+	}
+
+	// 按价格优先, 时间优先的顺序在链上选择对手方挂单, 每笔最多检查 TradeMatchMaxOrder 个挂单
+	visited := 0
+	err := listMatchOrders(action.localdb, match, func(local *pty.LocalOrder) (bool, error) {
+		if local.AmountPerBoardlot > 0 {
+			price := calcPriceOfToken(local.PricePerBoardlot, local.AmountPerBoardlot)
+			if (match.IsSell && price < match.PriceLimit) || (!match.IsSell && price > match.PriceLimit) {
+				return false, nil
+			}
+		}
+		visited++
+		fill, receipt, err := action.matchOrder(match, local.Key, result.RemainAmount)
+		if err != nil {
+			return false, err
+		}
+		if fill != nil {
+			logs = append(logs, receipt.Logs...)
+			kv = append(kv, receipt.KV...)
+			result.Fills = append(result.Fills, fill)
+			result.FilledAmount += fill.BoardlotCnt * fill.AmountPerBoardlot
+			result.RemainAmount -= fill.BoardlotCnt * fill.AmountPerBoardlot
+			result.TotalPrice += fill.BoardlotCnt * fill.PricePerBoardlot
+		}
+		return result.RemainAmount > 0 && visited < pty.TradeMatchMaxOrder, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Fills) == 0 {
+		return nil, pty.ErrTMatchNotFilled
+	}
+
+	logs = append(logs, &types.ReceiptLog{Ty: pty.TyLogTradeMatch, Log: types.Encode(result)})
+	return &types.Receipt{Ty: types.ExecOk, KV: kv, Logs: logs}, nil
+}
+
+// matchOrder 与一个对手方挂单成交, 挂单不满足条件或者挂单者受token转账限制时返回 nil
+func (action *tradeAction) matchOrder(match *pty.TradeForMatch, orderID string, remainAmount int64) (*pty.TradeMatchFill, *types.Receipt, error) {
+	if match.IsSell {
+		buyOrder := action.loadMatchBuyOrder(match, orderID)
+		if buyOrder == nil || action.checkRestriction(match.AssetExec, match.TokenSymbol, match.PriceExec, match.PriceSymbol, buyOrder.Address, "") != nil {
+			return nil, nil, nil
+		}
+		cnt := calcMatchBoardlot(remainAmount, buyOrder.AmountPerBoardlot, buyOrder.TotalBoardlot-buyOrder.BoughtBoardlot)
+		if cnt <= 0 || cnt < buyOrder.MinBoardlot {
+			return nil, nil, nil
+		}
+		receipt, err := action.sellToBuyOrder(buyOrder, cnt)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &pty.TradeMatchFill{
+			OrderID:           buyOrder.BuyID,
+			Owner:             buyOrder.Address,
+			AmountPerBoardlot: buyOrder.AmountPerBoardlot,
+			PricePerBoardlot:  buyOrder.PricePerBoardlot,
+			BoardlotCnt:       cnt,
+			TradedBoardlot:    buyOrder.BoughtBoardlot,
+			Status:            buyOrder.Status,
+		}, receipt, nil
+	}
+
+	sellOrder := action.loadMatchSellOrder(match, orderID)
+	if sellOrder == nil || action.checkRestriction(match.AssetExec, match.TokenSymbol, match.PriceExec, match.PriceSymbol, sellOrder.Address, "") != nil {
+		return nil, nil, nil
+	}
+	cnt := calcMatchBoardlot(remainAmount, sellOrder.AmountPerBoardlot, sellOrder.TotalBoardlot-sellOrder.SoldBoardlot)
+	if cnt <= 0 || cnt < sellOrder.MinBoardlot {
+		return nil, nil, nil
+	}
+	receipt, err := action.buyFromSellOrder(sellOrder, cnt)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &pty.TradeMatchFill{
+		OrderID:           sellOrder.SellID,
+		Owner:             sellOrder.Address,
+		AmountPerBoardlot: sellOrder.AmountPerBoardlot,
+		PricePerBoardlot:  sellOrder.PricePerBoardlot,
+		BoardlotCnt:       cnt,
+		TradedBoardlot:    sellOrder.SoldBoardlot,
+		Status:            sellOrder.Status,
+	}, receipt, nil
+}
+
+// checkRestriction 在合约内部转移token时检查token的暂停, 冻结和白名单限制, to 为空时只检查 from
+// 分叉之前的挂单没有记录资产的执行器, 默认为token
+func (action *tradeAction) checkRestriction(assetExec, symbol, priceExec, priceSymbol, from, to string) error {
+	cfg := action.api.GetConfig()
+	if assetExec == "" {
+		assetExec = defaultAssetExec
+	}
+	if err := tokenexec.CheckTransferRestriction(cfg, action.db, action.height, assetExec, symbol, from, to); err != nil {
+		return err
+	}
+	return tokenexec.CheckTransferRestriction(cfg, action.db, action.height, priceExec, priceSymbol, from, to)
+}
+
+// 剩余数量最多可以成交的手数
+func calcMatchBoardlot(remainAmount, amountPerBoardlot, leftBoardlot int64) int64 {
+	cnt := remainAmount / amountPerBoardlot
+	if cnt > leftBoardlot {
+		cnt = leftBoardlot
+	}
+	return cnt
+}
+
+// loadMatchSellOrder 检查候选的卖单, 只有交易对一致, 在售, 不属于吃单方且价格不超过限价的卖单才可以成交
+func (action *tradeAction) loadMatchSellOrder(match *pty.TradeForMatch, sellID string) *pty.SellOrder {
+	if !strings.HasPrefix(sellID, sellIDPrefix) {
+		return nil
+	}
+	order, err := getSellOrderFromID([]byte(sellID), action.db)
+	if err != nil || order.SellID != sellID || order.Status != pty.TradeOrderStatusOnSale || order.Address == action.fromaddr {
+		return nil
+	}
+	if !action.isMatchAsset(match, order.AssetExec, order.TokenSymbol, order.PriceExec, order.PriceSymbol) || order.AmountPerBoardlot <= 0 {
+		return nil
+	}
+	if calcPriceOfToken(order.PricePerBoardlot, order.AmountPerBoardlot) > match.PriceLimit {
+		return nil
+	}
+	return order
+}
+
+// loadMatchBuyOrder 检查候选的买单, 只有交易对一致, 求购中, 不属于吃单方且价格不低于限价的买单才可以成交
+func (action *tradeAction) loadMatchBuyOrder(match *pty.TradeForMatch, buyID string) *pty.BuyLimitOrder {
+	if !strings.HasPrefix(buyID, buyIDPrefix) {
+		return nil
+	}
+	order, err := getBuyOrderFromID([]byte(buyID), action.db)
+	if err != nil || order.BuyID != buyID || order.Status != pty.TradeOrderStatusOnBuy || order.Address == action.fromaddr {
+		return nil
+	}
+	if !action.isMatchAsset(match, order.AssetExec, order.TokenSymbol, order.PriceExec, order.PriceSymbol) || order.AmountPerBoardlot <= 0 {
+		return nil
+	}
+	if calcPriceOfToken(order.PricePerBoardlot, order.AmountPerBoardlot) < match.PriceLimit {
+		return nil
+	}
+	return order
+}
+
+// isMatchAsset 分叉之前的挂单没有记录资产和定价资产的执行器, 按默认值比较
+func (action *tradeAction) isMatchAsset(match *pty.TradeForMatch, assetExec, symbol, priceExec, priceSymbol string) bool {
+	if assetExec == "" {
+		assetExec = defaultAssetExec
+	}
+	if priceExec == "" {
+		priceExec = defaultPriceExec
+		priceSymbol = action.api.GetConfig().GetCoinSymbol()
+	}
+	return assetExec == match.AssetExec && symbol == match.TokenSymbol && priceExec == match.PriceExec && priceSymbol == match.PriceSymbol
+}
+
+// listMatchOrders 按价格档位遍历对手方的挂单, visit 返回 false 时停止
+func listMatchOrders(localdb dbm.KV, match *pty.TradeForMatch, visit func(*pty.LocalOrder) (bool, error)) error {
+	const indexName = "asset_isSell_status_price"
+	const pageSize = 20
+
+	ldb := NewOrderTableV2(localdb)
+	cond := &OrderV2Row{LocalOrder: &pty.LocalOrder{
+		AssetSymbol: match.TokenSymbol,
+		AssetExec:   match.AssetExec,
+		PriceExec:   match.PriceExec,
+		PriceSymbol: match.PriceSymbol,
+		IsSellOrder: !match.IsSell,
+		Status:      pty.TradeOrderStatusOnSale,
+	}}
+	prefix, err := cond.Get(indexName)
+	if err != nil {
+		return err
+	}
+	direction := int32(dbm.ListASC)
+	if match.IsSell {
+		direction = dbm.ListDESC
+	}
+
+	var edge []byte
+	for {
+		// 下一个价格档位
+		heads, err := ldb.ListIndex(indexName, prefix, edge, 1, direction)
+		if err == types.ErrNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		head := heads[0].Data.(*pty.LocalOrder)
+		if head.AmountPerBoardlot <= 0 {
+			// 没有价格的异常挂单, 单独作为一档
+			if goOn, err := visit(head); err != nil || !goOn {
+				return err
+			}
+			edge = heads[0].Primary
+			continue
+		}
+		level, err := (&OrderV2Row{LocalOrder: head}).Get(indexName)
+		if err != nil {
+			return err
+		}
+
+		// 同一价格档位内按挂单的先后顺序
+		var first, last []byte
+		for {
+			rows, err := ldb.ListIndex(indexName, level, last, pageSize, dbm.ListASC)
+			if err == types.ErrNotFound {
+				break
+			}
+			if err != nil {
+				return err
+			}
+			if first == nil {
+				first = rows[0].Primary
+			}
+			for _, row := range rows {
+				if goOn, err := visit(row.Data.(*pty.LocalOrder)); err != nil || !goOn {
+					return err
+				}
+			}
+			last = rows[len(rows)-1].Primary
+			if len(rows) < pageSize {
+				break
+			}
+		}
+		if direction == dbm.ListASC {
+			edge = last
+		} else {
+			edge = first
+		}
+	}
+}
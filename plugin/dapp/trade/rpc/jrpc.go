@@ -133,3 +133,26 @@ func (jrpc *Jrpc) CreateRawTradeRevokeBuyTx(in *ptypes.TradeRevokeBuyTx, result
 	*result = hex.EncodeToString(reply.Data)
 	return nil
 }
+
+//CreateRawTradeMatchTx : 按价格优先撮合吃单
+func (jrpc *Jrpc) CreateRawTradeMatchTx(in *ptypes.TradeMatchTx, result *interface{}) error {
+	if in == nil {
+		return types.ErrInvalidParam
+	}
+	param := &ptypes.TradeForMatch{
+		TokenSymbol: in.TokenSymbol,
+		AssetExec:   in.AssetExec,
+		PriceExec:   in.PriceExec,
+		PriceSymbol: in.PriceSymbol,
+		IsSell:      in.IsSell,
+		PriceLimit:  in.PriceLimit,
+		Amount:      in.Amount,
+	}
+
+	reply, err := jrpc.cli.CreateRawTradeMatchTx(context.Background(), param)
+	if err != nil {
+		return err
+	}
+	*result = hex.EncodeToString(reply.Data)
+	return nil
+}
@@ -143,6 +143,27 @@ func TestChain33_CreateRawTradeRevokeBuyTx(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestChain33_CreateRawTradeMatchTx(t *testing.T) {
+	_, client := newTestChain33()
+	var testResult interface{}
+	err := client.CreateRawTradeMatchTx(nil, &testResult)
+	assert.NotNil(t, err)
+	assert.Nil(t, testResult)
+
+	token := &pty.TradeMatchTx{
+		TokenSymbol: "CNY",
+		AssetExec:   "token",
+		IsSell:      true,
+		PriceLimit:  100,
+		Amount:      100,
+		Fee:         1,
+	}
+
+	err = client.CreateRawTradeMatchTx(token, &testResult)
+	assert.NotNil(t, testResult)
+	assert.Nil(t, err)
+}
+
 func TestDecodeLogTradeSellLimit(t *testing.T) {
 	var logTmp = &pty.ReceiptTradeSellLimit{}
 	dec := types.Encode(logTmp)
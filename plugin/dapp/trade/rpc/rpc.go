@@ -118,3 +118,21 @@ func (cc *channelClient) CreateRawTradeRevokeBuyTx(ctx context.Context, in *ptyp
 	data := types.Encode(tx)
 	return &types.UnsignTx{Data: data}, nil
 }
+
+//CreateRawTradeMatchTx :
+func (cc *channelClient) CreateRawTradeMatchTx(ctx context.Context, in *ptypes.TradeForMatch) (*types.UnsignTx, error) {
+	if in == nil {
+		return nil, types.ErrInvalidParam
+	}
+	match := &ptypes.Trade{
+		Ty:    ptypes.TradeMatch,
+		Value: &ptypes.Trade_Match{Match: in},
+	}
+	cfg := cc.GetConfig()
+	tx, err := types.CreateFormatTx(cfg, cfg.ExecName(ptypes.TradeX), types.Encode(match))
+	if err != nil {
+		return nil, err
+	}
+	data := types.Encode(tx)
+	return &types.UnsignTx{Data: data}, nil
+}
@@ -121,3 +121,20 @@ func TestChannelClient_CreateRawTradeRevokeBuyTx(t *testing.T) {
 	assert.NotNil(t, data)
 	assert.Nil(t, err)
 }
+
+func TestChannelClient_CreateRawTradeMatchTx(t *testing.T) {
+	client := newTestChannelClient()
+	data, err := client.CreateRawTradeMatchTx(context.Background(), nil)
+	assert.NotNil(t, err)
+	assert.Nil(t, data)
+
+	token := &ptypes.TradeForMatch{
+		TokenSymbol: "CNY",
+		AssetExec:   "token",
+		PriceLimit:  100,
+		Amount:      100,
+	}
+	data, err = client.CreateRawTradeMatchTx(context.Background(), token)
+	assert.NotNil(t, data)
+	assert.Nil(t, err)
+}
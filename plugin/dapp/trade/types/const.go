@@ -12,6 +12,7 @@ const (
 	TradeSellMarket
 	TradeBuyLimit
 	TradeRevokeBuy
+	TradeMatch
 )
 
 // log
@@ -23,6 +24,7 @@ const (
 	TyLogTradeSellMarket = 330
 	TyLogTradeBuyLimit   = 331
 	TyLogTradeBuyRevoke  = 332
+	TyLogTradeMatch      = 333
 )
 
 // 0->not start, 1->on sale, 2->sold out, 3->revoke, 4->expired
@@ -94,4 +96,11 @@ const (
 	ForkTradeFixAssetDBX = "ForkTradeFixAssetDB"
 	// ForkTradePriceX all asset can be price
 	ForkTradePriceX = "ForkTradePrice"
+	// ForkTradeMatchX support match orders by price in one tx
+	ForkTradeMatchX = "ForkTradeMatch"
+)
+
+const (
+	// TradeMatchMaxOrder 一笔撮合交易最多检查的挂单数量
+	TradeMatchMaxOrder = 100
 )
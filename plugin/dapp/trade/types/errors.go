@@ -39,4 +39,6 @@ var (
 	ErrTCntLessThanMinBoardlot = errors.New("ErrTradeCountLessThanMinBoardlot")
 	// ErrAssetAndPriceSame :
 	ErrAssetAndPriceSame = errors.New("ErrAssetAndPriceSame")
+	// ErrTMatchNotFilled :
+	ErrTMatchNotFilled = errors.New("ErrTradeMatchNotFilled")
 )
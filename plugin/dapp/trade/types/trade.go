@@ -24,6 +24,7 @@ var (
 		"BuyLimit":   TradeBuyLimit,
 		"SellMarket": TradeSellMarket,
 		"RevokeBuy":  TradeRevokeBuy,
+		"Match":      TradeMatch,
 	}
 
 	logInfo = map[int64]*types.LogInfo{
@@ -33,6 +34,7 @@ var (
 		TyLogTradeSellMarket: {Ty: reflect.TypeOf(ReceiptSellMarket{}), Name: "LogTradeSellMarket"},
 		TyLogTradeBuyLimit:   {Ty: reflect.TypeOf(ReceiptTradeBuyLimit{}), Name: "LogTradeBuyLimit"},
 		TyLogTradeBuyRevoke:  {Ty: reflect.TypeOf(ReceiptTradeBuyRevoke{}), Name: "LogTradeBuyRevoke"},
+		TyLogTradeMatch:      {Ty: reflect.TypeOf(ReceiptTradeMatch{}), Name: "LogTradeMatch"},
 	}
 )
 
@@ -65,6 +67,7 @@ func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(TradeX, ForkTradeIDX, 1450000)
 	cfg.RegisterDappFork(TradeX, ForkTradeFixAssetDBX, 2500000)
 	cfg.RegisterDappFork(TradeX, ForkTradePriceX, 3150000)
+	cfg.RegisterDappFork(TradeX, ForkTradeMatchX, 10000000)
 }
 
 //InitExecutor ...
@@ -109,6 +112,8 @@ func (t *TradeType) ActionName(tx *types.Transaction) string {
 		return "sellmarkettoken"
 	} else if action.Ty == TradeRevokeBuy && action.GetRevokeBuy() != nil {
 		return "revokebuytoken"
+	} else if action.Ty == TradeMatch && action.GetMatch() != nil {
+		return "matchtoken"
 	}
 	return "unknown"
 }
@@ -184,6 +189,14 @@ func (t *TradeType) CreateTx(action string, message json.RawMessage) (*types.Tra
 			return nil, types.ErrInvalidParam
 		}
 		return CreateRawTradeRevokeBuyTx(cfg, &param)
+	} else if action == "TradeMatch" {
+		var param TradeMatchTx
+		err := json.Unmarshal(message, &param)
+		if err != nil {
+			tlog.Error("CreateTx", "Error", err)
+			return nil, types.ErrInvalidParam
+		}
+		return CreateRawTradeMatchTx(cfg, &param)
 	}
 
 	return nil, types.ErrNotSupport
@@ -289,3 +302,24 @@ func CreateRawTradeRevokeBuyTx(cfg *types.Chain33Config, parm *TradeRevokeBuyTx)
 	}
 	return types.CreateFormatTx(cfg, cfg.ExecName(TradeX), types.Encode(buy))
 }
+
+//CreateRawTradeMatchTx : 按价格优先撮合吃单的交易
+func CreateRawTradeMatchTx(cfg *types.Chain33Config, parm *TradeMatchTx) (*types.Transaction, error) {
+	if parm == nil {
+		return nil, types.ErrInvalidParam
+	}
+	v := &TradeForMatch{
+		TokenSymbol: parm.TokenSymbol,
+		AssetExec:   parm.AssetExec,
+		PriceExec:   parm.PriceExec,
+		PriceSymbol: parm.PriceSymbol,
+		IsSell:      parm.IsSell,
+		PriceLimit:  parm.PriceLimit,
+		Amount:      parm.Amount,
+	}
+	match := &Trade{
+		Ty:    TradeMatch,
+		Value: &Trade_Match{v},
+	}
+	return types.CreateFormatTx(cfg, cfg.ExecName(TradeX), types.Encode(match))
+}
@@ -27,7 +27,6 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 // trade 交易部分
-//
 type Trade struct {
 	// Types that are valid to be assigned to Value:
 	//	*Trade_SellLimit
@@ -36,6 +35,7 @@ type Trade struct {
 	//	*Trade_BuyLimit
 	//	*Trade_SellMarket
 	//	*Trade_RevokeBuy
+	//	*Trade_Match
 	Value                isTrade_Value `protobuf_oneof:"value"`
 	Ty                   int32         `protobuf:"varint,4,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -96,6 +96,10 @@ type Trade_RevokeBuy struct {
 	RevokeBuy *TradeForRevokeBuy `protobuf:"bytes,7,opt,name=revokeBuy,proto3,oneof"`
 }
 
+type Trade_Match struct {
+	Match *TradeForMatch `protobuf:"bytes,8,opt,name=match,proto3,oneof"`
+}
+
 func (*Trade_SellLimit) isTrade_Value() {}
 
 func (*Trade_BuyMarket) isTrade_Value() {}
@@ -108,6 +112,8 @@ func (*Trade_SellMarket) isTrade_Value() {}
 
 func (*Trade_RevokeBuy) isTrade_Value() {}
 
+func (*Trade_Match) isTrade_Value() {}
+
 func (m *Trade) GetValue() isTrade_Value {
 	if m != nil {
 		return m.Value
@@ -157,6 +163,13 @@ func (m *Trade) GetRevokeBuy() *TradeForRevokeBuy {
 	return nil
 }
 
+func (m *Trade) GetMatch() *TradeForMatch {
+	if x, ok := m.GetValue().(*Trade_Match); ok {
+		return x.Match
+	}
+	return nil
+}
+
 func (m *Trade) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -173,20 +186,21 @@ func (*Trade) XXX_OneofWrappers() []interface{} {
 		(*Trade_BuyLimit)(nil),
 		(*Trade_SellMarket)(nil),
 		(*Trade_RevokeBuy)(nil),
+		(*Trade_Match)(nil),
 	}
 }
 
 // 创建众筹交易,确定一手交易的token的数量，单价以及总共有多少手token可以进行众筹
 type TradeForSell struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
-	// 每一手出售的token的数量
+	//每一手出售的token的数量
 	AmountPerBoardlot int64 `protobuf:"varint,2,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
 	// 起卖手数,必须达到这个门槛才允许进行交易
 	MinBoardlot int64 `protobuf:"varint,3,opt,name=minBoardlot,proto3" json:"minBoardlot,omitempty"`
-	// 每一手token的价格
+	//每一手token的价格
 	PricePerBoardlot int64 `protobuf:"varint,4,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
 	TotalBoardlot    int64 `protobuf:"varint,5,opt,name=totalBoardlot,proto3" json:"totalBoardlot,omitempty"`
-	// 此次出售的起始时间，如果非众筹则可以忽略此时间
+	//此次出售的起始时间，如果非众筹则可以忽略此时间
 	Starttime int64 `protobuf:"varint,6,opt,name=starttime,proto3" json:"starttime,omitempty"`
 	Stoptime  int64 `protobuf:"varint,7,opt,name=stoptime,proto3" json:"stoptime,omitempty"`
 	Crowdfund bool  `protobuf:"varint,8,opt,name=crowdfund,proto3" json:"crowdfund,omitempty"`
@@ -576,6 +590,146 @@ func (m *TradeForRevokeBuy) GetBuyID() string {
 	return ""
 }
 
+// 撮合成交: 按价格优先, 时间优先的顺序吃掉对手方的挂单
+// 一笔交易内可以部分成交, 未成交的部分不挂单, 在收据中返回
+type TradeForMatch struct {
+	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	AssetExec   string `protobuf:"bytes,2,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec   string `protobuf:"bytes,3,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,4,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	// true: 出售token, 吃买单; false: 购买token, 吃卖单
+	IsSell bool `protobuf:"varint,5,opt,name=isSell,proto3" json:"isSell,omitempty"`
+	// 每个token的限价, 单位和订单列表的价格一致
+	PriceLimit int64 `protobuf:"varint,6,opt,name=priceLimit,proto3" json:"priceLimit,omitempty"`
+	// 希望成交的token的数量
+	Amount               int64    `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeForMatch) Reset()         { *m = TradeForMatch{} }
+func (m *TradeForMatch) String() string { return proto.CompactTextString(m) }
+func (*TradeForMatch) ProtoMessage()    {}
+func (*TradeForMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{7}
+}
+
+func (m *TradeForMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeForMatch.Unmarshal(m, b)
+}
+func (m *TradeForMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeForMatch.Marshal(b, m, deterministic)
+}
+func (m *TradeForMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeForMatch.Merge(m, src)
+}
+func (m *TradeForMatch) XXX_Size() int {
+	return xxx_messageInfo_TradeForMatch.Size(m)
+}
+func (m *TradeForMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeForMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeForMatch proto.InternalMessageInfo
+
+func (m *TradeForMatch) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *TradeForMatch) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *TradeForMatch) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *TradeForMatch) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *TradeForMatch) GetIsSell() bool {
+	if m != nil {
+		return m.IsSell
+	}
+	return false
+}
+
+func (m *TradeForMatch) GetPriceLimit() int64 {
+	if m != nil {
+		return m.PriceLimit
+	}
+	return 0
+}
+
+func (m *TradeForMatch) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// 查询撮合的候选挂单, 不包含 owner 自己的挂单
+type ReqMatchOrders struct {
+	Match                *TradeForMatch `protobuf:"bytes,1,opt,name=match,proto3" json:"match,omitempty"`
+	Owner                string         `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReqMatchOrders) Reset()         { *m = ReqMatchOrders{} }
+func (m *ReqMatchOrders) String() string { return proto.CompactTextString(m) }
+func (*ReqMatchOrders) ProtoMessage()    {}
+func (*ReqMatchOrders) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{8}
+}
+
+func (m *ReqMatchOrders) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqMatchOrders.Unmarshal(m, b)
+}
+func (m *ReqMatchOrders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqMatchOrders.Marshal(b, m, deterministic)
+}
+func (m *ReqMatchOrders) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqMatchOrders.Merge(m, src)
+}
+func (m *ReqMatchOrders) XXX_Size() int {
+	return xxx_messageInfo_ReqMatchOrders.Size(m)
+}
+func (m *ReqMatchOrders) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqMatchOrders.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqMatchOrders proto.InternalMessageInfo
+
+func (m *ReqMatchOrders) GetMatch() *TradeForMatch {
+	if m != nil {
+		return m.Match
+	}
+	return nil
+}
+
+func (m *ReqMatchOrders) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 // 数据库部分
 type SellOrder struct {
 	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
@@ -607,7 +761,7 @@ func (m *SellOrder) Reset()         { *m = SellOrder{} }
 func (m *SellOrder) String() string { return proto.CompactTextString(m) }
 func (*SellOrder) ProtoMessage()    {}
 func (*SellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{7}
+	return fileDescriptor_ee944bd90e8a0312, []int{9}
 }
 
 func (m *SellOrder) XXX_Unmarshal(b []byte) error {
@@ -764,7 +918,7 @@ func (m *BuyLimitOrder) Reset()         { *m = BuyLimitOrder{} }
 func (m *BuyLimitOrder) String() string { return proto.CompactTextString(m) }
 func (*BuyLimitOrder) ProtoMessage()    {}
 func (*BuyLimitOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{8}
+	return fileDescriptor_ee944bd90e8a0312, []int{10}
 }
 
 func (m *BuyLimitOrder) XXX_Unmarshal(b []byte) error {
@@ -902,7 +1056,7 @@ func (m *ReceiptBuyBase) Reset()         { *m = ReceiptBuyBase{} }
 func (m *ReceiptBuyBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptBuyBase) ProtoMessage()    {}
 func (*ReceiptBuyBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{9}
+	return fileDescriptor_ee944bd90e8a0312, []int{11}
 }
 
 func (m *ReceiptBuyBase) XXX_Unmarshal(b []byte) error {
@@ -1061,7 +1215,7 @@ func (m *ReceiptSellBase) Reset()         { *m = ReceiptSellBase{} }
 func (m *ReceiptSellBase) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellBase) ProtoMessage()    {}
 func (*ReceiptSellBase) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{10}
+	return fileDescriptor_ee944bd90e8a0312, []int{12}
 }
 
 func (m *ReceiptSellBase) XXX_Unmarshal(b []byte) error {
@@ -1219,7 +1373,7 @@ func (m *ReceiptTradeBuyMarket) Reset()         { *m = ReceiptTradeBuyMarket{} }
 func (m *ReceiptTradeBuyMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyMarket) ProtoMessage()    {}
 func (*ReceiptTradeBuyMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{11}
+	return fileDescriptor_ee944bd90e8a0312, []int{13}
 }
 
 func (m *ReceiptTradeBuyMarket) XXX_Unmarshal(b []byte) error {
@@ -1258,7 +1412,7 @@ func (m *ReceiptTradeBuyLimit) Reset()         { *m = ReceiptTradeBuyLimit{} }
 func (m *ReceiptTradeBuyLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyLimit) ProtoMessage()    {}
 func (*ReceiptTradeBuyLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{12}
+	return fileDescriptor_ee944bd90e8a0312, []int{14}
 }
 
 func (m *ReceiptTradeBuyLimit) XXX_Unmarshal(b []byte) error {
@@ -1297,7 +1451,7 @@ func (m *ReceiptTradeBuyRevoke) Reset()         { *m = ReceiptTradeBuyRevoke{} }
 func (m *ReceiptTradeBuyRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeBuyRevoke) ProtoMessage()    {}
 func (*ReceiptTradeBuyRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{13}
+	return fileDescriptor_ee944bd90e8a0312, []int{15}
 }
 
 func (m *ReceiptTradeBuyRevoke) XXX_Unmarshal(b []byte) error {
@@ -1336,7 +1490,7 @@ func (m *ReceiptTradeSellLimit) Reset()         { *m = ReceiptTradeSellLimit{} }
 func (m *ReceiptTradeSellLimit) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellLimit) ProtoMessage()    {}
 func (*ReceiptTradeSellLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{14}
+	return fileDescriptor_ee944bd90e8a0312, []int{16}
 }
 
 func (m *ReceiptTradeSellLimit) XXX_Unmarshal(b []byte) error {
@@ -1375,7 +1529,7 @@ func (m *ReceiptSellMarket) Reset()         { *m = ReceiptSellMarket{} }
 func (m *ReceiptSellMarket) String() string { return proto.CompactTextString(m) }
 func (*ReceiptSellMarket) ProtoMessage()    {}
 func (*ReceiptSellMarket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{15}
+	return fileDescriptor_ee944bd90e8a0312, []int{17}
 }
 
 func (m *ReceiptSellMarket) XXX_Unmarshal(b []byte) error {
@@ -1414,7 +1568,7 @@ func (m *ReceiptTradeSellRevoke) Reset()         { *m = ReceiptTradeSellRevoke{}
 func (m *ReceiptTradeSellRevoke) String() string { return proto.CompactTextString(m) }
 func (*ReceiptTradeSellRevoke) ProtoMessage()    {}
 func (*ReceiptTradeSellRevoke) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{16}
+	return fileDescriptor_ee944bd90e8a0312, []int{18}
 }
 
 func (m *ReceiptTradeSellRevoke) XXX_Unmarshal(b []byte) error {
@@ -1442,6 +1596,242 @@ func (m *ReceiptTradeSellRevoke) GetBase() *ReceiptSellBase {
 	return nil
 }
 
+// 撮合中每一个被吃掉的挂单
+type TradeMatchFill struct {
+	// sellID 或 buyID
+	OrderID           string `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Owner             string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AmountPerBoardlot int64  `protobuf:"varint,3,opt,name=amountPerBoardlot,proto3" json:"amountPerBoardlot,omitempty"`
+	PricePerBoardlot  int64  `protobuf:"varint,4,opt,name=pricePerBoardlot,proto3" json:"pricePerBoardlot,omitempty"`
+	// 本次成交的手数
+	BoardlotCnt int64 `protobuf:"varint,5,opt,name=boardlotCnt,proto3" json:"boardlotCnt,omitempty"`
+	// 挂单成交后的状态
+	TradedBoardlot       int64    `protobuf:"varint,6,opt,name=tradedBoardlot,proto3" json:"tradedBoardlot,omitempty"`
+	Status               int32    `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TradeMatchFill) Reset()         { *m = TradeMatchFill{} }
+func (m *TradeMatchFill) String() string { return proto.CompactTextString(m) }
+func (*TradeMatchFill) ProtoMessage()    {}
+func (*TradeMatchFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{19}
+}
+
+func (m *TradeMatchFill) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TradeMatchFill.Unmarshal(m, b)
+}
+func (m *TradeMatchFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TradeMatchFill.Marshal(b, m, deterministic)
+}
+func (m *TradeMatchFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TradeMatchFill.Merge(m, src)
+}
+func (m *TradeMatchFill) XXX_Size() int {
+	return xxx_messageInfo_TradeMatchFill.Size(m)
+}
+func (m *TradeMatchFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_TradeMatchFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TradeMatchFill proto.InternalMessageInfo
+
+func (m *TradeMatchFill) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *TradeMatchFill) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *TradeMatchFill) GetAmountPerBoardlot() int64 {
+	if m != nil {
+		return m.AmountPerBoardlot
+	}
+	return 0
+}
+
+func (m *TradeMatchFill) GetPricePerBoardlot() int64 {
+	if m != nil {
+		return m.PricePerBoardlot
+	}
+	return 0
+}
+
+func (m *TradeMatchFill) GetBoardlotCnt() int64 {
+	if m != nil {
+		return m.BoardlotCnt
+	}
+	return 0
+}
+
+func (m *TradeMatchFill) GetTradedBoardlot() int64 {
+	if m != nil {
+		return m.TradedBoardlot
+	}
+	return 0
+}
+
+func (m *TradeMatchFill) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type ReceiptTradeMatch struct {
+	TokenSymbol string `protobuf:"bytes,1,opt,name=tokenSymbol,proto3" json:"tokenSymbol,omitempty"`
+	Owner       string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	AssetExec   string `protobuf:"bytes,3,opt,name=assetExec,proto3" json:"assetExec,omitempty"`
+	PriceExec   string `protobuf:"bytes,4,opt,name=priceExec,proto3" json:"priceExec,omitempty"`
+	PriceSymbol string `protobuf:"bytes,5,opt,name=priceSymbol,proto3" json:"priceSymbol,omitempty"`
+	IsSell      bool   `protobuf:"varint,6,opt,name=isSell,proto3" json:"isSell,omitempty"`
+	PriceLimit  int64  `protobuf:"varint,7,opt,name=priceLimit,proto3" json:"priceLimit,omitempty"`
+	// 请求的数量, 成交的数量和未成交的数量
+	Amount       int64 `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	FilledAmount int64 `protobuf:"varint,9,opt,name=filledAmount,proto3" json:"filledAmount,omitempty"`
+	RemainAmount int64 `protobuf:"varint,10,opt,name=remainAmount,proto3" json:"remainAmount,omitempty"`
+	// 成交的总价
+	TotalPrice           int64             `protobuf:"varint,11,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	Fills                []*TradeMatchFill `protobuf:"bytes,12,rep,name=fills,proto3" json:"fills,omitempty"`
+	TxHash               string            `protobuf:"bytes,13,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Height               int64             `protobuf:"varint,14,opt,name=height,proto3" json:"height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReceiptTradeMatch) Reset()         { *m = ReceiptTradeMatch{} }
+func (m *ReceiptTradeMatch) String() string { return proto.CompactTextString(m) }
+func (*ReceiptTradeMatch) ProtoMessage()    {}
+func (*ReceiptTradeMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee944bd90e8a0312, []int{20}
+}
+
+func (m *ReceiptTradeMatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceiptTradeMatch.Unmarshal(m, b)
+}
+func (m *ReceiptTradeMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceiptTradeMatch.Marshal(b, m, deterministic)
+}
+func (m *ReceiptTradeMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptTradeMatch.Merge(m, src)
+}
+func (m *ReceiptTradeMatch) XXX_Size() int {
+	return xxx_messageInfo_ReceiptTradeMatch.Size(m)
+}
+func (m *ReceiptTradeMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptTradeMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptTradeMatch proto.InternalMessageInfo
+
+func (m *ReceiptTradeMatch) GetTokenSymbol() string {
+	if m != nil {
+		return m.TokenSymbol
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetAssetExec() string {
+	if m != nil {
+		return m.AssetExec
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetPriceExec() string {
+	if m != nil {
+		return m.PriceExec
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetPriceSymbol() string {
+	if m != nil {
+		return m.PriceSymbol
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetIsSell() bool {
+	if m != nil {
+		return m.IsSell
+	}
+	return false
+}
+
+func (m *ReceiptTradeMatch) GetPriceLimit() int64 {
+	if m != nil {
+		return m.PriceLimit
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetFilledAmount() int64 {
+	if m != nil {
+		return m.FilledAmount
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetRemainAmount() int64 {
+	if m != nil {
+		return m.RemainAmount
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetTotalPrice() int64 {
+	if m != nil {
+		return m.TotalPrice
+	}
+	return 0
+}
+
+func (m *ReceiptTradeMatch) GetFills() []*TradeMatchFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *ReceiptTradeMatch) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ReceiptTradeMatch) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ReqAddrAssets struct {
 	Addr   string `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
 	Status int32  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1459,7 +1849,7 @@ func (m *ReqAddrAssets) Reset()         { *m = ReqAddrAssets{} }
 func (m *ReqAddrAssets) String() string { return proto.CompactTextString(m) }
 func (*ReqAddrAssets) ProtoMessage()    {}
 func (*ReqAddrAssets) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{17}
+	return fileDescriptor_ee944bd90e8a0312, []int{21}
 }
 
 func (m *ReqAddrAssets) XXX_Unmarshal(b []byte) error {
@@ -1523,7 +1913,9 @@ func (m *ReqAddrAssets) GetFromKey() string {
 }
 
 // 获取Token未完成卖单的交易列表
-// 	 fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
+//	fromKey : 第一次传参为空，获取卖单单价最低的列表。 当要获得下一页时，
+//
 // 传当前页最后一个；当要获得上一页时， 传当前页第一个。 	 count
 // :获取交易列表的个数。 	 direction :查找方式；0，上一页；1，下一页。
 // 越靠后的也单价越贵
@@ -1542,7 +1934,7 @@ func (m *ReqTokenSellOrder) Reset()         { *m = ReqTokenSellOrder{} }
 func (m *ReqTokenSellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenSellOrder) ProtoMessage()    {}
 func (*ReqTokenSellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{18}
+	return fileDescriptor_ee944bd90e8a0312, []int{22}
 }
 
 func (m *ReqTokenSellOrder) XXX_Unmarshal(b []byte) error {
@@ -1613,7 +2005,7 @@ func (m *ReqTokenBuyOrder) Reset()         { *m = ReqTokenBuyOrder{} }
 func (m *ReqTokenBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqTokenBuyOrder) ProtoMessage()    {}
 func (*ReqTokenBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{19}
+	return fileDescriptor_ee944bd90e8a0312, []int{23}
 }
 
 func (m *ReqTokenBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1693,7 +2085,7 @@ func (m *ReplyBuyOrder) Reset()         { *m = ReplyBuyOrder{} }
 func (m *ReplyBuyOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrder) ProtoMessage()    {}
 func (*ReplyBuyOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{20}
+	return fileDescriptor_ee944bd90e8a0312, []int{24}
 }
 
 func (m *ReplyBuyOrder) XXX_Unmarshal(b []byte) error {
@@ -1836,7 +2228,7 @@ func (m *ReplySellOrder) Reset()         { *m = ReplySellOrder{} }
 func (m *ReplySellOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrder) ProtoMessage()    {}
 func (*ReplySellOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{21}
+	return fileDescriptor_ee944bd90e8a0312, []int{25}
 }
 
 func (m *ReplySellOrder) XXX_Unmarshal(b []byte) error {
@@ -1966,7 +2358,7 @@ func (m *ReplySellOrders) Reset()         { *m = ReplySellOrders{} }
 func (m *ReplySellOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplySellOrders) ProtoMessage()    {}
 func (*ReplySellOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{22}
+	return fileDescriptor_ee944bd90e8a0312, []int{26}
 }
 
 func (m *ReplySellOrders) XXX_Unmarshal(b []byte) error {
@@ -2005,7 +2397,7 @@ func (m *ReplyBuyOrders) Reset()         { *m = ReplyBuyOrders{} }
 func (m *ReplyBuyOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyBuyOrders) ProtoMessage()    {}
 func (*ReplyBuyOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{23}
+	return fileDescriptor_ee944bd90e8a0312, []int{27}
 }
 
 func (m *ReplyBuyOrders) XXX_Unmarshal(b []byte) error {
@@ -2061,7 +2453,7 @@ func (m *ReplyTradeOrder) Reset()         { *m = ReplyTradeOrder{} }
 func (m *ReplyTradeOrder) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrder) ProtoMessage()    {}
 func (*ReplyTradeOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{24}
+	return fileDescriptor_ee944bd90e8a0312, []int{28}
 }
 
 func (m *ReplyTradeOrder) XXX_Unmarshal(b []byte) error {
@@ -2219,7 +2611,7 @@ func (m *ReplyTradeOrders) Reset()         { *m = ReplyTradeOrders{} }
 func (m *ReplyTradeOrders) String() string { return proto.CompactTextString(m) }
 func (*ReplyTradeOrders) ProtoMessage()    {}
 func (*ReplyTradeOrders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{25}
+	return fileDescriptor_ee944bd90e8a0312, []int{29}
 }
 
 func (m *ReplyTradeOrders) XXX_Unmarshal(b []byte) error {
@@ -2259,7 +2651,7 @@ func (m *ReqSellToken) Reset()         { *m = ReqSellToken{} }
 func (m *ReqSellToken) String() string { return proto.CompactTextString(m) }
 func (*ReqSellToken) ProtoMessage()    {}
 func (*ReqSellToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{26}
+	return fileDescriptor_ee944bd90e8a0312, []int{30}
 }
 
 func (m *ReqSellToken) XXX_Unmarshal(b []byte) error {
@@ -2306,7 +2698,7 @@ func (m *ReqRevokeSell) Reset()         { *m = ReqRevokeSell{} }
 func (m *ReqRevokeSell) String() string { return proto.CompactTextString(m) }
 func (*ReqRevokeSell) ProtoMessage()    {}
 func (*ReqRevokeSell) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{27}
+	return fileDescriptor_ee944bd90e8a0312, []int{31}
 }
 
 func (m *ReqRevokeSell) XXX_Unmarshal(b []byte) error {
@@ -2353,7 +2745,7 @@ func (m *ReqBuyToken) Reset()         { *m = ReqBuyToken{} }
 func (m *ReqBuyToken) String() string { return proto.CompactTextString(m) }
 func (*ReqBuyToken) ProtoMessage()    {}
 func (*ReqBuyToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{28}
+	return fileDescriptor_ee944bd90e8a0312, []int{32}
 }
 
 func (m *ReqBuyToken) XXX_Unmarshal(b []byte) error {
@@ -2418,7 +2810,7 @@ func (m *LocalOrder) Reset()         { *m = LocalOrder{} }
 func (m *LocalOrder) String() string { return proto.CompactTextString(m) }
 func (*LocalOrder) ProtoMessage()    {}
 func (*LocalOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee944bd90e8a0312, []int{29}
+	return fileDescriptor_ee944bd90e8a0312, []int{33}
 }
 
 func (m *LocalOrder) XXX_Unmarshal(b []byte) error {
@@ -2587,6 +2979,8 @@ func init() {
 	proto.RegisterType((*TradeForBuyLimit)(nil), "types.TradeForBuyLimit")
 	proto.RegisterType((*TradeForSellMarket)(nil), "types.TradeForSellMarket")
 	proto.RegisterType((*TradeForRevokeBuy)(nil), "types.TradeForRevokeBuy")
+	proto.RegisterType((*TradeForMatch)(nil), "types.TradeForMatch")
+	proto.RegisterType((*ReqMatchOrders)(nil), "types.ReqMatchOrders")
 	proto.RegisterType((*SellOrder)(nil), "types.SellOrder")
 	proto.RegisterType((*BuyLimitOrder)(nil), "types.BuyLimitOrder")
 	proto.RegisterType((*ReceiptBuyBase)(nil), "types.ReceiptBuyBase")
@@ -2597,6 +2991,8 @@ func init() {
 	proto.RegisterType((*ReceiptTradeSellLimit)(nil), "types.ReceiptTradeSellLimit")
 	proto.RegisterType((*ReceiptSellMarket)(nil), "types.ReceiptSellMarket")
 	proto.RegisterType((*ReceiptTradeSellRevoke)(nil), "types.ReceiptTradeSellRevoke")
+	proto.RegisterType((*TradeMatchFill)(nil), "types.TradeMatchFill")
+	proto.RegisterType((*ReceiptTradeMatch)(nil), "types.ReceiptTradeMatch")
 	proto.RegisterType((*ReqAddrAssets)(nil), "types.ReqAddrAssets")
 	proto.RegisterType((*ReqTokenSellOrder)(nil), "types.ReqTokenSellOrder")
 	proto.RegisterType((*ReqTokenBuyOrder)(nil), "types.ReqTokenBuyOrder")
@@ -2617,93 +3013,109 @@ func init() {
 }
 
 var fileDescriptor_ee944bd90e8a0312 = []byte{
-	// 1371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x8a, 0xdb, 0xc6,
-	0x17, 0x5f, 0x5b, 0x96, 0xd7, 0x3a, 0xfe, 0x58, 0xef, 0xc4, 0xd9, 0xbf, 0xb2, 0xfc, 0x29, 0x46,
-	0x84, 0x36, 0x09, 0x61, 0xa1, 0x09, 0x81, 0x42, 0x4b, 0x4b, 0x9c, 0x34, 0x75, 0xda, 0x84, 0x96,
-	0x59, 0x17, 0x7a, 0x2b, 0x5b, 0x93, 0xac, 0x58, 0x59, 0xf2, 0x4a, 0xa3, 0xc4, 0x7a, 0x83, 0x42,
-	0xdf, 0xa0, 0xbd, 0xe8, 0x03, 0xf4, 0x36, 0x50, 0x42, 0xdf, 0xa7, 0x97, 0xbd, 0xea, 0x03, 0x94,
-	0x19, 0x8d, 0xa5, 0xd1, 0x97, 0x3f, 0x20, 0x85, 0x4d, 0xd2, 0x3b, 0xcf, 0x99, 0x33, 0x67, 0x8e,
-	0xce, 0xef, 0xf7, 0x9b, 0x2f, 0x43, 0x9b, 0xfa, 0xa6, 0x45, 0x4e, 0x16, 0xbe, 0x47, 0x3d, 0xa4,
-	0xd2, 0x68, 0x41, 0x82, 0xe3, 0x43, 0xea, 0x9b, 0x6e, 0x60, 0xce, 0xa8, 0xed, 0xb9, 0x71, 0x8f,
-	0xf1, 0x77, 0x1d, 0xd4, 0x09, 0xf3, 0x44, 0x77, 0x41, 0x0b, 0x88, 0xe3, 0x3c, 0xb1, 0xe7, 0x36,
-	0xd5, 0x6b, 0xc3, 0xda, 0x8d, 0xf6, 0x9d, 0x2b, 0x27, 0x7c, 0xdc, 0x09, 0x77, 0x78, 0xe4, 0xf9,
-	0xa7, 0xc4, 0x71, 0xc6, 0x7b, 0x38, 0xf5, 0x43, 0x77, 0x40, 0x9b, 0x86, 0xd1, 0x53, 0xd3, 0x3f,
-	0x27, 0x54, 0xaf, 0xf3, 0x41, 0x28, 0x37, 0x68, 0x14, 0x46, 0x6c, 0x4c, 0xe2, 0x86, 0x3e, 0x05,
-	0xf0, 0xc9, 0x0b, 0xef, 0x9c, 0xb0, 0x70, 0xba, 0xc2, 0x07, 0x5d, 0xcb, 0x0d, 0xc2, 0x89, 0xc3,
-	0x78, 0x0f, 0x4b, 0xee, 0xe8, 0x1e, 0xb4, 0xa6, 0x61, 0x14, 0x27, 0xa9, 0xf2, 0xa1, 0xff, 0x2b,
-	0xce, 0xc7, 0xbb, 0xc7, 0x7b, 0x38, 0x71, 0x65, 0x73, 0xb2, 0xa4, 0x45, 0xa2, 0xcd, 0xd2, 0x39,
-	0x4f, 0x13, 0x07, 0x36, 0x67, 0xea, 0x8e, 0x3e, 0x01, 0x2d, 0xce, 0x60, 0x14, 0x46, 0xfa, 0x3e,
-	0x1f, 0xab, 0x97, 0xe6, 0x2b, 0x3e, 0x35, 0x71, 0x46, 0x3d, 0xa8, 0xd3, 0x48, 0x6f, 0x0c, 0x6b,
-	0x37, 0x54, 0x5c, 0xa7, 0xd1, 0x68, 0x1f, 0xd4, 0x17, 0xa6, 0x13, 0x12, 0xe3, 0x47, 0x05, 0x3a,
-	0xf2, 0xbc, 0x68, 0x08, 0x6d, 0xea, 0x9d, 0x13, 0xf7, 0x34, 0x9a, 0x4f, 0x3d, 0x87, 0xd7, 0x5f,
-	0xc3, 0xb2, 0x09, 0xdd, 0x86, 0x43, 0x73, 0xee, 0x85, 0x2e, 0xfd, 0x8e, 0xf8, 0x23, 0xcf, 0xf4,
-	0x2d, 0xc7, 0x8b, 0x4b, 0xae, 0xe0, 0x62, 0x07, 0x8b, 0x37, 0xb7, 0xdd, 0xc4, 0x4f, 0xe1, 0x7e,
-	0xb2, 0x09, 0xdd, 0x82, 0xfe, 0xc2, 0xb7, 0x67, 0x44, 0x0e, 0xd7, 0xe0, 0x6e, 0x05, 0x3b, 0xba,
-	0x0e, 0x5d, 0xea, 0x51, 0xd3, 0x49, 0x1c, 0x55, 0xee, 0x98, 0x35, 0xa2, 0xff, 0x83, 0x16, 0x50,
-	0xd3, 0xa7, 0xd4, 0x9e, 0x13, 0x5e, 0x63, 0x05, 0xa7, 0x06, 0x74, 0x0c, 0xad, 0x80, 0x7a, 0x0b,
-	0xde, 0xb9, 0xcf, 0x3b, 0x93, 0x36, 0x1b, 0x39, 0xf3, 0xbd, 0x97, 0xd6, 0xb3, 0xd0, 0xb5, 0xf4,
-	0xd6, 0xb0, 0x76, 0xa3, 0x85, 0x53, 0x03, 0xeb, 0x35, 0x83, 0x80, 0xd0, 0x2f, 0x97, 0x64, 0xa6,
-	0x6b, 0xbc, 0x32, 0xa9, 0x81, 0xf5, 0xf2, 0x7c, 0x79, 0x2f, 0xc4, 0xbd, 0x89, 0x81, 0xd5, 0x81,
-	0x37, 0x44, 0x5d, 0xdb, 0x71, 0x5d, 0x25, 0x93, 0xf1, 0x15, 0xb4, 0x25, 0xea, 0xa0, 0x23, 0x68,
-	0x32, 0xe8, 0x1f, 0x3f, 0x14, 0x18, 0x88, 0x16, 0x0b, 0x34, 0x15, 0x1f, 0xfa, 0xc0, 0x5d, 0x15,
-	0x5e, 0x36, 0x19, 0xb7, 0x01, 0x15, 0xe9, 0x5b, 0x15, 0xcf, 0x78, 0x55, 0x87, 0x7e, 0x9e, 0xb2,
-	0xef, 0x0a, 0x0b, 0x52, 0xb4, 0x9a, 0x6b, 0xd1, 0xda, 0xdf, 0x80, 0x56, 0xab, 0x88, 0xd6, 0x93,
-	0xb4, 0xc8, 0xa9, 0x5e, 0xd1, 0x00, 0xd4, 0x69, 0x18, 0x25, 0x35, 0x8e, 0x1b, 0x5b, 0x40, 0x76,
-	0x13, 0x0e, 0x0b, 0x0a, 0x2e, 0x0f, 0x66, 0xfc, 0xd4, 0x00, 0x8d, 0xcd, 0xf8, 0xad, 0x6f, 0x11,
-	0x7f, 0x0b, 0xa0, 0x74, 0xd8, 0x37, 0x2d, 0xcb, 0x27, 0x41, 0xc0, 0x27, 0xd6, 0xf0, 0xaa, 0x59,
-	0x0e, 0xa1, 0xb2, 0x25, 0x84, 0x8d, 0xed, 0x20, 0x54, 0xb7, 0x85, 0xb0, 0x59, 0x06, 0xa1, 0x01,
-	0x9d, 0xc0, 0x73, 0xac, 0xc4, 0x29, 0x96, 0x6b, 0xc6, 0x96, 0x15, 0x7b, 0x6b, 0x9d, 0xd8, 0xb5,
-	0x75, 0x62, 0x87, 0xbc, 0xd8, 0x53, 0xbd, 0xb4, 0x33, 0xfa, 0x63, 0x76, 0x6a, 0xd2, 0x30, 0xd0,
-	0x3b, 0x7c, 0x39, 0x15, 0x2d, 0x66, 0x3f, 0x23, 0xf6, 0xf3, 0x33, 0xaa, 0x77, 0xf9, 0x3c, 0xa2,
-	0x95, 0xa5, 0x61, 0x6f, 0x2d, 0x0d, 0x0f, 0x36, 0xd0, 0xb0, 0x5f, 0xa4, 0xe1, 0x6b, 0x05, 0xba,
-	0x2b, 0xd5, 0xbe, 0x0f, 0x8c, 0xf8, 0x10, 0x7a, 0x53, 0x2f, 0x7c, 0x7e, 0x46, 0x73, 0x9c, 0xc8,
-	0x59, 0x53, 0xed, 0xb4, 0x64, 0x21, 0xa6, 0xd8, 0x69, 0x15, 0xd8, 0x41, 0x35, 0x76, 0xed, 0xb5,
-	0xd8, 0x75, 0x36, 0x60, 0xd7, 0x2d, 0x62, 0xf7, 0xa7, 0x02, 0x3d, 0x4c, 0x66, 0xc4, 0x5e, 0xd0,
-	0x51, 0x18, 0x8d, 0xcc, 0x80, 0x6c, 0x01, 0xde, 0x00, 0x54, 0xef, 0xa5, 0x4b, 0x7c, 0x01, 0x5d,
-	0xdc, 0xa8, 0x06, 0x4e, 0x7b, 0xb3, 0xc0, 0x69, 0x97, 0x02, 0x38, 0x4d, 0x06, 0x4e, 0x88, 0x14,
-	0xf2, 0x22, 0xa5, 0xcb, 0xb1, 0x19, 0x9c, 0xad, 0xc4, 0x1b, 0xb7, 0x24, 0xa0, 0x3b, 0xd5, 0x40,
-	0x77, 0xd7, 0x02, 0xdd, 0xdb, 0x00, 0xf4, 0x41, 0x11, 0xe8, 0x3f, 0x1a, 0x70, 0x20, 0x80, 0x66,
-	0x2b, 0xf7, 0x3b, 0x8e, 0xf4, 0xe5, 0x5f, 0xb4, 0x53, 0xfe, 0x24, 0x6c, 0xeb, 0xe6, 0xd8, 0x26,
-	0xd8, 0xd3, 0xab, 0x60, 0xcf, 0x41, 0x35, 0x7b, 0xfa, 0x6b, 0xd9, 0x73, 0xb8, 0x81, 0x3d, 0xa8,
-	0xc8, 0x9e, 0x11, 0x5c, 0x15, 0xe4, 0xe1, 0x47, 0x84, 0x51, 0x72, 0x7f, 0xb9, 0x09, 0x8d, 0xa9,
-	0x19, 0x10, 0x71, 0x47, 0xba, 0x2a, 0x6e, 0x02, 0xd9, 0x15, 0x05, 0x73, 0x17, 0xe3, 0x3e, 0x0c,
-	0x72, 0x31, 0xe2, 0x73, 0xde, 0x0e, 0x21, 0x8a, 0x69, 0xc4, 0x27, 0x95, 0x5d, 0x62, 0x3c, 0xc8,
-	0xc6, 0x38, 0x4d, 0xae, 0x6f, 0xb7, 0x32, 0x31, 0x8e, 0xb2, 0x31, 0x56, 0x9a, 0x11, 0x41, 0xbe,
-	0x80, 0x43, 0xa9, 0x43, 0xd4, 0x62, 0x97, 0x00, 0x0f, 0xe1, 0x28, 0x9f, 0x85, 0xf8, 0x94, 0x5d,
-	0xa2, 0xfc, 0x5a, 0x83, 0x2e, 0x26, 0x17, 0xf7, 0x2d, 0xcb, 0xbf, 0xcf, 0xb0, 0x0e, 0x10, 0x82,
-	0x06, 0xdb, 0x48, 0x85, 0x96, 0xf9, 0x6f, 0x89, 0x78, 0xf5, 0xcc, 0x8e, 0x33, 0x00, 0x95, 0x6b,
-	0x5d, 0x57, 0x86, 0x0a, 0x23, 0x1e, 0x6f, 0x30, 0xaa, 0x58, 0xb6, 0x4f, 0xf8, 0xbd, 0x58, 0xdc,
-	0xd6, 0x52, 0x03, 0x1b, 0x33, 0x63, 0x02, 0xe7, 0xfa, 0x54, 0x71, 0xdc, 0x60, 0xbb, 0xf9, 0x33,
-	0xdf, 0x9b, 0x7f, 0x43, 0x22, 0x71, 0xc8, 0x5d, 0x35, 0x8d, 0x5f, 0x6a, 0xac, 0x52, 0x17, 0x13,
-	0xbe, 0xa6, 0xec, 0x76, 0x62, 0x5c, 0x45, 0xac, 0x67, 0x22, 0xa6, 0x19, 0x28, 0x72, 0x06, 0xeb,
-	0xb3, 0x4e, 0x2b, 0xa0, 0xca, 0x15, 0x30, 0x7e, 0xae, 0x41, 0x7f, 0x95, 0xdd, 0x28, 0x8c, 0x2e,
-	0x57, 0x72, 0xbf, 0x2b, 0x0c, 0xdc, 0x85, 0x13, 0xed, 0x90, 0xd9, 0x8e, 0xeb, 0xf5, 0xbb, 0x7f,
-	0xa4, 0x7a, 0x23, 0x3b, 0x73, 0x1f, 0x94, 0x73, 0x12, 0x89, 0xf5, 0x99, 0xfd, 0x5c, 0x7f, 0xa0,
-	0x36, 0x5e, 0xf1, 0x43, 0xd5, 0xc2, 0x89, 0x76, 0x61, 0xfc, 0xdb, 0x0a, 0xdd, 0x36, 0x5b, 0xed,
-	0xdb, 0x01, 0xdb, 0x98, 0x9d, 0x90, 0x64, 0xd4, 0x02, 0x74, 0x2f, 0x7e, 0x2a, 0x8b, 0x5b, 0x7a,
-	0x6d, 0xa8, 0x64, 0x76, 0x17, 0xd9, 0x17, 0x4b, 0x8e, 0xc6, 0x43, 0x81, 0xff, 0x4a, 0xb9, 0x81,
-	0x78, 0x1b, 0xcc, 0xc4, 0x19, 0xc8, 0x71, 0x56, 0x9e, 0x38, 0x75, 0x33, 0x5e, 0x37, 0x44, 0x42,
-	0x7c, 0x8b, 0x78, 0x0f, 0x96, 0x00, 0xfe, 0x4a, 0x9b, 0x67, 0x52, 0xce, 0x7a, 0x99, 0xb8, 0x34,
-	0x75, 0xbc, 0xd9, 0xf9, 0x84, 0x9d, 0x10, 0x7b, 0xf1, 0xf1, 0x31, 0x31, 0xb0, 0x0a, 0xda, 0x41,
-	0x42, 0x0e, 0x7e, 0x56, 0x6b, 0x61, 0xd9, 0xf4, 0xaf, 0x1f, 0xd8, 0xfa, 0x39, 0xea, 0x04, 0xe8,
-	0x04, 0x9a, 0x9e, 0x4c, 0xc0, 0x23, 0x99, 0x80, 0xa9, 0x23, 0x16, 0x5e, 0xc6, 0x53, 0xe8, 0x60,
-	0x72, 0xc1, 0x32, 0xe6, 0x1b, 0x24, 0xfa, 0x08, 0x1a, 0xac, 0x7a, 0x6b, 0xde, 0xc3, 0x31, 0x77,
-	0x28, 0xa7, 0xa0, 0xf1, 0x03, 0x3f, 0xab, 0x48, 0xaf, 0x81, 0x1f, 0x43, 0x33, 0x7e, 0x1d, 0x16,
-	0x11, 0xab, 0xdf, 0xbd, 0xb1, 0x70, 0xac, 0x88, 0xfc, 0x18, 0xda, 0x98, 0x5c, 0x8c, 0xc2, 0x28,
-	0xce, 0xf3, 0x3a, 0x28, 0xd3, 0x30, 0x12, 0x41, 0x4b, 0x5e, 0xe0, 0x31, 0xeb, 0x16, 0x3c, 0x4a,
-	0x43, 0xf1, 0x86, 0xf1, 0x57, 0x03, 0xe0, 0x89, 0x37, 0x33, 0xd3, 0x65, 0x9b, 0x63, 0x92, 0x95,
-	0x9b, 0x64, 0xfa, 0x4f, 0x6e, 0x3b, 0xcb, 0x4d, 0xb9, 0x84, 0x72, 0xd3, 0x61, 0x9f, 0x2e, 0x1f,
-	0xbb, 0x16, 0x59, 0x0a, 0xb1, 0xad, 0x9a, 0xe8, 0x03, 0x00, 0x3b, 0x78, 0x64, 0xbb, 0x76, 0x70,
-	0x46, 0x2c, 0xae, 0xb4, 0x16, 0x96, 0x2c, 0x59, 0xa1, 0x5e, 0xd9, 0x20, 0xd4, 0x41, 0x41, 0xa8,
-	0x77, 0x7e, 0x53, 0x40, 0xe5, 0x25, 0x47, 0x9f, 0xc3, 0xe0, 0x81, 0x4f, 0x4c, 0x4a, 0xb0, 0xf9,
-	0x32, 0xb9, 0x14, 0x4c, 0x96, 0xa8, 0x4c, 0x68, 0xc7, 0x07, 0xc2, 0xf8, 0xbd, 0x1b, 0xd8, 0xcf,
-	0xdd, 0xc9, 0xd2, 0xd8, 0x43, 0x9f, 0xc1, 0x95, 0xec, 0x78, 0x26, 0x88, 0x25, 0x2a, 0x11, 0x40,
-	0xd9, 0xe8, 0x47, 0x70, 0x94, 0x1d, 0x1d, 0xab, 0x6f, 0xb2, 0x44, 0xd5, 0xb2, 0x2c, 0x8f, 0xa3,
-	0x17, 0xb2, 0xe0, 0xf7, 0xab, 0xc9, 0x12, 0x55, 0xfd, 0x3b, 0x55, 0x16, 0xe7, 0x6b, 0x38, 0x2e,
-	0x56, 0x23, 0xbe, 0x68, 0x95, 0xe4, 0x94, 0x76, 0x96, 0xc5, 0x1a, 0xc3, 0xb5, 0xb2, 0x6f, 0x8b,
-	0xeb, 0x53, 0xf9, 0xef, 0x55, 0x49, 0xa4, 0x69, 0x93, 0xff, 0x51, 0x78, 0xf7, 0x9f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe0, 0xb9, 0xad, 0xb0, 0x51, 0x1c, 0x00, 0x00,
+	// 1620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xdd, 0x8e, 0xdb, 0x54,
+	0x10, 0xde, 0xc4, 0x71, 0x7e, 0x26, 0x9b, 0x6c, 0xf6, 0x34, 0x5d, 0xdc, 0x15, 0x42, 0x2b, 0xab,
+	0x82, 0xb6, 0x54, 0x2b, 0xd1, 0xaa, 0x12, 0x12, 0x08, 0xd8, 0xb4, 0x2c, 0x29, 0xb4, 0xa2, 0xf2,
+	0x06, 0x89, 0x5b, 0x27, 0x3e, 0xed, 0x5a, 0xeb, 0xc4, 0x59, 0xff, 0xb4, 0xf1, 0x1b, 0x20, 0xf1,
+	0x06, 0x70, 0x81, 0xc4, 0x33, 0x54, 0x42, 0x15, 0x12, 0xaf, 0xc2, 0x1d, 0x77, 0xf0, 0x04, 0x5c,
+	0xa0, 0xf3, 0x13, 0xfb, 0x1c, 0xff, 0x25, 0x41, 0x45, 0xda, 0xb6, 0xdc, 0xed, 0xcc, 0x99, 0x33,
+	0x19, 0xcf, 0xf7, 0xcd, 0x78, 0x8e, 0xcf, 0x42, 0x3b, 0xf0, 0x4c, 0x0b, 0x1f, 0xce, 0x3d, 0x37,
+	0x70, 0x91, 0x1a, 0x44, 0x73, 0xec, 0xef, 0xef, 0x06, 0x9e, 0x39, 0xf3, 0xcd, 0x49, 0x60, 0xbb,
+	0x33, 0xb6, 0xa2, 0xff, 0xac, 0x80, 0x3a, 0x22, 0x96, 0xe8, 0x36, 0xb4, 0x7c, 0xec, 0x38, 0x0f,
+	0xec, 0xa9, 0x1d, 0x68, 0x95, 0x83, 0xca, 0xb5, 0xf6, 0xad, 0x4b, 0x87, 0x74, 0xdf, 0x21, 0x35,
+	0x38, 0x76, 0xbd, 0x13, 0xec, 0x38, 0xc3, 0x2d, 0x23, 0xb1, 0x43, 0xb7, 0xa0, 0x35, 0x0e, 0xa3,
+	0x87, 0xa6, 0x77, 0x86, 0x03, 0xad, 0x4a, 0x37, 0xa1, 0xd4, 0xa6, 0x41, 0x18, 0x91, 0x3d, 0xb1,
+	0x19, 0xfa, 0x08, 0xc0, 0xc3, 0x4f, 0xdd, 0x33, 0x4c, 0xdc, 0x69, 0x0a, 0xdd, 0x74, 0x25, 0xb5,
+	0xc9, 0x88, 0x0d, 0x86, 0x5b, 0x86, 0x60, 0x8e, 0xee, 0x40, 0x73, 0x1c, 0x46, 0x2c, 0x48, 0x95,
+	0x6e, 0x7d, 0x2b, 0xfb, 0x7b, 0x74, 0x79, 0xb8, 0x65, 0xc4, 0xa6, 0xe4, 0x37, 0x49, 0xd0, 0x3c,
+	0xd0, 0x7a, 0xee, 0x6f, 0x9e, 0xc4, 0x06, 0xe4, 0x37, 0x13, 0x73, 0xf4, 0x21, 0xb4, 0x58, 0x04,
+	0x83, 0x30, 0xd2, 0x1a, 0x74, 0xaf, 0x96, 0x1b, 0x2f, 0x7f, 0xd4, 0xd8, 0x18, 0xdd, 0x04, 0x75,
+	0x6a, 0x06, 0x93, 0x53, 0xad, 0x49, 0x77, 0xf5, 0x53, 0xbb, 0x1e, 0x92, 0xb5, 0xe1, 0x96, 0xc1,
+	0x8c, 0x50, 0x17, 0xaa, 0x41, 0xa4, 0xd5, 0x0e, 0x2a, 0xd7, 0x54, 0xa3, 0x1a, 0x44, 0x83, 0x06,
+	0xa8, 0x4f, 0x4d, 0x27, 0xc4, 0xfa, 0x77, 0x0a, 0x6c, 0x8b, 0x51, 0xa2, 0x03, 0x68, 0x07, 0xee,
+	0x19, 0x9e, 0x9d, 0x44, 0xd3, 0xb1, 0xeb, 0x50, 0xb4, 0x5a, 0x86, 0xa8, 0x42, 0x37, 0x61, 0xd7,
+	0x9c, 0xba, 0xe1, 0x2c, 0x78, 0x84, 0xbd, 0x81, 0x6b, 0x7a, 0x96, 0xe3, 0x32, 0x80, 0x14, 0x23,
+	0xbb, 0x40, 0xfc, 0x4d, 0xed, 0x59, 0x6c, 0xa7, 0x50, 0x3b, 0x51, 0x85, 0x6e, 0x40, 0x6f, 0xee,
+	0xd9, 0x13, 0x2c, 0xba, 0xab, 0x51, 0xb3, 0x8c, 0x1e, 0x5d, 0x85, 0x4e, 0xe0, 0x06, 0xa6, 0x13,
+	0x1b, 0xaa, 0xd4, 0x50, 0x56, 0xa2, 0xb7, 0xa1, 0xe5, 0x07, 0xa6, 0x17, 0x04, 0xf6, 0x14, 0x53,
+	0x44, 0x14, 0x23, 0x51, 0xa0, 0x7d, 0x68, 0xfa, 0x81, 0x3b, 0xa7, 0x8b, 0x0d, 0xba, 0x18, 0xcb,
+	0x64, 0xe7, 0xc4, 0x73, 0x9f, 0x59, 0x8f, 0xc3, 0x99, 0x45, 0x33, 0xdb, 0x34, 0x12, 0x05, 0x59,
+	0x35, 0x7d, 0x1f, 0x07, 0x9f, 0x2f, 0xf0, 0x44, 0x6b, 0xd1, 0xcc, 0x24, 0x0a, 0xb2, 0x4a, 0xe3,
+	0xa5, 0xab, 0xc0, 0x56, 0x63, 0x05, 0xc9, 0x03, 0x15, 0x78, 0x5e, 0xdb, 0x2c, 0xaf, 0x82, 0x4a,
+	0xff, 0x02, 0xda, 0x02, 0xd1, 0xd0, 0x1e, 0xd4, 0x09, 0x51, 0xee, 0xdf, 0xe3, 0x18, 0x70, 0x89,
+	0x38, 0x1a, 0xf3, 0x07, 0xbd, 0x3b, 0x5b, 0x26, 0x5e, 0x54, 0xe9, 0x37, 0x01, 0x65, 0xc9, 0x5e,
+	0xe4, 0x4f, 0x7f, 0x5e, 0x85, 0x5e, 0x9a, 0xe0, 0xaf, 0x0b, 0x0b, 0x12, 0xb4, 0xea, 0xa5, 0x68,
+	0x35, 0x56, 0xa0, 0xd5, 0xcc, 0xa2, 0xf5, 0x20, 0x49, 0x72, 0x52, 0xdd, 0xa8, 0x0f, 0xea, 0x38,
+	0x8c, 0xe2, 0x1c, 0x33, 0x61, 0x0d, 0xc8, 0xae, 0xc3, 0x6e, 0xa6, 0xde, 0xf3, 0x9d, 0xe9, 0xbf,
+	0x57, 0xa0, 0x23, 0x55, 0xf9, 0x1a, 0x60, 0x49, 0xa9, 0xa8, 0x96, 0xa6, 0x42, 0x59, 0x91, 0x8a,
+	0x5a, 0x26, 0x15, 0x84, 0x59, 0xb6, 0x4f, 0x3b, 0xae, 0x4a, 0x2b, 0x86, 0x4b, 0xe8, 0x1d, 0x00,
+	0x6a, 0xc6, 0x5a, 0x2a, 0xab, 0x43, 0x41, 0x43, 0xf6, 0x31, 0xa6, 0xf0, 0x32, 0xe4, 0x92, 0x6e,
+	0x40, 0xd7, 0xc0, 0xe7, 0xf4, 0xd9, 0xbe, 0xf6, 0x2c, 0xec, 0xf9, 0xe8, 0xc6, 0xb2, 0xd9, 0x55,
+	0x8a, 0x9b, 0xdd, 0xb2, 0xd5, 0xf5, 0x41, 0x75, 0x9f, 0xcd, 0xb0, 0xc7, 0x9f, 0x93, 0x09, 0xfa,
+	0xf7, 0x35, 0x68, 0x91, 0xa0, 0xa8, 0xc3, 0x35, 0x32, 0xa6, 0x41, 0xc3, 0xb4, 0x2c, 0x0f, 0xfb,
+	0x3e, 0xf7, 0xb3, 0x14, 0xf3, 0x89, 0xaf, 0xac, 0x49, 0xfc, 0xda, 0x7a, 0xc4, 0x57, 0xd7, 0x25,
+	0x7e, 0x3d, 0x8f, 0xf8, 0x3a, 0x6c, 0xfb, 0xae, 0x63, 0xc5, 0x46, 0x2c, 0xbb, 0x92, 0x4e, 0x6e,
+	0x91, 0xcd, 0xb2, 0x16, 0xd9, 0x2a, 0x6b, 0x91, 0x90, 0x6e, 0x91, 0x49, 0x97, 0x69, 0x4b, 0x5d,
+	0x8b, 0xe8, 0x03, 0x33, 0x08, 0x7d, 0x6d, 0x9b, 0xbe, 0x84, 0xb8, 0x44, 0xf4, 0xa7, 0xd8, 0x7e,
+	0x72, 0x1a, 0x68, 0x1d, 0xc6, 0x01, 0x26, 0xc9, 0x8c, 0xed, 0x96, 0x32, 0x76, 0x67, 0x05, 0x63,
+	0x7b, 0xd9, 0xe2, 0x7d, 0xa1, 0x40, 0x67, 0xd9, 0xeb, 0xde, 0x04, 0x46, 0xbc, 0x0b, 0xdd, 0xb1,
+	0x1b, 0x3e, 0x39, 0x0d, 0x52, 0x9c, 0x48, 0x69, 0x93, 0x8e, 0xd3, 0x14, 0xdb, 0x57, 0x82, 0x5d,
+	0xab, 0x00, 0x3b, 0x28, 0xc6, 0xae, 0x5d, 0x8a, 0xdd, 0xf6, 0x0a, 0xec, 0x3a, 0x59, 0xec, 0xfe,
+	0x50, 0x48, 0x7b, 0x98, 0x60, 0x7b, 0x1e, 0x0c, 0xc2, 0x68, 0x60, 0xfa, 0x78, 0x0d, 0xf0, 0x72,
+	0x9b, 0x42, 0x31, 0x70, 0xad, 0x97, 0x0b, 0x5c, 0xeb, 0x42, 0x00, 0xd7, 0x12, 0x81, 0xe3, 0x45,
+	0x0a, 0xe9, 0x22, 0x0d, 0x16, 0x43, 0xd3, 0x3f, 0x5d, 0x16, 0x2f, 0x93, 0x04, 0xa0, 0xb7, 0x8b,
+	0x81, 0xee, 0x94, 0x02, 0xdd, 0x5d, 0x01, 0xf4, 0x4e, 0x16, 0xe8, 0x5f, 0x6b, 0xb0, 0xc3, 0x81,
+	0x26, 0x9d, 0xfb, 0x35, 0x47, 0xfa, 0xe2, 0x37, 0xed, 0x84, 0x3f, 0x31, 0xdb, 0x3a, 0x29, 0xb6,
+	0x71, 0xf6, 0x74, 0x0b, 0xd8, 0xb3, 0x53, 0xcc, 0x9e, 0x5e, 0x29, 0x7b, 0x76, 0x57, 0xb0, 0x07,
+	0x65, 0xd9, 0x33, 0x80, 0xcb, 0x9c, 0x3c, 0x74, 0x4a, 0x18, 0xc4, 0x67, 0xc4, 0xeb, 0x50, 0x1b,
+	0x9b, 0x3e, 0xe6, 0xa3, 0xc4, 0x65, 0x3e, 0x4a, 0xc8, 0x1d, 0xc5, 0xa0, 0x26, 0xfa, 0x11, 0xf4,
+	0x53, 0x3e, 0xd8, 0xe0, 0xb2, 0x81, 0x8b, 0x6c, 0x18, 0x6c, 0xbe, 0xdb, 0xc4, 0xc7, 0x5d, 0xd9,
+	0xc7, 0x49, 0x7c, 0x44, 0xbe, 0x21, 0xf9, 0xd8, 0x93, 0x7d, 0x2c, 0x6b, 0x86, 0x3b, 0xf9, 0x14,
+	0x76, 0x85, 0x05, 0x9e, 0x8b, 0x4d, 0x1c, 0xdc, 0x83, 0xbd, 0x74, 0x14, 0xfc, 0x51, 0x36, 0xf1,
+	0xf2, 0x77, 0x05, 0xba, 0x74, 0x3f, 0x9d, 0xd9, 0x8e, 0x6d, 0x87, 0xbe, 0x58, 0x5d, 0xf2, 0x0e,
+	0x8e, 0x07, 0xdd, 0xa5, 0xb8, 0x69, 0x2d, 0xe7, 0xbe, 0x6e, 0x37, 0x39, 0x57, 0xa4, 0xe6, 0x74,
+	0x35, 0x33, 0xa7, 0x93, 0x7e, 0x4c, 0x3f, 0x7e, 0x58, 0xa9, 0x62, 0x4e, 0x69, 0x85, 0xca, 0x69,
+	0x88, 0xaf, 0x4c, 0xfd, 0x37, 0x25, 0x86, 0x21, 0xc9, 0xc2, 0xbf, 0xee, 0x6a, 0x52, 0x05, 0x29,
+	0xa5, 0x15, 0x54, 0x5b, 0x51, 0x41, 0x6a, 0xd9, 0x58, 0x5f, 0x2f, 0x19, 0xeb, 0x1b, 0x25, 0x63,
+	0x7d, 0x53, 0x1c, 0xeb, 0x49, 0x87, 0x7b, 0x6c, 0x3b, 0x0e, 0xb6, 0x8e, 0xd8, 0x2a, 0xeb, 0x51,
+	0x92, 0x8e, 0xd8, 0x78, 0x78, 0x6a, 0xda, 0x33, 0x6e, 0xc3, 0x06, 0x0b, 0x49, 0x47, 0x7e, 0x9f,
+	0xb6, 0xce, 0x47, 0xe4, 0x27, 0x69, 0xc7, 0x52, 0x0c, 0x41, 0x83, 0xde, 0x07, 0x95, 0xf8, 0x24,
+	0x4d, 0x4b, 0x11, 0x4a, 0x4b, 0x66, 0x9d, 0xc1, 0x6c, 0x84, 0xa6, 0xd5, 0x29, 0x68, 0x5a, 0x5d,
+	0xb1, 0x69, 0xe9, 0x3f, 0x55, 0xa0, 0x63, 0xe0, 0xf3, 0x23, 0xcb, 0xf2, 0x8e, 0x48, 0xa6, 0x7d,
+	0x84, 0xa0, 0x46, 0x06, 0x41, 0x8e, 0x1a, 0xfd, 0x5b, 0x80, 0xbf, 0x2a, 0x4d, 0x4c, 0x7d, 0x50,
+	0x29, 0xaa, 0x9a, 0x72, 0xa0, 0x10, 0x18, 0xa9, 0x40, 0x80, 0xb2, 0x6c, 0x0f, 0xd3, 0x6f, 0x67,
+	0xfc, 0x1b, 0x4d, 0xa2, 0x20, 0x7b, 0x26, 0x34, 0x17, 0x2a, 0x5d, 0x61, 0x02, 0x29, 0x9a, 0xc7,
+	0x9e, 0x3b, 0xfd, 0x0a, 0x47, 0xfc, 0x68, 0xbb, 0x14, 0xf5, 0x1f, 0x2b, 0x84, 0x62, 0xe7, 0x23,
+	0xca, 0x9e, 0xcd, 0x4e, 0x3c, 0x4b, 0x8f, 0x55, 0xc9, 0x63, 0x12, 0x81, 0x22, 0x46, 0x50, 0x1e,
+	0x75, 0x92, 0x01, 0x55, 0x2a, 0x80, 0x1f, 0x2a, 0xd0, 0x5b, 0x46, 0x37, 0x08, 0xa3, 0x8b, 0x15,
+	0xdc, 0x2f, 0x0a, 0x01, 0x77, 0xee, 0x44, 0x1b, 0x44, 0xf6, 0x32, 0x7a, 0xd4, 0x6b, 0x75, 0x24,
+	0x78, 0x29, 0x93, 0x65, 0x0f, 0x94, 0x33, 0x1c, 0xf1, 0x9a, 0x24, 0x7f, 0x96, 0x1f, 0x08, 0xf5,
+	0xe7, 0xf4, 0x50, 0x30, 0x77, 0xa2, 0x4d, 0x18, 0xff, 0xaa, 0x42, 0xb7, 0xce, 0xa8, 0xf8, 0x6a,
+	0xc0, 0x36, 0x84, 0x1d, 0x19, 0x35, 0x1f, 0xdd, 0x61, 0x9f, 0xd3, 0x99, 0xa4, 0x55, 0xa4, 0x16,
+	0x2e, 0xdb, 0x1a, 0x82, 0xa1, 0x7e, 0x0f, 0xba, 0x52, 0xe5, 0xfa, 0xfc, 0xfe, 0x40, 0xf2, 0xd3,
+	0x17, 0xfd, 0x2c, 0x2d, 0x8d, 0xc4, 0x4c, 0x7f, 0x51, 0xe3, 0x01, 0xd1, 0x97, 0xc5, 0x1b, 0xd0,
+	0x02, 0x52, 0xc3, 0x4c, 0x23, 0x77, 0x98, 0xb9, 0x40, 0x5c, 0x1a, 0x3b, 0xee, 0xe4, 0x6c, 0x44,
+	0x4e, 0x38, 0xec, 0xb5, 0x9c, 0x28, 0x48, 0x06, 0xd9, 0x80, 0x42, 0x61, 0xa3, 0x67, 0x8d, 0xa6,
+	0x21, 0xaa, 0xfe, 0xf3, 0x03, 0x47, 0x2f, 0x45, 0x1d, 0x1f, 0x1d, 0x42, 0xdd, 0x15, 0x09, 0xb8,
+	0x27, 0x12, 0x30, 0x31, 0x34, 0xb8, 0x95, 0xfe, 0x10, 0xb6, 0x0d, 0x7c, 0x4e, 0x22, 0xa6, 0x2f,
+	0x48, 0xf4, 0x1e, 0xd4, 0x48, 0xf6, 0x4a, 0xee, 0xcc, 0x0c, 0x6a, 0x50, 0xf0, 0xd1, 0xf3, 0x5b,
+	0x3a, 0xab, 0x08, 0x77, 0x00, 0x1f, 0x40, 0x9d, 0xdd, 0x20, 0x69, 0x95, 0xdc, 0x7b, 0xaa, 0xc4,
+	0xd4, 0xe0, 0x86, 0x05, 0x9e, 0xef, 0x43, 0xdb, 0xc0, 0xe7, 0x83, 0x30, 0x62, 0x71, 0x5e, 0x05,
+	0x65, 0x1c, 0x46, 0x5a, 0xa5, 0xe8, 0x96, 0xce, 0x20, 0xcb, 0x9c, 0x47, 0x89, 0x2b, 0x2a, 0xe8,
+	0x7f, 0xd5, 0x00, 0x1e, 0xb8, 0x13, 0x33, 0x69, 0xdb, 0x14, 0x13, 0xb9, 0xdc, 0x04, 0xd5, 0xff,
+	0xe5, 0xb6, 0x71, 0xb9, 0x29, 0x17, 0xb0, 0xdc, 0x34, 0x68, 0x04, 0x8b, 0xfb, 0x33, 0x0b, 0x2f,
+	0x78, 0xb1, 0x2d, 0x45, 0x32, 0xdf, 0xdb, 0xfe, 0xb1, 0x3d, 0xb3, 0xfd, 0x53, 0x6c, 0xd1, 0x4a,
+	0x6b, 0x1a, 0x82, 0x46, 0x2e, 0xd4, 0x4b, 0x2b, 0x0a, 0xb5, 0x9f, 0x29, 0xd4, 0x5b, 0x7f, 0x2a,
+	0xa0, 0xd2, 0x94, 0xa3, 0x4f, 0xa0, 0x7f, 0xd7, 0xc3, 0x66, 0x80, 0x0d, 0xf3, 0x59, 0x7c, 0xa8,
+	0x1d, 0x2d, 0x50, 0x5e, 0xa1, 0xed, 0xef, 0x70, 0xe5, 0x37, 0x33, 0xdf, 0x7e, 0x32, 0x1b, 0x2d,
+	0xf4, 0x2d, 0xf4, 0x31, 0x5c, 0x92, 0xf7, 0x93, 0x82, 0x58, 0xa0, 0x9c, 0x02, 0xc8, 0xdb, 0x7d,
+	0x0c, 0x7b, 0xf2, 0x6e, 0x56, 0x7d, 0xa3, 0x05, 0x2a, 0x2e, 0xcb, 0x7c, 0x3f, 0x5a, 0x26, 0x0a,
+	0x7a, 0x12, 0x1b, 0x2d, 0x50, 0xd1, 0x0d, 0x76, 0x9e, 0x9f, 0x2f, 0x61, 0x3f, 0x9b, 0x0d, 0xf6,
+	0xa1, 0x20, 0x27, 0xa6, 0x64, 0x31, 0xcf, 0xd7, 0x10, 0xae, 0xe4, 0x3d, 0x1b, 0xcb, 0x4f, 0xe1,
+	0x0d, 0x77, 0x9e, 0xa7, 0xcf, 0xe0, 0xb2, 0xec, 0x89, 0x1e, 0xe1, 0x46, 0x0b, 0x94, 0x7b, 0x09,
+	0x94, 0xe3, 0x61, 0x5c, 0xa7, 0xff, 0x8e, 0x70, 0xfb, 0x9f, 0x01, 0x00, 0x1f, 0x3b, 0x47, 0x02,
+	0xb7, 0x20, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2724,6 +3136,7 @@ type TradeClient interface {
 	CreateRawTradeBuyLimitTx(ctx context.Context, in *TradeForBuyLimit, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(ctx context.Context, in *TradeForSellMarket, opts ...grpc.CallOption) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeForRevokeBuy, opts ...grpc.CallOption) (*types.UnsignTx, error)
+	CreateRawTradeMatchTx(ctx context.Context, in *TradeForMatch, opts ...grpc.CallOption) (*types.UnsignTx, error)
 }
 
 type tradeClient struct {
@@ -2788,6 +3201,15 @@ func (c *tradeClient) CreateRawTradeRevokeBuyTx(ctx context.Context, in *TradeFo
 	return out, nil
 }
 
+func (c *tradeClient) CreateRawTradeMatchTx(ctx context.Context, in *TradeForMatch, opts ...grpc.CallOption) (*types.UnsignTx, error) {
+	out := new(types.UnsignTx)
+	err := c.cc.Invoke(ctx, "/types.trade/CreateRawTradeMatchTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TradeServer is the server API for Trade service.
 type TradeServer interface {
 	CreateRawTradeSellTx(context.Context, *TradeForSell) (*types.UnsignTx, error)
@@ -2796,6 +3218,7 @@ type TradeServer interface {
 	CreateRawTradeBuyLimitTx(context.Context, *TradeForBuyLimit) (*types.UnsignTx, error)
 	CreateRawTradeSellMarketTx(context.Context, *TradeForSellMarket) (*types.UnsignTx, error)
 	CreateRawTradeRevokeBuyTx(context.Context, *TradeForRevokeBuy) (*types.UnsignTx, error)
+	CreateRawTradeMatchTx(context.Context, *TradeForMatch) (*types.UnsignTx, error)
 }
 
 // UnimplementedTradeServer can be embedded to have forward compatible implementations.
@@ -2820,6 +3243,9 @@ func (*UnimplementedTradeServer) CreateRawTradeSellMarketTx(ctx context.Context,
 func (*UnimplementedTradeServer) CreateRawTradeRevokeBuyTx(ctx context.Context, req *TradeForRevokeBuy) (*types.UnsignTx, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeRevokeBuyTx not implemented")
 }
+func (*UnimplementedTradeServer) CreateRawTradeMatchTx(ctx context.Context, req *TradeForMatch) (*types.UnsignTx, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRawTradeMatchTx not implemented")
+}
 
 func RegisterTradeServer(s *grpc.Server, srv TradeServer) {
 	s.RegisterService(&_Trade_serviceDesc, srv)
@@ -2933,6 +3359,24 @@ func _Trade_CreateRawTradeRevokeBuyTx_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Trade_CreateRawTradeMatchTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TradeForMatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TradeServer).CreateRawTradeMatchTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/types.trade/CreateRawTradeMatchTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TradeServer).CreateRawTradeMatchTx(ctx, req.(*TradeForMatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Trade_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "types.trade",
 	HandlerType: (*TradeServer)(nil),
@@ -2961,6 +3405,10 @@ var _Trade_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateRawTradeRevokeBuyTx",
 			Handler:    _Trade_CreateRawTradeRevokeBuyTx_Handler,
 		},
+		{
+			MethodName: "CreateRawTradeMatchTx",
+			Handler:    _Trade_CreateRawTradeMatchTx_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "trade.proto",
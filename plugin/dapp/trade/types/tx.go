@@ -55,3 +55,15 @@ type TradeRevokeBuyTx struct {
 	BuyID string `json:"buyID,"`
 	Fee   int64  `json:"fee"`
 }
+
+//TradeMatchTx :按价格撮合吃单
+type TradeMatchTx struct {
+	TokenSymbol string `json:"tokenSymbol"`
+	AssetExec   string `json:"assetExec"`
+	PriceExec   string `json:"priceExec"`
+	PriceSymbol string `json:"priceSymbol"`
+	IsSell      bool   `json:"isSell"`
+	PriceLimit  int64  `json:"priceLimit"`
+	Amount      int64  `json:"amount"`
+	Fee         int64  `json:"fee"`
+}
@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strconv"
+	"strings"
 
+	"github.com/33cn/chain33/common"
+	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/rpc/jsonclient"
 	rpctypes "github.com/33cn/chain33/rpc/types"
 	"github.com/33cn/chain33/types"
@@ -24,6 +28,13 @@ func Cmd() *cobra.Command {
 		cmdCheckContract(),
 		cmdCreateContract(),
 		cmdCallContract(),
+		cmdReadContract(),
+		cmdUpdateContract(),
+		cmdTransferAdmin(),
+		cmdRenounce(),
+		cmdContractMeta(),
+		cmdContractVersions(),
+		cmdContractEvents(),
 	)
 
 	return cmd
@@ -62,11 +73,105 @@ func cmdCallContract() *cobra.Command {
 	cmd.Flags().StringP("name", "n", "", "contract name")
 	cmd.Flags().StringP("method", "m", "", "method name")
 	cmd.Flags().IntSliceP("parameters", "p", nil, "parameters of the method which should be num")
+	cmd.Flags().StringArray("args", nil, "typed parameters as type:value, type is one of bytes(hex), string, address, int64, bool, repeat the flag for each one")
 	_ = cmd.MarkFlagRequired("name")
 	_ = cmd.MarkFlagRequired("method")
 	return cmd
 }
 
+func cmdReadContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "read",
+		Short: "call contract without sending a transaction, the changes are discarded",
+		Run:   readContract,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.Flags().StringP("method", "m", "", "method name")
+	cmd.Flags().IntSliceP("parameters", "p", nil, "parameters of the method which should be num")
+	cmd.Flags().StringArray("args", nil, "typed parameters as type:value, type is one of bytes(hex), string, address, int64, bool, repeat the flag for each one")
+	cmd.Flags().StringP("caller", "c", "", "caller address")
+	cmd.Flags().Int64P("gas", "g", 0, "gas limit, use the limit of the node if not set")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("method")
+	return cmd
+}
+
+func cmdUpdateContract() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "update the code of a contract, only the admin of the contract can do this",
+		Run:   updateContract,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.Flags().StringP("path", "p", "", "path of the new wasm file, such as ./test.wasm")
+	cmd.Flags().Bool("migrate", false, "call the migrate method of the new code after update")
+	cmd.Flags().StringArray("args", nil, "typed parameters of the migrate method as type:value, type is one of bytes(hex), string, address, int64, bool")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("path")
+	return cmd
+}
+
+func cmdTransferAdmin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_admin",
+		Short: "transfer the admin of a contract to another address",
+		Run:   transferAdmin,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.Flags().StringP("admin", "a", "", "address of the new admin")
+	_ = cmd.MarkFlagRequired("name")
+	_ = cmd.MarkFlagRequired("admin")
+	return cmd
+}
+
+func cmdRenounce() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "renounce",
+		Short: "renounce the upgradeability of a contract, it can not be updated any more",
+		Run:   renounce,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func cmdContractMeta() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "meta",
+		Short: "show the creator, admin and version of a contract",
+		Run:   contractMeta,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func cmdContractVersions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "versions",
+		Short: "show the version history of a contract",
+		Run:   contractVersions,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
+func cmdContractEvents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "events",
+		Short: "show the events emitted by a contract",
+		Run:   contractEvents,
+	}
+	cmd.Flags().StringP("name", "n", "", "contract name")
+	cmd.Flags().StringP("topic", "t", "", "event topic, all events of the contract if not set")
+	cmd.Flags().StringP("primary", "p", "", "primary key of the last page")
+	cmd.Flags().Int32P("count", "c", 20, "count of events")
+	cmd.Flags().Int32P("direction", "d", 0, "0: from the latest, 1: from the earliest")
+	_ = cmd.MarkFlagRequired("name")
+	return cmd
+}
+
 func checkContract(cmd *cobra.Command, args []string) {
 	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
 	name, _ := cmd.Flags().GetString("name")
@@ -118,11 +223,18 @@ func callContract(cmd *cobra.Command, args []string) {
 	for _, param := range parameters {
 		parameters2 = append(parameters2, int64(param))
 	}
+	typedArgs, _ := cmd.Flags().GetStringArray("args")
+	wasmArgs, err := parseWasmArgs(typedArgs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
 
 	payload := wasmtypes.WasmCall{
 		Contract:   name,
 		Method:     method,
 		Parameters: parameters2,
+		Args:       wasmArgs,
 	}
 	params := rpctypes.CreateTxIn{
 		Execer:     wasmtypes.WasmX,
@@ -132,3 +244,201 @@ func callContract(cmd *cobra.Command, args []string) {
 	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
 	ctx.RunWithoutMarshal()
 }
+
+func readContract(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	method, _ := cmd.Flags().GetString("method")
+	parameters, _ := cmd.Flags().GetIntSlice("parameters")
+	var parameters2 []int64
+	for _, param := range parameters {
+		parameters2 = append(parameters2, int64(param))
+	}
+	typedArgs, _ := cmd.Flags().GetStringArray("args")
+	wasmArgs, err := parseWasmArgs(typedArgs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	caller, _ := cmd.Flags().GetString("caller")
+	gas, _ := cmd.Flags().GetInt64("gas")
+
+	params := rpctypes.Query4Jrpc{
+		Execer:   wasmtypes.WasmX,
+		FuncName: "ReadContract",
+		Payload: types.MustPBToJSON(&wasmtypes.QueryReadContract{
+			Contract:   name,
+			Method:     method,
+			Parameters: parameters2,
+			Args:       wasmArgs,
+			Caller:     caller,
+			GasLimit:   gas,
+		}),
+	}
+
+	var resp wasmtypes.CallContractLog
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
+	ctx.Run()
+}
+
+func updateContract(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	path, _ := cmd.Flags().GetString("path")
+	migrate, _ := cmd.Flags().GetBool("migrate")
+	typedArgs, _ := cmd.Flags().GetStringArray("args")
+
+	code, err := ioutil.ReadFile(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+	wasmArgs, err := parseWasmArgs(typedArgs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return
+	}
+
+	payload := wasmtypes.WasmUpdate{
+		Name:    name,
+		Code:    code,
+		Migrate: migrate,
+		Args:    wasmArgs,
+	}
+	params := rpctypes.CreateTxIn{
+		Execer:     wasmtypes.WasmX,
+		ActionName: "Update",
+		Payload:    types.MustPBToJSON(&payload),
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func transferAdmin(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	admin, _ := cmd.Flags().GetString("admin")
+
+	payload := wasmtypes.WasmTransferAdmin{
+		Name:  name,
+		Admin: admin,
+	}
+	params := rpctypes.CreateTxIn{
+		Execer:     wasmtypes.WasmX,
+		ActionName: "TransferAdmin",
+		Payload:    types.MustPBToJSON(&payload),
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func renounce(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+
+	params := rpctypes.CreateTxIn{
+		Execer:     wasmtypes.WasmX,
+		ActionName: "Renounce",
+		Payload:    types.MustPBToJSON(&wasmtypes.WasmRenounce{Name: name}),
+	}
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.CreateTransaction", params, nil)
+	ctx.RunWithoutMarshal()
+}
+
+func contractMeta(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+
+	params := rpctypes.Query4Jrpc{
+		Execer:   wasmtypes.WasmX,
+		FuncName: "GetContractMeta",
+		Payload:  types.MustPBToJSON(&wasmtypes.QueryCheckContract{Name: name}),
+	}
+
+	var resp wasmtypes.WasmContractMeta
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
+	ctx.Run()
+}
+
+func contractVersions(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+
+	params := rpctypes.Query4Jrpc{
+		Execer:   wasmtypes.WasmX,
+		FuncName: "GetContractVersions",
+		Payload:  types.MustPBToJSON(&wasmtypes.QueryCheckContract{Name: name}),
+	}
+
+	var resp wasmtypes.WasmContractVersions
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
+	ctx.Run()
+}
+
+func contractEvents(cmd *cobra.Command, args []string) {
+	rpcLaddr, _ := cmd.Flags().GetString("rpc_laddr")
+	name, _ := cmd.Flags().GetString("name")
+	topic, _ := cmd.Flags().GetString("topic")
+	primary, _ := cmd.Flags().GetString("primary")
+	count, _ := cmd.Flags().GetInt32("count")
+	direction, _ := cmd.Flags().GetInt32("direction")
+
+	params := rpctypes.Query4Jrpc{
+		Execer:   wasmtypes.WasmX,
+		FuncName: "GetEvents",
+		Payload: types.MustPBToJSON(&wasmtypes.QueryEvents{
+			Contract:   name,
+			Topic:      topic,
+			PrimaryKey: primary,
+			Count:      count,
+			Direction:  direction,
+		}),
+	}
+
+	var resp wasmtypes.EventLogs
+	ctx := jsonclient.NewRPCCtx(rpcLaddr, "Chain33.Query", params, &resp)
+	ctx.Run()
+}
+
+// 解析 type:value 格式的参数
+func parseWasmArgs(args []string) ([]*wasmtypes.WasmParam, error) {
+	var params []*wasmtypes.WasmParam
+	for _, arg := range args {
+		kv := strings.SplitN(arg, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("invalid arg %s, should be type:value", arg)
+		}
+		param := &wasmtypes.WasmParam{}
+		switch kv[0] {
+		case "bytes":
+			value, err := common.FromHex(kv[1])
+			if err != nil {
+				return nil, err
+			}
+			param.Value = &wasmtypes.WasmParam_Bytes{Bytes: value}
+		case "string":
+			param.Value = &wasmtypes.WasmParam_Str{Str: kv[1]}
+		case "address":
+			if err := address.CheckAddress(kv[1]); err != nil {
+				return nil, err
+			}
+			param.Value = &wasmtypes.WasmParam_Address{Address: kv[1]}
+		case "int64":
+			value, err := strconv.ParseInt(kv[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			param.Value = &wasmtypes.WasmParam_Int{Int: value}
+		case "bool":
+			value, err := strconv.ParseBool(kv[1])
+			if err != nil {
+				return nil, err
+			}
+			param.Value = &wasmtypes.WasmParam_Bool{Bool: value}
+		default:
+			return nil, fmt.Errorf("unknown arg type %s", kv[0])
+		}
+		params = append(params, param)
+	}
+	return params, nil
+}
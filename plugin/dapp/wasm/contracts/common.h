@@ -31,6 +31,24 @@ void getFrom(const char* from_addr, size_t from_len);
 int64_t getHeight();
 int64_t getRandom();
 void sha256(const char* data, size_t data_len, char* sum, size_t sum_len);
+void keccak256(const char* data, size_t data_len, char* sum, size_t sum_len);
+void ripemd160(const char* data, size_t data_len, char* sum, size_t sum_len);
+int verifySecp256k1(const char* msg, size_t msg_len, const char* pub, size_t pub_len, const char* sig, size_t sig_len);
+int verifyEd25519(const char* msg, size_t msg_len, const char* pub, size_t pub_len, const char* sig, size_t sig_len);
+int verifySM2(const char* msg, size_t msg_len, const char* pub, size_t pub_len, const char* sig, size_t sig_len);
+int verifyBLSAggregate(const char* msg, size_t msg_len, const char* pubs, size_t pubs_len, const char* sig, size_t sig_len);
+
+size_t getParamCount();
+int getParamType(size_t index);
+size_t getParamSize(size_t index);
+size_t getParam(size_t index, char* value, size_t v_len);
+int64_t getParamInt(size_t index);
+int setReturn(const char* data, size_t len);
+
+int64_t callContract(const char* name, size_t name_len, const char* method, size_t method_len, const char* data, size_t data_len);
+size_t getCallReturnSize();
+size_t getCallReturn(char* value, size_t v_len);
+int emitEvent(const char* topic, size_t topic_len, const char* data, size_t data_len);
 void printlog(const char* log, size_t len);
 void printint(int64_t n);
 
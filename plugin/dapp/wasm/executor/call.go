@@ -0,0 +1,173 @@
+package executor
+
+// 合约调用合约
+// 被调用合约在独立的状态数据库中执行，执行成功后才把修改写入调用者的数据库，
+// 执行失败时丢弃它产生的所有数据；被调用合约和调用合约共用交易的 gas
+
+import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
+	"github.com/33cn/chain33/system/dapp"
+	"github.com/33cn/chain33/types"
+	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
+	"github.com/perlin-network/life/exec"
+)
+
+// 被调用合约使用的状态数据库，写入的数据先保存在内存中
+type callStateDB struct {
+	dbm.KV
+	cache map[string][]byte
+	keys  []string
+}
+
+func newCallStateDB(db dbm.KV) *callStateDB {
+	return &callStateDB{KV: db, cache: make(map[string][]byte)}
+}
+
+func (db *callStateDB) Get(key []byte) ([]byte, error) {
+	if value, ok := db.cache[string(key)]; ok {
+		if value == nil {
+			return nil, types.ErrNotFound
+		}
+		return value, nil
+	}
+	return db.KV.Get(key)
+}
+
+func (db *callStateDB) Set(key []byte, value []byte) error {
+	if _, ok := db.cache[string(key)]; !ok {
+		db.keys = append(db.keys, string(key))
+	}
+	db.cache[string(key)] = value
+	return nil
+}
+
+// 按写入顺序把数据写入上一层数据库
+func (db *callStateDB) flush() error {
+	for _, key := range db.keys {
+		if err := db.KV.Set([]byte(key), db.cache[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// 调用合约之前保存的调用者的执行环境
+type callFrame struct {
+	stateDB      dbm.KV
+	stateKVC     *dapp.KVCreator
+	contractName string
+	caller       string
+	params       []*types2.WasmParam
+	returnData   []byte
+	kvs          int
+	receiptLogs  int
+	customLogs   int
+	localCache   int
+	events       int
+}
+
+func (w *Wasm) saveFrame() *callFrame {
+	return &callFrame{
+		stateDB:      w.GetStateDB(),
+		stateKVC:     w.stateKVC,
+		contractName: w.contractName,
+		caller:       w.caller,
+		params:       w.params,
+		returnData:   w.returnData,
+		kvs:          len(w.kvs),
+		receiptLogs:  len(w.receiptLogs),
+		customLogs:   len(w.customLogs),
+		localCache:   len(w.localCache),
+		events:       len(w.events),
+	}
+}
+
+// 恢复调用者的执行环境，revert为true时丢弃被调用合约产生的数据
+func (w *Wasm) restoreFrame(frame *callFrame, revert bool) {
+	w.SetStateDB(frame.stateDB)
+	w.stateKVC = frame.stateKVC
+	w.contractName = frame.contractName
+	w.caller = frame.caller
+	w.params = frame.params
+	w.returnData = frame.returnData
+	w.callStack = w.callStack[:len(w.callStack)-1]
+	if revert {
+		w.kvs = w.kvs[:frame.kvs]
+		w.receiptLogs = w.receiptLogs[:frame.receiptLogs]
+		w.customLogs = w.customLogs[:frame.customLogs]
+		w.localCache = w.localCache[:frame.localCache]
+		w.events = w.events[:frame.events]
+	}
+}
+
+// 在调用合约的虚拟机中调用其它合约的导出方法，导出方法不能有参数，调用数据作为一个 bytes 类型的参数传入，
+// 返回被调用合约的返回值，调用出错时返回 -1
+func (w *Wasm) invokeContract(vm *exec.VirtualMachine, contract, method string, data []byte) int64 {
+	if len(w.callStack) >= types2.MaxCallDepth {
+		log.Error("callContract", "error", "call depth exceeded", "contract", contract)
+		return -1
+	}
+	// 不允许重入调用栈中的合约
+	for _, name := range w.callStack {
+		if name == contract {
+			log.Error("callContract", "error", "reentrant call", "contract", contract)
+			return -1
+		}
+	}
+	// gas 上限为 0 时虚拟机不限制 gas
+	gasLimit := int64(vm.Config.GasLimit - vm.Gas)
+	if gasLimit <= 0 {
+		log.Error("callContract", "contract", contract, "error", "gas limit exceeded")
+		return -1
+	}
+	callee, err := w.loadVirtualMachine(contract, gasLimit)
+	if err != nil {
+		log.Error("callContract", "contract", contract, "error", err)
+		return -1
+	}
+	entryID, ok := callee.GetFunctionExport(method)
+	if !ok || callee.FunctionCode[entryID].NumParams != 0 {
+		log.Error("callContract", "contract", contract, "error", types2.ErrInvalidMethod)
+		return -1
+	}
+
+	frame := w.saveFrame()
+	stateDB := newCallStateDB(frame.stateDB)
+	w.SetStateDB(stateDB)
+	w.stateKVC = dapp.NewKVCreator(stateDB, calcStatePrefix(contract), nil)
+	w.caller = address.ExecAddress(w.userExecName(frame.contractName, false))
+	w.contractName = contract
+	w.params = []*types2.WasmParam{{Value: &types2.WasmParam_Bytes{Bytes: data}}}
+	w.returnData = nil
+	w.callStack = append(w.callStack, contract)
+
+	ret, err := callee.RunWithGasLimit(entryID, int(gasLimit))
+	vm.Gas += callee.Gas
+	kvs := w.stateKVC.KVList()
+	w.callReturn = w.returnData
+	if err != nil {
+		delete(w.VMCache, contract)
+		w.restoreFrame(frame, true)
+		log.Error("callContract", "contract", contract, "method", method, "error", err)
+		return -1
+	}
+	if failed(ret) {
+		w.restoreFrame(frame, true)
+		return ret
+	}
+	if err := stateDB.flush(); err != nil {
+		w.restoreFrame(frame, true)
+		return -1
+	}
+	w.kvs = append(w.kvs, kvs...)
+	w.receiptLogs = append(w.receiptLogs, &types.ReceiptLog{Ty: types2.TyLogWasmCall, Log: types.Encode(&types2.CallContractLog{
+		Contract: contract,
+		Method:   method,
+		Result:   int32(ret),
+		Ret:      w.callReturn,
+		GasUsed:  int64(callee.Gas),
+	})})
+	w.restoreFrame(frame, false)
+	return ret
+}
@@ -3,8 +3,12 @@ package executor
 import (
 	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
+	"github.com/33cn/chain33/common/crypto"
 	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
 	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
+	"github.com/perlin-network/life/exec"
+	"golang.org/x/crypto/ripemd160"
 )
 
 //stateDB wrapper
@@ -135,8 +139,9 @@ func execAddress(name string) string {
 	return address.ExecAddress(name)
 }
 
+// 合约被其它合约调用时返回调用合约的地址
 func getFrom() string {
-	return wasmCB.tx.From()
+	return wasmCB.caller
 }
 
 func getHeight() int64 {
@@ -163,6 +168,103 @@ func getRandom() int64 {
 	return rand
 }
 
+//call parameters wrapper
+func getParamCount() int {
+	return len(wasmCB.params)
+}
+
+func getWasmParam(index int) *types2.WasmParam {
+	if index < 0 || index >= len(wasmCB.params) {
+		return nil
+	}
+	return wasmCB.params[index]
+}
+
+func getParamType(index int) int {
+	switch getWasmParam(index).GetValue().(type) {
+	case *types2.WasmParam_Bytes:
+		return types2.WasmParamBytes
+	case *types2.WasmParam_Str:
+		return types2.WasmParamString
+	case *types2.WasmParam_Address:
+		return types2.WasmParamAddress
+	case *types2.WasmParam_Int:
+		return types2.WasmParamInt64
+	case *types2.WasmParam_Bool:
+		return types2.WasmParamBool
+	}
+	return -1
+}
+
+// bytes, string, address 类型的参数
+func getParam(index int) ([]byte, error) {
+	switch value := getWasmParam(index).GetValue().(type) {
+	case *types2.WasmParam_Bytes:
+		return value.Bytes, nil
+	case *types2.WasmParam_Str:
+		return []byte(value.Str), nil
+	case *types2.WasmParam_Address:
+		return []byte(value.Address), nil
+	}
+	return nil, types2.ErrInvalidParam
+}
+
+func getParamSize(index int) int {
+	value, err := getParam(index)
+	if err != nil {
+		return 0
+	}
+	return len(value)
+}
+
+// int64, bool 类型的参数，bool 值转为 1 或 0
+func getParamInt(index int) (int64, error) {
+	switch value := getWasmParam(index).GetValue().(type) {
+	case *types2.WasmParam_Int:
+		return value.Int, nil
+	case *types2.WasmParam_Bool:
+		if value.Bool {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, types2.ErrInvalidParam
+}
+
+func setReturn(data []byte) error {
+	if len(data) > types2.MaxReturnSize {
+		return types2.ErrReturnOversize
+	}
+	wasmCB.returnData = data
+	return nil
+}
+
+//contract call wrapper
+func callContract(vm *exec.VirtualMachine, contract, method string, data []byte) int64 {
+	return wasmCB.invokeContract(vm, contract, method, data)
+}
+
+func getCallReturnSize() int {
+	return len(wasmCB.callReturn)
+}
+
+func getCallReturn() []byte {
+	return wasmCB.callReturn
+}
+
+//event wrapper
+func emitEvent(topic string, data []byte) error {
+	if len(topic) > types2.MaxTopicSize {
+		return types2.ErrTopicOversize
+	}
+	wasmCB.events = append(wasmCB.events, &types2.EventLog{
+		Contract: wasmCB.contractName,
+		Topic:    topic,
+		Data:     data,
+	})
+	return nil
+}
+
 func printlog(s string) {
 	wasmCB.customLogs = append(wasmCB.customLogs, s)
 }
@@ -170,3 +272,73 @@ func printlog(s string) {
 func sha256(data []byte) []byte {
 	return common.Sha256(data)
 }
+
+//crypto wrapper
+func keccak256(data []byte) []byte {
+	return common.Sha3(data)
+}
+
+func ripemd160Sum(data []byte) []byte {
+	hasher := ripemd160.New()
+	_, _ = hasher.Write(data)
+	return hasher.Sum(nil)
+}
+
+// 使用 chain33 注册的签名算法验证签名，signType 为 types.SECP256K1, types.ED25519 或 types.SM2
+func verifySignature(signType int, msg, pub, sig []byte) bool {
+	c, err := crypto.New(types.GetSignName("", signType))
+	if err != nil {
+		return false
+	}
+	pubKey, err := c.PubKeyFromBytes(pub)
+	if err != nil {
+		return false
+	}
+	signature, err := c.SignatureFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	return pubKey.VerifyBytes(msg, signature)
+}
+
+// 验证多个公钥对同一个消息的 BLS 聚合签名，pubs 为依次拼接的公钥
+// 每个公钥签名的消息为公钥和 msg 拼接后的数据(消息增强)，防止使用伪造公钥抵消其它公钥的流氓公钥攻击，公钥不能重复
+func verifyBLSAggregate(msg, pubs, sig []byte) bool {
+	if len(pubs) == 0 || len(pubs)%bls.BLSPublicKeyLength != 0 || len(sig) != bls.BLSSignatureLength {
+		return false
+	}
+	c, err := crypto.New(bls.Name)
+	if err != nil {
+		return false
+	}
+	aggr, err := crypto.ToAggregate(c)
+	if err != nil {
+		return false
+	}
+	var pubKeys []crypto.PubKey
+	var msgs [][]byte
+	seen := make(map[string]bool)
+	for i := 0; i < len(pubs); i += bls.BLSPublicKeyLength {
+		pub := pubs[i : i+bls.BLSPublicKeyLength]
+		if seen[string(pub)] {
+			return false
+		}
+		seen[string(pub)] = true
+		pubKey, err := c.PubKeyFromBytes(pub)
+		if err != nil {
+			return false
+		}
+		pubKeys = append(pubKeys, pubKey)
+		msgs = append(msgs, blsAugmentMsg(pub, msg))
+	}
+	signature, err := c.SignatureFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	return aggr.VerifyAggregatedN(pubKeys, msgs, signature) == nil
+}
+
+// BLS 聚合签名中每个公钥实际签名的消息
+func blsAugmentMsg(pub, msg []byte) []byte {
+	return append(append([]byte{}, pub...), msg...)
+}
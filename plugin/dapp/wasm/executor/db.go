@@ -1,6 +1,9 @@
 package executor
 
 import (
+	"encoding/hex"
+	"fmt"
+
 	"github.com/33cn/chain33/types"
 	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
 )
@@ -10,6 +13,17 @@ func contractKey(name string) []byte {
 	return append([]byte("mavl-"+types2.WasmX+"-code-"), []byte(name)...)
 }
 
+// 合约名中不包含'.'，与合约状态数据的前缀 "mavl-wasm-{contract}-" 不会冲突
+// "mavl-wasm-meta.{name}"
+func contractMetaKey(name string) []byte {
+	return append([]byte("mavl-"+types2.WasmX+"-meta."), []byte(name)...)
+}
+
+// "mavl-wasm-version.{name}-{version}"
+func contractVersionKey(name string, version int32) []byte {
+	return []byte(fmt.Sprintf("mavl-%s-version.%s-%010d", types2.WasmX, name, version))
+}
+
 // "mavl-wasm-{contract}-"
 func calcStatePrefix(contract string) []byte {
 	var prefix []byte
@@ -28,6 +42,32 @@ func calcLocalPrefix(contract string) []byte {
 	return prefix
 }
 
+// 事件在区块中的顺序
+func calcEventOrder(height int64, index, seq int) string {
+	return fmt.Sprintf("%012d%06d%04d", height, index, seq)
+}
+
+// "LODB-wasm-event.{contract}-"
+func calcEventPrefix(contract string) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-event.%s-", types2.WasmX, contract))
+}
+
+// "LODB-wasm-event.{contract}-{order}"
+func calcEventKey(contract, order string) []byte {
+	return append(calcEventPrefix(contract), []byte(order)...)
+}
+
+// 主题可以包含任意字符，编码为十六进制后作为key的一部分
+// "LODB-wasm-topic.{contract}-{hex(topic)}-"
+func calcTopicEventPrefix(contract, topic string) []byte {
+	return []byte(fmt.Sprintf("LODB-%s-topic.%s-%s-", types2.WasmX, contract, hex.EncodeToString([]byte(topic))))
+}
+
+// "LODB-wasm-topic.{contract}-{hex(topic)}-{order}"
+func calcTopicEventKey(contract, topic, order string) []byte {
+	return append(calcTopicEventPrefix(contract, topic), []byte(order)...)
+}
+
 func (w *Wasm) contractExist(name string) bool {
 	_, err := w.GetStateDB().Get(contractKey(name))
 	if err != nil && err != types.ErrNotFound {
@@ -35,3 +75,16 @@ func (w *Wasm) contractExist(name string) bool {
 	}
 	return err == nil
 }
+
+func (w *Wasm) getContractMeta(name string) (*types2.WasmContractMeta, error) {
+	data, err := w.GetStateDB().Get(contractMetaKey(name))
+	if err != nil {
+		return nil, err
+	}
+	var meta types2.WasmContractMeta
+	err = types.Decode(data, &meta)
+	if err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
@@ -2,7 +2,9 @@ package executor
 
 import (
 	"encoding/hex"
+	"sync"
 
+	"github.com/33cn/chain33/common"
 	"github.com/33cn/chain33/common/address"
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
@@ -14,6 +16,9 @@ import (
 
 var wasmCB *Wasm
 
+// 回调函数通过 wasmCB 访问执行器，执行交易和只读调用合约不能同时进行
+var wasmLock sync.Mutex
+
 func (w *Wasm) userExecName(name string, local bool) string {
 	execer := "user." + types2.WasmX + "." + name
 	if local {
@@ -57,6 +62,16 @@ func (w *Wasm) Exec_Create(payload *types2.WasmCreate, tx *types.Transaction, in
 		return nil, err
 	}
 	kvc.AddNoPrefix(contractKey(name), code)
+	cfg := w.GetAPI().GetConfig()
+	if cfg.IsDappFork(w.GetHeight(), types2.WasmX, types2.ForkWasmUpgrade) {
+		meta := &types2.WasmContractMeta{
+			Name:    name,
+			Creator: tx.From(),
+			Admin:   tx.From(),
+			Version: 1,
+		}
+		w.addContractVersion(kvc, meta, code, tx)
+	}
 
 	receiptLog := &types.ReceiptLog{
 		Ty: types2.TyLogWasmCreate,
@@ -80,45 +95,17 @@ func (w *Wasm) Exec_Call(payload *types2.WasmCall, tx *types.Transaction, index
 	if !w.checkTxExec(string(tx.Execer), types2.WasmX) {
 		return nil, types.ErrExecNameNotMatch
 	}
-
-	w.stateKVC = dapp.NewKVCreator(w.GetStateDB(), calcStatePrefix(payload.Contract), nil)
-	var vm *exec.VirtualMachine
-	var ok bool
-	if vm, ok = w.VMCache[payload.Contract]; !ok {
-		code, err := w.stateKVC.GetNoPrefix(contractKey(payload.Contract))
-		if err != nil {
-			return nil, err
-		}
-		vm, err = exec.NewVirtualMachine(code, exec.VMConfig{
-			DefaultMemoryPages:   128,
-			DefaultTableSize:     128,
-			DisableFloatingPoint: true,
-			GasLimit:             uint64(tx.Fee),
-		}, new(Resolver), &compiler.SimpleGasPolicy{GasPerInstruction: 1})
-		if err != nil {
-			return nil, err
-		}
-		w.VMCache[payload.Contract] = vm
-	} else {
-		vm.Config.GasLimit = uint64(tx.Fee)
-		vm.Gas = 0
-	}
-
-	// Get the function ID of the entry function to be executed.
-	entryID, ok := vm.GetFunctionExport(payload.Method)
-	if !ok {
-		return nil, types2.ErrInvalidMethod
+	// 分叉之前的节点不识别 args，不做检查
+	if w.hostFuncEnabled() && !validateParams(payload.Args) {
+		return nil, types2.ErrInvalidParam
 	}
+	return w.execContract(payload.Contract, payload.Method, payload.Parameters, payload.Args, tx)
+}
 
-	w.contractName = payload.Contract
+// 执行合约的导出方法
+func (w *Wasm) execContract(contract, method string, parameters []int64, args []*types2.WasmParam, tx *types.Transaction) (*types.Receipt, error) {
 	w.tx = tx
-	w.execAddr = address.ExecAddress(string(types.GetRealExecName(tx.Execer)))
-	wasmCB = w
-	defer func() {
-		wasmCB = nil
-	}()
-	// Run the WebAssembly module's entry function.
-	ret, err := vm.RunWithGasLimit(entryID, int(tx.Fee), payload.Parameters...)
+	callLog, err := w.runContract(contract, method, parameters, args, tx.From(), tx.Fee)
 	if err != nil {
 		return nil, err
 	}
@@ -127,11 +114,7 @@ func (w *Wasm) Exec_Call(payload *types2.WasmCall, tx *types.Transaction, index
 	kvs = append(kvs, w.stateKVC.KVList()...)
 
 	var logs []*types.ReceiptLog
-	logs = append(logs, &types.ReceiptLog{Ty: types2.TyLogWasmCall, Log: types.Encode(&types2.CallContractLog{
-		Contract: payload.Contract,
-		Method:   payload.Method,
-		Result:   int32(ret),
-	})})
+	logs = append(logs, &types.ReceiptLog{Ty: types2.TyLogWasmCall, Log: types.Encode(callLog)})
 	logs = append(logs, w.receiptLogs...)
 	logs = append(logs, &types.ReceiptLog{Ty: types2.TyLogCustom, Log: types.Encode(&types2.CustomLog{
 		Info: w.customLogs,
@@ -142,22 +125,314 @@ func (w *Wasm) Exec_Call(payload *types2.WasmCall, tx *types.Transaction, index
 			Log: types.Encode(log),
 		})
 	}
+	for _, event := range w.events {
+		logs = append(logs, &types.ReceiptLog{
+			Ty:  types2.TyLogWasmEvent,
+			Log: types.Encode(event),
+		})
+	}
 
 	receipt := &types.Receipt{
 		Ty:   types.ExecOk,
 		KV:   kvs,
 		Logs: logs,
 	}
-	if int32(ret) < 0 || int16(ret) < 0 {
+	if failed(int64(callLog.Result)) {
 		receipt.Ty = types.ExecPack
 	}
 
 	return receipt, nil
 }
 
+// 在虚拟机中运行合约的导出方法，产生的数据保存在执行器中
+func (w *Wasm) runContract(contract, method string, parameters []int64, args []*types2.WasmParam, caller string, gasLimit int64) (*types2.CallContractLog, error) {
+	// 执行器在同一个区块的交易间复用，需要清理上一笔交易的数据
+	w.kvs = nil
+	w.receiptLogs = nil
+	w.customLogs = nil
+	w.localCache = nil
+	w.events = nil
+	w.stateKVC = dapp.NewKVCreator(w.GetStateDB(), calcStatePrefix(contract), nil)
+	vm, err := w.loadVirtualMachine(contract, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the function ID of the entry function to be executed.
+	entryID, ok := vm.GetFunctionExport(method)
+	if !ok {
+		return nil, types2.ErrInvalidMethod
+	}
+	if vm.FunctionCode[entryID].NumParams != len(parameters) {
+		return nil, types2.ErrInvalidParam
+	}
+
+	w.contractName = contract
+	w.caller = caller
+	w.callStack = []string{contract}
+	w.params = args
+	w.returnData = nil
+	w.callReturn = nil
+	w.execAddr = address.ExecAddress(types2.WasmX)
+	wasmLock.Lock()
+	wasmCB = w
+	defer func() {
+		wasmCB = nil
+		wasmLock.Unlock()
+	}()
+	// Run the WebAssembly module's entry function.
+	ret, err := vm.RunWithGasLimit(entryID, int(gasLimit), parameters...)
+	if err != nil {
+		// 执行出错的虚拟机不能再次执行
+		delete(w.VMCache, contract)
+		return nil, err
+	}
+	return &types2.CallContractLog{
+		Contract: contract,
+		Method:   method,
+		Result:   int32(ret),
+		Ret:      w.returnData,
+		GasUsed:  int64(vm.Gas),
+	}, nil
+}
+
+// 从缓存中获取合约的虚拟机，不存在时根据合约代码创建
+func (w *Wasm) loadVirtualMachine(contract string, gasLimit int64) (*exec.VirtualMachine, error) {
+	table := w.loadGasTable()
+	if vm, ok := w.VMCache[contract]; ok {
+		vm.Config.GasLimit = uint64(gasLimit)
+		vm.Gas = 0
+		return vm, nil
+	}
+	code, err := w.GetStateDB().Get(contractKey(contract))
+	if err != nil {
+		return nil, err
+	}
+	vm, err := newVirtualMachine(code, gasLimit, table)
+	if err != nil {
+		return nil, err
+	}
+	w.VMCache[contract] = vm
+	return vm, nil
+}
+
+// 当前高度是否可以调用 ForkWasmHostFunc 新增的回调函数
+func (w *Wasm) hostFuncEnabled() bool {
+	return w.GetAPI().GetConfig().IsDappFork(w.GetHeight(), types2.WasmX, types2.ForkWasmHostFunc)
+}
+
+// 获取当前高度使用的计费表，计费表变化时缓存的虚拟机需要重新创建
+func (w *Wasm) loadGasTable() *types2.GasTable {
+	cfg := w.GetAPI().GetConfig()
+	table := types2.GetGasTable(cfg, w.GetHeight())
+	// 测试链可以在分叉之前使用配置的计费表
+	if gasTableOverride != nil && !cfg.IsDappFork(w.GetHeight(), types2.WasmX, types2.ForkWasmGasTable) {
+		table = gasTableOverride
+	}
+	if table != w.gasTable {
+		w.gasTable = table
+		w.VMCache = make(map[string]*exec.VirtualMachine)
+	}
+	return table
+}
+
+func (w *Wasm) Exec_Update(payload *types2.WasmUpdate, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if payload == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if !w.checkTxExec(string(tx.Execer), types2.WasmX) {
+		return nil, types.ErrExecNameNotMatch
+	}
+	cfg := w.GetAPI().GetConfig()
+	if !cfg.IsDappFork(w.GetHeight(), types2.WasmX, types2.ForkWasmUpgrade) {
+		return nil, types.ErrActionNotSupport
+	}
+	code := payload.Code
+	if len(code) > types2.MaxCodeSize {
+		return nil, types2.ErrCodeOversize
+	}
+	if err := validation.ValidateWasm(code); err != nil {
+		return nil, types2.ErrInvalidWasm
+	}
+	if !validateParams(payload.Args) {
+		return nil, types2.ErrInvalidParam
+	}
+	meta, err := w.checkContractAdmin(payload.Name, tx.From())
+	if err != nil {
+		return nil, err
+	}
+	var vm *exec.VirtualMachine
+	if payload.Migrate {
+		vm, err = newVirtualMachine(code, tx.Fee, w.loadGasTable())
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := vm.GetFunctionExport(types2.MigrateMethod); !ok {
+			return nil, types2.ErrInvalidMethod
+		}
+	}
+
+	kvc := dapp.NewKVCreator(w.GetStateDB(), nil, nil)
+	kvc.AddNoPrefix(contractKey(meta.Name), code)
+	meta.Version++
+	w.addContractVersion(kvc, meta, code, tx)
+	// 代码已经更新，缓存的虚拟机需要重新创建
+	delete(w.VMCache, meta.Name)
+
+	receipt := &types.Receipt{
+		Ty: types.ExecOk,
+		KV: kvc.KVList(),
+		Logs: []*types.ReceiptLog{{
+			Ty: types2.TyLogWasmUpdate,
+			Log: types.Encode(&types2.UpdateContractLog{
+				Name:     meta.Name,
+				Version:  meta.Version,
+				CodeHash: hex.EncodeToString(common.Sha256(code)),
+			}),
+		}},
+	}
+	if !payload.Migrate {
+		return receipt, nil
+	}
+
+	w.VMCache[meta.Name] = vm
+	callReceipt, err := w.execContract(meta.Name, types2.MigrateMethod, nil, payload.Args, tx)
+	if err != nil || callReceipt.Ty != types.ExecOk {
+		// 交易执行失败时代码不会更新，缓存的新代码的虚拟机不能再使用
+		delete(w.VMCache, meta.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	receipt.Ty = callReceipt.Ty
+	receipt.KV = append(receipt.KV, callReceipt.KV...)
+	receipt.Logs = append(receipt.Logs, callReceipt.Logs...)
+	return receipt, nil
+}
+
+func (w *Wasm) Exec_TransferAdmin(payload *types2.WasmTransferAdmin, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if payload == nil {
+		return nil, types.ErrInvalidParam
+	}
+	if err := address.CheckAddress(payload.Admin); err != nil {
+		return nil, types2.ErrInvalidParam
+	}
+	return w.setContractAdmin(payload.Name, payload.Admin, tx)
+}
+
+func (w *Wasm) Exec_Renounce(payload *types2.WasmRenounce, tx *types.Transaction, index int) (*types.Receipt, error) {
+	if payload == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return w.setContractAdmin(payload.Name, "", tx)
+}
+
+// 修改合约管理员，管理员为空时合约不能再升级
+func (w *Wasm) setContractAdmin(name, admin string, tx *types.Transaction) (*types.Receipt, error) {
+	if !w.checkTxExec(string(tx.Execer), types2.WasmX) {
+		return nil, types.ErrExecNameNotMatch
+	}
+	cfg := w.GetAPI().GetConfig()
+	if !cfg.IsDappFork(w.GetHeight(), types2.WasmX, types2.ForkWasmUpgrade) {
+		return nil, types.ErrActionNotSupport
+	}
+	meta, err := w.checkContractAdmin(name, tx.From())
+	if err != nil {
+		return nil, err
+	}
+	prev := meta.Admin
+	meta.Admin = admin
+
+	kvc := dapp.NewKVCreator(w.GetStateDB(), nil, nil)
+	kvc.AddNoPrefix(contractMetaKey(meta.Name), types.Encode(meta))
+	return &types.Receipt{
+		Ty: types.ExecOk,
+		KV: kvc.KVList(),
+		Logs: []*types.ReceiptLog{{
+			Ty: types2.TyLogWasmAdmin,
+			Log: types.Encode(&types2.ContractAdminLog{
+				Name:    meta.Name,
+				Prev:    prev,
+				Current: admin,
+			}),
+		}},
+	}, nil
+}
+
+// 只有合约管理员可以升级合约和修改管理员，升级功能之前创建的合约没有元数据，不能升级
+func (w *Wasm) checkContractAdmin(name, from string) (*types2.WasmContractMeta, error) {
+	if !w.contractExist(name) {
+		return nil, types2.ErrContractNotExist
+	}
+	meta, err := w.getContractMeta(name)
+	if err == types.ErrNotFound {
+		return nil, types2.ErrNotUpgradeable
+	}
+	if err != nil {
+		return nil, err
+	}
+	if meta.Admin == "" {
+		return nil, types2.ErrNotUpgradeable
+	}
+	if meta.Admin != from {
+		return nil, types2.ErrPermissionDenied
+	}
+	return meta, nil
+}
+
+// 保存合约元数据和当前版本的记录
+func (w *Wasm) addContractVersion(kvc *dapp.KVCreator, meta *types2.WasmContractMeta, code []byte, tx *types.Transaction) {
+	kvc.AddNoPrefix(contractMetaKey(meta.Name), types.Encode(meta))
+	kvc.AddNoPrefix(contractVersionKey(meta.Name, meta.Version), types.Encode(&types2.WasmContractVersion{
+		Version:  meta.Version,
+		CodeHash: hex.EncodeToString(common.Sha256(code)),
+		Height:   w.GetHeight(),
+		TxHash:   hex.EncodeToString(tx.Hash()),
+		Operator: tx.From(),
+	}))
+}
+
+// 合约的返回值为负数时表示执行失败
+func failed(ret int64) bool {
+	return int32(ret) < 0 || int16(ret) < 0
+}
+
+func newVirtualMachine(code []byte, fee int64, table *types2.GasTable) (*exec.VirtualMachine, error) {
+	return exec.NewVirtualMachine(code, exec.VMConfig{
+		DefaultMemoryPages:   128,
+		DefaultTableSize:     128,
+		DisableFloatingPoint: true,
+		GasLimit:             uint64(fee),
+		MaxMemoryPages:       table.MaxMemoryPages,
+	}, new(Resolver), &gasPolicy{table: table})
+}
+
+// 按计费表计算每条指令的 gas
+type gasPolicy struct {
+	table *types2.GasTable
+}
+
+func (p *gasPolicy) GetCost(ins compiler.Instr) int64 {
+	return p.table.InstructionGas(ins.Op)
+}
+
 func validateName(name string) bool {
 	if !types2.NameReg.MatchString(name) || len(name) < 4 || len(name) > 20 {
 		return false
 	}
 	return true
 }
+
+func validateParams(params []*types2.WasmParam) bool {
+	for _, param := range params {
+		switch value := param.GetValue().(type) {
+		case nil:
+			return false
+		case *types2.WasmParam_Address:
+			if address.CheckAddress(value.Address) != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -10,11 +10,26 @@ func (w *Wasm) ExecDelLocal_Create(payload *types2.WasmCreate, tx *types.Transac
 }
 
 func (w *Wasm) ExecDelLocal_Call(payload *types2.WasmCall, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
-	localExecer := w.userExecName(payload.Contract, true)
+	return w.execDelLocalData(payload.Contract, tx)
+}
+
+func (w *Wasm) ExecDelLocal_Update(payload *types2.WasmUpdate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return w.execDelLocalData(payload.Name, tx)
+}
+
+func (w *Wasm) ExecDelLocal_TransferAdmin(payload *types2.WasmTransferAdmin, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{}, nil
+}
+
+func (w *Wasm) ExecDelLocal_Renounce(payload *types2.WasmRenounce, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{}, nil
+}
+
+func (w *Wasm) execDelLocalData(contract string, tx *types.Transaction) (*types.LocalDBSet, error) {
+	localExecer := w.userExecName(contract, true)
 	kvs, err := w.DelRollbackKV(tx, []byte(localExecer))
 	if err != nil {
 		return nil, err
 	}
 	return &types.LocalDBSet{KV: kvs}, nil
-
 }
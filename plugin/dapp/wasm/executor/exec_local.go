@@ -1,6 +1,8 @@
 package executor
 
 import (
+	"encoding/hex"
+
 	"github.com/33cn/chain33/types"
 	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
 )
@@ -10,11 +12,29 @@ func (w *Wasm) ExecLocal_Create(payload *types2.WasmCreate, tx *types.Transactio
 }
 
 func (w *Wasm) ExecLocal_Call(payload *types2.WasmCall, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return w.execLocalData(payload.Contract, tx, receipt, index)
+}
+
+// 更新合约时调用migrate方法也会写入本地数据
+func (w *Wasm) ExecLocal_Update(payload *types2.WasmUpdate, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return w.execLocalData(payload.Name, tx, receipt, index)
+}
+
+func (w *Wasm) ExecLocal_TransferAdmin(payload *types2.WasmTransferAdmin, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{}, nil
+}
+
+func (w *Wasm) ExecLocal_Renounce(payload *types2.WasmRenounce, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
+	return &types.LocalDBSet{}, nil
+}
+
+func (w *Wasm) execLocalData(contract string, tx *types.Transaction, receipt *types.ReceiptData, index int) (*types.LocalDBSet, error) {
 	if receipt.Ty != types.ExecOk {
 		return &types.LocalDBSet{}, nil
 	}
-	localExecer := w.userExecName(payload.Contract, true)
+	localExecer := w.userExecName(contract, true)
 	var KVs []*types.KeyValue
+	var eventSeq int
 	for _, item := range receipt.Logs {
 		if item.Ty == types2.TyLogLocalData {
 			var data types2.LocalDataLog
@@ -27,6 +47,21 @@ func (w *Wasm) ExecLocal_Call(payload *types2.WasmCall, tx *types.Transaction, r
 				Value: data.Value,
 			})
 		}
+		// 事件按合约和主题分别建立索引
+		if item.Ty == types2.TyLogWasmEvent {
+			var event types2.EventLog
+			err := types.Decode(item.Log, &event)
+			if err != nil {
+				return nil, err
+			}
+			event.Height = w.GetHeight()
+			event.TxHash = hex.EncodeToString(tx.Hash())
+			order := calcEventOrder(w.GetHeight(), index, eventSeq)
+			eventSeq++
+			value := types.Encode(&event)
+			KVs = append(KVs, &types.KeyValue{Key: calcEventKey(event.Contract, order), Value: value})
+			KVs = append(KVs, &types.KeyValue{Key: calcTopicEventKey(event.Contract, event.Topic, order), Value: value})
+		}
 	}
 
 	return &types.LocalDBSet{KV: w.AddRollbackKV(tx, []byte(localExecer), KVs)}, nil
@@ -1,13 +1,117 @@
 package executor
 
 import (
+	"github.com/33cn/chain33/common/address"
+	dbm "github.com/33cn/chain33/common/db"
 	"github.com/33cn/chain33/types"
 	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
 )
 
+// 每次最多查询的事件数量
+const eventPageSize = 100
+
 func (w *Wasm) Query_Check(query *types2.QueryCheckContract) (types.Message, error) {
 	if query == nil {
 		return nil, types.ErrInvalidParam
 	}
 	return &types.Reply{IsOk: w.contractExist(query.Name)}, nil
 }
+
+// Query_GetContractMeta 获取合约的创建者、管理员和当前版本
+func (w *Wasm) Query_GetContractMeta(query *types2.QueryCheckContract) (types.Message, error) {
+	if query == nil {
+		return nil, types.ErrInvalidParam
+	}
+	return w.getContractMeta(query.Name)
+}
+
+// Query_GetContractVersions 获取合约的所有版本记录
+func (w *Wasm) Query_GetContractVersions(query *types2.QueryCheckContract) (types.Message, error) {
+	if query == nil {
+		return nil, types.ErrInvalidParam
+	}
+	meta, err := w.getContractMeta(query.Name)
+	if err != nil {
+		return nil, err
+	}
+	reply := &types2.WasmContractVersions{}
+	for version := int32(1); version <= meta.Version; version++ {
+		data, err := w.GetStateDB().Get(contractVersionKey(query.Name, version))
+		if err != nil {
+			return nil, err
+		}
+		var item types2.WasmContractVersion
+		err = types.Decode(data, &item)
+		if err != nil {
+			return nil, err
+		}
+		reply.Versions = append(reply.Versions, &item)
+	}
+	return reply, nil
+}
+
+// Query_ReadContract 只读调用合约的导出方法，和交易使用相同的计费表，执行产生的数据不会保存
+func (w *Wasm) Query_ReadContract(query *types2.QueryReadContract) (types.Message, error) {
+	if query == nil || !validateParams(query.Args) {
+		return nil, types.ErrInvalidParam
+	}
+	if query.Caller != "" && address.CheckAddress(query.Caller) != nil {
+		return nil, types.ErrInvalidParam
+	}
+	gasLimit := query.GasLimit
+	if gasLimit <= 0 || gasLimit > subcfg.QueryGasLimit {
+		gasLimit = subcfg.QueryGasLimit
+	}
+	stateDB := w.GetStateDB()
+	w.SetStateDB(newCallStateDB(stateDB))
+	defer w.SetStateDB(stateDB)
+	callLog, err := w.runContract(query.Contract, query.Method, query.Parameters, query.Args, query.Caller, gasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return callLog, nil
+}
+
+// Query_GetEvents 按合约和主题分页查询合约产生的事件
+func (w *Wasm) Query_GetEvents(query *types2.QueryEvents) (types.Message, error) {
+	if query == nil || !validateName(query.Contract) {
+		return nil, types.ErrInvalidParam
+	}
+	count := query.Count
+	if count <= 0 || count > eventPageSize {
+		count = eventPageSize
+	}
+	prefix := calcEventPrefix(query.Contract)
+	if query.Topic != "" {
+		prefix = calcTopicEventPrefix(query.Contract, query.Topic)
+	}
+	var key []byte
+	if query.PrimaryKey != "" {
+		key = append(append([]byte{}, prefix...), []byte(query.PrimaryKey)...)
+	}
+	direction := dbm.ListDESC
+	if query.Direction != 0 {
+		direction = dbm.ListASC
+	}
+	values, err := w.GetLocalDB().List(prefix, key, count, direction|dbm.ListWithKey)
+	if err != nil && err != types.ErrNotFound {
+		return nil, err
+	}
+	reply := &types2.EventLogs{}
+	for _, value := range values {
+		var kv types.KeyValue
+		if err := types.Decode(value, &kv); err != nil {
+			return nil, err
+		}
+		var event types2.EventLog
+		if err := types.Decode(kv.Value, &event); err != nil {
+			return nil, err
+		}
+		reply.Events = append(reply.Events, &event)
+		reply.PrimaryKey = string(kv.Key[len(prefix):])
+	}
+	if len(reply.Events) < int(count) {
+		reply.PrimaryKey = ""
+	}
+	return reply, nil
+}
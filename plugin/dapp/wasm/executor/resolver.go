@@ -4,14 +4,54 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/33cn/chain33/types"
+	"github.com/33cn/plugin/plugin/crypto/bls"
+	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
 	"github.com/perlin-network/life/exec"
 )
 
 // Resolver defines imports for WebAssembly modules ran in Life.
 type Resolver struct{}
 
+// ForkWasmHostFunc 之后新增的回调函数
+var forkHostFuncs = map[string]bool{
+	"getParamCount":      true,
+	"getParamType":       true,
+	"getParamSize":       true,
+	"getParam":           true,
+	"getParamInt":        true,
+	"setReturn":          true,
+	"callContract":       true,
+	"getCallReturnSize":  true,
+	"getCallReturn":      true,
+	"emitEvent":          true,
+	"keccak256":          true,
+	"ripemd160":          true,
+	"verifySecp256k1":    true,
+	"verifyEd25519":      true,
+	"verifySM2":          true,
+	"verifyBLSAggregate": true,
+}
+
 // ResolveFunc defines a set of import functions that may be called within a WebAssembly module.
 func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
+	// 分叉之前和旧版本节点一样，调用新增的回调函数会执行失败
+	if module == "env" && forkHostFuncs[field] && !wasmCB.hostFuncEnabled() {
+		log.Error("ResolveFunc", "field not enabled", field)
+		return nil
+	}
+	f := r.resolveFunc(module, field)
+	if f == nil {
+		return nil
+	}
+	// 调用回调函数之前按计费表消耗 gas
+	return func(vm *exec.VirtualMachine) int64 {
+		vm.AddAndCheckGas(wasmCB.gasTable.HostFunctionGas(field))
+		return f(vm)
+	}
+}
+
+func (r *Resolver) resolveFunc(module, field string) exec.FunctionImport {
 	switch module {
 	case "env":
 		switch field {
@@ -22,6 +62,7 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 				key := vm.Memory[keyPtr : keyPtr+keyLen]
 				valuePtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
 				valueLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+				vm.AddAndCheckGas(wasmCB.gasTable.StateByte * uint64(keyLen+valueLen))
 				value := make([]byte, valueLen)
 				copy(value, vm.Memory[valuePtr:valuePtr+valueLen])
 				setStateDB(key, value)
@@ -64,6 +105,7 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 				key := vm.Memory[keyPtr : keyPtr+keyLen]
 				valuePtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
 				valueLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+				vm.AddAndCheckGas(wasmCB.gasTable.LocalByte * uint64(keyLen+valueLen))
 				value := make([]byte, valueLen)
 				copy(value, vm.Memory[valuePtr:valuePtr+valueLen])
 				setLocalDB(key, value)
@@ -261,6 +303,98 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 		case "getRandom":
 			return func(vm *exec.VirtualMachine) int64 { return getRandom() }
 
+		case "getParamCount":
+			return func(vm *exec.VirtualMachine) int64 { return int64(getParamCount()) }
+
+		case "getParamType":
+			return func(vm *exec.VirtualMachine) int64 {
+				index := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				return int64(getParamType(index))
+			}
+
+		case "getParamSize":
+			return func(vm *exec.VirtualMachine) int64 {
+				index := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				return int64(getParamSize(index))
+			}
+
+		case "getParam":
+			return func(vm *exec.VirtualMachine) int64 {
+				index := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				valuePtr := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				valueLen := int(uint32(vm.GetCurrentFrame().Locals[2]))
+				value, err := getParam(index)
+				if err != nil || valueLen != len(value) {
+					return 0
+				}
+				copy(vm.Memory[valuePtr:valuePtr+valueLen], value)
+				return int64(valueLen)
+			}
+
+		case "getParamInt":
+			return func(vm *exec.VirtualMachine) int64 {
+				index := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				value, _ := getParamInt(index)
+				return value
+			}
+
+		case "setReturn":
+			return func(vm *exec.VirtualMachine) int64 {
+				dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				data := make([]byte, dataLen)
+				copy(data, vm.Memory[dataPtr:dataPtr+dataLen])
+				if err := setReturn(data); err != nil {
+					return -1
+				}
+				return 0
+			}
+
+		case "callContract":
+			return func(vm *exec.VirtualMachine) int64 {
+				namePtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				nameLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				name := string(vm.Memory[namePtr : namePtr+nameLen])
+				methodPtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
+				methodLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+				method := string(vm.Memory[methodPtr : methodPtr+methodLen])
+				dataPtr := int(uint32(vm.GetCurrentFrame().Locals[4]))
+				dataLen := int(uint32(vm.GetCurrentFrame().Locals[5]))
+				data := make([]byte, dataLen)
+				copy(data, vm.Memory[dataPtr:dataPtr+dataLen])
+				return callContract(vm, name, method, data)
+			}
+
+		case "getCallReturnSize":
+			return func(vm *exec.VirtualMachine) int64 { return int64(getCallReturnSize()) }
+
+		case "getCallReturn":
+			return func(vm *exec.VirtualMachine) int64 {
+				valuePtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				valueLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				value := getCallReturn()
+				if valueLen != len(value) {
+					return 0
+				}
+				copy(vm.Memory[valuePtr:valuePtr+valueLen], value)
+				return int64(valueLen)
+			}
+
+		case "emitEvent":
+			return func(vm *exec.VirtualMachine) int64 {
+				topicPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				topicLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				topic := string(vm.Memory[topicPtr : topicPtr+topicLen])
+				dataPtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
+				dataLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+				data := make([]byte, dataLen)
+				copy(data, vm.Memory[dataPtr:dataPtr+dataLen])
+				if err := emitEvent(topic, data); err != nil {
+					return -1
+				}
+				return 0
+			}
+
 		case "printlog":
 			return func(vm *exec.VirtualMachine) int64 {
 				logPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
@@ -288,6 +422,57 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 				return 0
 			}
 
+		case "keccak256":
+			return func(vm *exec.VirtualMachine) int64 {
+				dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				vm.AddAndCheckGas(hashGas(types2.GasKeccak256, types2.GasKeccak256Word, dataLen))
+				data := vm.Memory[dataPtr : dataPtr+dataLen]
+				sumPtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
+				sumLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+				copy(vm.Memory[sumPtr:sumPtr+sumLen], keccak256(data))
+				return 0
+			}
+
+		case "ripemd160":
+			return func(vm *exec.VirtualMachine) int64 {
+				dataPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				dataLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				vm.AddAndCheckGas(hashGas(types2.GasRipemd160, types2.GasRipemd160Word, dataLen))
+				data := vm.Memory[dataPtr : dataPtr+dataLen]
+				sumPtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
+				sumLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+				copy(vm.Memory[sumPtr:sumPtr+sumLen], ripemd160Sum(data))
+				return 0
+			}
+
+		case "verifySecp256k1":
+			return resolveVerify(types.SECP256K1, types2.GasVerifySecp256k1)
+
+		case "verifyEd25519":
+			return resolveVerify(types.ED25519, types2.GasVerifyEd25519)
+
+		case "verifySM2":
+			return resolveVerify(types.SM2, types2.GasVerifySM2)
+
+		case "verifyBLSAggregate":
+			return func(vm *exec.VirtualMachine) int64 {
+				msgPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+				msgLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+				msg := vm.Memory[msgPtr : msgPtr+msgLen]
+				pubsPtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
+				pubsLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+				pubs := vm.Memory[pubsPtr : pubsPtr+pubsLen]
+				sigPtr := int(uint32(vm.GetCurrentFrame().Locals[4]))
+				sigLen := int(uint32(vm.GetCurrentFrame().Locals[5]))
+				sig := vm.Memory[sigPtr : sigPtr+sigLen]
+				vm.AddAndCheckGas(types2.GasVerifyBLS + types2.GasVerifyBLSPubKey*uint64(pubsLen/bls.BLSPublicKeyLength))
+				if verifyBLSAggregate(msg, pubs, sig) {
+					return 1
+				}
+				return 0
+			}
+
 		default:
 			log.Error("ResolveFunc", "unknown field", field)
 		}
@@ -298,6 +483,31 @@ func (r *Resolver) ResolveFunc(module, field string) exec.FunctionImport {
 	return nil
 }
 
+// 验证签名的回调函数，签名正确时返回1，否则返回0
+func resolveVerify(signType int, gas uint64) exec.FunctionImport {
+	return func(vm *exec.VirtualMachine) int64 {
+		vm.AddAndCheckGas(gas)
+		msgPtr := int(uint32(vm.GetCurrentFrame().Locals[0]))
+		msgLen := int(uint32(vm.GetCurrentFrame().Locals[1]))
+		msg := vm.Memory[msgPtr : msgPtr+msgLen]
+		pubPtr := int(uint32(vm.GetCurrentFrame().Locals[2]))
+		pubLen := int(uint32(vm.GetCurrentFrame().Locals[3]))
+		pub := vm.Memory[pubPtr : pubPtr+pubLen]
+		sigPtr := int(uint32(vm.GetCurrentFrame().Locals[4]))
+		sigLen := int(uint32(vm.GetCurrentFrame().Locals[5]))
+		sig := vm.Memory[sigPtr : sigPtr+sigLen]
+		if verifySignature(signType, msg, pub, sig) {
+			return 1
+		}
+		return 0
+	}
+}
+
+// 哈希函数的 gas 消耗，数据按每32字节计算
+func hashGas(base, word uint64, size int) uint64 {
+	return base + word*uint64((size+31)/32)
+}
+
 // ResolveGlobal defines a set of global variables for use within a WebAssembly module.
 func (r *Resolver) ResolveGlobal(module, field string) int64 {
 	fmt.Printf("Resolve global: %s %s\n", module, field)
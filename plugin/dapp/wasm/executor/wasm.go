@@ -1,6 +1,8 @@
 package executor
 
 import (
+	"encoding/json"
+
 	"github.com/33cn/chain33/common/log/log15"
 	"github.com/33cn/chain33/system/dapp"
 	drivers "github.com/33cn/chain33/system/dapp"
@@ -12,10 +14,36 @@ import (
 var driverName = types2.WasmX
 var log = log15.New("module", "execs."+types2.WasmX)
 
+type subConfig struct {
+	// 只能在测试链上配置，ForkWasmGasTable 之前使用的计费表，没有配置的项使用最新的计费表中的值
+	GasTable json.RawMessage `json:"gasTable"`
+	// 只读调用合约的 gas 上限
+	QueryGasLimit int64 `json:"queryGasLimit"`
+}
+
+var subcfg subConfig
+
+// 测试链配置的 ForkWasmGasTable 之前使用的计费表
+var gasTableOverride *types2.GasTable
+
 func Init(name string, cfg *types.Chain33Config, sub []byte) {
 	if name != driverName {
 		panic("system dapp can not be rename")
 	}
+	subcfg = subConfig{
+		QueryGasLimit: types2.DefaultQueryGasLimit,
+	}
+	if sub != nil {
+		types.MustDecode(sub, &subcfg)
+	}
+	gasTableOverride = nil
+	if len(subcfg.GasTable) > 0 {
+		if !cfg.IsTestNet() {
+			panic("wasm gasTable can only be configured on test chains")
+		}
+		gasTableOverride = types2.LatestGasTable().Clone()
+		types.MustDecode(subcfg.GasTable, gasTableOverride)
+	}
 
 	drivers.Register(cfg, name, newWasm, cfg.GetDappFork(name, "Enable"))
 	initExecType()
@@ -37,6 +65,13 @@ type Wasm struct {
 	customLogs   []string
 	execAddr     string
 	contractName string
+	params       []*types2.WasmParam
+	returnData   []byte
+	events       []*types2.EventLog
+	caller       string           // 合约调用合约时为调用合约的地址
+	callStack    []string         // 调用栈中的合约
+	callReturn   []byte           // 最近一次调用其它合约的返回数据
+	gasTable     *types2.GasTable // 缓存的虚拟机使用的计费表
 	VMCache      map[string]*exec.VirtualMachine
 }
 
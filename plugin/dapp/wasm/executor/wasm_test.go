@@ -17,7 +17,9 @@ import (
 	"github.com/33cn/chain33/system/dapp"
 	"github.com/33cn/chain33/types"
 	"github.com/33cn/chain33/util"
+	"github.com/33cn/plugin/plugin/crypto/bls"
 	types2 "github.com/33cn/plugin/plugin/dapp/wasm/types"
+	"github.com/perlin-network/life/compiler"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
@@ -39,6 +41,8 @@ var (
 
 func init() {
 	cfg = types.NewChain33Config(strings.Replace(types.GetDefaultCfgstring(), "Title=\"local\"", "Title=\"chain33\"", 1))
+	cfg.SetDappFork(types2.WasmX, types2.ForkWasmUpgrade, 0)
+	cfg.SetDappFork(types2.WasmX, types2.ForkWasmHostFunc, 0)
 	Init(types2.WasmX, cfg, nil)
 }
 
@@ -93,6 +97,71 @@ func TestWasm_Exec(t *testing.T) {
 
 	testCreate(t, acc, kvdb)
 	testCall(t, acc, kvdb)
+	testUpdate(t, acc, kvdb)
+}
+
+func TestWasm_UpgradeFork(t *testing.T) {
+	cfg.SetDappFork(types2.WasmX, types2.ForkWasmUpgrade, 10)
+	defer cfg.SetDappFork(types2.WasmX, types2.ForkWasmUpgrade, 0)
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	acc := initAccount(ldb)
+
+	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
+	require.Nil(t, err, "read wasm file error")
+	wasm := newWasm()
+	wasm.SetCoinsAccount(acc)
+	wasm.SetStateDB(kvdb)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	wasm.SetAPI(&api)
+	exec := func(action *types2.WasmAction) (*types.Receipt, error) {
+		tx, err := types.FormatTx(cfg, types2.WasmX, &types.Transaction{Payload: types.Encode(action)})
+		require.Nil(t, err, "format tx error")
+		require.Nil(t, signTx(tx, PrivKeys[0]))
+		return wasm.Exec(tx, 0)
+	}
+
+	//fork 之前创建合约不记录元数据和版本
+	receipt, err := exec(&types2.WasmAction{
+		Ty:    types2.WasmActionCreate,
+		Value: &types2.WasmAction_Create{Create: &types2.WasmCreate{Name: "dice", Code: code}},
+	})
+	require.Nil(t, err)
+	require.Equal(t, 1, len(receipt.KV))
+	require.Equal(t, contractKey("dice"), receipt.KV[0].Key)
+
+	_, err = exec(&types2.WasmAction{
+		Ty:    types2.WasmActionUpdate,
+		Value: &types2.WasmAction_Update{Update: &types2.WasmUpdate{Name: "dice", Code: code}},
+	})
+	require.Equal(t, types.ErrActionNotSupport, err)
+	_, err = exec(&types2.WasmAction{
+		Ty:    types2.WasmActionRenounce,
+		Value: &types2.WasmAction_Renounce{Renounce: &types2.WasmRenounce{Name: "dice"}},
+	})
+	require.Equal(t, types.ErrActionNotSupport, err)
+}
+
+func TestWasm_HostFuncFork(t *testing.T) {
+	cfg.SetDappFork(types2.WasmX, types2.ForkWasmHostFunc, 10)
+	defer cfg.SetDappFork(types2.WasmX, types2.ForkWasmHostFunc, 0)
+	wasmCB = newWasm().(*Wasm)
+	defer func() { wasmCB = nil }()
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	wasmCB.SetAPI(&api)
+	wasmCB.gasTable = types2.LegacyGasTable
+
+	r := new(Resolver)
+	require.NotNil(t, r.ResolveFunc("env", "getStateDB"))
+	for field := range forkHostFuncs {
+		require.Nil(t, r.ResolveFunc("env", field), field)
+	}
+	wasmCB.SetEnv(10, 0, 0)
+	for field := range forkHostFuncs {
+		require.NotNil(t, r.ResolveFunc("env", field), field)
+	}
 }
 
 func TestWasm_Callback(t *testing.T) {
@@ -248,6 +317,37 @@ func TestWasm_Callback(t *testing.T) {
 	require.Equal(t, int64(7e8), execAccountTransfer.Current.Balance)
 	require.Equal(t, int64(0), execAccountTransfer.Current.Frozen)
 
+	//test call parameters
+	wasmCB.params = []*types2.WasmParam{
+		{Value: &types2.WasmParam_Str{Str: "dice"}},
+		{Value: &types2.WasmParam_Address{Address: Addrs[0]}},
+		{Value: &types2.WasmParam_Int{Int: 1e8}},
+		{Value: &types2.WasmParam_Bool{Bool: true}},
+	}
+	require.Equal(t, 4, getParamCount())
+	require.Equal(t, types2.WasmParamString, getParamType(0))
+	require.Equal(t, types2.WasmParamBool, getParamType(3))
+	require.Equal(t, -1, getParamType(4))
+	require.Equal(t, len(Addrs[0]), getParamSize(1))
+	param, err := getParam(1)
+	require.Nil(t, err)
+	require.Equal(t, Addrs[0], string(param))
+	_, err = getParam(2)
+	require.Equal(t, types2.ErrInvalidParam, err)
+	n, err := getParamInt(2)
+	require.Nil(t, err)
+	require.Equal(t, int64(1e8), n)
+	n, _ = getParamInt(3)
+	require.Equal(t, int64(1), n)
+	require.True(t, validateParams(wasmCB.params))
+	require.False(t, validateParams([]*types2.WasmParam{{Value: &types2.WasmParam_Address{Address: "dice"}}}))
+	require.False(t, validateParams([]*types2.WasmParam{{}}))
+
+	//test return data
+	require.Nil(t, setReturn([]byte("ret")))
+	require.Equal(t, []byte("ret"), wasmCB.returnData)
+	require.Equal(t, types2.ErrReturnOversize, setReturn(make([]byte, types2.MaxReturnSize+1)))
+
 	//test random
 	gclient, err := grpcclient.NewMainChainClient(cfg, "")
 	require.Nil(t, err)
@@ -263,6 +363,229 @@ func TestWasm_Callback(t *testing.T) {
 	t.Log(random)
 }
 
+func TestWasm_CallContract(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	acc := initAccount(ldb)
+	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
+	require.Nil(t, err, "read wasm file error")
+	require.Nil(t, kvdb.Set(contractKey("dice"), code))
+	require.Nil(t, kvdb.Set(contractKey("dicetwo"), code))
+
+	w := newWasm().(*Wasm)
+	w.SetCoinsAccount(acc)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	w.SetAPI(&api)
+	w.SetStateDB(kvdb)
+	w.tx = &types.Transaction{Execer: []byte(types2.WasmX), Fee: 1e8}
+	w.execAddr = wasmAddr
+	w.contractName = "dice"
+	w.callStack = []string{"dice"}
+	w.stateKVC = dapp.NewKVCreator(kvdb, calcStatePrefix("dice"), nil)
+	vm, err := w.loadVirtualMachine("dice", 1e8)
+	require.Nil(t, err)
+	wasmCB = w
+	defer func() {
+		wasmCB = nil
+	}()
+
+	// dicetwo 的游戏由 dice 合约创建，gamestatus 结构体共104字节
+	status := make([]byte, 104)
+	copy(status, address.ExecAddress(w.userExecName("dice", false)))
+	status[96] = 1
+	require.Nil(t, kvdb.Set(append(calcStatePrefix("dicetwo"), []byte("dice_status")...), status))
+
+	//被调用合约执行成功
+	require.Equal(t, int64(0), callContract(vm, "dicetwo", "draw", nil))
+	require.True(t, vm.Gas > 0)
+	require.Equal(t, 1, len(w.receiptLogs))
+	require.Equal(t, int32(types2.TyLogWasmCall), w.receiptLogs[0].Ty)
+	require.Equal(t, "dice", w.contractName)
+	require.Equal(t, []string{"dice"}, w.callStack)
+
+	//被调用合约执行失败，产生的数据被丢弃
+	require.True(t, failed(callContract(vm, "dicetwo", "stopgame", nil)))
+	require.Equal(t, 1, len(w.receiptLogs))
+	require.Equal(t, 0, len(w.customLogs))
+
+	//不能重入调用栈中的合约，不能调用有参数的方法和不存在的合约
+	require.Equal(t, int64(-1), callContract(vm, "dice", "draw", nil))
+	require.Equal(t, int64(-1), callContract(vm, "dicetwo", "play", nil))
+	require.Equal(t, int64(-1), callContract(vm, "dicethree", "draw", nil))
+	w.callStack = make([]string, types2.MaxCallDepth)
+	require.Equal(t, int64(-1), callContract(vm, "dicetwo", "draw", nil))
+
+	//被调用合约执行成功后才把数据写入上一层数据库
+	stateDB := newCallStateDB(kvdb)
+	require.Nil(t, stateDB.Set([]byte("mavl-wasm-test"), []byte("test")))
+	_, err = kvdb.Get([]byte("mavl-wasm-test"))
+	require.Equal(t, types.ErrNotFound, err)
+	value, err := stateDB.Get([]byte("mavl-wasm-test"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("test"), value)
+	require.Nil(t, stateDB.flush())
+	value, err = kvdb.Get([]byte("mavl-wasm-test"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("test"), value)
+}
+
+func TestWasm_Event(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	w := newWasm().(*Wasm)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	w.SetAPI(&api)
+	w.SetLocalDB(kvdb)
+	w.contractName = "dice"
+	wasmCB = w
+	defer func() {
+		wasmCB = nil
+	}()
+
+	require.Nil(t, emitEvent("play", []byte("1")))
+	require.Nil(t, emitEvent("draw", []byte("2")))
+	require.Nil(t, emitEvent("play", []byte("3")))
+	require.Equal(t, types2.ErrTopicOversize, emitEvent(strings.Repeat("a", types2.MaxTopicSize+1), nil))
+	var logs []*types.ReceiptLog
+	for _, event := range w.events {
+		logs = append(logs, &types.ReceiptLog{Ty: types2.TyLogWasmEvent, Log: types.Encode(event)})
+	}
+	tx := &types.Transaction{Execer: []byte(types2.WasmX)}
+	set, err := w.ExecLocal_Call(&types2.WasmCall{Contract: "dice"}, tx, &types.ReceiptData{Ty: types.ExecOk, Logs: logs}, 0)
+	require.Nil(t, err)
+	for _, kv := range set.KV {
+		require.Nil(t, kvdb.Set(kv.Key, kv.Value))
+	}
+
+	msg, err := w.Query_GetEvents(&types2.QueryEvents{Contract: "dice"})
+	require.Nil(t, err)
+	events := msg.(*types2.EventLogs).Events
+	require.Equal(t, 3, len(events))
+	require.Equal(t, []byte("3"), events[0].Data)
+	require.Equal(t, hex.EncodeToString(tx.Hash()), events[0].TxHash)
+	msg, err = w.Query_GetEvents(&types2.QueryEvents{Contract: "dice", Topic: "play", Count: 1, Direction: 1})
+	require.Nil(t, err)
+	reply := msg.(*types2.EventLogs)
+	require.Equal(t, 1, len(reply.Events))
+	require.Equal(t, []byte("1"), reply.Events[0].Data)
+	msg, err = w.Query_GetEvents(&types2.QueryEvents{Contract: "dice", Topic: "play", Count: 1, Direction: 1, PrimaryKey: reply.PrimaryKey})
+	require.Nil(t, err)
+	require.Equal(t, []byte("3"), msg.(*types2.EventLogs).Events[0].Data)
+}
+
+func TestWasm_Crypto(t *testing.T) {
+	require.Equal(t, "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470", hex.EncodeToString(keccak256(nil)))
+	require.Equal(t, "9c1185a5c5e9fc54612808977ee8f548b2258d31", hex.EncodeToString(ripemd160Sum(nil)))
+	require.Equal(t, uint64(types2.GasKeccak256+2*types2.GasKeccak256Word), hashGas(types2.GasKeccak256, types2.GasKeccak256Word, 33))
+
+	msg := []byte("wasm")
+	for _, signType := range []int{types.SECP256K1, types.ED25519, types.SM2} {
+		c, err := crypto.New(types.GetSignName("", signType))
+		require.Nil(t, err)
+		priv, err := c.GenKey()
+		require.Nil(t, err)
+		pub, sig := priv.PubKey().Bytes(), priv.Sign(msg).Bytes()
+		require.True(t, verifySignature(signType, msg, pub, sig))
+		require.False(t, verifySignature(signType, []byte("wasm2"), pub, sig))
+		require.False(t, verifySignature(signType, msg, pub[1:], sig))
+	}
+
+	c, err := crypto.New(bls.Name)
+	require.Nil(t, err)
+	aggr, err := crypto.ToAggregate(c)
+	require.Nil(t, err)
+	var pubs []byte
+	var sigs []crypto.Signature
+	for i := 0; i < 2; i++ {
+		priv, err := c.GenKey()
+		require.Nil(t, err)
+		pub := priv.PubKey().Bytes()
+		pubs = append(pubs, pub...)
+		sigs = append(sigs, priv.Sign(append(append([]byte{}, pub...), msg...)))
+	}
+	sig, err := aggr.Aggregate(sigs)
+	require.Nil(t, err)
+	require.True(t, verifyBLSAggregate(msg, pubs, sig.Bytes()))
+	require.False(t, verifyBLSAggregate(msg, pubs[:bls.BLSPublicKeyLength], sig.Bytes()))
+	require.False(t, verifyBLSAggregate(msg, pubs[1:], sig.Bytes()))
+	// 公钥不能重复
+	require.False(t, verifyBLSAggregate(msg, append(append([]byte{}, pubs...), pubs[:bls.BLSPublicKeyLength]...), sig.Bytes()))
+
+	// 直接对 msg 签名的聚合签名不能通过验证
+	sigs = sigs[:0]
+	pubs = pubs[:0]
+	for i := 0; i < 2; i++ {
+		priv, err := c.GenKey()
+		require.Nil(t, err)
+		pubs = append(pubs, priv.PubKey().Bytes()...)
+		sigs = append(sigs, priv.Sign(msg))
+	}
+	sig, err = aggr.Aggregate(sigs)
+	require.Nil(t, err)
+	require.False(t, verifyBLSAggregate(msg, pubs, sig.Bytes()))
+}
+
+func TestWasm_ReadContract(t *testing.T) {
+	dir, ldb, kvdb := util.CreateTestDB()
+	defer util.CloseTestDB(dir, ldb)
+	acc := initAccount(ldb)
+	testCreate(t, acc, kvdb)
+
+	w := newWasm().(*Wasm)
+	w.SetCoinsAccount(acc)
+	w.SetStateDB(kvdb)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	w.SetAPI(&api)
+	wasmCB = w
+	require.Nil(t, transferToExec(Addrs[0], wasmAddr, 1e9))
+	wasmCB = nil
+
+	query := &types2.QueryReadContract{Contract: "dice", Method: "startgame", Parameters: []int64{1e9}, Caller: Addrs[0]}
+	msg, err := w.Query_ReadContract(query)
+	require.Nil(t, err)
+	callLog := msg.(*types2.CallContractLog)
+	require.Equal(t, int32(0), callLog.Result)
+	require.True(t, callLog.GasUsed > 0)
+	//执行产生的数据不会保存
+	_, err = kvdb.Get(append(calcStatePrefix("dice"), []byte("dice_status")...))
+	require.Equal(t, types.ErrNotFound, err)
+
+	//超过 gas 上限时执行失败
+	query.GasLimit = callLog.GasUsed - 1
+	_, err = w.Query_ReadContract(query)
+	require.NotNil(t, err)
+	query.Parameters = nil
+	_, err = w.Query_ReadContract(query)
+	require.Equal(t, types2.ErrInvalidParam, err)
+
+	//计费表按分叉高度固定，测试链可以配置分叉之前使用的计费表
+	forkHeight := cfg.GetDappFork(types2.WasmX, types2.ForkWasmGasTable)
+	require.Equal(t, types2.LegacyGasTable, types2.GetGasTable(cfg, forkHeight-1))
+	require.Equal(t, types2.LatestGasTable(), types2.GetGasTable(cfg, forkHeight))
+	require.Equal(t, types2.LegacyGasTable, w.loadGasTable())
+	defer func() { gasTableOverride = nil }()
+	gasTableOverride = types2.LatestGasTable().Clone()
+	require.Equal(t, gasTableOverride, w.loadGasTable())
+	w.SetEnv(forkHeight, 0, 0)
+	require.Equal(t, types2.LatestGasTable(), w.loadGasTable())
+
+	table := types2.LatestGasTable().Clone()
+	policy := &gasPolicy{table: table}
+	require.Equal(t, int64(1), policy.GetCost(compiler.Instr{Op: "i32.add"}))
+	require.Equal(t, int64(8), policy.GetCost(compiler.Instr{Op: "i64.div_s"}))
+	require.Equal(t, uint64(500), table.HostFunctionGas("setStateDB"))
+	require.Equal(t, uint64(50), table.HostFunctionGas("getFrom"))
+	//dice 合约初始需要 256 页内存
+	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
+	require.Nil(t, err)
+	table.MaxMemoryPages = 128
+	_, err = newVirtualMachine(code, 1e8, table)
+	require.NotNil(t, err)
+}
+
 func testCreate(t testing.TB, acc *account.DB, stateDB db.KV) {
 	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
 	require.Nil(t, err, "read wasm file error")
@@ -280,6 +603,8 @@ func testCreate(t testing.TB, acc *account.DB, stateDB db.KV) {
 	}
 	tx, err = types.FormatTx(cfg, types2.WasmX, tx)
 	require.Nil(t, err, "format tx error")
+	err = signTx(tx, PrivKeys[0])
+	require.Nil(t, err)
 
 	wasm := newWasm()
 
@@ -332,6 +657,71 @@ func testCall(t testing.TB, acc *account.DB, stateDB db.KV) {
 	require.Equal(t, int32(types2.TyLogWasmCall), receipt.Logs[0].Ty)
 }
 
+func testUpdate(t *testing.T, acc *account.DB, stateDB db.KV) {
+	code, err := ioutil.ReadFile("../contracts/dice/dice.wasm")
+	require.Nil(t, err, "read wasm file error")
+	wasm := newWasm()
+	wasm.SetCoinsAccount(acc)
+	wasm.SetStateDB(stateDB)
+	api := mocks.QueueProtocolAPI{}
+	api.On("GetConfig").Return(cfg)
+	wasm.SetAPI(&api)
+	exec := func(action *types2.WasmAction, privKey string) (*types.Receipt, error) {
+		tx, err := types.FormatTx(cfg, types2.WasmX, &types.Transaction{Payload: types.Encode(action)})
+		require.Nil(t, err, "format tx error")
+		require.Nil(t, signTx(tx, privKey))
+		return wasm.Exec(tx, 0)
+	}
+	update := &types2.WasmAction{
+		Ty:    types2.WasmActionUpdate,
+		Value: &types2.WasmAction_Update{Update: &types2.WasmUpdate{Name: "dice", Code: code}},
+	}
+
+	//only admin can update
+	_, err = exec(update, PrivKeys[1])
+	require.Equal(t, types2.ErrPermissionDenied, err)
+	receipt, err := exec(update, PrivKeys[0])
+	require.Nil(t, err)
+	require.Equal(t, int32(types.ExecOk), receipt.Ty)
+	require.Equal(t, int32(types2.TyLogWasmUpdate), receipt.Logs[0].Ty)
+	//dice has no migrate method
+	update.GetUpdate().Migrate = true
+	_, err = exec(update, PrivKeys[0])
+	require.Equal(t, types2.ErrInvalidMethod, err)
+	update.GetUpdate().Migrate = false
+
+	w := wasm.(*Wasm)
+	msg, err := w.Query_GetContractMeta(&types2.QueryCheckContract{Name: "dice"})
+	require.Nil(t, err)
+	meta := msg.(*types2.WasmContractMeta)
+	require.Equal(t, Addrs[0], meta.Creator)
+	require.Equal(t, Addrs[0], meta.Admin)
+	require.Equal(t, int32(2), meta.Version)
+	msg, err = w.Query_GetContractVersions(&types2.QueryCheckContract{Name: "dice"})
+	require.Nil(t, err)
+	versions := msg.(*types2.WasmContractVersions).Versions
+	require.Equal(t, 2, len(versions))
+	require.Equal(t, hex.EncodeToString(common.Sha256(code)), versions[1].CodeHash)
+
+	//transfer admin
+	_, err = exec(&types2.WasmAction{
+		Ty:    types2.WasmActionTransferAdmin,
+		Value: &types2.WasmAction_TransferAdmin{TransferAdmin: &types2.WasmTransferAdmin{Name: "dice", Admin: Addrs[1]}},
+	}, PrivKeys[0])
+	require.Nil(t, err)
+	_, err = exec(update, PrivKeys[0])
+	require.Equal(t, types2.ErrPermissionDenied, err)
+
+	//renounce
+	_, err = exec(&types2.WasmAction{
+		Ty:    types2.WasmActionRenounce,
+		Value: &types2.WasmAction_Renounce{Renounce: &types2.WasmRenounce{Name: "dice"}},
+	}, PrivKeys[1])
+	require.Nil(t, err)
+	_, err = exec(update, PrivKeys[1])
+	require.Equal(t, types2.ErrNotUpgradeable, err)
+}
+
 func initAccount(db db.KV) *account.DB {
 	wasmAddr = address.ExecAddress(cfg.ExecName(types2.WasmX))
 	acc, err := account.NewAccountDB(cfg, "coins", "bty", db)
@@ -10,4 +10,9 @@ var (
 	ErrInvalidContractName = errors.New("invalid contract name")
 	ErrInvalidParam        = errors.New("invalid parameters")
 	ErrUnknown             = errors.New("unknown error")
+	ErrReturnOversize      = errors.New("return data oversize")
+	ErrContractNotExist    = errors.New("contract not exist")
+	ErrNotUpgradeable      = errors.New("contract is not upgradeable")
+	ErrPermissionDenied    = errors.New("permission denied")
+	ErrTopicOversize       = errors.New("event topic oversize")
 )
@@ -0,0 +1,123 @@
+package types
+
+import "github.com/33cn/chain33/types"
+
+// GasTable 合约执行的 gas 计费表，不同的分叉高度使用不同的计费表
+type GasTable struct {
+	// 每条指令默认的 gas
+	Instruction int64 `json:"instruction"`
+	// 按指令名称设置的 gas，如 i32.div_s, call, memory.grow
+	Instructions map[string]int64 `json:"instructions"`
+	// 每次调用回调函数默认的 gas
+	HostFunction uint64 `json:"hostFunction"`
+	// 按回调函数名称设置的 gas
+	HostFunctions map[string]uint64 `json:"hostFunctions"`
+	// 写入状态数据库每字节的 gas，按 key 和 value 的长度计算
+	StateByte uint64 `json:"stateByte"`
+	// 写入本地数据库每字节的 gas
+	LocalByte uint64 `json:"localByte"`
+	// 虚拟机最多可以使用的内存页数，每页 64KB，0 表示不限制
+	MaxMemoryPages int `json:"maxMemoryPages"`
+}
+
+// InstructionGas 获取指令的 gas
+func (t *GasTable) InstructionGas(op string) int64 {
+	if gas, ok := t.Instructions[op]; ok {
+		return gas
+	}
+	return t.Instruction
+}
+
+// HostFunctionGas 获取调用回调函数的 gas
+func (t *GasTable) HostFunctionGas(name string) uint64 {
+	if gas, ok := t.HostFunctions[name]; ok {
+		return gas
+	}
+	return t.HostFunction
+}
+
+// Clone 复制计费表
+func (t *GasTable) Clone() *GasTable {
+	c := *t
+	c.Instructions = make(map[string]int64, len(t.Instructions))
+	for k, v := range t.Instructions {
+		c.Instructions[k] = v
+	}
+	c.HostFunctions = make(map[string]uint64, len(t.HostFunctions))
+	for k, v := range t.HostFunctions {
+		c.HostFunctions[k] = v
+	}
+	return &c
+}
+
+// LegacyGasTable ForkWasmGasTable 之前每条指令消耗 1 gas，回调函数和内存不计费
+var LegacyGasTable = &GasTable{Instruction: 1}
+
+// 按分叉排列的计费表，计费表是共识的一部分，修改时需要增加新的分叉和计费表，不能修改已有的计费表
+var gasTables = []struct {
+	fork  string
+	table *GasTable
+}{
+	{ForkWasmGasTable, gasTableV1()},
+}
+
+// GetGasTable 获取指定高度使用的计费表
+func GetGasTable(cfg *types.Chain33Config, height int64) *GasTable {
+	for i := len(gasTables) - 1; i >= 0; i-- {
+		if cfg.IsDappFork(height, WasmX, gasTables[i].fork) {
+			return gasTables[i].table
+		}
+	}
+	return LegacyGasTable
+}
+
+// LatestGasTable 最新的计费表
+func LatestGasTable() *GasTable {
+	return gasTables[len(gasTables)-1].table
+}
+
+// ForkWasmGasTable 的计费表
+func gasTableV1() *GasTable {
+	return &GasTable{
+		Instruction: 1,
+		Instructions: map[string]int64{
+			"i32.mul":       3,
+			"i64.mul":       3,
+			"i32.div_s":     8,
+			"i32.div_u":     8,
+			"i32.rem_s":     8,
+			"i32.rem_u":     8,
+			"i64.div_s":     8,
+			"i64.div_u":     8,
+			"i64.rem_s":     8,
+			"i64.rem_u":     8,
+			"call":          10,
+			"call_indirect": 15,
+			"memory.grow":   1000,
+		},
+		HostFunction: 50,
+		HostFunctions: map[string]uint64{
+			"setStateDB":         500,
+			"getStateDB":         200,
+			"getStateDBSize":     200,
+			"setLocalDB":         200,
+			"getLocalDB":         100,
+			"getLocalDBSize":     100,
+			"getBalance":         400,
+			"getFrozen":          400,
+			"transfer":           1000,
+			"transferToExec":     1000,
+			"transferWithdraw":   1000,
+			"execFrozen":         1000,
+			"execActive":         1000,
+			"execTransfer":       1000,
+			"execTransferFrozen": 1000,
+			"getRandom":          500,
+			"callContract":       700,
+			"emitEvent":          300,
+		},
+		StateByte:      10,
+		LocalByte:      2,
+		MaxMemoryPages: 512,
+	}
+}
@@ -14,12 +14,54 @@ const (
 	NameRegExp = "^[a-z0-9]+$"
 	//TODO: max size to define
 	MaxCodeSize = 1 << 20
+	// 合约通过setReturn设置的返回数据的最大长度
+	MaxReturnSize = 1 << 12
+	// 合约调用合约的最大深度
+	MaxCallDepth = 8
+	// 事件主题的最大长度
+	MaxTopicSize = 64
+	// 更新合约代码时调用的迁移方法
+	MigrateMethod = "migrate"
+	// ForkWasmUpgrade 支持合约升级
+	ForkWasmUpgrade = "ForkWasmUpgrade"
+	// ForkWasmGasTable 按计费表计算指令和回调函数的 gas，并限制虚拟机的内存
+	ForkWasmGasTable = "ForkWasmGasTable"
+	// ForkWasmHostFunc 合约可以导入新增的回调函数：带类型的参数和返回数据、合约调用合约、事件以及哈希和签名验证
+	ForkWasmHostFunc = "ForkWasmHostFunc"
+	// 只读调用合约默认的 gas 上限
+	DefaultQueryGasLimit = 1e8
+)
+
+// 密码学回调函数的 gas 消耗，哈希函数另外按数据的长度每32字节计算
+const (
+	GasKeccak256       = 30
+	GasKeccak256Word   = 6
+	GasRipemd160       = 600
+	GasRipemd160Word   = 120
+	GasVerifySecp256k1 = 3000
+	GasVerifyEd25519   = 2000
+	GasVerifySM2       = 5000
+	// BLS 聚合签名验证另外按公钥的个数计算，每个公钥需要一次配对运算
+	GasVerifyBLS       = 25000
+	GasVerifyBLSPubKey = 25000
+)
+
+// 调用参数的类型，合约通过getParamType获取
+const (
+	WasmParamBytes = iota + 1
+	WasmParamString
+	WasmParamAddress
+	WasmParamInt64
+	WasmParamBool
 )
 
 // action for executor
 const (
 	WasmActionCreate = iota + 1
 	WasmActionCall
+	WasmActionUpdate
+	WasmActionTransferAdmin
+	WasmActionRenounce
 )
 
 // log ty for executor
@@ -28,6 +70,9 @@ const (
 	TyLogWasmCall
 	TyLogCustom
 	TyLogLocalData
+	TyLogWasmUpdate
+	TyLogWasmAdmin
+	TyLogWasmEvent
 )
 
 func init() {
@@ -40,6 +85,9 @@ func init() {
 
 func InitFork(cfg *types.Chain33Config) {
 	cfg.RegisterDappFork(WasmX, "Enable", 0)
+	cfg.RegisterDappFork(WasmX, ForkWasmUpgrade, 10000000)
+	cfg.RegisterDappFork(WasmX, ForkWasmGasTable, 10000000)
+	cfg.RegisterDappFork(WasmX, ForkWasmHostFunc, 10000000)
 }
 
 func InitExecutor(cfg *types.Chain33Config) {
@@ -63,8 +111,11 @@ func (t *WasmType) GetPayload() types.Message {
 
 func (t *WasmType) GetTypeMap() map[string]int32 {
 	return map[string]int32{
-		"Create": WasmActionCreate,
-		"Call":   WasmActionCall,
+		"Create":        WasmActionCreate,
+		"Call":          WasmActionCall,
+		"Update":        WasmActionUpdate,
+		"TransferAdmin": WasmActionTransferAdmin,
+		"Renounce":      WasmActionRenounce,
 	}
 }
 
@@ -74,5 +125,8 @@ func (t *WasmType) GetLogMap() map[int64]*types.LogInfo {
 		TyLogWasmCall:   {Ty: reflect.TypeOf(CallContractLog{}), Name: "LogWasmCall"},
 		TyLogCustom:     {Ty: reflect.TypeOf(CustomLog{}), Name: "LogWasmCustom"},
 		TyLogLocalData:  {Ty: reflect.TypeOf(LocalDataLog{}), Name: "LogWasmLocalData"},
+		TyLogWasmUpdate: {Ty: reflect.TypeOf(UpdateContractLog{}), Name: "LogWasmUpdate"},
+		TyLogWasmAdmin:  {Ty: reflect.TypeOf(ContractAdminLog{}), Name: "LogWasmAdmin"},
+		TyLogWasmEvent:  {Ty: reflect.TypeOf(EventLog{}), Name: "LogWasmEvent"},
 	}
 }
@@ -25,6 +25,9 @@ type WasmAction struct {
 	// Types that are valid to be assigned to Value:
 	//	*WasmAction_Create
 	//	*WasmAction_Call
+	//	*WasmAction_Update
+	//	*WasmAction_TransferAdmin
+	//	*WasmAction_Renounce
 	Value                isWasmAction_Value `protobuf_oneof:"value"`
 	Ty                   int32              `protobuf:"varint,3,opt,name=ty,proto3" json:"ty,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -69,10 +72,28 @@ type WasmAction_Call struct {
 	Call *WasmCall `protobuf:"bytes,2,opt,name=call,proto3,oneof"`
 }
 
+type WasmAction_Update struct {
+	Update *WasmUpdate `protobuf:"bytes,4,opt,name=update,proto3,oneof"`
+}
+
+type WasmAction_TransferAdmin struct {
+	TransferAdmin *WasmTransferAdmin `protobuf:"bytes,5,opt,name=transferAdmin,proto3,oneof"`
+}
+
+type WasmAction_Renounce struct {
+	Renounce *WasmRenounce `protobuf:"bytes,6,opt,name=renounce,proto3,oneof"`
+}
+
 func (*WasmAction_Create) isWasmAction_Value() {}
 
 func (*WasmAction_Call) isWasmAction_Value() {}
 
+func (*WasmAction_Update) isWasmAction_Value() {}
+
+func (*WasmAction_TransferAdmin) isWasmAction_Value() {}
+
+func (*WasmAction_Renounce) isWasmAction_Value() {}
+
 func (m *WasmAction) GetValue() isWasmAction_Value {
 	if m != nil {
 		return m.Value
@@ -94,6 +115,27 @@ func (m *WasmAction) GetCall() *WasmCall {
 	return nil
 }
 
+func (m *WasmAction) GetUpdate() *WasmUpdate {
+	if x, ok := m.GetValue().(*WasmAction_Update); ok {
+		return x.Update
+	}
+	return nil
+}
+
+func (m *WasmAction) GetTransferAdmin() *WasmTransferAdmin {
+	if x, ok := m.GetValue().(*WasmAction_TransferAdmin); ok {
+		return x.TransferAdmin
+	}
+	return nil
+}
+
+func (m *WasmAction) GetRenounce() *WasmRenounce {
+	if x, ok := m.GetValue().(*WasmAction_Renounce); ok {
+		return x.Renounce
+	}
+	return nil
+}
+
 func (m *WasmAction) GetTy() int32 {
 	if m != nil {
 		return m.Ty
@@ -106,6 +148,9 @@ func (*WasmAction) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*WasmAction_Create)(nil),
 		(*WasmAction_Call)(nil),
+		(*WasmAction_Update)(nil),
+		(*WasmAction_TransferAdmin)(nil),
+		(*WasmAction_Renounce)(nil),
 	}
 }
 
@@ -157,12 +202,13 @@ func (m *WasmCreate) GetCode() []byte {
 }
 
 type WasmCall struct {
-	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
-	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
-	Parameters           []int64  `protobuf:"varint,3,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Contract             string       `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Method               string       `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Parameters           []int64      `protobuf:"varint,3,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`
+	Args                 []*WasmParam `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *WasmCall) Reset()         { *m = WasmCall{} }
@@ -211,6 +257,464 @@ func (m *WasmCall) GetParameters() []int64 {
 	return nil
 }
 
+func (m *WasmCall) GetArgs() []*WasmParam {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+// 带类型的调用参数，合约通过getParam系列回调函数读取
+type WasmParam struct {
+	// Types that are valid to be assigned to Value:
+	//	*WasmParam_Bytes
+	//	*WasmParam_Str
+	//	*WasmParam_Address
+	//	*WasmParam_Int
+	//	*WasmParam_Bool
+	Value                isWasmParam_Value `protobuf_oneof:"value"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *WasmParam) Reset()         { *m = WasmParam{} }
+func (m *WasmParam) String() string { return proto.CompactTextString(m) }
+func (*WasmParam) ProtoMessage()    {}
+func (*WasmParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{3}
+}
+
+func (m *WasmParam) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmParam.Unmarshal(m, b)
+}
+func (m *WasmParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmParam.Marshal(b, m, deterministic)
+}
+func (m *WasmParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmParam.Merge(m, src)
+}
+func (m *WasmParam) XXX_Size() int {
+	return xxx_messageInfo_WasmParam.Size(m)
+}
+func (m *WasmParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmParam proto.InternalMessageInfo
+
+type isWasmParam_Value interface {
+	isWasmParam_Value()
+}
+
+type WasmParam_Bytes struct {
+	Bytes []byte `protobuf:"bytes,1,opt,name=bytes,proto3,oneof"`
+}
+
+type WasmParam_Str struct {
+	Str string `protobuf:"bytes,2,opt,name=str,proto3,oneof"`
+}
+
+type WasmParam_Address struct {
+	Address string `protobuf:"bytes,3,opt,name=address,proto3,oneof"`
+}
+
+type WasmParam_Int struct {
+	Int int64 `protobuf:"varint,4,opt,name=int,proto3,oneof"`
+}
+
+type WasmParam_Bool struct {
+	Bool bool `protobuf:"varint,5,opt,name=bool,proto3,oneof"`
+}
+
+func (*WasmParam_Bytes) isWasmParam_Value() {}
+
+func (*WasmParam_Str) isWasmParam_Value() {}
+
+func (*WasmParam_Address) isWasmParam_Value() {}
+
+func (*WasmParam_Int) isWasmParam_Value() {}
+
+func (*WasmParam_Bool) isWasmParam_Value() {}
+
+func (m *WasmParam) GetValue() isWasmParam_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *WasmParam) GetBytes() []byte {
+	if x, ok := m.GetValue().(*WasmParam_Bytes); ok {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (m *WasmParam) GetStr() string {
+	if x, ok := m.GetValue().(*WasmParam_Str); ok {
+		return x.Str
+	}
+	return ""
+}
+
+func (m *WasmParam) GetAddress() string {
+	if x, ok := m.GetValue().(*WasmParam_Address); ok {
+		return x.Address
+	}
+	return ""
+}
+
+func (m *WasmParam) GetInt() int64 {
+	if x, ok := m.GetValue().(*WasmParam_Int); ok {
+		return x.Int
+	}
+	return 0
+}
+
+func (m *WasmParam) GetBool() bool {
+	if x, ok := m.GetValue().(*WasmParam_Bool); ok {
+		return x.Bool
+	}
+	return false
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WasmParam) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WasmParam_Bytes)(nil),
+		(*WasmParam_Str)(nil),
+		(*WasmParam_Address)(nil),
+		(*WasmParam_Int)(nil),
+		(*WasmParam_Bool)(nil),
+	}
+}
+
+// 更新合约代码，migrate为true时更新后调用新代码中的migrate方法
+type WasmUpdate struct {
+	Name                 string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code                 []byte       `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Migrate              bool         `protobuf:"varint,3,opt,name=migrate,proto3" json:"migrate,omitempty"`
+	Args                 []*WasmParam `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *WasmUpdate) Reset()         { *m = WasmUpdate{} }
+func (m *WasmUpdate) String() string { return proto.CompactTextString(m) }
+func (*WasmUpdate) ProtoMessage()    {}
+func (*WasmUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{4}
+}
+
+func (m *WasmUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmUpdate.Unmarshal(m, b)
+}
+func (m *WasmUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmUpdate.Marshal(b, m, deterministic)
+}
+func (m *WasmUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmUpdate.Merge(m, src)
+}
+func (m *WasmUpdate) XXX_Size() int {
+	return xxx_messageInfo_WasmUpdate.Size(m)
+}
+func (m *WasmUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmUpdate proto.InternalMessageInfo
+
+func (m *WasmUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WasmUpdate) GetCode() []byte {
+	if m != nil {
+		return m.Code
+	}
+	return nil
+}
+
+func (m *WasmUpdate) GetMigrate() bool {
+	if m != nil {
+		return m.Migrate
+	}
+	return false
+}
+
+func (m *WasmUpdate) GetArgs() []*WasmParam {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+type WasmTransferAdmin struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Admin                string   `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WasmTransferAdmin) Reset()         { *m = WasmTransferAdmin{} }
+func (m *WasmTransferAdmin) String() string { return proto.CompactTextString(m) }
+func (*WasmTransferAdmin) ProtoMessage()    {}
+func (*WasmTransferAdmin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{5}
+}
+
+func (m *WasmTransferAdmin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmTransferAdmin.Unmarshal(m, b)
+}
+func (m *WasmTransferAdmin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmTransferAdmin.Marshal(b, m, deterministic)
+}
+func (m *WasmTransferAdmin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmTransferAdmin.Merge(m, src)
+}
+func (m *WasmTransferAdmin) XXX_Size() int {
+	return xxx_messageInfo_WasmTransferAdmin.Size(m)
+}
+func (m *WasmTransferAdmin) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmTransferAdmin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmTransferAdmin proto.InternalMessageInfo
+
+func (m *WasmTransferAdmin) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WasmTransferAdmin) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+// 放弃合约的升级权限，之后合约不能再更新
+type WasmRenounce struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WasmRenounce) Reset()         { *m = WasmRenounce{} }
+func (m *WasmRenounce) String() string { return proto.CompactTextString(m) }
+func (*WasmRenounce) ProtoMessage()    {}
+func (*WasmRenounce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{6}
+}
+
+func (m *WasmRenounce) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmRenounce.Unmarshal(m, b)
+}
+func (m *WasmRenounce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmRenounce.Marshal(b, m, deterministic)
+}
+func (m *WasmRenounce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmRenounce.Merge(m, src)
+}
+func (m *WasmRenounce) XXX_Size() int {
+	return xxx_messageInfo_WasmRenounce.Size(m)
+}
+func (m *WasmRenounce) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmRenounce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmRenounce proto.InternalMessageInfo
+
+func (m *WasmRenounce) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// 合约的元数据，admin为空表示合约已不可升级
+type WasmContractMeta struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Creator              string   `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	Admin                string   `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	Version              int32    `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WasmContractMeta) Reset()         { *m = WasmContractMeta{} }
+func (m *WasmContractMeta) String() string { return proto.CompactTextString(m) }
+func (*WasmContractMeta) ProtoMessage()    {}
+func (*WasmContractMeta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{7}
+}
+
+func (m *WasmContractMeta) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmContractMeta.Unmarshal(m, b)
+}
+func (m *WasmContractMeta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmContractMeta.Marshal(b, m, deterministic)
+}
+func (m *WasmContractMeta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmContractMeta.Merge(m, src)
+}
+func (m *WasmContractMeta) XXX_Size() int {
+	return xxx_messageInfo_WasmContractMeta.Size(m)
+}
+func (m *WasmContractMeta) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmContractMeta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmContractMeta proto.InternalMessageInfo
+
+func (m *WasmContractMeta) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WasmContractMeta) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *WasmContractMeta) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *WasmContractMeta) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type WasmContractVersion struct {
+	Version              int32    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	CodeHash             string   `protobuf:"bytes,2,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	Height               int64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash               string   `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Operator             string   `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WasmContractVersion) Reset()         { *m = WasmContractVersion{} }
+func (m *WasmContractVersion) String() string { return proto.CompactTextString(m) }
+func (*WasmContractVersion) ProtoMessage()    {}
+func (*WasmContractVersion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{8}
+}
+
+func (m *WasmContractVersion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmContractVersion.Unmarshal(m, b)
+}
+func (m *WasmContractVersion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmContractVersion.Marshal(b, m, deterministic)
+}
+func (m *WasmContractVersion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmContractVersion.Merge(m, src)
+}
+func (m *WasmContractVersion) XXX_Size() int {
+	return xxx_messageInfo_WasmContractVersion.Size(m)
+}
+func (m *WasmContractVersion) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmContractVersion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmContractVersion proto.InternalMessageInfo
+
+func (m *WasmContractVersion) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WasmContractVersion) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+func (m *WasmContractVersion) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *WasmContractVersion) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *WasmContractVersion) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+type WasmContractVersions struct {
+	Versions             []*WasmContractVersion `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *WasmContractVersions) Reset()         { *m = WasmContractVersions{} }
+func (m *WasmContractVersions) String() string { return proto.CompactTextString(m) }
+func (*WasmContractVersions) ProtoMessage()    {}
+func (*WasmContractVersions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{9}
+}
+
+func (m *WasmContractVersions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WasmContractVersions.Unmarshal(m, b)
+}
+func (m *WasmContractVersions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WasmContractVersions.Marshal(b, m, deterministic)
+}
+func (m *WasmContractVersions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WasmContractVersions.Merge(m, src)
+}
+func (m *WasmContractVersions) XXX_Size() int {
+	return xxx_messageInfo_WasmContractVersions.Size(m)
+}
+func (m *WasmContractVersions) XXX_DiscardUnknown() {
+	xxx_messageInfo_WasmContractVersions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WasmContractVersions proto.InternalMessageInfo
+
+func (m *WasmContractVersions) GetVersions() []*WasmContractVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
 type QueryCheckContract struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -222,7 +726,7 @@ func (m *QueryCheckContract) Reset()         { *m = QueryCheckContract{} }
 func (m *QueryCheckContract) String() string { return proto.CompactTextString(m) }
 func (*QueryCheckContract) ProtoMessage()    {}
 func (*QueryCheckContract) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d78909ad64e3bbb, []int{3}
+	return fileDescriptor_7d78909ad64e3bbb, []int{10}
 }
 
 func (m *QueryCheckContract) XXX_Unmarshal(b []byte) error {
@@ -250,6 +754,86 @@ func (m *QueryCheckContract) GetName() string {
 	return ""
 }
 
+// 只读调用合约，执行产生的数据不会保存，gasLimit为0时使用配置的gas上限
+type QueryReadContract struct {
+	Contract             string       `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Method               string       `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Parameters           []int64      `protobuf:"varint,3,rep,packed,name=parameters,proto3" json:"parameters,omitempty"`
+	Args                 []*WasmParam `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	Caller               string       `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	GasLimit             int64        `protobuf:"varint,6,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *QueryReadContract) Reset()         { *m = QueryReadContract{} }
+func (m *QueryReadContract) String() string { return proto.CompactTextString(m) }
+func (*QueryReadContract) ProtoMessage()    {}
+func (*QueryReadContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{11}
+}
+
+func (m *QueryReadContract) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryReadContract.Unmarshal(m, b)
+}
+func (m *QueryReadContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryReadContract.Marshal(b, m, deterministic)
+}
+func (m *QueryReadContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReadContract.Merge(m, src)
+}
+func (m *QueryReadContract) XXX_Size() int {
+	return xxx_messageInfo_QueryReadContract.Size(m)
+}
+func (m *QueryReadContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReadContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReadContract proto.InternalMessageInfo
+
+func (m *QueryReadContract) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryReadContract) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *QueryReadContract) GetParameters() []int64 {
+	if m != nil {
+		return m.Parameters
+	}
+	return nil
+}
+
+func (m *QueryReadContract) GetArgs() []*WasmParam {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *QueryReadContract) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *QueryReadContract) GetGasLimit() int64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 type CustomLog struct {
 	Info                 []string `protobuf:"bytes,1,rep,name=info,proto3" json:"info,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -261,7 +845,7 @@ func (m *CustomLog) Reset()         { *m = CustomLog{} }
 func (m *CustomLog) String() string { return proto.CompactTextString(m) }
 func (*CustomLog) ProtoMessage()    {}
 func (*CustomLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d78909ad64e3bbb, []int{4}
+	return fileDescriptor_7d78909ad64e3bbb, []int{12}
 }
 
 func (m *CustomLog) XXX_Unmarshal(b []byte) error {
@@ -301,7 +885,7 @@ func (m *CreateContractLog) Reset()         { *m = CreateContractLog{} }
 func (m *CreateContractLog) String() string { return proto.CompactTextString(m) }
 func (*CreateContractLog) ProtoMessage()    {}
 func (*CreateContractLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d78909ad64e3bbb, []int{5}
+	return fileDescriptor_7d78909ad64e3bbb, []int{13}
 }
 
 func (m *CreateContractLog) XXX_Unmarshal(b []byte) error {
@@ -340,6 +924,8 @@ type CallContractLog struct {
 	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 	Method               string   `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
 	Result               int32    `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"`
+	Ret                  []byte   `protobuf:"bytes,4,opt,name=ret,proto3" json:"ret,omitempty"`
+	GasUsed              int64    `protobuf:"varint,5,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -349,7 +935,7 @@ func (m *CallContractLog) Reset()         { *m = CallContractLog{} }
 func (m *CallContractLog) String() string { return proto.CompactTextString(m) }
 func (*CallContractLog) ProtoMessage()    {}
 func (*CallContractLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d78909ad64e3bbb, []int{6}
+	return fileDescriptor_7d78909ad64e3bbb, []int{14}
 }
 
 func (m *CallContractLog) XXX_Unmarshal(b []byte) error {
@@ -391,6 +977,321 @@ func (m *CallContractLog) GetResult() int32 {
 	return 0
 }
 
+func (m *CallContractLog) GetRet() []byte {
+	if m != nil {
+		return m.Ret
+	}
+	return nil
+}
+
+func (m *CallContractLog) GetGasUsed() int64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+type UpdateContractLog struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Version              int32    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	CodeHash             string   `protobuf:"bytes,3,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateContractLog) Reset()         { *m = UpdateContractLog{} }
+func (m *UpdateContractLog) String() string { return proto.CompactTextString(m) }
+func (*UpdateContractLog) ProtoMessage()    {}
+func (*UpdateContractLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{15}
+}
+
+func (m *UpdateContractLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateContractLog.Unmarshal(m, b)
+}
+func (m *UpdateContractLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateContractLog.Marshal(b, m, deterministic)
+}
+func (m *UpdateContractLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateContractLog.Merge(m, src)
+}
+func (m *UpdateContractLog) XXX_Size() int {
+	return xxx_messageInfo_UpdateContractLog.Size(m)
+}
+func (m *UpdateContractLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateContractLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateContractLog proto.InternalMessageInfo
+
+func (m *UpdateContractLog) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateContractLog) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *UpdateContractLog) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
+}
+
+type ContractAdminLog struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prev                 string   `protobuf:"bytes,2,opt,name=prev,proto3" json:"prev,omitempty"`
+	Current              string   `protobuf:"bytes,3,opt,name=current,proto3" json:"current,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContractAdminLog) Reset()         { *m = ContractAdminLog{} }
+func (m *ContractAdminLog) String() string { return proto.CompactTextString(m) }
+func (*ContractAdminLog) ProtoMessage()    {}
+func (*ContractAdminLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{16}
+}
+
+func (m *ContractAdminLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContractAdminLog.Unmarshal(m, b)
+}
+func (m *ContractAdminLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContractAdminLog.Marshal(b, m, deterministic)
+}
+func (m *ContractAdminLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractAdminLog.Merge(m, src)
+}
+func (m *ContractAdminLog) XXX_Size() int {
+	return xxx_messageInfo_ContractAdminLog.Size(m)
+}
+func (m *ContractAdminLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractAdminLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractAdminLog proto.InternalMessageInfo
+
+func (m *ContractAdminLog) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ContractAdminLog) GetPrev() string {
+	if m != nil {
+		return m.Prev
+	}
+	return ""
+}
+
+func (m *ContractAdminLog) GetCurrent() string {
+	if m != nil {
+		return m.Current
+	}
+	return ""
+}
+
+// 合约通过emitEvent产生的事件，height和txHash在保存到localdb时填写
+type EventLog struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Topic                string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Height               int64    `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	TxHash               string   `protobuf:"bytes,5,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventLog) Reset()         { *m = EventLog{} }
+func (m *EventLog) String() string { return proto.CompactTextString(m) }
+func (*EventLog) ProtoMessage()    {}
+func (*EventLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{17}
+}
+
+func (m *EventLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventLog.Unmarshal(m, b)
+}
+func (m *EventLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventLog.Marshal(b, m, deterministic)
+}
+func (m *EventLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLog.Merge(m, src)
+}
+func (m *EventLog) XXX_Size() int {
+	return xxx_messageInfo_EventLog.Size(m)
+}
+func (m *EventLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLog proto.InternalMessageInfo
+
+func (m *EventLog) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *EventLog) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *EventLog) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *EventLog) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EventLog) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+// topic为空时查询合约的所有事件
+type QueryEvents struct {
+	Contract             string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Topic                string   `protobuf:"bytes,2,opt,name=topic,proto3" json:"topic,omitempty"`
+	PrimaryKey           string   `protobuf:"bytes,3,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Direction            int32    `protobuf:"varint,5,opt,name=direction,proto3" json:"direction,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryEvents) Reset()         { *m = QueryEvents{} }
+func (m *QueryEvents) String() string { return proto.CompactTextString(m) }
+func (*QueryEvents) ProtoMessage()    {}
+func (*QueryEvents) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{18}
+}
+
+func (m *QueryEvents) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryEvents.Unmarshal(m, b)
+}
+func (m *QueryEvents) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryEvents.Marshal(b, m, deterministic)
+}
+func (m *QueryEvents) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvents.Merge(m, src)
+}
+func (m *QueryEvents) XXX_Size() int {
+	return xxx_messageInfo_QueryEvents.Size(m)
+}
+func (m *QueryEvents) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvents.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvents proto.InternalMessageInfo
+
+func (m *QueryEvents) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryEvents) GetTopic() string {
+	if m != nil {
+		return m.Topic
+	}
+	return ""
+}
+
+func (m *QueryEvents) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
+func (m *QueryEvents) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueryEvents) GetDirection() int32 {
+	if m != nil {
+		return m.Direction
+	}
+	return 0
+}
+
+type EventLogs struct {
+	Events               []*EventLog `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	PrimaryKey           string      `protobuf:"bytes,2,opt,name=primaryKey,proto3" json:"primaryKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *EventLogs) Reset()         { *m = EventLogs{} }
+func (m *EventLogs) String() string { return proto.CompactTextString(m) }
+func (*EventLogs) ProtoMessage()    {}
+func (*EventLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d78909ad64e3bbb, []int{19}
+}
+
+func (m *EventLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventLogs.Unmarshal(m, b)
+}
+func (m *EventLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventLogs.Marshal(b, m, deterministic)
+}
+func (m *EventLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLogs.Merge(m, src)
+}
+func (m *EventLogs) XXX_Size() int {
+	return xxx_messageInfo_EventLogs.Size(m)
+}
+func (m *EventLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLogs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLogs proto.InternalMessageInfo
+
+func (m *EventLogs) GetEvents() []*EventLog {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *EventLogs) GetPrimaryKey() string {
+	if m != nil {
+		return m.PrimaryKey
+	}
+	return ""
+}
+
 type LocalDataLog struct {
 	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
@@ -403,7 +1304,7 @@ func (m *LocalDataLog) Reset()         { *m = LocalDataLog{} }
 func (m *LocalDataLog) String() string { return proto.CompactTextString(m) }
 func (*LocalDataLog) ProtoMessage()    {}
 func (*LocalDataLog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7d78909ad64e3bbb, []int{7}
+	return fileDescriptor_7d78909ad64e3bbb, []int{20}
 }
 
 func (m *LocalDataLog) XXX_Unmarshal(b []byte) error {
@@ -442,10 +1343,23 @@ func init() {
 	proto.RegisterType((*WasmAction)(nil), "types.wasmAction")
 	proto.RegisterType((*WasmCreate)(nil), "types.wasmCreate")
 	proto.RegisterType((*WasmCall)(nil), "types.wasmCall")
+	proto.RegisterType((*WasmParam)(nil), "types.wasmParam")
+	proto.RegisterType((*WasmUpdate)(nil), "types.wasmUpdate")
+	proto.RegisterType((*WasmTransferAdmin)(nil), "types.wasmTransferAdmin")
+	proto.RegisterType((*WasmRenounce)(nil), "types.wasmRenounce")
+	proto.RegisterType((*WasmContractMeta)(nil), "types.wasmContractMeta")
+	proto.RegisterType((*WasmContractVersion)(nil), "types.wasmContractVersion")
+	proto.RegisterType((*WasmContractVersions)(nil), "types.wasmContractVersions")
 	proto.RegisterType((*QueryCheckContract)(nil), "types.queryCheckContract")
+	proto.RegisterType((*QueryReadContract)(nil), "types.queryReadContract")
 	proto.RegisterType((*CustomLog)(nil), "types.customLog")
 	proto.RegisterType((*CreateContractLog)(nil), "types.createContractLog")
 	proto.RegisterType((*CallContractLog)(nil), "types.callContractLog")
+	proto.RegisterType((*UpdateContractLog)(nil), "types.updateContractLog")
+	proto.RegisterType((*ContractAdminLog)(nil), "types.contractAdminLog")
+	proto.RegisterType((*EventLog)(nil), "types.eventLog")
+	proto.RegisterType((*QueryEvents)(nil), "types.queryEvents")
+	proto.RegisterType((*EventLogs)(nil), "types.eventLogs")
 	proto.RegisterType((*LocalDataLog)(nil), "types.localDataLog")
 }
 
@@ -454,26 +1368,60 @@ func init() {
 }
 
 var fileDescriptor_7d78909ad64e3bbb = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4f, 0x4b, 0xc3, 0x40,
-	0x10, 0xc5, 0x9b, 0xa6, 0x8d, 0xcd, 0x58, 0xac, 0x5d, 0xa4, 0x04, 0x0f, 0x1a, 0x16, 0x84, 0x80,
-	0xd0, 0x83, 0x8a, 0x17, 0x4f, 0x5a, 0x0f, 0x3d, 0x78, 0xda, 0xbb, 0xc2, 0xba, 0x5d, 0x6d, 0xe9,
-	0x26, 0x5b, 0x37, 0x13, 0x25, 0xdf, 0x5e, 0xf6, 0x4f, 0x25, 0x07, 0xf1, 0xe0, 0x6d, 0x66, 0xf6,
-	0x37, 0xef, 0x65, 0x66, 0x02, 0xf0, 0xc5, 0xeb, 0x72, 0xbe, 0x33, 0x1a, 0x35, 0x19, 0x62, 0xbb,
-	0x93, 0x35, 0x6d, 0x7d, 0xf1, 0x5e, 0xe0, 0x46, 0x57, 0xe4, 0x12, 0x12, 0x61, 0x24, 0x47, 0x99,
-	0x45, 0x79, 0x54, 0x1c, 0x5e, 0x4d, 0xe7, 0x8e, 0x9a, 0x5b, 0x64, 0xe1, 0x1e, 0x96, 0x3d, 0x16,
-	0x10, 0x72, 0x01, 0x03, 0xc1, 0x95, 0xca, 0xfa, 0x0e, 0x9d, 0x74, 0x51, 0xae, 0xd4, 0xb2, 0xc7,
-	0xdc, 0x33, 0x39, 0x82, 0x3e, 0xb6, 0x59, 0x9c, 0x47, 0xc5, 0x90, 0xf5, 0xb1, 0x7d, 0x38, 0x80,
-	0xe1, 0x27, 0x57, 0x8d, 0xa4, 0x37, 0xde, 0xda, 0xeb, 0x12, 0x02, 0x83, 0x8a, 0x97, 0xde, 0x38,
-	0x65, 0x2e, 0xb6, 0x35, 0xa1, 0x57, 0xd2, 0x39, 0x8c, 0x99, 0x8b, 0xe9, 0x0b, 0x8c, 0xf6, 0x16,
-	0xe4, 0x14, 0x46, 0x42, 0x57, 0x68, 0xb8, 0xc0, 0xd0, 0xf7, 0x93, 0x93, 0x19, 0x24, 0xa5, 0xc4,
-	0xb5, 0x5e, 0xb9, 0xee, 0x94, 0x85, 0x8c, 0x9c, 0x01, 0xec, 0xb8, 0xe1, 0xa5, 0x44, 0x69, 0xea,
-	0x2c, 0xce, 0xe3, 0x22, 0x66, 0x9d, 0x0a, 0x2d, 0x80, 0x7c, 0x34, 0xd2, 0xb4, 0x8b, 0xb5, 0x14,
-	0xdb, 0xc5, 0x5e, 0xed, 0x97, 0xaf, 0xa3, 0xe7, 0x90, 0x8a, 0xa6, 0x46, 0x5d, 0x3e, 0xe9, 0x77,
-	0x0b, 0x6c, 0xaa, 0x37, 0x9d, 0x45, 0x79, 0x6c, 0x01, 0x1b, 0xd3, 0x3b, 0x98, 0xfa, 0x55, 0xed,
-	0x65, 0x02, 0xf8, 0xe7, 0x9c, 0x69, 0x98, 0xf3, 0x19, 0x26, 0x76, 0x7d, 0xdd, 0xd6, 0xff, 0x8c,
-	0x3b, 0x83, 0xc4, 0xc8, 0xba, 0x51, 0x18, 0x2e, 0x10, 0x32, 0x7a, 0x0b, 0x63, 0xa5, 0x05, 0x57,
-	0x8f, 0x1c, 0xb9, 0xd5, 0x3e, 0x86, 0x78, 0x2b, 0x5b, 0x27, 0x3b, 0x66, 0x36, 0x24, 0x27, 0xe1,
-	0x4e, 0x61, 0xfb, 0x3e, 0x79, 0x4d, 0xdc, 0xdf, 0x73, 0xfd, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xc3,
-	0xc2, 0x9e, 0xbb, 0x4b, 0x02, 0x00, 0x00,
+	// 870 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8b, 0x23, 0x45,
+	0x14, 0x4f, 0xa7, 0xd3, 0x99, 0xe4, 0x4d, 0x74, 0x93, 0xda, 0x61, 0x68, 0x06, 0xd1, 0xa1, 0x50,
+	0x0c, 0x08, 0x03, 0xae, 0xb2, 0x17, 0x11, 0x5c, 0x57, 0x21, 0xe0, 0x2a, 0x52, 0xcc, 0x7a, 0xf3,
+	0x50, 0xd3, 0x5d, 0x9b, 0x34, 0xd3, 0xdd, 0xd5, 0x56, 0x55, 0x46, 0xfb, 0xe6, 0x41, 0x04, 0xcf,
+	0x1e, 0x3c, 0xf8, 0x69, 0xfc, 0x66, 0x52, 0xaf, 0xaa, 0x92, 0x9a, 0xdd, 0xec, 0x1f, 0xe6, 0xb2,
+	0xb7, 0xfa, 0xbd, 0xfa, 0xbd, 0x57, 0xef, 0x7f, 0x37, 0xc0, 0xaf, 0x5c, 0x37, 0x17, 0x9d, 0x92,
+	0x46, 0x92, 0xcc, 0xf4, 0x9d, 0xd0, 0xf4, 0xdf, 0xa1, 0x93, 0x3e, 0x2a, 0x4c, 0x25, 0x5b, 0xf2,
+	0x09, 0x8c, 0x0b, 0x25, 0xb8, 0x11, 0x79, 0x72, 0x9e, 0x2c, 0x8f, 0x1f, 0x2c, 0x2e, 0x90, 0x76,
+	0x61, 0x29, 0x8f, 0xf1, 0x62, 0x35, 0x60, 0x9e, 0x42, 0x3e, 0x82, 0x51, 0xc1, 0xeb, 0x3a, 0x1f,
+	0x22, 0xf5, 0x5e, 0x4c, 0xe5, 0x75, 0xbd, 0x1a, 0x30, 0xbc, 0xb6, 0x36, 0xb7, 0x5d, 0x69, 0x6d,
+	0x8e, 0x5e, 0xb0, 0xf9, 0xb4, 0x2b, 0xbd, 0x4d, 0x47, 0x21, 0x5f, 0xc1, 0x3b, 0x46, 0xf1, 0x56,
+	0x3f, 0x13, 0xea, 0x51, 0xd9, 0x54, 0x6d, 0x9e, 0xa1, 0x4e, 0x1e, 0xe9, 0x5c, 0xc6, 0xf7, 0xab,
+	0x01, 0xbb, 0xad, 0x40, 0x3e, 0x85, 0x89, 0x12, 0xad, 0xdc, 0xb6, 0x85, 0xc8, 0xc7, 0xa8, 0x7c,
+	0x3f, 0x52, 0x66, 0xfe, 0x6a, 0x35, 0x60, 0x3b, 0x1a, 0x79, 0x17, 0x86, 0xa6, 0xcf, 0xd3, 0xf3,
+	0x64, 0x99, 0xb1, 0xa1, 0xe9, 0xbf, 0x3e, 0x82, 0xec, 0x86, 0xd7, 0x5b, 0x41, 0x3f, 0x07, 0xd8,
+	0x47, 0x4e, 0x08, 0x8c, 0x5a, 0xde, 0xb8, 0xd4, 0x4c, 0x19, 0x9e, 0xad, 0xac, 0x90, 0xa5, 0xc0,
+	0x1c, 0xcc, 0x18, 0x9e, 0xe9, 0x1f, 0x09, 0x4c, 0x42, 0x16, 0xc8, 0x19, 0x4c, 0x0a, 0xd9, 0x1a,
+	0xc5, 0x0b, 0xe3, 0x15, 0x77, 0x98, 0x9c, 0xc2, 0xb8, 0x11, 0x66, 0x23, 0x4b, 0x54, 0x9f, 0x32,
+	0x8f, 0xc8, 0xfb, 0x00, 0x1d, 0x57, 0xbc, 0x11, 0x46, 0x28, 0x9d, 0xa7, 0xe7, 0xe9, 0x32, 0x65,
+	0x91, 0x84, 0x7c, 0x08, 0x23, 0xae, 0xd6, 0x3a, 0x1f, 0x9d, 0xa7, 0xcb, 0xe3, 0x07, 0xf3, 0x28,
+	0xbc, 0x1f, 0x2d, 0x89, 0xe1, 0x2d, 0xfd, 0x33, 0x81, 0xe9, 0x4e, 0x46, 0x4e, 0x21, 0xbb, 0xea,
+	0x8d, 0xd0, 0xe8, 0xc4, 0x6c, 0x35, 0x60, 0x0e, 0x12, 0x02, 0xa9, 0x36, 0xca, 0x39, 0xb0, 0x1a,
+	0x30, 0x0b, 0xc8, 0x19, 0x1c, 0xf1, 0xb2, 0x54, 0x42, 0xeb, 0x3c, 0xf5, 0xf2, 0x20, 0xb0, 0xfc,
+	0xaa, 0x35, 0x58, 0xca, 0xd4, 0xf2, 0xab, 0xd6, 0x90, 0x13, 0x18, 0x5d, 0x49, 0x59, 0x63, 0xad,
+	0x26, 0xb6, 0xee, 0x16, 0xed, 0xb3, 0x68, 0x00, 0xf6, 0xb5, 0x7e, 0xd3, 0x2c, 0x92, 0x1c, 0x8e,
+	0x9a, 0x6a, 0xad, 0x6c, 0xdf, 0x58, 0x27, 0x26, 0x2c, 0xc0, 0x37, 0x0c, 0xff, 0x4b, 0x58, 0xbc,
+	0xd0, 0x2d, 0x07, 0x1f, 0x3f, 0x81, 0x8c, 0xdb, 0x4b, 0x5f, 0x04, 0x07, 0x28, 0x85, 0x59, 0xdc,
+	0x2f, 0x87, 0x34, 0x69, 0x07, 0x73, 0xac, 0xb3, 0xaf, 0xe7, 0xf7, 0xc2, 0xf0, 0x83, 0x2f, 0xe4,
+	0x70, 0x84, 0x23, 0x23, 0x7d, 0x9e, 0x59, 0x80, 0xfb, 0xb7, 0xd3, 0xe8, 0x6d, 0xcb, 0xbf, 0x11,
+	0x4a, 0x57, 0xb2, 0xc5, 0x3c, 0x67, 0x2c, 0x40, 0xfa, 0x4f, 0x02, 0xf7, 0xe3, 0x27, 0x7f, 0x72,
+	0xf2, 0x58, 0x23, 0xb9, 0xa5, 0xe1, 0xfa, 0xaf, 0x14, 0x2b, 0xae, 0x37, 0xfe, 0xf1, 0x1d, 0xb6,
+	0xfd, 0xb7, 0x11, 0xd5, 0x7a, 0x63, 0xf0, 0xf9, 0x94, 0x79, 0x64, 0xe5, 0xe6, 0x37, 0xd4, 0x18,
+	0xb9, 0xbe, 0x74, 0xc8, 0xda, 0x92, 0x9d, 0x50, 0x18, 0x48, 0xe6, 0x6c, 0x05, 0x4c, 0x7f, 0x80,
+	0x93, 0x03, 0x8e, 0x69, 0xf2, 0x10, 0x26, 0xde, 0x15, 0xdb, 0x7a, 0xb6, 0x60, 0x67, 0xf1, 0xa2,
+	0xb8, 0x4d, 0x67, 0x3b, 0x2e, 0x5d, 0x02, 0xf9, 0x65, 0x2b, 0x54, 0xff, 0x78, 0x23, 0x8a, 0xeb,
+	0x40, 0x3b, 0x58, 0x85, 0xff, 0x12, 0x58, 0x20, 0x95, 0x09, 0x5e, 0xee, 0x98, 0x6f, 0x6d, 0xee,
+	0xac, 0x75, 0xbb, 0xf7, 0x44, 0xc8, 0x91, 0x47, 0xd6, 0xa3, 0x35, 0xd7, 0x4f, 0xaa, 0xa6, 0x32,
+	0xb8, 0x98, 0x52, 0xb6, 0xc3, 0xf4, 0x03, 0x98, 0x16, 0x5b, 0x6d, 0x64, 0xf3, 0x44, 0xae, 0x6d,
+	0x90, 0x55, 0xfb, 0x4c, 0x62, 0xba, 0xa6, 0x0c, 0xcf, 0xf4, 0x0b, 0x58, 0xb8, 0xad, 0x1b, 0x02,
+	0xf4, 0xc4, 0x57, 0x8e, 0xd2, 0xd4, 0x2f, 0xa4, 0xbf, 0x12, 0xb8, 0x67, 0x9d, 0x88, 0x75, 0xef,
+	0x92, 0x9f, 0x53, 0x18, 0x2b, 0xa1, 0xb7, 0xb5, 0xf1, 0xbb, 0xd2, 0x23, 0x32, 0x87, 0x54, 0x09,
+	0xb7, 0x13, 0x66, 0xcc, 0x1e, 0x6d, 0x3f, 0xae, 0xb9, 0x7e, 0xaa, 0x45, 0x89, 0x49, 0x48, 0x59,
+	0x80, 0xf4, 0x67, 0x58, 0xb8, 0x55, 0xff, 0xba, 0x40, 0xa2, 0x96, 0x1e, 0xbe, 0xbc, 0xa5, 0xd3,
+	0xdb, 0x2d, 0x4d, 0x2f, 0x61, 0x1e, 0xc2, 0xc0, 0x89, 0x7f, 0x45, 0x9a, 0x3a, 0x25, 0x6e, 0x42,
+	0x9a, 0xec, 0x19, 0xc7, 0x74, 0xab, 0x94, 0x68, 0x8d, 0x37, 0x1b, 0x20, 0xfd, 0x3d, 0x81, 0x89,
+	0xb8, 0x11, 0xed, 0x6b, 0x33, 0x77, 0x02, 0x99, 0x91, 0x5d, 0x55, 0x84, 0x5d, 0x82, 0xc0, 0x3e,
+	0x56, 0x72, 0xc3, 0xd1, 0xea, 0x8c, 0xe1, 0x39, 0x9a, 0xbd, 0xd1, 0x4b, 0x66, 0x2f, 0x8b, 0x67,
+	0x8f, 0xfe, 0x9d, 0xc0, 0x31, 0x76, 0xf9, 0xb7, 0xd6, 0x0f, 0x7d, 0x07, 0x2f, 0x6c, 0x77, 0xab,
+	0xaa, 0xe1, 0xaa, 0xff, 0x4e, 0xf4, 0x3e, 0xc2, 0x48, 0x62, 0xb5, 0x0a, 0xb9, 0xf5, 0xbb, 0x3d,
+	0x63, 0x0e, 0x90, 0xf7, 0x60, 0x5a, 0x56, 0x4a, 0xe0, 0xef, 0x01, 0xba, 0x94, 0xb1, 0xbd, 0x80,
+	0x5e, 0xc2, 0x34, 0xe4, 0x45, 0x93, 0x8f, 0x61, 0x8c, 0x20, 0x0c, 0x7a, 0xf8, 0x23, 0x08, 0x0c,
+	0xe6, 0xaf, 0x9f, 0xf3, 0x64, 0xf8, 0xbc, 0x27, 0xf4, 0x21, 0xcc, 0x6a, 0x59, 0xf0, 0xfa, 0x1b,
+	0x6e, 0xb8, 0xcd, 0xf8, 0x1c, 0xd2, 0x6b, 0xd1, 0xbb, 0x2f, 0x17, 0x4b, 0xaf, 0x9d, 0xaf, 0xf8,
+	0x6d, 0xf1, 0x5f, 0x0c, 0x07, 0xae, 0xc6, 0xf8, 0x6b, 0xf3, 0xd9, 0xff, 0x03, 0x00, 0xea, 0x36,
+	0xc6, 0x61, 0xe8, 0x08, 0x00, 0x00,
 }